@@ -16,6 +16,7 @@ func InitializeAPI() *server.EchoServer {
 		RepositorySet,
 		ServiceSet,
 		MiddlewareSet,
+		JobSet,
 		server.NewEchoServer,
 	)
 
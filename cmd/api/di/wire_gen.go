@@ -10,16 +10,50 @@ import (
 	"github.com/graphzc/sdd-task-management-example/cmd/api/server"
 	"github.com/graphzc/sdd-task-management-example/internal/config"
 	"github.com/graphzc/sdd-task-management-example/internal/handlers"
+	attachment3 "github.com/graphzc/sdd-task-management-example/internal/handlers/attachment"
 	"github.com/graphzc/sdd-task-management-example/internal/handlers/auth"
+	calendarfeed3 "github.com/graphzc/sdd-task-management-example/internal/handlers/calendarfeed"
+	comment3 "github.com/graphzc/sdd-task-management-example/internal/handlers/comment"
 	"github.com/graphzc/sdd-task-management-example/internal/handlers/common"
+	customfield3 "github.com/graphzc/sdd-task-management-example/internal/handlers/customfield"
+	project3 "github.com/graphzc/sdd-task-management-example/internal/handlers/project"
+	tag3 "github.com/graphzc/sdd-task-management-example/internal/handlers/tag"
 	task3 "github.com/graphzc/sdd-task-management-example/internal/handlers/task"
+	timeentry3 "github.com/graphzc/sdd-task-management-example/internal/handlers/timeentry"
+	webhook3 "github.com/graphzc/sdd-task-management-example/internal/handlers/webhook"
+	workflow3 "github.com/graphzc/sdd-task-management-example/internal/handlers/workflow"
+	workspace3 "github.com/graphzc/sdd-task-management-example/internal/handlers/workspace"
 	"github.com/graphzc/sdd-task-management-example/internal/infrastructure/context"
 	"github.com/graphzc/sdd-task-management-example/internal/infrastructure/database"
+	"github.com/graphzc/sdd-task-management-example/internal/infrastructure/storage"
+	"github.com/graphzc/sdd-task-management-example/internal/jobs"
 	"github.com/graphzc/sdd-task-management-example/internal/middlewares"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/activity"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/attachment"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/calendarfeed"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/comment"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/customfield"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/project"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/tag"
 	"github.com/graphzc/sdd-task-management-example/internal/repositories/task"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/timeentry"
 	"github.com/graphzc/sdd-task-management-example/internal/repositories/user"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/webhook"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/workflow"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/workspace"
+	attachment2 "github.com/graphzc/sdd-task-management-example/internal/services/attachment"
+	"github.com/graphzc/sdd-task-management-example/internal/services/authz"
+	calendarfeed2 "github.com/graphzc/sdd-task-management-example/internal/services/calendarfeed"
+	comment2 "github.com/graphzc/sdd-task-management-example/internal/services/comment"
+	customfield2 "github.com/graphzc/sdd-task-management-example/internal/services/customfield"
+	project2 "github.com/graphzc/sdd-task-management-example/internal/services/project"
+	tag2 "github.com/graphzc/sdd-task-management-example/internal/services/tag"
 	task2 "github.com/graphzc/sdd-task-management-example/internal/services/task"
+	timeentry2 "github.com/graphzc/sdd-task-management-example/internal/services/timeentry"
 	user2 "github.com/graphzc/sdd-task-management-example/internal/services/user"
+	webhook2 "github.com/graphzc/sdd-task-management-example/internal/services/webhook"
+	workflow2 "github.com/graphzc/sdd-task-management-example/internal/services/workflow"
+	workspace2 "github.com/graphzc/sdd-task-management-example/internal/services/workspace"
 )
 
 // Injectors from wire.go:
@@ -33,10 +67,47 @@ func InitializeAPI() *server.EchoServer {
 	service := user2.NewService(configConfig, repository)
 	authHandler := auth.New(service)
 	taskRepository := task.NewRepository(db)
-	taskService := task2.NewService(configConfig, taskRepository)
+	tagRepository := tag.NewRepository(db)
+	projectRepository := project.NewRepository(db)
+	customfieldRepository := customfield.NewRepository(db)
+	workflowRepository := workflow.NewRepository(db)
+	workspaceRepository := workspace.NewRepository(db)
+	activityRepository := activity.NewRepository(db)
+	policy := authz.NewPolicy(workspaceRepository)
+	transactor := database.NewTransactor(db)
+	webhookRepository := webhook.NewRepository(db)
+	webhookService := webhook2.NewService(configConfig, webhookRepository, taskRepository, policy)
+	attachmentRepository := attachment.NewRepository(db)
+	blobStore := storage.NewBlobStore(contextContext, configConfig)
+	attachmentService := attachment2.NewService(configConfig, attachmentRepository, taskRepository, policy, blobStore)
+	taskService := task2.NewService(configConfig, taskRepository, tagRepository, projectRepository, customfieldRepository, workflowRepository, repository, activityRepository, webhookService, attachmentService, policy, transactor)
 	taskHandler := task3.New(taskService)
-	handlersHandlers := handlers.NewHandlers(handler, authHandler, taskHandler)
+	tagService := tag2.NewService(configConfig, tagRepository)
+	tagHandler := tag3.New(tagService)
+	projectService := project2.NewService(configConfig, projectRepository)
+	projectHandler := project3.New(projectService)
+	workspaceService := workspace2.NewService(configConfig, workspaceRepository, repository, policy)
+	workspaceHandler := workspace3.New(workspaceService)
+	commentRepository := comment.NewRepository(db)
+	commentService := comment2.NewService(configConfig, commentRepository, taskService)
+	commentHandler := comment3.New(commentService)
+	attachmentHandler := attachment3.New(attachmentService)
+	timeentryRepository := timeentry.NewRepository(db)
+	timeentryService := timeentry2.NewService(configConfig, timeentryRepository, taskService)
+	timeentryHandler := timeentry3.New(timeentryService)
+	customfieldService := customfield2.NewService(configConfig, customfieldRepository, projectRepository, policy)
+	customfieldHandler := customfield3.New(customfieldService)
+	workflowService := workflow2.NewService(configConfig, workflowRepository, projectRepository, policy, transactor)
+	workflowHandler := workflow3.New(workflowService)
+	calendarfeedRepository := calendarfeed.NewRepository(db)
+	calendarfeedService := calendarfeed2.NewService(configConfig, calendarfeedRepository, taskService)
+	calendarfeedHandler := calendarfeed3.New(calendarfeedService)
+	webhookHandler := webhook3.New(webhookService)
+	handlersHandlers := handlers.NewHandlers(handler, authHandler, taskHandler, tagHandler, projectHandler, workspaceHandler, commentHandler, attachmentHandler, timeentryHandler, customfieldHandler, workflowHandler, calendarfeedHandler, webhookHandler)
 	authMiddleware := middlewares.NewAuthMiddleware(configConfig)
-	echoServer := server.NewEchoServer(configConfig, handlersHandlers, authMiddleware)
+	feedTokenMiddleware := middlewares.NewFeedTokenMiddleware(calendarfeedService)
+	trashPurgeJob := jobs.NewTrashPurgeJob(configConfig, taskService)
+	webhookDeliveryJob := jobs.NewWebhookDeliveryJob(configConfig, webhookService)
+	echoServer := server.NewEchoServer(configConfig, handlersHandlers, authMiddleware, feedTokenMiddleware, trashPurgeJob, webhookDeliveryJob)
 	return echoServer
 }
@@ -5,16 +5,50 @@ package di
 import (
 	config "github.com/graphzc/sdd-task-management-example/internal/config"
 	handlers "github.com/graphzc/sdd-task-management-example/internal/handlers"
+	attachment "github.com/graphzc/sdd-task-management-example/internal/handlers/attachment"
 	auth "github.com/graphzc/sdd-task-management-example/internal/handlers/auth"
+	calendarfeed "github.com/graphzc/sdd-task-management-example/internal/handlers/calendarfeed"
+	comment "github.com/graphzc/sdd-task-management-example/internal/handlers/comment"
 	common "github.com/graphzc/sdd-task-management-example/internal/handlers/common"
+	customfield "github.com/graphzc/sdd-task-management-example/internal/handlers/customfield"
+	project "github.com/graphzc/sdd-task-management-example/internal/handlers/project"
+	tag "github.com/graphzc/sdd-task-management-example/internal/handlers/tag"
 	task "github.com/graphzc/sdd-task-management-example/internal/handlers/task"
+	timeentry "github.com/graphzc/sdd-task-management-example/internal/handlers/timeentry"
+	webhook "github.com/graphzc/sdd-task-management-example/internal/handlers/webhook"
+	workflow "github.com/graphzc/sdd-task-management-example/internal/handlers/workflow"
+	workspace "github.com/graphzc/sdd-task-management-example/internal/handlers/workspace"
 	context "github.com/graphzc/sdd-task-management-example/internal/infrastructure/context"
 	database "github.com/graphzc/sdd-task-management-example/internal/infrastructure/database"
+	storage "github.com/graphzc/sdd-task-management-example/internal/infrastructure/storage"
+	jobs "github.com/graphzc/sdd-task-management-example/internal/jobs"
 	middlewares "github.com/graphzc/sdd-task-management-example/internal/middlewares"
+	activity "github.com/graphzc/sdd-task-management-example/internal/repositories/activity"
+	attachment2 "github.com/graphzc/sdd-task-management-example/internal/repositories/attachment"
+	calendarfeed2 "github.com/graphzc/sdd-task-management-example/internal/repositories/calendarfeed"
+	comment2 "github.com/graphzc/sdd-task-management-example/internal/repositories/comment"
+	customfield2 "github.com/graphzc/sdd-task-management-example/internal/repositories/customfield"
+	project2 "github.com/graphzc/sdd-task-management-example/internal/repositories/project"
+	tag2 "github.com/graphzc/sdd-task-management-example/internal/repositories/tag"
 	task2 "github.com/graphzc/sdd-task-management-example/internal/repositories/task"
+	timeentry2 "github.com/graphzc/sdd-task-management-example/internal/repositories/timeentry"
 	user "github.com/graphzc/sdd-task-management-example/internal/repositories/user"
+	webhook2 "github.com/graphzc/sdd-task-management-example/internal/repositories/webhook"
+	workflow2 "github.com/graphzc/sdd-task-management-example/internal/repositories/workflow"
+	workspace2 "github.com/graphzc/sdd-task-management-example/internal/repositories/workspace"
+	attachment3 "github.com/graphzc/sdd-task-management-example/internal/services/attachment"
+	authz "github.com/graphzc/sdd-task-management-example/internal/services/authz"
+	calendarfeed3 "github.com/graphzc/sdd-task-management-example/internal/services/calendarfeed"
+	comment3 "github.com/graphzc/sdd-task-management-example/internal/services/comment"
+	customfield3 "github.com/graphzc/sdd-task-management-example/internal/services/customfield"
+	project3 "github.com/graphzc/sdd-task-management-example/internal/services/project"
+	tag3 "github.com/graphzc/sdd-task-management-example/internal/services/tag"
 	task3 "github.com/graphzc/sdd-task-management-example/internal/services/task"
+	timeentry3 "github.com/graphzc/sdd-task-management-example/internal/services/timeentry"
 	user2 "github.com/graphzc/sdd-task-management-example/internal/services/user"
+	webhook3 "github.com/graphzc/sdd-task-management-example/internal/services/webhook"
+	workflow3 "github.com/graphzc/sdd-task-management-example/internal/services/workflow"
+	workspace3 "github.com/graphzc/sdd-task-management-example/internal/services/workspace"
 
 	"github.com/google/wire"
 )
@@ -25,26 +59,66 @@ var ConfigSet = wire.NewSet(
 
 var HandlerSet = wire.NewSet(
 	handlers.NewHandlers,
+	attachment.New,
 	auth.New,
+	calendarfeed.New,
+	comment.New,
 	common.New,
+	customfield.New,
+	project.New,
+	tag.New,
 	task.New,
+	timeentry.New,
+	webhook.New,
+	workflow.New,
+	workspace.New,
 )
 
 var InfrastructureSet = wire.NewSet(
 	context.NewContext,
 	database.NewSQLXClient,
+	database.NewTransactor,
+	storage.NewBlobStore,
+)
+
+var JobSet = wire.NewSet(
+	jobs.NewTrashPurgeJob,
+	jobs.NewWebhookDeliveryJob,
 )
 
 var MiddlewareSet = wire.NewSet(
 	middlewares.NewAuthMiddleware,
+	middlewares.NewFeedTokenMiddleware,
 )
 
 var RepositorySet = wire.NewSet(
+	activity.NewRepository,
+	attachment2.NewRepository,
+	calendarfeed2.NewRepository,
+	comment2.NewRepository,
+	customfield2.NewRepository,
+	project2.NewRepository,
+	tag2.NewRepository,
 	task2.NewRepository,
+	timeentry2.NewRepository,
 	user.NewRepository,
+	webhook2.NewRepository,
+	workflow2.NewRepository,
+	workspace2.NewRepository,
 )
 
 var ServiceSet = wire.NewSet(
+	attachment3.NewService,
+	authz.NewPolicy,
+	calendarfeed3.NewService,
+	comment3.NewService,
+	customfield3.NewService,
+	project3.NewService,
+	tag3.NewService,
 	task3.NewService,
+	timeentry3.NewService,
 	user2.NewService,
+	webhook3.NewService,
+	workflow3.NewService,
+	workspace3.NewService,
 )
@@ -1,12 +1,15 @@
 package server
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/graphzc/sdd-task-management-example/internal/config"
 	"github.com/graphzc/sdd-task-management-example/internal/handlers"
+	"github.com/graphzc/sdd-task-management-example/internal/jobs"
 	"github.com/graphzc/sdd-task-management-example/internal/middlewares"
 	"github.com/graphzc/sdd-task-management-example/internal/router"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
 	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
 	"github.com/graphzc/sdd-task-management-example/internal/utils/validator"
 	"github.com/labstack/echo/v4"
@@ -14,26 +17,37 @@ import (
 )
 
 type EchoServer struct {
-	config         *config.Config
-	handlers       *handlers.Handlers
-	authMiddleware middlewares.AuthMiddleware
+	config              *config.Config
+	handlers            *handlers.Handlers
+	authMiddleware      middlewares.AuthMiddleware
+	feedTokenMiddleware middlewares.FeedTokenMiddleware
+	trashPurgeJob       *jobs.TrashPurgeJob
+	webhookDeliveryJob  *jobs.WebhookDeliveryJob
 }
 
 func NewEchoServer(
 	config *config.Config,
 	handlers *handlers.Handlers,
 	authMiddleware middlewares.AuthMiddleware,
+	feedTokenMiddleware middlewares.FeedTokenMiddleware,
+	trashPurgeJob *jobs.TrashPurgeJob,
+	webhookDeliveryJob *jobs.WebhookDeliveryJob,
 ) *EchoServer {
 	return &EchoServer{
-		config:         config,
-		handlers:       handlers,
-		authMiddleware: authMiddleware,
+		config:              config,
+		handlers:            handlers,
+		authMiddleware:      authMiddleware,
+		feedTokenMiddleware: feedTokenMiddleware,
+		trashPurgeJob:       trashPurgeJob,
+		webhookDeliveryJob:  webhookDeliveryJob,
 	}
 }
 
 func (s *EchoServer) Start() error {
 	e := echo.New()
 
+	e.Binder = echoutil.NewBinder()
+
 	e.Validator = validator.NewValidator()
 
 	e.HTTPErrorHandler = servererr.EchoHTTPErrorHandler
@@ -42,12 +56,17 @@ func (s *EchoServer) Start() error {
 		AllowOrigins:     s.config.CORS.AllowOrigins,
 		AllowMethods:     []string{echo.GET, echo.POST, echo.PUT, echo.DELETE, echo.PATCH},
 		AllowCredentials: true,
-		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization},
+		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, "If-Match"},
+		ExposeHeaders:    []string{"ETag"},
 	}))
 
-	router := router.NewRouter(e, s.handlers, s.authMiddleware)
+	router := router.NewRouter(s.config, e, s.handlers, s.authMiddleware, s.feedTokenMiddleware)
 
 	router.RegisterAPIRoutes()
 
+	// Background jobs
+	go s.trashPurgeJob.Run(context.Background())
+	go s.webhookDeliveryJob.Run(context.Background())
+
 	return e.Start(fmt.Sprintf(":%s", s.config.Port))
 }
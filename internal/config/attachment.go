@@ -0,0 +1,9 @@
+package config
+
+type Attachment struct {
+	// StorageDriver selects the blob store, either "local" or "gcs"
+	StorageDriver    string   `env:"STORAGE_DRIVER" envDefault:"local"`
+	LocalDir         string   `env:"LOCAL_DIR" envDefault:"./uploads"`
+	MaxSizeBytes     int64    `env:"MAX_SIZE_BYTES" envDefault:"10485760"`
+	AllowedMIMETypes []string `env:"ALLOWED_MIME_TYPES" envSeparator:"," envDefault:"image/png,image/jpeg,image/gif,image/webp,application/pdf,text/plain,application/zip"`
+}
@@ -0,0 +1,6 @@
+package config
+
+type CalendarFeed struct {
+	// BaseURL is the public address of the API feed URLs are built on
+	BaseURL string `env:"BASE_URL" envDefault:"http://localhost:8080"`
+}
@@ -7,14 +7,18 @@ import (
 )
 
 type Config struct {
-	AllowOrigins         []string `env:"ALLOW_ORIGINS" envSeparator:","`
-	LogFormat            string   `env:"LOG_FORMAT"`
-	Port                 string   `env:"PORT"`
-	JWT                  JWT      `envPrefix:"JWT_"`
-	CORS                 CORS     `envPrefix:"CORS_"`
-	Database             Database `envPrefix:"DATABASE_"`
-	GoogleAppCredentials string   `env:"GOOGLE_APP_CREDENTIALS"`
-	UploadSlipBucket     string   `env:"UPLOAD_SLIP_BUCKET"`
+	AllowOrigins         []string     `env:"ALLOW_ORIGINS" envSeparator:","`
+	LogFormat            string       `env:"LOG_FORMAT"`
+	Port                 string       `env:"PORT"`
+	JWT                  JWT          `envPrefix:"JWT_"`
+	CORS                 CORS         `envPrefix:"CORS_"`
+	Database             Database     `envPrefix:"DATABASE_"`
+	Task                 Task         `envPrefix:"TASK_"`
+	Attachment           Attachment   `envPrefix:"ATTACHMENT_"`
+	CalendarFeed         CalendarFeed `envPrefix:"CALENDAR_FEED_"`
+	Webhook              Webhook      `envPrefix:"WEBHOOK_"`
+	GoogleAppCredentials string       `env:"GOOGLE_APP_CREDENTIALS"`
+	UploadSlipBucket     string       `env:"UPLOAD_SLIP_BUCKET"`
 }
 
 // @WireSet("Config")
@@ -0,0 +1,13 @@
+package config
+
+import "time"
+
+type Task struct {
+	// BlockInProgress also prevents starting a task while its blockers are open
+	BlockInProgress bool `env:"BLOCK_IN_PROGRESS" envDefault:"false"`
+	// TrashRetention is how long deleted tasks stay in the trash before being purged
+	TrashRetention     time.Duration `env:"TRASH_RETENTION" envDefault:"720h"`
+	TrashPurgeInterval time.Duration `env:"TRASH_PURGE_INTERVAL" envDefault:"1h"`
+	// BoardColumnLimit caps the tasks returned per column of the board
+	BoardColumnLimit int `env:"BOARD_COLUMN_LIMIT" envDefault:"200"`
+}
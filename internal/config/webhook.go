@@ -0,0 +1,18 @@
+package config
+
+import "time"
+
+type Webhook struct {
+	// DeliveryInterval is how often pending deliveries are looked for
+	DeliveryInterval  time.Duration `env:"DELIVERY_INTERVAL" envDefault:"5s"`
+	DeliveryBatchSize int           `env:"DELIVERY_BATCH_SIZE" envDefault:"20"`
+	// Timeout bounds a single delivery attempt
+	Timeout time.Duration `env:"TIMEOUT" envDefault:"10s"`
+	// MaxAttempts is how many times a delivery is tried before it fails,
+	// waiting RetryBaseDelay doubled on every retry up to RetryMaxDelay
+	MaxAttempts    int           `env:"MAX_ATTEMPTS" envDefault:"8"`
+	RetryBaseDelay time.Duration `env:"RETRY_BASE_DELAY" envDefault:"30s"`
+	RetryMaxDelay  time.Duration `env:"RETRY_MAX_DELAY" envDefault:"1h"`
+	// DisableAfterFailures disables a webhook once this many deliveries in a row failed
+	DisableAfterFailures int `env:"DISABLE_AFTER_FAILURES" envDefault:"5"`
+}
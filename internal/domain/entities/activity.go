@@ -0,0 +1,27 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+)
+
+// TaskActivity is an immutable record of a change made to a task
+type TaskActivity struct {
+	ID        string                   `json:"id" db:"id"`
+	TaskID    string                   `json:"taskId" db:"task_id"`
+	ActorID   string                   `json:"actorId" db:"actor_id"`
+	Action    enums.TaskActivityAction `json:"action" db:"action"`
+	Changes   []TaskFieldChange        `json:"changes" db:"changes"`
+	CreatedAt time.Time                `json:"createdAt" db:"created_at"`
+
+	// Actor describes the user who made the change, nil once the account is gone
+	Actor *UserSummary `json:"actor,omitempty" db:"-"`
+}
+
+// TaskFieldChange holds the value of a single task field before and after a change
+type TaskFieldChange struct {
+	Field  string `json:"field"`
+	Before any    `json:"before"`
+	After  any    `json:"after"`
+}
@@ -0,0 +1,14 @@
+package entities
+
+import "time"
+
+type Attachment struct {
+	ID          string    `json:"id" db:"id"`
+	TaskID      string    `json:"taskId" db:"task_id"`
+	UserID      string    `json:"userId" db:"user_id"`
+	FileName    string    `json:"fileName" db:"file_name"`
+	ContentType string    `json:"contentType" db:"content_type"`
+	Size        int64     `json:"size" db:"size"`
+	ContentHash string    `json:"contentHash" db:"content_hash"`
+	CreatedAt   time.Time `json:"createdAt" db:"created_at"`
+}
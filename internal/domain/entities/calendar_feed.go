@@ -0,0 +1,11 @@
+package entities
+
+import "time"
+
+// CalendarFeed is the secret iCalendar feed of a user. Only a hash of its
+// token is kept, the token itself is shown once when the feed is created.
+type CalendarFeed struct {
+	UserID    string    `json:"userId" db:"user_id"`
+	TokenHash string    `json:"-" db:"token_hash"`
+	CreatedAt time.Time `json:"createdAt" db:"created_at"`
+}
@@ -0,0 +1,21 @@
+package entities
+
+import "time"
+
+type Comment struct {
+	ID        string     `json:"id" db:"id"`
+	TaskID    string     `json:"taskId" db:"task_id"`
+	UserID    string     `json:"userId" db:"user_id"`
+	Body      string     `json:"body" db:"body"`
+	CreatedAt time.Time  `json:"createdAt" db:"created_at"`
+	UpdatedAt time.Time  `json:"updatedAt" db:"updated_at"`
+	EditedAt  *time.Time `json:"editedAt,omitempty" db:"edited_at"`
+
+	// Author describes the user who wrote the comment
+	Author *UserSummary `json:"author,omitempty" db:"-"`
+}
+
+// IsEdited reports whether the comment body was changed after it was posted
+func (c *Comment) IsEdited() bool {
+	return c.EditedAt != nil
+}
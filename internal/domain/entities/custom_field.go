@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+)
+
+// CustomFieldMaxTextLength caps text values, and the maximum length a text field may set
+const CustomFieldMaxTextLength = 1000
+
+// CustomField is an attribute defined by a workspace or a project for its tasks.
+// Exactly one of WorkspaceID and ProjectID is set.
+type CustomField struct {
+	ID          string                `json:"id" db:"id"`
+	WorkspaceID *string               `json:"workspaceId" db:"workspace_id"`
+	ProjectID   *string               `json:"projectId" db:"project_id"`
+	Name        string                `json:"name" db:"name"`
+	Type        enums.CustomFieldType `json:"type" db:"type"`
+	Required    bool                  `json:"required" db:"required"`
+	// Options lists the choices of select fields
+	Options []string `json:"options" db:"options"`
+	// Min and Max bound number values, or the length of text values
+	Min       *float64  `json:"min" db:"min_value"`
+	Max       *float64  `json:"max" db:"max_value"`
+	CreatedAt time.Time `json:"createdAt" db:"created_at"`
+	UpdatedAt time.Time `json:"updatedAt" db:"updated_at"`
+}
+
+// TaskCustomFieldValue is the value a task holds for a custom field, encoded as JSON
+type TaskCustomFieldValue struct {
+	FieldID string                `json:"fieldId" db:"field_id"`
+	Name    string                `json:"name" db:"-"`
+	Type    enums.CustomFieldType `json:"type" db:"-"`
+	Value   json.RawMessage       `json:"value" db:"value"`
+}
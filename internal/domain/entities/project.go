@@ -0,0 +1,17 @@
+package entities
+
+import "time"
+
+type Project struct {
+	ID          string     `json:"id" db:"id"`
+	UserID      string     `json:"userId" db:"user_id"`
+	Name        string     `json:"name" db:"name"`
+	Description string     `json:"description" db:"description"`
+	ArchivedAt  *time.Time `json:"archivedAt" db:"archived_at"`
+	CreatedAt   time.Time  `json:"createdAt" db:"created_at"`
+	UpdatedAt   time.Time  `json:"updatedAt" db:"updated_at"`
+}
+
+func (p *Project) IsArchived() bool {
+	return p.ArchivedAt != nil
+}
@@ -9,10 +9,98 @@ import (
 type Task struct {
 	ID          string             `json:"id" db:"id"`
 	UserID      string             `json:"userId" db:"user_id"`
+	WorkspaceID *string            `json:"workspaceId" db:"workspace_id"`
+	ProjectID   *string            `json:"projectId" db:"project_id"`
+	ParentID    *string            `json:"parentId" db:"parent_id"`
 	Title       string             `json:"title" db:"title"`
 	Description string             `json:"description" db:"description"`
 	Priority    enums.TaskPriority `json:"priority" db:"priority"`
 	Status      enums.TaskStatus   `json:"status" db:"status"`
-	CreatedAt   time.Time          `json:"createdAt" db:"created_at"`
-	UpdatedAt   time.Time          `json:"updatedAt" db:"updated_at"`
+	// StatusCategory is the category of Status in the task's workflow
+	StatusCategory enums.TaskStatusCategory `json:"statusCategory" db:"status_category"`
+	StartAt        *time.Time               `json:"startAt" db:"start_at"`
+	DueAt          *time.Time               `json:"dueAt" db:"due_at"`
+	CreatedAt      time.Time                `json:"createdAt" db:"created_at"`
+	UpdatedAt      time.Time                `json:"updatedAt" db:"updated_at"`
+	// DeletedAt is set while the task is in the trash
+	DeletedAt *time.Time `json:"deletedAt,omitempty" db:"deleted_at"`
+	// SeriesID links an occurrence of a recurring task to its series
+	SeriesID *string `json:"seriesId" db:"series_id"`
+	// BoardRank orders the task among the tasks sharing its status on a board
+	BoardRank string `json:"boardRank" db:"board_rank"`
+	// Version is bumped on every change to the task, clients echo it back to avoid lost updates
+	Version int `json:"version" db:"version"`
+
+	// Subtasks is a computed rollup of the task's descendants
+	Subtasks *SubtaskRollup `json:"subtasks,omitempty" db:"-"`
+	Tags     []Tag          `json:"tags,omitempty" db:"-"`
+	// LoggedSeconds is the total of the task's finished time entries
+	LoggedSeconds int64 `json:"loggedSeconds" db:"-"`
+	// CustomFields holds the values of the custom fields that apply to the task
+	CustomFields []TaskCustomFieldValue `json:"customFields,omitempty" db:"-"`
+
+	// Creator and Assignees describe the users involved with the task
+	Creator   *UserSummary   `json:"creator,omitempty" db:"-"`
+	Assignees []TaskAssignee `json:"assignees,omitempty" db:"-"`
+}
+
+// IsOverdue reports whether the task is past its due date and not completed yet
+func (t *Task) IsOverdue(now time.Time) bool {
+	if t.DueAt == nil || t.StatusCategory == enums.TaskStatusCategoryDone {
+		return false
+	}
+
+	return t.DueAt.Before(now)
+}
+
+// IsDeleted reports whether the task is in the trash
+func (t *Task) IsDeleted() bool {
+	return t.DeletedAt != nil
+}
+
+type TaskSearchResult struct {
+	Task                 Task    `json:"task"`
+	Rank                 float64 `json:"rank"`
+	TitleHighlight       string  `json:"titleHighlight"`
+	DescriptionHighlight string  `json:"descriptionHighlight"`
+}
+
+type SubtaskRollup struct {
+	Total     int `json:"total"`
+	Completed int `json:"completed"`
+}
+
+type TaskAssignee struct {
+	UserSummary
+	AssignedAt time.Time `json:"assignedAt"`
+}
+
+type TaskTree struct {
+	Task
+	Children []TaskTree `json:"children"`
+}
+
+// TaskSeries is a recurring task, each of its occurrences is a task linked to it
+type TaskSeries struct {
+	ID     string `json:"id" db:"id"`
+	UserID string `json:"userId" db:"user_id"`
+	// Rule is an iCalendar RRULE, occurrences are counted from AnchorAt
+	Rule     string    `json:"rule" db:"rule"`
+	AnchorAt time.Time `json:"anchorAt" db:"anchor_at"`
+	// LastOccurrenceAt is the due date of the latest spawned occurrence
+	LastOccurrenceAt time.Time  `json:"lastOccurrenceAt" db:"last_occurrence_at"`
+	StoppedAt        *time.Time `json:"stoppedAt" db:"stopped_at"`
+	CreatedAt        time.Time  `json:"createdAt" db:"created_at"`
+	UpdatedAt        time.Time  `json:"updatedAt" db:"updated_at"`
+}
+
+// IsStopped reports whether the series no longer spawns occurrences
+func (s *TaskSeries) IsStopped() bool {
+	return s.StoppedAt != nil
+}
+
+// TaskOccurrence is an upcoming occurrence of a series
+type TaskOccurrence struct {
+	StartAt *time.Time `json:"startAt"`
+	DueAt   time.Time  `json:"dueAt"`
 }
@@ -0,0 +1,40 @@
+package entities
+
+import "time"
+
+type TimeEntry struct {
+	ID        string    `json:"id" db:"id"`
+	TaskID    string    `json:"taskId" db:"task_id"`
+	UserID    string    `json:"userId" db:"user_id"`
+	StartedAt time.Time `json:"startedAt" db:"started_at"`
+	// EndedAt is nil while the entry is a running timer
+	EndedAt   *time.Time `json:"endedAt" db:"ended_at"`
+	Note      string     `json:"note" db:"note"`
+	CreatedAt time.Time  `json:"createdAt" db:"created_at"`
+	UpdatedAt time.Time  `json:"updatedAt" db:"updated_at"`
+
+	// User describes who logged the time
+	User *UserSummary `json:"user,omitempty" db:"-"`
+}
+
+// IsRunning reports whether the entry is a timer that was not stopped yet
+func (e *TimeEntry) IsRunning() bool {
+	return e.EndedAt == nil
+}
+
+// Duration is the time logged by the entry, a running timer counts up to now
+func (e *TimeEntry) Duration(now time.Time) time.Duration {
+	if e.EndedAt == nil {
+		return now.Sub(e.StartedAt)
+	}
+
+	return e.EndedAt.Sub(e.StartedAt)
+}
+
+// TimeReportRow is the time logged within one group of a time report
+type TimeReportRow struct {
+	// Key identifies the group, it is empty for entries without a project or tag
+	Key     string `json:"key" db:"key"`
+	Label   string `json:"label" db:"label"`
+	Seconds int64  `json:"seconds" db:"seconds"`
+}
@@ -0,0 +1,52 @@
+package entities
+
+import (
+	"slices"
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+)
+
+// Webhook sends the task events it subscribes to to a URL. A personal webhook
+// receives the events of its owner's personal tasks, a workspace webhook those
+// of the workspace's tasks.
+type Webhook struct {
+	ID          string               `json:"id" db:"id"`
+	UserID      string               `json:"userId" db:"user_id"`
+	WorkspaceID *string              `json:"workspaceId" db:"workspace_id"`
+	URL         string               `json:"url" db:"url"`
+	Secret      string               `json:"-" db:"secret"`
+	Events      []enums.WebhookEvent `json:"events" db:"events"`
+	Enabled     bool                 `json:"enabled" db:"enabled"`
+	// ConsecutiveFailures counts the deliveries that ran out of attempts since the last success
+	ConsecutiveFailures int `json:"consecutiveFailures" db:"consecutive_failures"`
+	// DisabledAt is set when the webhook was disabled for failing too often
+	DisabledAt *time.Time `json:"disabledAt" db:"disabled_at"`
+	CreatedAt  time.Time  `json:"createdAt" db:"created_at"`
+	UpdatedAt  time.Time  `json:"updatedAt" db:"updated_at"`
+}
+
+// Subscribes reports whether the webhook wants the event
+func (w *Webhook) Subscribes(event enums.WebhookEvent) bool {
+	return slices.Contains(w.Events, event)
+}
+
+// WebhookDelivery is one event sent, or to be sent, to a webhook, along with
+// the outcome of its latest attempt
+type WebhookDelivery struct {
+	ID        string             `json:"id" db:"id"`
+	WebhookID string             `json:"webhookId" db:"webhook_id"`
+	EventID   string             `json:"eventId" db:"event_id"`
+	Event     enums.WebhookEvent `json:"event" db:"event"`
+	// Payload is the JSON body sent to the webhook
+	Payload        string                      `json:"payload" db:"payload"`
+	Status         enums.WebhookDeliveryStatus `json:"status" db:"status"`
+	Attempts       int                         `json:"attempts" db:"attempts"`
+	NextAttemptAt  *time.Time                  `json:"nextAttemptAt" db:"next_attempt_at"`
+	LastAttemptAt  *time.Time                  `json:"lastAttemptAt" db:"last_attempt_at"`
+	ResponseStatus *int                        `json:"responseStatus" db:"response_status"`
+	LastError      *string                     `json:"lastError" db:"last_error"`
+	// RedeliveryOf links a manual redelivery to the delivery it repeats
+	RedeliveryOf *string   `json:"redeliveryOf" db:"redelivery_of"`
+	CreatedAt    time.Time `json:"createdAt" db:"created_at"`
+}
@@ -0,0 +1,111 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+)
+
+// Workflow lists the statuses tasks of a workspace or project move through and the
+// transitions allowed between them. At most one of WorkspaceID and ProjectID is set,
+// neither for the default workflow used where no workflow is configured.
+type Workflow struct {
+	ID          string               `json:"id" db:"id"`
+	WorkspaceID *string              `json:"workspaceId" db:"workspace_id"`
+	ProjectID   *string              `json:"projectId" db:"project_id"`
+	Name        string               `json:"name" db:"name"`
+	CreatedAt   time.Time            `json:"createdAt" db:"created_at"`
+	UpdatedAt   time.Time            `json:"updatedAt" db:"updated_at"`
+	Statuses    []WorkflowStatus     `json:"statuses" db:"-"`
+	Transitions []WorkflowTransition `json:"transitions" db:"-"`
+}
+
+// WorkflowStatus is a status of a workflow, ordered by Position
+type WorkflowStatus struct {
+	Key      enums.TaskStatus         `json:"key" db:"key"`
+	Name     string                   `json:"name" db:"name"`
+	Category enums.TaskStatusCategory `json:"category" db:"category"`
+	Position int                      `json:"position" db:"position"`
+}
+
+// WorkflowTransition allows moving a task from one status to another
+type WorkflowTransition struct {
+	From enums.TaskStatus `json:"from" db:"from_status"`
+	To   enums.TaskStatus `json:"to" db:"to_status"`
+}
+
+// DefaultWorkflow returns the workflow of tasks outside a configured workspace or project:
+// TODO, IN_PROGRESS and COMPLETED with every transition between them allowed
+func DefaultWorkflow() *Workflow {
+	statuses := []WorkflowStatus{
+		{Key: enums.TaskStatusTodo, Name: "To do", Category: enums.TaskStatusCategoryTodo, Position: 0},
+		{Key: enums.TaskStatusInProgress, Name: "In progress", Category: enums.TaskStatusCategoryActive, Position: 1},
+		{Key: enums.TaskStatusCompleted, Name: "Completed", Category: enums.TaskStatusCategoryDone, Position: 2},
+	}
+
+	var transitions []WorkflowTransition
+	for _, from := range statuses {
+		for _, to := range statuses {
+			if from.Key != to.Key {
+				transitions = append(transitions, WorkflowTransition{From: from.Key, To: to.Key})
+			}
+		}
+	}
+
+	return &Workflow{
+		Name:        "Default",
+		Statuses:    statuses,
+		Transitions: transitions,
+	}
+}
+
+// IsDefault reports whether the workflow is the built-in default rather than a configured one
+func (w *Workflow) IsDefault() bool {
+	return w.ID == ""
+}
+
+// FindStatus returns the status with the key, or nil when the workflow has none
+func (w *Workflow) FindStatus(key enums.TaskStatus) *WorkflowStatus {
+	for i := range w.Statuses {
+		if w.Statuses[i].Key == key {
+			return &w.Statuses[i]
+		}
+	}
+	return nil
+}
+
+// InitialStatus is the status new tasks start in, the first one of the workflow
+func (w *Workflow) InitialStatus() WorkflowStatus {
+	return w.Statuses[0]
+}
+
+// NextStatuses lists the statuses a task can move to from a status, in workflow order
+func (w *Workflow) NextStatuses(from enums.TaskStatus) []enums.TaskStatus {
+	var next []enums.TaskStatus
+	for _, status := range w.Statuses {
+		if w.CanTransition(from, status.Key) {
+			next = append(next, status.Key)
+		}
+	}
+	return next
+}
+
+// CanTransition reports whether a task may move from one status to another.
+// A task whose status is not part of the workflow, as after moving it to another
+// project, may move to any status to get back on track.
+func (w *Workflow) CanTransition(from, to enums.TaskStatus) bool {
+	if w.FindStatus(to) == nil {
+		return false
+	}
+
+	if w.FindStatus(from) == nil {
+		return true
+	}
+
+	for _, transition := range w.Transitions {
+		if transition.From == from && transition.To == to {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,29 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+)
+
+type Workspace struct {
+	ID        string    `json:"id" db:"id"`
+	Name      string    `json:"name" db:"name"`
+	CreatedAt time.Time `json:"createdAt" db:"created_at"`
+	UpdatedAt time.Time `json:"updatedAt" db:"updated_at"`
+
+	// Role is the requesting user's role, filled in when listing their workspaces
+	Role enums.WorkspaceRole `json:"role,omitempty" db:"-"`
+}
+
+type WorkspaceMember struct {
+	WorkspaceID string              `json:"workspaceId" db:"workspace_id"`
+	UserID      string              `json:"userId" db:"user_id"`
+	Role        enums.WorkspaceRole `json:"role" db:"role"`
+	CreatedAt   time.Time           `json:"createdAt" db:"created_at"`
+	UpdatedAt   time.Time           `json:"updatedAt" db:"updated_at"`
+
+	// Name and Email describe the member's user account
+	Name  string `json:"name" db:"-"`
+	Email string `json:"email" db:"-"`
+}
@@ -0,0 +1,17 @@
+package enums
+
+// TaskActivityAction is the kind of change recorded in a task's activity history
+type TaskActivityAction string
+
+const (
+	TaskActivityActionCreated       TaskActivityAction = "CREATED"
+	TaskActivityActionUpdated       TaskActivityAction = "UPDATED"
+	TaskActivityActionStatusChanged TaskActivityAction = "STATUS_CHANGED"
+	TaskActivityActionDeleted       TaskActivityAction = "DELETED"
+	TaskActivityActionRestored      TaskActivityAction = "RESTORED"
+	TaskActivityActionPurged        TaskActivityAction = "PURGED"
+)
+
+func (a TaskActivityAction) String() string {
+	return string(a)
+}
@@ -0,0 +1,37 @@
+package enums
+
+// CustomFieldType is the kind of value a custom field holds
+type CustomFieldType string
+
+const (
+	CustomFieldTypeText         CustomFieldType = "text"
+	CustomFieldTypeNumber       CustomFieldType = "number"
+	CustomFieldTypeDate         CustomFieldType = "date"
+	CustomFieldTypeSingleSelect CustomFieldType = "single_select"
+	CustomFieldTypeMultiSelect  CustomFieldType = "multi_select"
+	CustomFieldTypeCheckbox     CustomFieldType = "checkbox"
+)
+
+func (ft CustomFieldType) String() string {
+	return string(ft)
+}
+
+func (ft CustomFieldType) IsValid() bool {
+	switch ft {
+	case CustomFieldTypeText, CustomFieldTypeNumber, CustomFieldTypeDate,
+		CustomFieldTypeSingleSelect, CustomFieldTypeMultiSelect, CustomFieldTypeCheckbox:
+		return true
+	default:
+		return false
+	}
+}
+
+// HasOptions reports whether values are picked from a list of options
+func (ft CustomFieldType) HasOptions() bool {
+	return ft == CustomFieldTypeSingleSelect || ft == CustomFieldTypeMultiSelect
+}
+
+// HasBounds reports whether values can be bounded by a minimum and maximum
+func (ft CustomFieldType) HasBounds() bool {
+	return ft == CustomFieldTypeText || ft == CustomFieldTypeNumber
+}
@@ -1,5 +1,7 @@
 package enums
 
+// TaskStatus is the key of a status in a task's workflow. The constants are
+// the statuses of the default workflow.
 type TaskStatus string
 
 const (
@@ -12,6 +14,23 @@ func (ts TaskStatus) String() string {
 	return string(ts)
 }
 
+// TaskStatusCategory groups workflow statuses by how far along a task is
+type TaskStatusCategory string
+
+const (
+	TaskStatusCategoryTodo   TaskStatusCategory = "todo"
+	TaskStatusCategoryActive TaskStatusCategory = "active"
+	TaskStatusCategoryDone   TaskStatusCategory = "done"
+)
+
+func (sc TaskStatusCategory) String() string {
+	return string(sc)
+}
+
+func (sc TaskStatusCategory) IsValid() bool {
+	return sc == TaskStatusCategoryTodo || sc == TaskStatusCategoryActive || sc == TaskStatusCategoryDone
+}
+
 type TaskPriority int
 
 const (
@@ -23,3 +42,60 @@ const (
 func (tp TaskPriority) Int() int {
 	return int(tp)
 }
+
+// TaskDeleteStrategy decides what happens to subtasks when their parent is deleted
+type TaskDeleteStrategy string
+
+const (
+	TaskDeleteStrategyReject   TaskDeleteStrategy = "reject"
+	TaskDeleteStrategyCascade  TaskDeleteStrategy = "cascade"
+	TaskDeleteStrategyReparent TaskDeleteStrategy = "reparent"
+)
+
+func (ds TaskDeleteStrategy) String() string {
+	return string(ds)
+}
+
+// TaskBulkAction is the kind of change a bulk operation makes
+type TaskBulkAction string
+
+const (
+	TaskBulkActionCreate       TaskBulkAction = "create"
+	TaskBulkActionUpdate       TaskBulkAction = "update"
+	TaskBulkActionUpdateStatus TaskBulkAction = "update_status"
+	TaskBulkActionDelete       TaskBulkAction = "delete"
+)
+
+func (ba TaskBulkAction) String() string {
+	return string(ba)
+}
+
+// TaskBulkMode decides what happens to a batch when one of its operations fails
+type TaskBulkMode string
+
+const (
+	// TaskBulkModeAllOrNothing rolls the whole batch back
+	TaskBulkModeAllOrNothing TaskBulkMode = "all_or_nothing"
+	// TaskBulkModeBestEffort rolls back only the failed operations
+	TaskBulkModeBestEffort TaskBulkMode = "best_effort"
+)
+
+func (bm TaskBulkMode) String() string {
+	return string(bm)
+}
+
+// TaskImportRowStatus is the outcome of one row of an import
+type TaskImportRowStatus string
+
+const (
+	// TaskImportRowStatusCreated is a row whose task was created, or would be on a dry run
+	TaskImportRowStatusCreated TaskImportRowStatus = "created"
+	// TaskImportRowStatusSkipped is a blank row
+	TaskImportRowStatusSkipped TaskImportRowStatus = "skipped"
+	// TaskImportRowStatusFailed is a row that could not be read, validated or created
+	TaskImportRowStatusFailed TaskImportRowStatus = "failed"
+)
+
+func (rs TaskImportRowStatus) String() string {
+	return string(rs)
+}
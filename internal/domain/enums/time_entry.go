@@ -0,0 +1,14 @@
+package enums
+
+// TimeReportGroupBy decides how logged time is grouped in a time report
+type TimeReportGroupBy string
+
+const (
+	TimeReportGroupByDay     TimeReportGroupBy = "day"
+	TimeReportGroupByProject TimeReportGroupBy = "project"
+	TimeReportGroupByTag     TimeReportGroupBy = "tag"
+)
+
+func (g TimeReportGroupBy) String() string {
+	return string(g)
+}
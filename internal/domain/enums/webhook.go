@@ -0,0 +1,40 @@
+package enums
+
+// WebhookEvent is the kind of task change a webhook can subscribe to
+type WebhookEvent string
+
+const (
+	WebhookEventTaskCreated       WebhookEvent = "task.created"
+	WebhookEventTaskUpdated       WebhookEvent = "task.updated"
+	WebhookEventTaskStatusChanged WebhookEvent = "task.status_changed"
+	WebhookEventTaskDeleted       WebhookEvent = "task.deleted"
+	WebhookEventTaskRestored      WebhookEvent = "task.restored"
+)
+
+func (e WebhookEvent) String() string {
+	return string(e)
+}
+
+func (e WebhookEvent) IsValid() bool {
+	switch e {
+	case WebhookEventTaskCreated, WebhookEventTaskUpdated, WebhookEventTaskStatusChanged,
+		WebhookEventTaskDeleted, WebhookEventTaskRestored:
+		return true
+	default:
+		return false
+	}
+}
+
+// WebhookDeliveryStatus is where a delivery stands. A pending delivery is
+// waiting for its next attempt, a failed one ran out of attempts.
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "FAILED"
+)
+
+func (s WebhookDeliveryStatus) String() string {
+	return string(s)
+}
@@ -0,0 +1,40 @@
+package enums
+
+// WorkspaceRole is the role a member holds within a workspace
+type WorkspaceRole string
+
+const (
+	WorkspaceRoleOwner  WorkspaceRole = "owner"
+	WorkspaceRoleAdmin  WorkspaceRole = "admin"
+	WorkspaceRoleMember WorkspaceRole = "member"
+	WorkspaceRoleViewer WorkspaceRole = "viewer"
+)
+
+func (wr WorkspaceRole) String() string {
+	return string(wr)
+}
+
+// Rank orders roles by privilege, higher is more privileged. Unknown roles rank zero.
+func (wr WorkspaceRole) Rank() int {
+	switch wr {
+	case WorkspaceRoleOwner:
+		return 4
+	case WorkspaceRoleAdmin:
+		return 3
+	case WorkspaceRoleMember:
+		return 2
+	case WorkspaceRoleViewer:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (wr WorkspaceRole) IsValid() bool {
+	return wr.Rank() > 0
+}
+
+// AtLeast reports whether the role is as privileged as other
+func (wr WorkspaceRole) AtLeast(other WorkspaceRole) bool {
+	return wr.Rank() >= other.Rank()
+}
@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"mime/multipart"
+	"time"
+)
+
+type AttachmentResponse struct {
+	ID          string    `json:"id"`
+	TaskID      string    `json:"taskId"`
+	UserID      string    `json:"userId"`
+	FileName    string    `json:"fileName"`
+	ContentType string    `json:"contentType"`
+	Size        int64     `json:"size"`
+	ContentHash string    `json:"contentHash"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// Request DTOs for wrapped handlers
+type AttachmentListRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+type AttachmentUploadRequest struct {
+	ID   string                `param:"id" validate:"required"`
+	File *multipart.FileHeader `form:"file" validate:"required"`
+}
+
+type AttachmentGetByIDRequest struct {
+	ID           string `param:"id" validate:"required"`
+	AttachmentID string `param:"attachmentId" validate:"required"`
+}
+
+type AttachmentDeleteRequest = AttachmentGetByIDRequest
@@ -0,0 +1,13 @@
+package dto
+
+import "time"
+
+// CalendarFeedCreatedResponse carries the feed URL, which cannot be read back later
+type CalendarFeedCreatedResponse struct {
+	URL       string    `json:"url"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type CalendarFeedResponse struct {
+	CreatedAt time.Time `json:"createdAt"`
+}
@@ -0,0 +1,41 @@
+package dto
+
+import "time"
+
+type CommentCreateRequest struct {
+	Body string `json:"body" validate:"required,max=5000"`
+}
+
+type CommentUpdateRequest = CommentCreateRequest
+
+type CommentResponse struct {
+	ID        string            `json:"id"`
+	TaskID    string            `json:"taskId"`
+	Body      string            `json:"body"`
+	Author    *TaskUserResponse `json:"author,omitempty"`
+	Edited    bool              `json:"edited"`
+	EditedAt  *time.Time        `json:"editedAt,omitempty"`
+	CreatedAt time.Time         `json:"createdAt"`
+	UpdatedAt time.Time         `json:"updatedAt"`
+}
+
+// Request DTOs for wrapped handlers
+type CommentListRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+type CommentCreateWithTaskIDRequest struct {
+	ID   string `param:"id" validate:"required"`
+	Body string `json:"body" validate:"required,max=5000"`
+}
+
+type CommentUpdateWithIDRequest struct {
+	ID        string `param:"id" validate:"required"`
+	CommentID string `param:"commentId" validate:"required"`
+	Body      string `json:"body" validate:"required,max=5000"`
+}
+
+type CommentDeleteRequest struct {
+	ID        string `param:"id" validate:"required"`
+	CommentID string `param:"commentId" validate:"required"`
+}
@@ -1,5 +1,7 @@
 package dto
 
+import "io"
+
 type ErrorResponse struct {
 	Code    string `json:"code"`
 	Message string `json:"message"`
@@ -12,3 +14,12 @@ type HealthCheckResponse struct {
 type MessageResponse struct {
 	Message string `json:"message"`
 }
+
+// FileResponse is streamed to the client as is instead of being encoded as JSON
+type FileResponse struct {
+	Name        string
+	ContentType string
+	// Size is the content length in bytes, or -1 when unknown
+	Size    int64
+	Content io.ReadCloser
+}
@@ -0,0 +1,58 @@
+package dto
+
+import "time"
+
+type CustomFieldCreateRequest struct {
+	Name     string   `json:"name" validate:"required,max=100"`
+	Type     string   `json:"type" validate:"required,oneof=text number date single_select multi_select checkbox"`
+	Required bool     `json:"required"`
+	Options  []string `json:"options" validate:"omitempty,max=50,dive,required,max=100"`
+	// Min and Max bound number values, or the length of text values
+	Min *float64 `json:"min"`
+	Max *float64 `json:"max"`
+}
+
+type CustomFieldUpdateRequest struct {
+	Name     string   `json:"name" validate:"required,max=100"`
+	Required bool     `json:"required"`
+	Options  []string `json:"options" validate:"omitempty,max=50,dive,required,max=100"`
+	Min      *float64 `json:"min"`
+	Max      *float64 `json:"max"`
+}
+
+type CustomFieldResponse struct {
+	ID          string    `json:"id"`
+	WorkspaceID *string   `json:"workspaceId"`
+	ProjectID   *string   `json:"projectId"`
+	Name        string    `json:"name"`
+	Type        string    `json:"type"`
+	Required    bool      `json:"required"`
+	Options     []string  `json:"options"`
+	Min         *float64  `json:"min"`
+	Max         *float64  `json:"max"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// Request DTOs for wrapped handlers
+type CustomFieldCreateWithScopeIDRequest struct {
+	ID string `param:"id" validate:"required"`
+	CustomFieldCreateRequest
+}
+
+type CustomFieldListByScopeIDRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+type CustomFieldGetByIDRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+type CustomFieldUpdateWithIDRequest struct {
+	ID string `param:"id" validate:"required"`
+	CustomFieldUpdateRequest
+}
+
+type CustomFieldDeleteRequest struct {
+	ID string `param:"id" validate:"required"`
+}
@@ -0,0 +1,40 @@
+package dto
+
+import "time"
+
+type ProjectCreateRequest struct {
+	Name        string `json:"name" validate:"required,max=100"`
+	Description string `json:"description"`
+}
+
+type ProjectUpdateRequest = ProjectCreateRequest
+
+type ProjectResponse struct {
+	ID          string     `json:"id"`
+	UserID      string     `json:"userId"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	IsArchived  bool       `json:"isArchived"`
+	ArchivedAt  *time.Time `json:"archivedAt"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+}
+
+// Request DTOs for wrapped handlers
+type ProjectGetByIDRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+type ProjectListRequest struct {
+	IncludeArchived bool `query:"includeArchived"`
+}
+
+type ProjectUpdateWithIDRequest struct {
+	ID          string `param:"id" validate:"required"`
+	Name        string `json:"name" validate:"required,max=100"`
+	Description string `json:"description"`
+}
+
+type ProjectDeleteRequest struct {
+	ID string `param:"id" validate:"required"`
+}
@@ -0,0 +1,33 @@
+package dto
+
+import "time"
+
+type TagCreateRequest struct {
+	Name  string `json:"name" validate:"required,max=50"`
+	Color string `json:"color" validate:"required,hexcolor"`
+}
+
+type TagUpdateRequest = TagCreateRequest
+
+type TagResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Color     string    `json:"color"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Request DTOs for wrapped handlers
+type TagGetByIDRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+type TagUpdateWithIDRequest struct {
+	ID    string `param:"id" validate:"required"`
+	Name  string `json:"name" validate:"required,max=50"`
+	Color string `json:"color" validate:"required,hexcolor"`
+}
+
+type TagDeleteRequest struct {
+	ID string `param:"id" validate:"required"`
+}
@@ -1,32 +1,295 @@
 package dto
 
 import (
+	"encoding/json"
+	"mime/multipart"
 	"time"
 
 	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/patchutil"
 )
 
 type TaskCreateRequest struct {
-	Title       string `json:"title" validate:"required"`
-	Description string `json:"description" validate:"required"`
-	Priority    int    `json:"priority" validate:"required,min=1,max=3"`
+	WorkspaceID *string    `json:"workspaceId" validate:"omitempty,uuid"`
+	ProjectID   *string    `json:"projectId" validate:"omitempty,uuid"`
+	ParentID    *string    `json:"parentId" validate:"omitempty,uuid"`
+	Title       string     `json:"title" validate:"required"`
+	Description string     `json:"description" validate:"required"`
+	Priority    int        `json:"priority" validate:"required,min=1,max=3"`
+	StartAt     *time.Time `json:"startAt"`
+	DueAt       *time.Time `json:"dueAt"`
+	TagIDs      []string   `json:"tagIds" validate:"omitempty,dive,uuid"`
+	// CustomFields holds values keyed by field ID
+	CustomFields map[string]json.RawMessage `json:"customFields" validate:"omitempty,dive,keys,uuid,endkeys"`
 }
 
-type TaskUpdateRequest = TaskCreateRequest
+type TaskUpdateRequest struct {
+	Title       string     `json:"title" validate:"required"`
+	Description string     `json:"description" validate:"required"`
+	Priority    int        `json:"priority" validate:"required,min=1,max=3"`
+	StartAt     *time.Time `json:"startAt"`
+	DueAt       *time.Time `json:"dueAt"`
+	TagIDs      []string   `json:"tagIds" validate:"omitempty,dive,uuid"`
+	// CustomFields holds values keyed by field ID
+	CustomFields map[string]json.RawMessage `json:"customFields" validate:"omitempty,dive,keys,uuid,endkeys"`
+	// Version is the version of the task the change is based on, checked when set
+	Version *int `json:"version" validate:"omitempty,min=1"`
+}
+
+// TaskPatchRequest is a JSON Merge Patch (RFC 7396) of a task. Members left out
+// keep their value and null removes an optional one.
+type TaskPatchRequest struct {
+	Title        patchutil.Optional[string]                     `json:"title" validate:"omitempty,min=1"`
+	Description  patchutil.Optional[string]                     `json:"description" validate:"omitempty,min=1"`
+	Priority     patchutil.Optional[int]                        `json:"priority" validate:"omitempty,min=1,max=3"`
+	StartAt      patchutil.Optional[time.Time]                  `json:"startAt"`
+	DueAt        patchutil.Optional[time.Time]                  `json:"dueAt"`
+	TagIDs       patchutil.Optional[[]string]                   `json:"tagIds" validate:"omitempty,dive,uuid"`
+	CustomFields patchutil.Optional[map[string]json.RawMessage] `json:"customFields" validate:"omitempty,dive,keys,uuid,endkeys"`
+	Version      *int                                           `json:"version" validate:"omitempty,min=1"`
+}
 
 type TaskUpdateStatusRequest struct {
-	Status string `json:"status" validate:"required"`
+	Status  string `json:"status" validate:"required"`
+	Version *int   `json:"version" validate:"omitempty,min=1"`
+}
+
+type TaskUpdateProjectRequest struct {
+	ProjectID *string `json:"projectId" validate:"omitempty,uuid"`
+	Version   *int    `json:"version" validate:"omitempty,min=1"`
+}
+
+type TaskUpdateWorkspaceRequest struct {
+	WorkspaceID *string `json:"workspaceId" validate:"omitempty,uuid"`
+	Version     *int    `json:"version" validate:"omitempty,min=1"`
+}
+
+type TaskUpdateParentRequest struct {
+	ParentID *string `json:"parentId" validate:"omitempty,uuid"`
+	Version  *int    `json:"version" validate:"omitempty,min=1"`
+}
+
+type TaskDependencyRequest struct {
+	BlockedByID string `json:"blockedById" query:"blockedById" validate:"required,uuid"`
+}
+
+type TaskAssigneeRequest struct {
+	UserID string `json:"userId" validate:"required,uuid"`
+}
+
+type TaskRecurrenceRequest struct {
+	Rule string `json:"rule" validate:"required"`
+}
+
+// TaskMoveRequest places a task after afterId and before beforeId on the board,
+// at the top of the column when both are left out. Status defaults to the current one.
+type TaskMoveRequest struct {
+	Status   *string `json:"status" validate:"omitempty,min=1"`
+	BeforeID *string `json:"beforeId" validate:"omitempty,uuid"`
+	AfterID  *string `json:"afterId" validate:"omitempty,uuid"`
+}
+
+// TaskBoardRequest selects a workspace's board, or the personal board of the user
+type TaskBoardRequest struct {
+	WorkspaceID *string `query:"workspaceId" validate:"omitempty,uuid"`
+	ProjectID   *string `query:"projectId" validate:"omitempty,uuid"`
+}
+
+type TaskDeleteStrategyRequest struct {
+	Strategy string `json:"strategy" query:"strategy" validate:"omitempty,oneof=reject cascade reparent"`
+	Version  *int   `json:"version" query:"version" validate:"omitempty,min=1"`
+}
+
+type TaskBulkRequest struct {
+	Mode       string                     `json:"mode" validate:"omitempty,oneof=all_or_nothing best_effort"`
+	Operations []TaskBulkOperationRequest `json:"operations" validate:"required,min=1,max=100,dive"`
+}
+
+// TaskBulkOperationRequest carries the payload matching its action, taskId is not used by create.
+// Every other action needs the version of the task, given in its payload.
+type TaskBulkOperationRequest struct {
+	Action string                     `json:"action" validate:"required,oneof=create update update_status delete"`
+	TaskID string                     `json:"taskId" validate:"required_unless=Action create,omitempty,uuid"`
+	Create *TaskCreateRequest         `json:"create" validate:"required_if=Action create"`
+	Update *TaskUpdateRequest         `json:"update" validate:"required_if=Action update"`
+	Status *TaskUpdateStatusRequest   `json:"status" validate:"required_if=Action update_status"`
+	Delete *TaskDeleteStrategyRequest `json:"delete"`
+}
+
+// TaskImportRequest uploads a CSV or JSON file of tasks to create. Mapping is a JSON
+// object from CSV header to task field, headers named after a field need no entry.
+// Rows without a workspace or project go to WorkspaceID or ProjectID when given.
+type TaskImportRequest struct {
+	File        *multipart.FileHeader `form:"file" validate:"required"`
+	Format      string                `form:"format" validate:"omitempty,oneof=csv json"`
+	Mapping     string                `form:"mapping" validate:"omitempty,json"`
+	DryRun      bool                  `form:"dryRun"`
+	WorkspaceID *string               `form:"workspaceId" validate:"omitempty,uuid"`
+	ProjectID   *string               `form:"projectId" validate:"omitempty,uuid"`
 }
 
 type TaskResponse struct {
 	ID          string             `json:"id"`
 	UserID      string             `json:"userId"`
+	WorkspaceID *string            `json:"workspaceId"`
+	ProjectID   *string            `json:"projectId"`
+	ParentID    *string            `json:"parentId"`
 	Title       string             `json:"title"`
 	Description string             `json:"description"`
 	Priority    enums.TaskPriority `json:"priority"`
 	Status      enums.TaskStatus   `json:"status"`
+	StartAt     *time.Time         `json:"startAt"`
+	DueAt       *time.Time         `json:"dueAt"`
+	IsOverdue   bool               `json:"isOverdue"`
 	CreatedAt   time.Time          `json:"createdAt"`
 	UpdatedAt   time.Time          `json:"updatedAt"`
+	DeletedAt   *time.Time         `json:"deletedAt,omitempty"`
+	SeriesID    *string            `json:"seriesId"`
+	// StatusCategory is todo, active or done whatever the status is called in the workflow
+	StatusCategory enums.TaskStatusCategory `json:"statusCategory"`
+	// BoardRank orders the task within its board column, lower ranks come first
+	BoardRank string `json:"boardRank"`
+	// Version changes on every edit, it is also sent as the ETag of the task
+	Version int `json:"version"`
+
+	Subtasks *SubtaskRollupResponse `json:"subtasks,omitempty"`
+	Tags     []TagResponse          `json:"tags,omitempty"`
+	// LoggedSeconds is the total time logged on the task
+	LoggedSeconds int64 `json:"loggedSeconds"`
+	// CustomFields holds the values of the custom fields that apply to the task
+	CustomFields []TaskCustomFieldValueResponse `json:"customFields,omitempty"`
+
+	// Creator is who created the task, Assignees are who it is assigned to
+	Creator   *TaskUserResponse      `json:"creator,omitempty"`
+	Assignees []TaskAssigneeResponse `json:"assignees,omitempty"`
+}
+
+type TaskUserResponse struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type TaskAssigneeResponse struct {
+	TaskUserResponse
+	AssignedAt time.Time `json:"assignedAt"`
+}
+
+type SubtaskRollupResponse struct {
+	Total     int `json:"total"`
+	Completed int `json:"completed"`
+}
+
+type TaskTreeResponse struct {
+	TaskResponse
+	Children []TaskTreeResponse `json:"children"`
+}
+
+type TaskListResponse struct {
+	Items      []TaskResponse `json:"items"`
+	NextCursor *string        `json:"nextCursor"`
+	PrevCursor *string        `json:"prevCursor"`
+	TotalCount int            `json:"totalCount"`
+}
+
+// TaskSearchHighlightResponse holds HTML-escaped text with the matched terms wrapped in <mark>
+type TaskSearchHighlightResponse struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+type TaskSearchItemResponse struct {
+	Task       TaskResponse                `json:"task"`
+	Rank       float64                     `json:"rank"`
+	Highlights TaskSearchHighlightResponse `json:"highlights"`
+}
+
+type TaskActivityResponse struct {
+	ID        string                    `json:"id"`
+	TaskID    string                    `json:"taskId"`
+	Action    string                    `json:"action"`
+	Actor     *TaskUserResponse         `json:"actor,omitempty"`
+	Changes   []TaskFieldChangeResponse `json:"changes"`
+	CreatedAt time.Time                 `json:"createdAt"`
+}
+
+type TaskFieldChangeResponse struct {
+	Field  string `json:"field"`
+	Before any    `json:"before"`
+	After  any    `json:"after"`
+}
+
+type TaskActivityListResponse struct {
+	Items      []TaskActivityResponse `json:"items"`
+	NextCursor *string                `json:"nextCursor"`
+}
+
+type TaskBoardResponse struct {
+	Columns []TaskBoardColumnResponse `json:"columns"`
+}
+
+type TaskBoardColumnResponse struct {
+	Status     enums.TaskStatus         `json:"status"`
+	Name       string                   `json:"name"`
+	Category   enums.TaskStatusCategory `json:"category"`
+	Tasks      []TaskResponse           `json:"tasks"`
+	TotalCount int                      `json:"totalCount"`
+	// Truncated is set when the column holds more tasks than returned
+	Truncated bool `json:"truncated"`
+}
+
+type TaskBulkResponse struct {
+	Mode string `json:"mode"`
+	// Committed is false when an all_or_nothing batch was rolled back
+	Committed bool                     `json:"committed"`
+	Succeeded int                      `json:"succeeded"`
+	Failed    int                      `json:"failed"`
+	Results   []TaskBulkResultResponse `json:"results"`
+}
+
+type TaskBulkResultResponse struct {
+	Index      int            `json:"index"`
+	Action     string         `json:"action"`
+	TaskID     *string        `json:"taskId"`
+	Status     int            `json:"status"`
+	RolledBack bool           `json:"rolledBack"`
+	Error      *ErrorResponse `json:"error,omitempty"`
+}
+
+type TaskImportResponse struct {
+	DryRun bool `json:"dryRun"`
+	// Committed is false for a dry run, whose created rows were rolled back
+	Committed bool                    `json:"committed"`
+	Created   int                     `json:"created"`
+	Skipped   int                     `json:"skipped"`
+	Failed    int                     `json:"failed"`
+	Rows      []TaskImportRowResponse `json:"rows"`
+}
+
+// TaskImportRowResponse reports one row, numbered by its line in a CSV file
+// or its position in a JSON array counting from 1
+type TaskImportRowResponse struct {
+	Row    int            `json:"row"`
+	Status string         `json:"status"`
+	TaskID *string        `json:"taskId"`
+	Error  *ErrorResponse `json:"error,omitempty"`
+}
+
+type TaskRecurrenceResponse struct {
+	SeriesID  string                   `json:"seriesId"`
+	Rule      string                   `json:"rule"`
+	AnchorAt  time.Time                `json:"anchorAt"`
+	StoppedAt *time.Time               `json:"stoppedAt"`
+	Upcoming  []TaskOccurrenceResponse `json:"upcoming"`
+}
+
+type TaskOccurrenceResponse struct {
+	StartAt *time.Time `json:"startAt"`
+	DueAt   time.Time  `json:"dueAt"`
+}
+
+type TaskSearchResponse struct {
+	Items []TaskSearchItemResponse `json:"items"`
 }
 
 // Request DTOs for wrapped handlers
@@ -34,20 +297,154 @@ type TaskGetByIDRequest struct {
 	ID string `param:"id" validate:"required"`
 }
 
+type TaskListRequest struct {
+	Cursor      string     `query:"cursor"`
+	Limit       int        `query:"limit" validate:"omitempty,min=1,max=100"`
+	Status      []string   `query:"status" validate:"omitempty,dive,max=30"`
+	Priority    []int      `query:"priority" validate:"omitempty,dive,min=1,max=3"`
+	CreatedFrom *time.Time `query:"createdFrom"`
+	CreatedTo   *time.Time `query:"createdTo"`
+	UpdatedFrom *time.Time `query:"updatedFrom"`
+	UpdatedTo   *time.Time `query:"updatedTo"`
+	Overdue     bool       `query:"overdue"`
+	Tag         []string   `query:"tag" validate:"omitempty,dive,uuid"`
+	TagMode     string     `query:"tagMode" validate:"omitempty,oneof=and or"`
+	Assignee    []string   `query:"assignee" validate:"omitempty,dive,uuid|eq=me"`
+	// CustomField filters by custom field values, each formatted as fieldId:value
+	CustomField []string `query:"customField"`
+	// StatusCategory keeps tasks whose status is in any of the categories
+	StatusCategory []string `query:"statusCategory" validate:"omitempty,dive,oneof=todo active done"`
+	// IncludeArchived also lists tasks that belong to archived projects
+	IncludeArchived bool   `query:"includeArchived"`
+	SortBy          string `query:"sortBy" validate:"omitempty,oneof=priority title createdAt updatedAt boardRank"`
+	SortOrder       string `query:"sortOrder" validate:"omitempty,oneof=asc desc"`
+}
+
+// TaskExportRequest takes the filters of the task list, every matching task is
+// exported so the cursor and limit are not used
+type TaskExportRequest struct {
+	Format string `query:"format" validate:"required,oneof=csv json md ics"`
+	TaskListRequest
+}
+
+type TaskCustomFieldValueResponse struct {
+	FieldID string          `json:"fieldId"`
+	Name    string          `json:"name"`
+	Type    string          `json:"type"`
+	Value   json.RawMessage `json:"value"`
+}
+
+type TaskSearchRequest struct {
+	Q      string `query:"q" validate:"required"`
+	Limit  int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Offset int    `query:"offset" validate:"omitempty,min=0"`
+}
+
 type TaskUpdateWithIDRequest struct {
-	ID          string `param:"id" validate:"required"`
-	Title       string `json:"title" validate:"required"`
-	Description string `json:"description" validate:"required"`
-	Priority    int    `json:"priority" validate:"required,min=1,max=3"`
+	ID          string     `param:"id" validate:"required"`
+	Title       string     `json:"title" validate:"required"`
+	Description string     `json:"description" validate:"required"`
+	Priority    int        `json:"priority" validate:"required,min=1,max=3"`
+	StartAt     *time.Time `json:"startAt"`
+	DueAt       *time.Time `json:"dueAt"`
+	TagIDs      []string   `json:"tagIds" validate:"omitempty,dive,uuid"`
+	// CustomFields holds values keyed by field ID
+	CustomFields map[string]json.RawMessage `json:"customFields" validate:"omitempty,dive,keys,uuid,endkeys"`
+	// IfMatch is the ETag of the task the change is based on, Version may be sent instead
+	IfMatch string `header:"If-Match"`
+	Version *int   `json:"version" validate:"omitempty,min=1"`
+}
+
+type TaskPatchWithIDRequest struct {
+	ID           string                                         `param:"id" validate:"required"`
+	Title        patchutil.Optional[string]                     `json:"title" validate:"omitempty,min=1"`
+	Description  patchutil.Optional[string]                     `json:"description" validate:"omitempty,min=1"`
+	Priority     patchutil.Optional[int]                        `json:"priority" validate:"omitempty,min=1,max=3"`
+	StartAt      patchutil.Optional[time.Time]                  `json:"startAt"`
+	DueAt        patchutil.Optional[time.Time]                  `json:"dueAt"`
+	TagIDs       patchutil.Optional[[]string]                   `json:"tagIds" validate:"omitempty,dive,uuid"`
+	CustomFields patchutil.Optional[map[string]json.RawMessage] `json:"customFields" validate:"omitempty,dive,keys,uuid,endkeys"`
+	// IfMatch is the ETag of the task the patch is based on, Version may be sent instead
+	IfMatch string `header:"If-Match"`
+	Version *int   `json:"version" validate:"omitempty,min=1"`
 }
 
 type TaskUpdateStatusWithIDRequest struct {
+	ID      string `param:"id" validate:"required"`
+	Status  string `json:"status" validate:"required"`
+	IfMatch string `header:"If-Match"`
+	Version *int   `json:"version" validate:"omitempty,min=1"`
+}
+
+type TaskListByProjectRequest struct {
+	ID string `param:"id" validate:"required"`
+	TaskListRequest
+}
+
+type TaskUpdateProjectWithIDRequest struct {
+	ID        string  `param:"id" validate:"required"`
+	ProjectID *string `json:"projectId" validate:"omitempty,uuid"`
+	IfMatch   string  `header:"If-Match"`
+	Version   *int    `json:"version" validate:"omitempty,min=1"`
+}
+
+type TaskListByWorkspaceRequest struct {
+	ID string `param:"id" validate:"required"`
+	TaskListRequest
+}
+
+type TaskUpdateWorkspaceWithIDRequest struct {
+	ID          string  `param:"id" validate:"required"`
+	WorkspaceID *string `json:"workspaceId" validate:"omitempty,uuid"`
+	IfMatch     string  `header:"If-Match"`
+	Version     *int    `json:"version" validate:"omitempty,min=1"`
+}
+
+type TaskUpdateParentWithIDRequest struct {
+	ID       string  `param:"id" validate:"required"`
+	ParentID *string `json:"parentId" validate:"omitempty,uuid"`
+	IfMatch  string  `header:"If-Match"`
+	Version  *int    `json:"version" validate:"omitempty,min=1"`
+}
+
+type TaskDependencyWithIDRequest struct {
+	ID          string `param:"id" validate:"required"`
+	BlockedByID string `json:"blockedById" query:"blockedById" validate:"required,uuid"`
+}
+
+type TaskActivityListRequest struct {
 	ID     string `param:"id" validate:"required"`
-	Status string `json:"status" validate:"required"`
+	Cursor string `query:"cursor"`
+	Limit  int    `query:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+type TaskAssigneeWithIDRequest struct {
+	ID     string `param:"id" validate:"required"`
+	UserID string `json:"userId" param:"userId" validate:"required,uuid"`
+}
+
+type TaskRecurrenceWithIDRequest struct {
+	ID   string `param:"id" validate:"required"`
+	Rule string `json:"rule" validate:"required"`
+}
+
+type TaskMoveWithIDRequest struct {
+	ID       string  `param:"id" validate:"required"`
+	Status   *string `json:"status" validate:"omitempty,min=1"`
+	BeforeID *string `json:"beforeId" validate:"omitempty,uuid"`
+	AfterID  *string `json:"afterId" validate:"omitempty,uuid"`
+}
+
+type TaskRecurrencePreviewRequest struct {
+	ID    string `param:"id" validate:"required"`
+	Count int    `query:"count" validate:"omitempty,min=1,max=50"`
 }
 
 type TaskDeleteRequest struct {
-	ID string `param:"id" validate:"required"`
+	ID       string `param:"id" validate:"required"`
+	Strategy string `query:"strategy" validate:"omitempty,oneof=reject cascade reparent"`
+	IfMatch  string `header:"If-Match"`
+	Version  *int   `query:"version" validate:"omitempty,min=1"`
 }
 
 type EmptyRequest struct{}
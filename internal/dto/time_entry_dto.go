@@ -0,0 +1,81 @@
+package dto
+
+import "time"
+
+type TimerRequest struct {
+	Note *string `json:"note" validate:"omitempty,max=1000"`
+}
+
+type TimeEntryCreateRequest struct {
+	StartedAt time.Time `json:"startedAt" validate:"required"`
+	EndedAt   time.Time `json:"endedAt" validate:"required"`
+	Note      string    `json:"note" validate:"max=1000"`
+}
+
+type TimeEntryUpdateRequest = TimeEntryCreateRequest
+
+type TimeEntryResponse struct {
+	ID              string            `json:"id"`
+	TaskID          string            `json:"taskId"`
+	User            *TaskUserResponse `json:"user,omitempty"`
+	StartedAt       time.Time         `json:"startedAt"`
+	EndedAt         *time.Time        `json:"endedAt"`
+	Running         bool              `json:"running"`
+	DurationSeconds int64             `json:"durationSeconds"`
+	Note            string            `json:"note"`
+	CreatedAt       time.Time         `json:"createdAt"`
+	UpdatedAt       time.Time         `json:"updatedAt"`
+}
+
+type TimeReportResponse struct {
+	From         time.Time               `json:"from"`
+	To           time.Time               `json:"to"`
+	GroupBy      string                  `json:"groupBy"`
+	TotalSeconds int64                   `json:"totalSeconds"`
+	TotalHours   float64                 `json:"totalHours"`
+	Rows         []TimeReportRowResponse `json:"rows"`
+}
+
+type TimeReportRowResponse struct {
+	// Key is null for time logged on tasks without a project or tag
+	Key     *string `json:"key"`
+	Label   string  `json:"label"`
+	Seconds int64   `json:"seconds"`
+	Hours   float64 `json:"hours"`
+}
+
+// Request DTOs for wrapped handlers
+type TimerWithTaskIDRequest struct {
+	ID   string  `param:"id" validate:"required"`
+	Note *string `json:"note" validate:"omitempty,max=1000"`
+}
+
+type TimeEntryListRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+type TimeEntryCreateWithTaskIDRequest struct {
+	ID        string    `param:"id" validate:"required"`
+	StartedAt time.Time `json:"startedAt" validate:"required"`
+	EndedAt   time.Time `json:"endedAt" validate:"required"`
+	Note      string    `json:"note" validate:"max=1000"`
+}
+
+type TimeEntryUpdateWithIDRequest struct {
+	ID        string    `param:"id" validate:"required"`
+	EntryID   string    `param:"entryId" validate:"required"`
+	StartedAt time.Time `json:"startedAt" validate:"required"`
+	EndedAt   time.Time `json:"endedAt" validate:"required"`
+	Note      string    `json:"note" validate:"max=1000"`
+}
+
+type TimeEntryDeleteRequest struct {
+	ID      string `param:"id" validate:"required"`
+	EntryID string `param:"entryId" validate:"required"`
+}
+
+type TimeReportRequest struct {
+	From    time.Time `query:"from" validate:"required"`
+	To      time.Time `query:"to" validate:"required"`
+	GroupBy string    `query:"groupBy" validate:"omitempty,oneof=day project tag"`
+}
@@ -0,0 +1,90 @@
+package dto
+
+import "time"
+
+type WebhookCreateRequest struct {
+	URL string `json:"url" validate:"required,http_url,max=2048"`
+	// Secret signs the deliveries, one is generated when it is omitted
+	Secret *string  `json:"secret" validate:"omitempty,min=16,max=255"`
+	Events []string `json:"events" validate:"required,min=1,dive,oneof=task.created task.updated task.status_changed task.deleted task.restored"`
+}
+
+type WebhookUpdateRequest struct {
+	URL string `json:"url" validate:"required,http_url,max=2048"`
+	// Secret replaces the current secret, which is kept when it is omitted
+	Secret  *string  `json:"secret" validate:"omitempty,min=16,max=255"`
+	Events  []string `json:"events" validate:"required,min=1,dive,oneof=task.created task.updated task.status_changed task.deleted task.restored"`
+	Enabled *bool    `json:"enabled" validate:"required"`
+}
+
+type WebhookResponse struct {
+	ID                  string     `json:"id"`
+	WorkspaceID         *string    `json:"workspaceId"`
+	URL                 string     `json:"url"`
+	Events              []string   `json:"events"`
+	Enabled             bool       `json:"enabled"`
+	ConsecutiveFailures int        `json:"consecutiveFailures"`
+	DisabledAt          *time.Time `json:"disabledAt"`
+	CreatedAt           time.Time  `json:"createdAt"`
+	UpdatedAt           time.Time  `json:"updatedAt"`
+}
+
+// WebhookCreatedResponse is the only response carrying the secret
+type WebhookCreatedResponse struct {
+	WebhookResponse
+	Secret string `json:"secret"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID             string     `json:"id"`
+	WebhookID      string     `json:"webhookId"`
+	EventID        string     `json:"eventId"`
+	Event          string     `json:"event"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  *time.Time `json:"nextAttemptAt"`
+	LastAttemptAt  *time.Time `json:"lastAttemptAt"`
+	ResponseStatus *int       `json:"responseStatus"`
+	LastError      *string    `json:"lastError"`
+	RedeliveryOf   *string    `json:"redeliveryOf"`
+	CreatedAt      time.Time  `json:"createdAt"`
+}
+
+type WebhookDeliveryListResponse struct {
+	Items      []WebhookDeliveryResponse `json:"items"`
+	NextCursor *string                   `json:"nextCursor"`
+}
+
+// Request DTOs for wrapped handlers
+type WebhookCreateWithWorkspaceIDRequest struct {
+	ID string `param:"id" validate:"required"`
+	WebhookCreateRequest
+}
+
+type WebhookListByWorkspaceIDRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+type WebhookGetByIDRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+type WebhookUpdateWithIDRequest struct {
+	ID string `param:"id" validate:"required"`
+	WebhookUpdateRequest
+}
+
+type WebhookDeleteRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+type WebhookDeliveryListRequest struct {
+	ID     string `param:"id" validate:"required"`
+	Cursor string `query:"cursor"`
+	Limit  int    `query:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+type WebhookRedeliverRequest struct {
+	ID         string `param:"id" validate:"required"`
+	DeliveryID string `param:"deliveryId" validate:"required"`
+}
@@ -0,0 +1,56 @@
+package dto
+
+import "time"
+
+// WorkflowSaveRequest replaces a workflow, the first status is the one new tasks start in
+type WorkflowSaveRequest struct {
+	Name        string                      `json:"name" validate:"required,max=100"`
+	Statuses    []WorkflowStatusRequest     `json:"statuses" validate:"required,min=1,max=20,dive"`
+	Transitions []WorkflowTransitionRequest `json:"transitions" validate:"omitempty,max=400,dive"`
+}
+
+type WorkflowStatusRequest struct {
+	Key      string `json:"key" validate:"required,max=30"`
+	Name     string `json:"name" validate:"omitempty,max=50"`
+	Category string `json:"category" validate:"required,oneof=todo active done"`
+}
+
+type WorkflowTransitionRequest struct {
+	From string `json:"from" validate:"required,max=30"`
+	To   string `json:"to" validate:"required,max=30"`
+}
+
+type WorkflowResponse struct {
+	// ID is empty for the default workflow
+	ID          string                       `json:"id,omitempty"`
+	WorkspaceID *string                      `json:"workspaceId"`
+	ProjectID   *string                      `json:"projectId"`
+	Name        string                       `json:"name"`
+	IsDefault   bool                         `json:"isDefault"`
+	Statuses    []WorkflowStatusResponse     `json:"statuses"`
+	Transitions []WorkflowTransitionResponse `json:"transitions"`
+	CreatedAt   *time.Time                   `json:"createdAt,omitempty"`
+	UpdatedAt   *time.Time                   `json:"updatedAt,omitempty"`
+}
+
+type WorkflowStatusResponse struct {
+	Key      string `json:"key"`
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Position int    `json:"position"`
+}
+
+type WorkflowTransitionResponse struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// Request DTOs for wrapped handlers
+type WorkflowSaveWithScopeIDRequest struct {
+	ID string `param:"id" validate:"required"`
+	WorkflowSaveRequest
+}
+
+type WorkflowByScopeIDRequest struct {
+	ID string `param:"id" validate:"required"`
+}
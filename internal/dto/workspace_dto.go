@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+)
+
+type WorkspaceCreateRequest struct {
+	Name string `json:"name" validate:"required,max=100"`
+}
+
+type WorkspaceUpdateRequest = WorkspaceCreateRequest
+
+type WorkspaceResponse struct {
+	ID        string              `json:"id"`
+	Name      string              `json:"name"`
+	Role      enums.WorkspaceRole `json:"role,omitempty"`
+	CreatedAt time.Time           `json:"createdAt"`
+	UpdatedAt time.Time           `json:"updatedAt"`
+}
+
+type WorkspaceMemberAddRequest struct {
+	Email string `json:"email" validate:"required,email"`
+	Role  string `json:"role" validate:"required,oneof=owner admin member viewer"`
+}
+
+type WorkspaceMemberUpdateRoleRequest struct {
+	Role string `json:"role" validate:"required,oneof=owner admin member viewer"`
+}
+
+type WorkspaceMemberResponse struct {
+	UserID    string              `json:"userId"`
+	Name      string              `json:"name"`
+	Email     string              `json:"email"`
+	Role      enums.WorkspaceRole `json:"role"`
+	CreatedAt time.Time           `json:"createdAt"`
+	UpdatedAt time.Time           `json:"updatedAt"`
+}
+
+// Request DTOs for wrapped handlers
+type WorkspaceGetByIDRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+type WorkspaceUpdateWithIDRequest struct {
+	ID   string `param:"id" validate:"required"`
+	Name string `json:"name" validate:"required,max=100"`
+}
+
+type WorkspaceDeleteRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+type WorkspaceMemberAddWithIDRequest struct {
+	ID    string `param:"id" validate:"required"`
+	Email string `json:"email" validate:"required,email"`
+	Role  string `json:"role" validate:"required,oneof=owner admin member viewer"`
+}
+
+type WorkspaceMemberUpdateRoleWithIDRequest struct {
+	ID     string `param:"id" validate:"required"`
+	UserID string `param:"userId" validate:"required"`
+	Role   string `json:"role" validate:"required,oneof=owner admin member viewer"`
+}
+
+type WorkspaceMemberDeleteRequest struct {
+	ID     string `param:"id" validate:"required"`
+	UserID string `param:"userId" validate:"required"`
+}
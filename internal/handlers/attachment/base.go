@@ -0,0 +1,151 @@
+package attachment
+
+import (
+	"context"
+	"mime/multipart"
+
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/attachment"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/rs/zerolog/log"
+)
+
+type Handler interface {
+	UploadAttachment(ctx context.Context, taskID string, file *multipart.FileHeader, userID string) (*dto.AttachmentResponse, error)
+	GetAttachmentsByTaskID(ctx context.Context, taskID string, userID string) ([]dto.AttachmentResponse, error)
+	DownloadAttachment(ctx context.Context, taskID string, attachmentID string, userID string) (*dto.FileResponse, error)
+	DeleteAttachmentByID(ctx context.Context, taskID string, attachmentID string, userID string) (*dto.MessageResponse, error)
+
+	// Wrapper methods for WrapWithStatus compatibility
+	UploadAttachmentWrapped(ctx context.Context, req *dto.AttachmentUploadRequest) (*dto.AttachmentResponse, error)
+	GetAttachmentsByTaskIDWrapped(ctx context.Context, req *dto.AttachmentListRequest) ([]dto.AttachmentResponse, error)
+	DownloadAttachmentWrapped(ctx context.Context, req *dto.AttachmentGetByIDRequest) (*dto.FileResponse, error)
+	DeleteAttachmentByIDWrapped(ctx context.Context, req *dto.AttachmentDeleteRequest) (*dto.MessageResponse, error)
+}
+
+type handler struct {
+	attachmentService attachment.Service
+}
+
+// @WireSet("Handler")
+func New(attachmentService attachment.Service) Handler {
+	return &handler{
+		attachmentService: attachmentService,
+	}
+}
+
+func (h *handler) UploadAttachment(ctx context.Context, taskID string, file *multipart.FileHeader, userID string) (*dto.AttachmentResponse, error) {
+	content, err := file.Open()
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to open uploaded file")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid file",
+		)
+	}
+	defer content.Close()
+
+	serviceInput := attachment.AttachmentUploadInput{
+		FileName: file.Filename,
+		Content:  content,
+	}
+
+	createdAttachment, err := h.attachmentService.UploadAttachment(ctx, taskID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	attachmentResponse := toAttachmentResponse(createdAttachment)
+
+	return &attachmentResponse, nil
+}
+
+func (h *handler) GetAttachmentsByTaskID(ctx context.Context, taskID string, userID string) ([]dto.AttachmentResponse, error) {
+	attachments, err := h.attachmentService.FindAttachmentsByTaskID(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	attachmentResponses := make([]dto.AttachmentResponse, len(attachments))
+	for i := range attachments {
+		attachmentResponses[i] = toAttachmentResponse(&attachments[i])
+	}
+
+	return attachmentResponses, nil
+}
+
+func (h *handler) DownloadAttachment(ctx context.Context, taskID string, attachmentID string, userID string) (*dto.FileResponse, error) {
+	output, err := h.attachmentService.DownloadAttachment(ctx, taskID, attachmentID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.FileResponse{
+		Name:        output.Attachment.FileName,
+		ContentType: output.Attachment.ContentType,
+		Size:        output.Attachment.Size,
+		Content:     output.Content,
+	}, nil
+}
+
+func (h *handler) DeleteAttachmentByID(ctx context.Context, taskID string, attachmentID string, userID string) (*dto.MessageResponse, error) {
+	err := h.attachmentService.DeleteAttachmentByID(ctx, taskID, attachmentID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Attachment deleted successfully",
+	}, nil
+}
+
+// Wrapper methods for WrapWithStatus compatibility
+
+func (h *handler) UploadAttachmentWrapped(ctx context.Context, req *dto.AttachmentUploadRequest) (*dto.AttachmentResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.UploadAttachment(ctx, req.ID, req.File, userID)
+}
+
+func (h *handler) GetAttachmentsByTaskIDWrapped(ctx context.Context, req *dto.AttachmentListRequest) ([]dto.AttachmentResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetAttachmentsByTaskID(ctx, req.ID, userID)
+}
+
+func (h *handler) DownloadAttachmentWrapped(ctx context.Context, req *dto.AttachmentGetByIDRequest) (*dto.FileResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.DownloadAttachment(ctx, req.ID, req.AttachmentID, userID)
+}
+
+func (h *handler) DeleteAttachmentByIDWrapped(ctx context.Context, req *dto.AttachmentDeleteRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.DeleteAttachmentByID(ctx, req.ID, req.AttachmentID, userID)
+}
@@ -0,0 +1,19 @@
+package attachment
+
+import (
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+)
+
+func toAttachmentResponse(attachment *entities.Attachment) dto.AttachmentResponse {
+	return dto.AttachmentResponse{
+		ID:          attachment.ID,
+		TaskID:      attachment.TaskID,
+		UserID:      attachment.UserID,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		ContentHash: attachment.ContentHash,
+		CreatedAt:   attachment.CreatedAt,
+	}
+}
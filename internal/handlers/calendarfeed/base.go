@@ -0,0 +1,151 @@
+package calendarfeed
+
+import (
+	"context"
+	"io"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/calendarfeed"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/icalutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+)
+
+// feedName is the calendar name clients show for the feed
+const feedName = "Tasks"
+
+type Handler interface {
+	CreateFeed(ctx context.Context, userID string) (*dto.CalendarFeedCreatedResponse, error)
+	GetFeed(ctx context.Context, userID string) (*dto.CalendarFeedResponse, error)
+	DeleteFeed(ctx context.Context, userID string) (*dto.MessageResponse, error)
+	GetFeedTasks(ctx context.Context, userID string) (*dto.FileResponse, error)
+
+	// Wrapper methods for WrapWithStatus compatibility
+	CreateFeedWrapped(ctx context.Context, _ any) (*dto.CalendarFeedCreatedResponse, error)
+	GetFeedWrapped(ctx context.Context, _ any) (*dto.CalendarFeedResponse, error)
+	DeleteFeedWrapped(ctx context.Context, _ any) (*dto.MessageResponse, error)
+	GetFeedTasksWrapped(ctx context.Context, _ any) (*dto.FileResponse, error)
+}
+
+type handler struct {
+	calendarFeedService calendarfeed.Service
+}
+
+// @WireSet("Handler")
+func New(calendarFeedService calendarfeed.Service) Handler {
+	return &handler{
+		calendarFeedService: calendarFeedService,
+	}
+}
+
+func (h *handler) CreateFeed(ctx context.Context, userID string) (*dto.CalendarFeedCreatedResponse, error) {
+	createdFeed, err := h.calendarFeedService.CreateFeed(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.CalendarFeedCreatedResponse{
+		URL:       createdFeed.URL,
+		CreatedAt: createdFeed.CreatedAt,
+	}, nil
+}
+
+func (h *handler) GetFeed(ctx context.Context, userID string) (*dto.CalendarFeedResponse, error) {
+	foundFeed, err := h.calendarFeedService.FindFeedByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.CalendarFeedResponse{
+		CreatedAt: foundFeed.CreatedAt,
+	}, nil
+}
+
+func (h *handler) DeleteFeed(ctx context.Context, userID string) (*dto.MessageResponse, error) {
+	err := h.calendarFeedService.DeleteFeed(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Calendar feed revoked successfully",
+	}, nil
+}
+
+// GetFeedTasks serves the open tasks of the user as an iCalendar file, each
+// task as a VTODO and, when it has a due date, as a VEVENT too
+func (h *handler) GetFeedTasks(ctx context.Context, userID string) (*dto.FileResponse, error) {
+	output, err := h.calendarFeedService.ExportFeedTasks(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The feed is read again on every refresh of the client, caches must not keep it
+	echoutil.SetResponseHeader(ctx, "Cache-Control", "no-store")
+
+	return echoutil.StreamFile(ctx, "tasks.ics", "text/calendar; charset=utf-8", func(w io.Writer) error {
+		return writeFeed(ctx, w, output.ForEach)
+	}), nil
+}
+
+func writeFeed(ctx context.Context, w io.Writer, each func(ctx context.Context, fn func(task *entities.Task) error) error) error {
+	header := func(cal *icalutil.Writer) {
+		cal.Property("METHOD", "PUBLISH")
+		cal.Text("X-WR-CALNAME", feedName)
+	}
+
+	return icalutil.WriteCalendar(w, header, func(cal *icalutil.Writer) error {
+		return each(ctx, func(task *entities.Task) error {
+			icalutil.WriteTaskTodo(cal, task)
+			icalutil.WriteTaskEvent(cal, task)
+			return cal.Err()
+		})
+	})
+}
+
+// Wrapper methods for WrapWithStatus compatibility
+
+func (h *handler) CreateFeedWrapped(ctx context.Context, _ any) (*dto.CalendarFeedCreatedResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.CreateFeed(ctx, userID)
+}
+
+func (h *handler) GetFeedWrapped(ctx context.Context, _ any) (*dto.CalendarFeedResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetFeed(ctx, userID)
+}
+
+func (h *handler) DeleteFeedWrapped(ctx context.Context, _ any) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.DeleteFeed(ctx, userID)
+}
+
+func (h *handler) GetFeedTasksWrapped(ctx context.Context, _ any) (*dto.FileResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetFeedTasks(ctx, userID)
+}
@@ -0,0 +1,141 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/comment"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+)
+
+type Handler interface {
+	CreateComment(ctx context.Context, taskID string, req *dto.CommentCreateRequest, userID string) (*dto.CommentResponse, error)
+	GetCommentsByTaskID(ctx context.Context, taskID string, userID string) ([]dto.CommentResponse, error)
+	UpdateCommentByID(ctx context.Context, taskID string, commentID string, req *dto.CommentUpdateRequest, userID string) (*dto.MessageResponse, error)
+	DeleteCommentByID(ctx context.Context, taskID string, commentID string, userID string) (*dto.MessageResponse, error)
+
+	// Wrapper methods for WrapWithStatus compatibility
+	CreateCommentWrapped(ctx context.Context, req *dto.CommentCreateWithTaskIDRequest) (*dto.CommentResponse, error)
+	GetCommentsByTaskIDWrapped(ctx context.Context, req *dto.CommentListRequest) ([]dto.CommentResponse, error)
+	UpdateCommentByIDWrapped(ctx context.Context, req *dto.CommentUpdateWithIDRequest) (*dto.MessageResponse, error)
+	DeleteCommentByIDWrapped(ctx context.Context, req *dto.CommentDeleteRequest) (*dto.MessageResponse, error)
+}
+
+type handler struct {
+	commentService comment.Service
+}
+
+// @WireSet("Handler")
+func New(commentService comment.Service) Handler {
+	return &handler{
+		commentService: commentService,
+	}
+}
+
+func (h *handler) CreateComment(ctx context.Context, taskID string, req *dto.CommentCreateRequest, userID string) (*dto.CommentResponse, error) {
+	serviceInput := comment.CommentCreateInput{
+		Body: req.Body,
+	}
+
+	createdComment, err := h.commentService.CreateComment(ctx, taskID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	commentResponse := toCommentResponse(createdComment)
+
+	return &commentResponse, nil
+}
+
+func (h *handler) GetCommentsByTaskID(ctx context.Context, taskID string, userID string) ([]dto.CommentResponse, error) {
+	comments, err := h.commentService.FindCommentsByTaskID(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	commentResponses := make([]dto.CommentResponse, len(comments))
+	for i := range comments {
+		commentResponses[i] = toCommentResponse(&comments[i])
+	}
+
+	return commentResponses, nil
+}
+
+func (h *handler) UpdateCommentByID(ctx context.Context, taskID string, commentID string, req *dto.CommentUpdateRequest, userID string) (*dto.MessageResponse, error) {
+	serviceInput := comment.CommentUpdateInput{
+		Body: req.Body,
+	}
+
+	err := h.commentService.UpdateCommentByID(ctx, taskID, commentID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Comment updated successfully",
+	}, nil
+}
+
+func (h *handler) DeleteCommentByID(ctx context.Context, taskID string, commentID string, userID string) (*dto.MessageResponse, error) {
+	err := h.commentService.DeleteCommentByID(ctx, taskID, commentID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Comment deleted successfully",
+	}, nil
+}
+
+// Wrapper methods for WrapWithStatus compatibility
+
+func (h *handler) CreateCommentWrapped(ctx context.Context, req *dto.CommentCreateWithTaskIDRequest) (*dto.CommentResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	createReq := &dto.CommentCreateRequest{
+		Body: req.Body,
+	}
+	return h.CreateComment(ctx, req.ID, createReq, userID)
+}
+
+func (h *handler) GetCommentsByTaskIDWrapped(ctx context.Context, req *dto.CommentListRequest) ([]dto.CommentResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetCommentsByTaskID(ctx, req.ID, userID)
+}
+
+func (h *handler) UpdateCommentByIDWrapped(ctx context.Context, req *dto.CommentUpdateWithIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	updateReq := &dto.CommentUpdateRequest{
+		Body: req.Body,
+	}
+	return h.UpdateCommentByID(ctx, req.ID, req.CommentID, updateReq, userID)
+}
+
+func (h *handler) DeleteCommentByIDWrapped(ctx context.Context, req *dto.CommentDeleteRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.DeleteCommentByID(ctx, req.ID, req.CommentID, userID)
+}
@@ -0,0 +1,28 @@
+package comment
+
+import (
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+)
+
+func toCommentResponse(comment *entities.Comment) dto.CommentResponse {
+	response := dto.CommentResponse{
+		ID:        comment.ID,
+		TaskID:    comment.TaskID,
+		Body:      comment.Body,
+		Edited:    comment.IsEdited(),
+		EditedAt:  comment.EditedAt,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+	}
+
+	if comment.Author != nil {
+		response.Author = &dto.TaskUserResponse{
+			ID:    comment.Author.ID,
+			Name:  comment.Author.Name,
+			Email: comment.Author.Email,
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,204 @@
+package customfield
+
+import (
+	"context"
+
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/customfield"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+)
+
+type Handler interface {
+	CreateWorkspaceCustomField(ctx context.Context, workspaceID string, req *dto.CustomFieldCreateRequest, userID string) (*dto.CustomFieldResponse, error)
+	GetCustomFieldsByWorkspaceID(ctx context.Context, workspaceID string, userID string) ([]dto.CustomFieldResponse, error)
+	CreateProjectCustomField(ctx context.Context, projectID string, req *dto.CustomFieldCreateRequest, userID string) (*dto.CustomFieldResponse, error)
+	GetCustomFieldsByProjectID(ctx context.Context, projectID string, userID string) ([]dto.CustomFieldResponse, error)
+	GetCustomFieldByID(ctx context.Context, fieldID string, userID string) (*dto.CustomFieldResponse, error)
+	UpdateCustomFieldByID(ctx context.Context, fieldID string, req *dto.CustomFieldUpdateRequest, userID string) (*dto.MessageResponse, error)
+	DeleteCustomFieldByID(ctx context.Context, fieldID string, userID string) (*dto.MessageResponse, error)
+
+	// Wrapper methods for WrapWithStatus compatibility
+	CreateWorkspaceCustomFieldWrapped(ctx context.Context, req *dto.CustomFieldCreateWithScopeIDRequest) (*dto.CustomFieldResponse, error)
+	GetCustomFieldsByWorkspaceIDWrapped(ctx context.Context, req *dto.CustomFieldListByScopeIDRequest) ([]dto.CustomFieldResponse, error)
+	CreateProjectCustomFieldWrapped(ctx context.Context, req *dto.CustomFieldCreateWithScopeIDRequest) (*dto.CustomFieldResponse, error)
+	GetCustomFieldsByProjectIDWrapped(ctx context.Context, req *dto.CustomFieldListByScopeIDRequest) ([]dto.CustomFieldResponse, error)
+	GetCustomFieldByIDWrapped(ctx context.Context, req *dto.CustomFieldGetByIDRequest) (*dto.CustomFieldResponse, error)
+	UpdateCustomFieldByIDWrapped(ctx context.Context, req *dto.CustomFieldUpdateWithIDRequest) (*dto.MessageResponse, error)
+	DeleteCustomFieldByIDWrapped(ctx context.Context, req *dto.CustomFieldDeleteRequest) (*dto.MessageResponse, error)
+}
+
+type handler struct {
+	customFieldService customfield.Service
+}
+
+// @WireSet("Handler")
+func New(customFieldService customfield.Service) Handler {
+	return &handler{
+		customFieldService: customFieldService,
+	}
+}
+
+func (h *handler) CreateWorkspaceCustomField(ctx context.Context, workspaceID string, req *dto.CustomFieldCreateRequest, userID string) (*dto.CustomFieldResponse, error) {
+	serviceInput := toCustomFieldCreateInput(req)
+
+	createdField, err := h.customFieldService.CreateWorkspaceCustomField(ctx, workspaceID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldResponse := toCustomFieldResponse(createdField)
+
+	return &fieldResponse, nil
+}
+
+func (h *handler) GetCustomFieldsByWorkspaceID(ctx context.Context, workspaceID string, userID string) ([]dto.CustomFieldResponse, error) {
+	fields, err := h.customFieldService.FindCustomFieldsByWorkspaceID(ctx, workspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toCustomFieldResponses(fields), nil
+}
+
+func (h *handler) CreateProjectCustomField(ctx context.Context, projectID string, req *dto.CustomFieldCreateRequest, userID string) (*dto.CustomFieldResponse, error) {
+	serviceInput := toCustomFieldCreateInput(req)
+
+	createdField, err := h.customFieldService.CreateProjectCustomField(ctx, projectID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldResponse := toCustomFieldResponse(createdField)
+
+	return &fieldResponse, nil
+}
+
+func (h *handler) GetCustomFieldsByProjectID(ctx context.Context, projectID string, userID string) ([]dto.CustomFieldResponse, error) {
+	fields, err := h.customFieldService.FindCustomFieldsByProjectID(ctx, projectID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toCustomFieldResponses(fields), nil
+}
+
+func (h *handler) GetCustomFieldByID(ctx context.Context, fieldID string, userID string) (*dto.CustomFieldResponse, error) {
+	foundField, err := h.customFieldService.FindCustomFieldByID(ctx, fieldID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldResponse := toCustomFieldResponse(foundField)
+
+	return &fieldResponse, nil
+}
+
+func (h *handler) UpdateCustomFieldByID(ctx context.Context, fieldID string, req *dto.CustomFieldUpdateRequest, userID string) (*dto.MessageResponse, error) {
+	serviceInput := customfield.CustomFieldUpdateInput{
+		Name:     req.Name,
+		Required: req.Required,
+		Options:  req.Options,
+		Min:      req.Min,
+		Max:      req.Max,
+	}
+
+	err := h.customFieldService.UpdateCustomFieldByID(ctx, fieldID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Custom field updated successfully",
+	}, nil
+}
+
+func (h *handler) DeleteCustomFieldByID(ctx context.Context, fieldID string, userID string) (*dto.MessageResponse, error) {
+	err := h.customFieldService.DeleteCustomFieldByID(ctx, fieldID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Custom field deleted successfully",
+	}, nil
+}
+
+// Wrapper methods for WrapWithStatus compatibility
+
+func (h *handler) CreateWorkspaceCustomFieldWrapped(ctx context.Context, req *dto.CustomFieldCreateWithScopeIDRequest) (*dto.CustomFieldResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.CreateWorkspaceCustomField(ctx, req.ID, &req.CustomFieldCreateRequest, userID)
+}
+
+func (h *handler) GetCustomFieldsByWorkspaceIDWrapped(ctx context.Context, req *dto.CustomFieldListByScopeIDRequest) ([]dto.CustomFieldResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetCustomFieldsByWorkspaceID(ctx, req.ID, userID)
+}
+
+func (h *handler) CreateProjectCustomFieldWrapped(ctx context.Context, req *dto.CustomFieldCreateWithScopeIDRequest) (*dto.CustomFieldResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.CreateProjectCustomField(ctx, req.ID, &req.CustomFieldCreateRequest, userID)
+}
+
+func (h *handler) GetCustomFieldsByProjectIDWrapped(ctx context.Context, req *dto.CustomFieldListByScopeIDRequest) ([]dto.CustomFieldResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetCustomFieldsByProjectID(ctx, req.ID, userID)
+}
+
+func (h *handler) GetCustomFieldByIDWrapped(ctx context.Context, req *dto.CustomFieldGetByIDRequest) (*dto.CustomFieldResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetCustomFieldByID(ctx, req.ID, userID)
+}
+
+func (h *handler) UpdateCustomFieldByIDWrapped(ctx context.Context, req *dto.CustomFieldUpdateWithIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.UpdateCustomFieldByID(ctx, req.ID, &req.CustomFieldUpdateRequest, userID)
+}
+
+func (h *handler) DeleteCustomFieldByIDWrapped(ctx context.Context, req *dto.CustomFieldDeleteRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.DeleteCustomFieldByID(ctx, req.ID, userID)
+}
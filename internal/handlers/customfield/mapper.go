@@ -0,0 +1,43 @@
+package customfield
+
+import (
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/customfield"
+)
+
+func toCustomFieldResponse(field *entities.CustomField) dto.CustomFieldResponse {
+	return dto.CustomFieldResponse{
+		ID:          field.ID,
+		WorkspaceID: field.WorkspaceID,
+		ProjectID:   field.ProjectID,
+		Name:        field.Name,
+		Type:        field.Type.String(),
+		Required:    field.Required,
+		Options:     field.Options,
+		Min:         field.Min,
+		Max:         field.Max,
+		CreatedAt:   field.CreatedAt,
+		UpdatedAt:   field.UpdatedAt,
+	}
+}
+
+func toCustomFieldResponses(fields []entities.CustomField) []dto.CustomFieldResponse {
+	responses := make([]dto.CustomFieldResponse, len(fields))
+	for i := range fields {
+		responses[i] = toCustomFieldResponse(&fields[i])
+	}
+
+	return responses
+}
+
+func toCustomFieldCreateInput(req *dto.CustomFieldCreateRequest) customfield.CustomFieldCreateInput {
+	return customfield.CustomFieldCreateInput{
+		Name:     req.Name,
+		Type:     req.Type,
+		Required: req.Required,
+		Options:  req.Options,
+		Min:      req.Min,
+		Max:      req.Max,
+	}
+}
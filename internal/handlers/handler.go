@@ -1,15 +1,35 @@
 package handlers
 
 import (
+	"github.com/graphzc/sdd-task-management-example/internal/handlers/attachment"
 	"github.com/graphzc/sdd-task-management-example/internal/handlers/auth"
+	"github.com/graphzc/sdd-task-management-example/internal/handlers/calendarfeed"
+	"github.com/graphzc/sdd-task-management-example/internal/handlers/comment"
 	"github.com/graphzc/sdd-task-management-example/internal/handlers/common"
+	"github.com/graphzc/sdd-task-management-example/internal/handlers/customfield"
+	"github.com/graphzc/sdd-task-management-example/internal/handlers/project"
+	"github.com/graphzc/sdd-task-management-example/internal/handlers/tag"
 	"github.com/graphzc/sdd-task-management-example/internal/handlers/task"
+	"github.com/graphzc/sdd-task-management-example/internal/handlers/timeentry"
+	"github.com/graphzc/sdd-task-management-example/internal/handlers/webhook"
+	"github.com/graphzc/sdd-task-management-example/internal/handlers/workflow"
+	"github.com/graphzc/sdd-task-management-example/internal/handlers/workspace"
 )
 
 type Handlers struct {
-	Common common.Handler
-	Auth   auth.Handler
-	Task   task.Handler
+	Common       common.Handler
+	Auth         auth.Handler
+	Task         task.Handler
+	Tag          tag.Handler
+	Project      project.Handler
+	Workspace    workspace.Handler
+	Comment      comment.Handler
+	Attachment   attachment.Handler
+	TimeEntry    timeentry.Handler
+	CustomField  customfield.Handler
+	Workflow     workflow.Handler
+	CalendarFeed calendarfeed.Handler
+	Webhook      webhook.Handler
 }
 
 // @WireSet("Handler")
@@ -17,10 +37,30 @@ func NewHandlers(
 	commonHandler common.Handler,
 	authHandler auth.Handler,
 	taskHandler task.Handler,
+	tagHandler tag.Handler,
+	projectHandler project.Handler,
+	workspaceHandler workspace.Handler,
+	commentHandler comment.Handler,
+	attachmentHandler attachment.Handler,
+	timeEntryHandler timeentry.Handler,
+	customFieldHandler customfield.Handler,
+	workflowHandler workflow.Handler,
+	calendarFeedHandler calendarfeed.Handler,
+	webhookHandler webhook.Handler,
 ) *Handlers {
 	return &Handlers{
-		Common: commonHandler,
-		Auth:   authHandler,
-		Task:   taskHandler,
+		Common:       commonHandler,
+		Auth:         authHandler,
+		Task:         taskHandler,
+		Tag:          tagHandler,
+		Project:      projectHandler,
+		Workspace:    workspaceHandler,
+		Comment:      commentHandler,
+		Attachment:   attachmentHandler,
+		TimeEntry:    timeEntryHandler,
+		CustomField:  customFieldHandler,
+		Workflow:     workflowHandler,
+		CalendarFeed: calendarFeedHandler,
+		Webhook:      webhookHandler,
 	}
 }
@@ -0,0 +1,217 @@
+package project
+
+import (
+	"context"
+
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/project"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+)
+
+type Handler interface {
+	CreateProject(ctx context.Context, req *dto.ProjectCreateRequest, userID string) (*dto.ProjectResponse, error)
+	GetProjectByID(ctx context.Context, projectID string, userID string) (*dto.ProjectResponse, error)
+	GetProjectsByUserID(ctx context.Context, req *dto.ProjectListRequest, userID string) ([]dto.ProjectResponse, error)
+	UpdateProjectByID(ctx context.Context, projectID string, req *dto.ProjectUpdateRequest, userID string) (*dto.MessageResponse, error)
+	ArchiveProjectByID(ctx context.Context, projectID string, userID string) (*dto.MessageResponse, error)
+	UnarchiveProjectByID(ctx context.Context, projectID string, userID string) (*dto.MessageResponse, error)
+	DeleteProjectByID(ctx context.Context, projectID string, userID string) (*dto.MessageResponse, error)
+
+	// Wrapper methods for WrapWithStatus compatibility
+	CreateProjectWrapped(ctx context.Context, req *dto.ProjectCreateRequest) (*dto.ProjectResponse, error)
+	GetProjectByIDWrapped(ctx context.Context, req *dto.ProjectGetByIDRequest) (*dto.ProjectResponse, error)
+	GetProjectsByUserIDWrapped(ctx context.Context, req *dto.ProjectListRequest) ([]dto.ProjectResponse, error)
+	UpdateProjectByIDWrapped(ctx context.Context, req *dto.ProjectUpdateWithIDRequest) (*dto.MessageResponse, error)
+	ArchiveProjectByIDWrapped(ctx context.Context, req *dto.ProjectGetByIDRequest) (*dto.MessageResponse, error)
+	UnarchiveProjectByIDWrapped(ctx context.Context, req *dto.ProjectGetByIDRequest) (*dto.MessageResponse, error)
+	DeleteProjectByIDWrapped(ctx context.Context, req *dto.ProjectDeleteRequest) (*dto.MessageResponse, error)
+}
+
+type handler struct {
+	projectService project.Service
+}
+
+// @WireSet("Handler")
+func New(projectService project.Service) Handler {
+	return &handler{
+		projectService: projectService,
+	}
+}
+
+func (h *handler) CreateProject(ctx context.Context, req *dto.ProjectCreateRequest, userID string) (*dto.ProjectResponse, error) {
+	serviceInput := project.ProjectCreateInput{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+
+	createdProject, err := h.projectService.CreateProject(ctx, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	projectResponse := toProjectResponse(createdProject)
+
+	return &projectResponse, nil
+}
+
+func (h *handler) GetProjectByID(ctx context.Context, projectID string, userID string) (*dto.ProjectResponse, error) {
+	foundProject, err := h.projectService.FindProjectByID(ctx, projectID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	projectResponse := toProjectResponse(foundProject)
+
+	return &projectResponse, nil
+}
+
+func (h *handler) GetProjectsByUserID(ctx context.Context, req *dto.ProjectListRequest, userID string) ([]dto.ProjectResponse, error) {
+	serviceInput := project.ProjectListInput{
+		IncludeArchived: req.IncludeArchived,
+	}
+
+	projects, err := h.projectService.FindProjectsByUserID(ctx, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	projectResponses := make([]dto.ProjectResponse, len(projects))
+	for i := range projects {
+		projectResponses[i] = toProjectResponse(&projects[i])
+	}
+
+	return projectResponses, nil
+}
+
+func (h *handler) UpdateProjectByID(ctx context.Context, projectID string, req *dto.ProjectUpdateRequest, userID string) (*dto.MessageResponse, error) {
+	serviceInput := project.ProjectUpdateInput{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+
+	err := h.projectService.UpdateProjectByID(ctx, projectID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Project updated successfully",
+	}, nil
+}
+
+func (h *handler) ArchiveProjectByID(ctx context.Context, projectID string, userID string) (*dto.MessageResponse, error) {
+	err := h.projectService.ArchiveProjectByID(ctx, projectID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Project archived successfully",
+	}, nil
+}
+
+func (h *handler) UnarchiveProjectByID(ctx context.Context, projectID string, userID string) (*dto.MessageResponse, error) {
+	err := h.projectService.UnarchiveProjectByID(ctx, projectID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Project unarchived successfully",
+	}, nil
+}
+
+func (h *handler) DeleteProjectByID(ctx context.Context, projectID string, userID string) (*dto.MessageResponse, error) {
+	err := h.projectService.DeleteProjectByID(ctx, projectID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Project deleted successfully",
+	}, nil
+}
+
+// Wrapper methods for WrapWithStatus compatibility
+
+func (h *handler) CreateProjectWrapped(ctx context.Context, req *dto.ProjectCreateRequest) (*dto.ProjectResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.CreateProject(ctx, req, userID)
+}
+
+func (h *handler) GetProjectByIDWrapped(ctx context.Context, req *dto.ProjectGetByIDRequest) (*dto.ProjectResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetProjectByID(ctx, req.ID, userID)
+}
+
+func (h *handler) GetProjectsByUserIDWrapped(ctx context.Context, req *dto.ProjectListRequest) ([]dto.ProjectResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetProjectsByUserID(ctx, req, userID)
+}
+
+func (h *handler) UpdateProjectByIDWrapped(ctx context.Context, req *dto.ProjectUpdateWithIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	updateReq := &dto.ProjectUpdateRequest{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+	return h.UpdateProjectByID(ctx, req.ID, updateReq, userID)
+}
+
+func (h *handler) ArchiveProjectByIDWrapped(ctx context.Context, req *dto.ProjectGetByIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.ArchiveProjectByID(ctx, req.ID, userID)
+}
+
+func (h *handler) UnarchiveProjectByIDWrapped(ctx context.Context, req *dto.ProjectGetByIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.UnarchiveProjectByID(ctx, req.ID, userID)
+}
+
+func (h *handler) DeleteProjectByIDWrapped(ctx context.Context, req *dto.ProjectDeleteRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.DeleteProjectByID(ctx, req.ID, userID)
+}
@@ -0,0 +1,19 @@
+package project
+
+import (
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+)
+
+func toProjectResponse(project *entities.Project) dto.ProjectResponse {
+	return dto.ProjectResponse{
+		ID:          project.ID,
+		UserID:      project.UserID,
+		Name:        project.Name,
+		Description: project.Description,
+		IsArchived:  project.IsArchived(),
+		ArchivedAt:  project.ArchivedAt,
+		CreatedAt:   project.CreatedAt,
+		UpdatedAt:   project.UpdatedAt,
+	}
+}
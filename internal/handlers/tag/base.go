@@ -0,0 +1,165 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/tag"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+)
+
+type Handler interface {
+	CreateTag(ctx context.Context, req *dto.TagCreateRequest, userID string) (*dto.TagResponse, error)
+	GetTagByID(ctx context.Context, tagID string, userID string) (*dto.TagResponse, error)
+	GetTagsByUserID(ctx context.Context, userID string) ([]dto.TagResponse, error)
+	UpdateTagByID(ctx context.Context, tagID string, req *dto.TagUpdateRequest, userID string) (*dto.MessageResponse, error)
+	DeleteTagByID(ctx context.Context, tagID string, userID string) (*dto.MessageResponse, error)
+
+	// Wrapper methods for WrapWithStatus compatibility
+	CreateTagWrapped(ctx context.Context, req *dto.TagCreateRequest) (*dto.TagResponse, error)
+	GetTagByIDWrapped(ctx context.Context, req *dto.TagGetByIDRequest) (*dto.TagResponse, error)
+	GetTagsByUserIDWrapped(ctx context.Context, _ any) ([]dto.TagResponse, error)
+	UpdateTagByIDWrapped(ctx context.Context, req *dto.TagUpdateWithIDRequest) (*dto.MessageResponse, error)
+	DeleteTagByIDWrapped(ctx context.Context, req *dto.TagDeleteRequest) (*dto.MessageResponse, error)
+}
+
+type handler struct {
+	tagService tag.Service
+}
+
+// @WireSet("Handler")
+func New(tagService tag.Service) Handler {
+	return &handler{
+		tagService: tagService,
+	}
+}
+
+func (h *handler) CreateTag(ctx context.Context, req *dto.TagCreateRequest, userID string) (*dto.TagResponse, error) {
+	serviceInput := tag.TagCreateInput{
+		Name:  req.Name,
+		Color: req.Color,
+	}
+
+	createdTag, err := h.tagService.CreateTag(ctx, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tagResponse := toTagResponse(createdTag)
+
+	return &tagResponse, nil
+}
+
+func (h *handler) GetTagByID(ctx context.Context, tagID string, userID string) (*dto.TagResponse, error) {
+	foundTag, err := h.tagService.FindTagByID(ctx, tagID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tagResponse := toTagResponse(foundTag)
+
+	return &tagResponse, nil
+}
+
+func (h *handler) GetTagsByUserID(ctx context.Context, userID string) ([]dto.TagResponse, error) {
+	tags, err := h.tagService.FindTagsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tagResponses := make([]dto.TagResponse, len(tags))
+	for i := range tags {
+		tagResponses[i] = toTagResponse(&tags[i])
+	}
+
+	return tagResponses, nil
+}
+
+func (h *handler) UpdateTagByID(ctx context.Context, tagID string, req *dto.TagUpdateRequest, userID string) (*dto.MessageResponse, error) {
+	serviceInput := tag.TagUpdateInput{
+		Name:  req.Name,
+		Color: req.Color,
+	}
+
+	err := h.tagService.UpdateTagByID(ctx, tagID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Tag updated successfully",
+	}, nil
+}
+
+func (h *handler) DeleteTagByID(ctx context.Context, tagID string, userID string) (*dto.MessageResponse, error) {
+	err := h.tagService.DeleteTagByID(ctx, tagID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Tag deleted successfully",
+	}, nil
+}
+
+// Wrapper methods for WrapWithStatus compatibility
+
+func (h *handler) CreateTagWrapped(ctx context.Context, req *dto.TagCreateRequest) (*dto.TagResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.CreateTag(ctx, req, userID)
+}
+
+func (h *handler) GetTagByIDWrapped(ctx context.Context, req *dto.TagGetByIDRequest) (*dto.TagResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetTagByID(ctx, req.ID, userID)
+}
+
+func (h *handler) GetTagsByUserIDWrapped(ctx context.Context, _ any) ([]dto.TagResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetTagsByUserID(ctx, userID)
+}
+
+func (h *handler) UpdateTagByIDWrapped(ctx context.Context, req *dto.TagUpdateWithIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	updateReq := &dto.TagUpdateRequest{
+		Name:  req.Name,
+		Color: req.Color,
+	}
+	return h.UpdateTagByID(ctx, req.ID, updateReq, userID)
+}
+
+func (h *handler) DeleteTagByIDWrapped(ctx context.Context, req *dto.TagDeleteRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.DeleteTagByID(ctx, req.ID, userID)
+}
@@ -0,0 +1,45 @@
+package task
+
+import (
+	"context"
+
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/task"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+)
+
+func (h *handler) GetTaskActivityByID(ctx context.Context, taskID string, req *dto.TaskActivityListRequest, userID string) (*dto.TaskActivityListResponse, error) {
+	serviceInput := task.TaskActivityListInput{
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+	}
+
+	output, err := h.taskService.FindTaskActivityByID(ctx, taskID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.TaskActivityResponse, len(output.Activities))
+	for i := range output.Activities {
+		items[i] = toTaskActivityResponse(&output.Activities[i])
+	}
+
+	return &dto.TaskActivityListResponse{
+		Items:      items,
+		NextCursor: optionalString(output.NextCursor),
+	}, nil
+}
+
+// Wrapper methods for WrapWithStatus compatibility
+
+func (h *handler) GetTaskActivityByIDWrapped(ctx context.Context, req *dto.TaskActivityListRequest) (*dto.TaskActivityListResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetTaskActivityByID(ctx, req.ID, req, userID)
+}
@@ -0,0 +1,94 @@
+package task
+
+import (
+	"context"
+
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/task"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+)
+
+func (h *handler) AssignTask(ctx context.Context, taskID string, req *dto.TaskAssigneeRequest, userID string) (*dto.MessageResponse, error) {
+	serviceInput := task.TaskAssigneeInput{
+		UserID: req.UserID,
+	}
+
+	err := h.taskService.AssignTask(ctx, taskID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Task assigned successfully",
+	}, nil
+}
+
+func (h *handler) UnassignTask(ctx context.Context, taskID string, req *dto.TaskAssigneeRequest, userID string) (*dto.MessageResponse, error) {
+	serviceInput := task.TaskAssigneeInput{
+		UserID: req.UserID,
+	}
+
+	err := h.taskService.UnassignTask(ctx, taskID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Task unassigned successfully",
+	}, nil
+}
+
+func (h *handler) GetAssignedTasks(ctx context.Context, req *dto.TaskListRequest, userID string) (*dto.TaskListResponse, error) {
+	serviceInput := toTaskListInput(req)
+
+	output, err := h.taskService.FindAssignedTasks(ctx, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskListResponse := toTaskListResponse(output)
+
+	return &taskListResponse, nil
+}
+
+// Wrapper methods for WrapWithStatus compatibility
+
+func (h *handler) AssignTaskWrapped(ctx context.Context, req *dto.TaskAssigneeWithIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	assigneeReq := &dto.TaskAssigneeRequest{
+		UserID: req.UserID,
+	}
+	return h.AssignTask(ctx, req.ID, assigneeReq, userID)
+}
+
+func (h *handler) UnassignTaskWrapped(ctx context.Context, req *dto.TaskAssigneeWithIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	assigneeReq := &dto.TaskAssigneeRequest{
+		UserID: req.UserID,
+	}
+	return h.UnassignTask(ctx, req.ID, assigneeReq, userID)
+}
+
+func (h *handler) GetAssignedTasksWrapped(ctx context.Context, req *dto.TaskListRequest) (*dto.TaskListResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetAssignedTasks(ctx, req, userID)
+}
@@ -6,43 +6,97 @@ import (
 	"github.com/graphzc/sdd-task-management-example/internal/dto"
 	"github.com/graphzc/sdd-task-management-example/internal/services/task"
 	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/etagutil"
 	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/validator"
 )
 
 type Handler interface {
 	CreateTask(ctx context.Context, req *dto.TaskCreateRequest, userID string) (*dto.MessageResponse, error)
 	GetTaskByID(ctx context.Context, taskID string, userID string) (*dto.TaskResponse, error)
-	GetTasksByUserID(ctx context.Context, userID string) ([]dto.TaskResponse, error)
+	GetTasksByUserID(ctx context.Context, req *dto.TaskListRequest, userID string) (*dto.TaskListResponse, error)
+	SearchTasks(ctx context.Context, req *dto.TaskSearchRequest, userID string) (*dto.TaskSearchResponse, error)
 	UpdateTaskByID(ctx context.Context, taskID string, req *dto.TaskUpdateRequest, userID string) (*dto.MessageResponse, error)
+	PatchTaskByID(ctx context.Context, taskID string, req *dto.TaskPatchRequest, userID string) (*dto.MessageResponse, error)
 	UpdateTaskStatusByID(ctx context.Context, taskID string, req *dto.TaskUpdateStatusRequest, userID string) (*dto.MessageResponse, error)
-	DeleteTaskByID(ctx context.Context, taskID string, userID string) (*dto.MessageResponse, error)
+	DeleteTaskByID(ctx context.Context, taskID string, req *dto.TaskDeleteStrategyRequest, userID string) (*dto.MessageResponse, error)
+	GetSubtasksByID(ctx context.Context, taskID string, userID string) ([]dto.TaskResponse, error)
+	GetTaskTreeByID(ctx context.Context, taskID string, userID string) (*dto.TaskTreeResponse, error)
+	UpdateTaskParentByID(ctx context.Context, taskID string, req *dto.TaskUpdateParentRequest, userID string) (*dto.MessageResponse, error)
+	AddTaskDependency(ctx context.Context, taskID string, req *dto.TaskDependencyRequest, userID string) (*dto.MessageResponse, error)
+	RemoveTaskDependency(ctx context.Context, taskID string, req *dto.TaskDependencyRequest, userID string) (*dto.MessageResponse, error)
+	GetTaskBlockersByID(ctx context.Context, taskID string, userID string) ([]dto.TaskResponse, error)
+	GetTasksByProjectID(ctx context.Context, projectID string, req *dto.TaskListRequest, userID string) (*dto.TaskListResponse, error)
+	UpdateTaskProjectByID(ctx context.Context, taskID string, req *dto.TaskUpdateProjectRequest, userID string) (*dto.MessageResponse, error)
+	GetTasksByWorkspaceID(ctx context.Context, workspaceID string, req *dto.TaskListRequest, userID string) (*dto.TaskListResponse, error)
+	UpdateTaskWorkspaceByID(ctx context.Context, taskID string, req *dto.TaskUpdateWorkspaceRequest, userID string) (*dto.MessageResponse, error)
+	AssignTask(ctx context.Context, taskID string, req *dto.TaskAssigneeRequest, userID string) (*dto.MessageResponse, error)
+	UnassignTask(ctx context.Context, taskID string, req *dto.TaskAssigneeRequest, userID string) (*dto.MessageResponse, error)
+	GetAssignedTasks(ctx context.Context, req *dto.TaskListRequest, userID string) (*dto.TaskListResponse, error)
+	GetTaskActivityByID(ctx context.Context, taskID string, req *dto.TaskActivityListRequest, userID string) (*dto.TaskActivityListResponse, error)
+	GetTrashedTasks(ctx context.Context, req *dto.TaskListRequest, userID string) (*dto.TaskListResponse, error)
+	RestoreTaskByID(ctx context.Context, taskID string, userID string) (*dto.MessageResponse, error)
+	PurgeTaskByID(ctx context.Context, taskID string, userID string) (*dto.MessageResponse, error)
+	BulkTasks(ctx context.Context, req *dto.TaskBulkRequest, userID string) (*dto.TaskBulkResponse, error)
+	SetTaskRecurrence(ctx context.Context, taskID string, req *dto.TaskRecurrenceRequest, userID string) (*dto.MessageResponse, error)
+	GetTaskRecurrence(ctx context.Context, taskID string, req *dto.TaskRecurrencePreviewRequest, userID string) (*dto.TaskRecurrenceResponse, error)
+	StopTaskRecurrence(ctx context.Context, taskID string, userID string) (*dto.MessageResponse, error)
+	MoveTask(ctx context.Context, taskID string, req *dto.TaskMoveRequest, userID string) (*dto.MessageResponse, error)
+	GetBoard(ctx context.Context, req *dto.TaskBoardRequest, userID string) (*dto.TaskBoardResponse, error)
+	ImportTasks(ctx context.Context, req *dto.TaskImportRequest, userID string) (*dto.TaskImportResponse, error)
+	ExportTasks(ctx context.Context, req *dto.TaskExportRequest, userID string) (*dto.FileResponse, error)
 
 	// Wrapper methods for WrapWithStatus compatibility
 	CreateTaskWrapped(ctx context.Context, req *dto.TaskCreateRequest) (*dto.MessageResponse, error)
 	GetTaskByIDWrapped(ctx context.Context, req *dto.TaskGetByIDRequest) (*dto.TaskResponse, error)
-	GetTasksByUserIDWrapped(ctx context.Context, _ any) ([]dto.TaskResponse, error)
+	GetTasksByUserIDWrapped(ctx context.Context, req *dto.TaskListRequest) (*dto.TaskListResponse, error)
+	SearchTasksWrapped(ctx context.Context, req *dto.TaskSearchRequest) (*dto.TaskSearchResponse, error)
 	UpdateTaskByIDWrapped(ctx context.Context, req *dto.TaskUpdateWithIDRequest) (*dto.MessageResponse, error)
+	PatchTaskByIDWrapped(ctx context.Context, req *dto.TaskPatchWithIDRequest) (*dto.MessageResponse, error)
 	UpdateTaskStatusByIDWrapped(ctx context.Context, req *dto.TaskUpdateStatusWithIDRequest) (*dto.MessageResponse, error)
 	DeleteTaskByIDWrapped(ctx context.Context, req *dto.TaskDeleteRequest) (*dto.MessageResponse, error)
+	GetSubtasksByIDWrapped(ctx context.Context, req *dto.TaskGetByIDRequest) ([]dto.TaskResponse, error)
+	GetTaskTreeByIDWrapped(ctx context.Context, req *dto.TaskGetByIDRequest) (*dto.TaskTreeResponse, error)
+	UpdateTaskParentByIDWrapped(ctx context.Context, req *dto.TaskUpdateParentWithIDRequest) (*dto.MessageResponse, error)
+	AddTaskDependencyWrapped(ctx context.Context, req *dto.TaskDependencyWithIDRequest) (*dto.MessageResponse, error)
+	RemoveTaskDependencyWrapped(ctx context.Context, req *dto.TaskDependencyWithIDRequest) (*dto.MessageResponse, error)
+	GetTaskBlockersByIDWrapped(ctx context.Context, req *dto.TaskGetByIDRequest) ([]dto.TaskResponse, error)
+	GetTasksByProjectIDWrapped(ctx context.Context, req *dto.TaskListByProjectRequest) (*dto.TaskListResponse, error)
+	UpdateTaskProjectByIDWrapped(ctx context.Context, req *dto.TaskUpdateProjectWithIDRequest) (*dto.MessageResponse, error)
+	GetTasksByWorkspaceIDWrapped(ctx context.Context, req *dto.TaskListByWorkspaceRequest) (*dto.TaskListResponse, error)
+	UpdateTaskWorkspaceByIDWrapped(ctx context.Context, req *dto.TaskUpdateWorkspaceWithIDRequest) (*dto.MessageResponse, error)
+	AssignTaskWrapped(ctx context.Context, req *dto.TaskAssigneeWithIDRequest) (*dto.MessageResponse, error)
+	UnassignTaskWrapped(ctx context.Context, req *dto.TaskAssigneeWithIDRequest) (*dto.MessageResponse, error)
+	GetAssignedTasksWrapped(ctx context.Context, req *dto.TaskListRequest) (*dto.TaskListResponse, error)
+	GetTaskActivityByIDWrapped(ctx context.Context, req *dto.TaskActivityListRequest) (*dto.TaskActivityListResponse, error)
+	GetTrashedTasksWrapped(ctx context.Context, req *dto.TaskListRequest) (*dto.TaskListResponse, error)
+	RestoreTaskByIDWrapped(ctx context.Context, req *dto.TaskGetByIDRequest) (*dto.MessageResponse, error)
+	PurgeTaskByIDWrapped(ctx context.Context, req *dto.TaskGetByIDRequest) (*dto.MessageResponse, error)
+	BulkTasksWrapped(ctx context.Context, req *dto.TaskBulkRequest) (*dto.TaskBulkResponse, error)
+	SetTaskRecurrenceWrapped(ctx context.Context, req *dto.TaskRecurrenceWithIDRequest) (*dto.MessageResponse, error)
+	GetTaskRecurrenceWrapped(ctx context.Context, req *dto.TaskRecurrencePreviewRequest) (*dto.TaskRecurrenceResponse, error)
+	StopTaskRecurrenceWrapped(ctx context.Context, req *dto.TaskGetByIDRequest) (*dto.MessageResponse, error)
+	MoveTaskWrapped(ctx context.Context, req *dto.TaskMoveWithIDRequest) (*dto.MessageResponse, error)
+	GetBoardWrapped(ctx context.Context, req *dto.TaskBoardRequest) (*dto.TaskBoardResponse, error)
+	ImportTasksWrapped(ctx context.Context, req *dto.TaskImportRequest) (*dto.TaskImportResponse, error)
+	ExportTasksWrapped(ctx context.Context, req *dto.TaskExportRequest) (*dto.FileResponse, error)
 }
 
 type handler struct {
 	taskService task.Service
+	validator   *validator.Validator
 }
 
 // @WireSet("Handler")
 func New(taskService task.Service) Handler {
 	return &handler{
 		taskService: taskService,
+		validator:   validator.NewValidator(),
 	}
 }
 
 func (h *handler) CreateTask(ctx context.Context, req *dto.TaskCreateRequest, userID string) (*dto.MessageResponse, error) {
-	serviceInput := task.TaskCreateInput{
-		Title:       req.Title,
-		Description: req.Description,
-		Priority:    req.Priority,
-	}
+	serviceInput := toTaskCreateInput(req)
 
 	err := h.taskService.CreateTask(ctx, &serviceInput, userID)
 	if err != nil {
@@ -60,46 +114,66 @@ func (h *handler) GetTaskByID(ctx context.Context, taskID string, userID string)
 		return nil, err
 	}
 
-	return &dto.TaskResponse{
-		ID:          foundTask.ID,
-		UserID:      foundTask.UserID,
-		Title:       foundTask.Title,
-		Description: foundTask.Description,
-		Priority:    foundTask.Priority,
-		Status:      foundTask.Status,
-		CreatedAt:   foundTask.CreatedAt,
-		UpdatedAt:   foundTask.UpdatedAt,
-	}, nil
+	taskResponse := toTaskResponse(foundTask)
+
+	// Clients send the tag back in If-Match when they change the task
+	echoutil.SetResponseHeader(ctx, "ETag", etagutil.Format(foundTask.Version))
+
+	return &taskResponse, nil
 }
 
-func (h *handler) GetTasksByUserID(ctx context.Context, userID string) ([]dto.TaskResponse, error) {
-	tasks, err := h.taskService.FindTaskByUserID(ctx, userID)
+func (h *handler) GetTasksByUserID(ctx context.Context, req *dto.TaskListRequest, userID string) (*dto.TaskListResponse, error) {
+	serviceInput := toTaskListInput(req)
+
+	output, err := h.taskService.FindTaskByUserID(ctx, &serviceInput, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	taskResponses := make([]dto.TaskResponse, len(tasks))
-	for i, task := range tasks {
-		taskResponses[i] = dto.TaskResponse{
-			ID:          task.ID,
-			UserID:      task.UserID,
-			Title:       task.Title,
-			Description: task.Description,
-			Priority:    task.Priority,
-			Status:      task.Status,
-			CreatedAt:   task.CreatedAt,
-			UpdatedAt:   task.UpdatedAt,
+	taskListResponse := toTaskListResponse(output)
+
+	return &taskListResponse, nil
+}
+
+func (h *handler) SearchTasks(ctx context.Context, req *dto.TaskSearchRequest, userID string) (*dto.TaskSearchResponse, error) {
+	serviceInput := task.TaskSearchInput{
+		Query:  req.Q,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	}
+
+	results, err := h.taskService.SearchTasks(ctx, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.TaskSearchItemResponse, len(results))
+	for i, result := range results {
+		items[i] = dto.TaskSearchItemResponse{
+			Task: toTaskResponse(&result.Task),
+			Rank: result.Rank,
+			Highlights: dto.TaskSearchHighlightResponse{
+				Title:       result.TitleHighlight,
+				Description: result.DescriptionHighlight,
+			},
 		}
 	}
 
-	return taskResponses, nil
+	return &dto.TaskSearchResponse{
+		Items: items,
+	}, nil
 }
 
 func (h *handler) UpdateTaskByID(ctx context.Context, taskID string, req *dto.TaskUpdateRequest, userID string) (*dto.MessageResponse, error) {
 	serviceInput := task.TaskUpdateInput{
-		Title:       req.Title,
-		Description: req.Description,
-		Priority:    req.Priority,
+		Title:        req.Title,
+		Description:  req.Description,
+		Priority:     req.Priority,
+		StartAt:      req.StartAt,
+		DueAt:        req.DueAt,
+		TagIDs:       req.TagIDs,
+		CustomFields: req.CustomFields,
+		Version:      req.Version,
 	}
 
 	err := h.taskService.UpdateTaskByID(ctx, taskID, &serviceInput, userID)
@@ -112,9 +186,32 @@ func (h *handler) UpdateTaskByID(ctx context.Context, taskID string, req *dto.Ta
 	}, nil
 }
 
+func (h *handler) PatchTaskByID(ctx context.Context, taskID string, req *dto.TaskPatchRequest, userID string) (*dto.MessageResponse, error) {
+	serviceInput := task.TaskPatchInput{
+		Title:        req.Title,
+		Description:  req.Description,
+		Priority:     req.Priority,
+		StartAt:      req.StartAt,
+		DueAt:        req.DueAt,
+		TagIDs:       req.TagIDs,
+		CustomFields: req.CustomFields,
+		Version:      req.Version,
+	}
+
+	err := h.taskService.PatchTaskByID(ctx, taskID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Task updated successfully",
+	}, nil
+}
+
 func (h *handler) UpdateTaskStatusByID(ctx context.Context, taskID string, req *dto.TaskUpdateStatusRequest, userID string) (*dto.MessageResponse, error) {
 	serviceInput := task.TaskUpdateStatusInput{
-		Status: req.Status,
+		Status:  req.Status,
+		Version: req.Version,
 	}
 
 	err := h.taskService.UpdateTaskStatusByID(ctx, taskID, &serviceInput, userID)
@@ -127,8 +224,13 @@ func (h *handler) UpdateTaskStatusByID(ctx context.Context, taskID string, req *
 	}, nil
 }
 
-func (h *handler) DeleteTaskByID(ctx context.Context, taskID string, userID string) (*dto.MessageResponse, error) {
-	err := h.taskService.DeleteTaskByID(ctx, taskID, userID)
+func (h *handler) DeleteTaskByID(ctx context.Context, taskID string, req *dto.TaskDeleteStrategyRequest, userID string) (*dto.MessageResponse, error) {
+	serviceInput := task.TaskDeleteInput{
+		Strategy: req.Strategy,
+		Version:  req.Version,
+	}
+
+	err := h.taskService.DeleteTaskByID(ctx, taskID, &serviceInput, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -162,7 +264,18 @@ func (h *handler) GetTaskByIDWrapped(ctx context.Context, req *dto.TaskGetByIDRe
 	return h.GetTaskByID(ctx, req.ID, userID)
 }
 
-func (h *handler) GetTasksByUserIDWrapped(ctx context.Context, _ any) ([]dto.TaskResponse, error) {
+func (h *handler) GetTasksByUserIDWrapped(ctx context.Context, req *dto.TaskListRequest) (*dto.TaskListResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetTasksByUserID(ctx, req, userID)
+}
+
+func (h *handler) SearchTasksWrapped(ctx context.Context, req *dto.TaskSearchRequest) (*dto.TaskSearchResponse, error) {
 	userID, err := echoutil.GetUserIDFromContext(ctx)
 	if err != nil {
 		return nil, servererr.NewError(
@@ -170,7 +283,7 @@ func (h *handler) GetTasksByUserIDWrapped(ctx context.Context, _ any) ([]dto.Tas
 			"user ID not found in context",
 		)
 	}
-	return h.GetTasksByUserID(ctx, userID)
+	return h.SearchTasks(ctx, req, userID)
 }
 
 func (h *handler) UpdateTaskByIDWrapped(ctx context.Context, req *dto.TaskUpdateWithIDRequest) (*dto.MessageResponse, error) {
@@ -181,14 +294,48 @@ func (h *handler) UpdateTaskByIDWrapped(ctx context.Context, req *dto.TaskUpdate
 			"user ID not found in context",
 		)
 	}
+	version, err := requireVersion(req.IfMatch, req.Version)
+	if err != nil {
+		return nil, err
+	}
 	updateReq := &dto.TaskUpdateRequest{
-		Title:       req.Title,
-		Description: req.Description,
-		Priority:    req.Priority,
+		Title:        req.Title,
+		Description:  req.Description,
+		Priority:     req.Priority,
+		StartAt:      req.StartAt,
+		DueAt:        req.DueAt,
+		TagIDs:       req.TagIDs,
+		CustomFields: req.CustomFields,
+		Version:      version,
 	}
 	return h.UpdateTaskByID(ctx, req.ID, updateReq, userID)
 }
 
+func (h *handler) PatchTaskByIDWrapped(ctx context.Context, req *dto.TaskPatchWithIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	version, err := requireVersion(req.IfMatch, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	patchReq := &dto.TaskPatchRequest{
+		Title:        req.Title,
+		Description:  req.Description,
+		Priority:     req.Priority,
+		StartAt:      req.StartAt,
+		DueAt:        req.DueAt,
+		TagIDs:       req.TagIDs,
+		CustomFields: req.CustomFields,
+		Version:      version,
+	}
+	return h.PatchTaskByID(ctx, req.ID, patchReq, userID)
+}
+
 func (h *handler) UpdateTaskStatusByIDWrapped(ctx context.Context, req *dto.TaskUpdateStatusWithIDRequest) (*dto.MessageResponse, error) {
 	userID, err := echoutil.GetUserIDFromContext(ctx)
 	if err != nil {
@@ -197,8 +344,13 @@ func (h *handler) UpdateTaskStatusByIDWrapped(ctx context.Context, req *dto.Task
 			"user ID not found in context",
 		)
 	}
+	version, err := requireVersion(req.IfMatch, req.Version)
+	if err != nil {
+		return nil, err
+	}
 	statusReq := &dto.TaskUpdateStatusRequest{
-		Status: req.Status,
+		Status:  req.Status,
+		Version: version,
 	}
 	return h.UpdateTaskStatusByID(ctx, req.ID, statusReq, userID)
 }
@@ -211,5 +363,13 @@ func (h *handler) DeleteTaskByIDWrapped(ctx context.Context, req *dto.TaskDelete
 			"user ID not found in context",
 		)
 	}
-	return h.DeleteTaskByID(ctx, req.ID, userID)
+	version, err := requireVersion(req.IfMatch, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	strategyReq := &dto.TaskDeleteStrategyRequest{
+		Strategy: req.Strategy,
+		Version:  version,
+	}
+	return h.DeleteTaskByID(ctx, req.ID, strategyReq, userID)
 }
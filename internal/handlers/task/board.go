@@ -0,0 +1,90 @@
+package task
+
+import (
+	"context"
+
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/task"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+)
+
+func (h *handler) MoveTask(ctx context.Context, taskID string, req *dto.TaskMoveRequest, userID string) (*dto.MessageResponse, error) {
+	serviceInput := task.TaskMoveInput{
+		Status:   req.Status,
+		BeforeID: req.BeforeID,
+		AfterID:  req.AfterID,
+	}
+
+	err := h.taskService.MoveTask(ctx, taskID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Task moved successfully",
+	}, nil
+}
+
+func (h *handler) GetBoard(ctx context.Context, req *dto.TaskBoardRequest, userID string) (*dto.TaskBoardResponse, error) {
+	serviceInput := task.TaskBoardInput{
+		WorkspaceID: req.WorkspaceID,
+		ProjectID:   req.ProjectID,
+	}
+
+	output, err := h.taskService.FindBoard(ctx, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := dto.TaskBoardResponse{
+		Columns: make([]dto.TaskBoardColumnResponse, len(output.Columns)),
+	}
+
+	for i, column := range output.Columns {
+		tasks := make([]dto.TaskResponse, len(column.Tasks))
+		for j := range column.Tasks {
+			tasks[j] = toTaskResponse(&column.Tasks[j])
+		}
+
+		response.Columns[i] = dto.TaskBoardColumnResponse{
+			Status:     column.Status,
+			Name:       column.Name,
+			Category:   column.Category,
+			Tasks:      tasks,
+			TotalCount: column.TotalCount,
+			Truncated:  column.Truncated,
+		}
+	}
+
+	return &response, nil
+}
+
+// Wrapper methods for WrapWithStatus compatibility
+
+func (h *handler) MoveTaskWrapped(ctx context.Context, req *dto.TaskMoveWithIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	moveReq := &dto.TaskMoveRequest{
+		Status:   req.Status,
+		BeforeID: req.BeforeID,
+		AfterID:  req.AfterID,
+	}
+	return h.MoveTask(ctx, req.ID, moveReq, userID)
+}
+
+func (h *handler) GetBoardWrapped(ctx context.Context, req *dto.TaskBoardRequest) (*dto.TaskBoardResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetBoard(ctx, req, userID)
+}
@@ -0,0 +1,123 @@
+package task
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/task"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+)
+
+func (h *handler) BulkTasks(ctx context.Context, req *dto.TaskBulkRequest, userID string) (*dto.TaskBulkResponse, error) {
+	serviceInput := task.TaskBulkInput{
+		Mode:       req.Mode,
+		Operations: make([]task.TaskBulkOperationInput, len(req.Operations)),
+	}
+
+	for i, op := range req.Operations {
+		serviceInput.Operations[i] = toTaskBulkOperationInput(&op)
+	}
+
+	output, err := h.taskService.BulkTasks(ctx, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := dto.TaskBulkResponse{
+		Mode:      output.Mode.String(),
+		Committed: output.Committed,
+		Results:   make([]dto.TaskBulkResultResponse, len(output.Results)),
+	}
+
+	for i, result := range output.Results {
+		response.Results[i] = toTaskBulkResultResponse(i, &result)
+		if result.Err != nil {
+			response.Failed++
+		} else if !result.RolledBack {
+			response.Succeeded++
+		}
+	}
+
+	return &response, nil
+}
+
+func toTaskBulkOperationInput(op *dto.TaskBulkOperationRequest) task.TaskBulkOperationInput {
+	input := task.TaskBulkOperationInput{
+		Action: op.Action,
+		TaskID: op.TaskID,
+	}
+
+	if op.Create != nil {
+		createInput := toTaskCreateInput(op.Create)
+		input.Create = &createInput
+	}
+
+	if op.Update != nil {
+		input.Update = &task.TaskUpdateInput{
+			Title:        op.Update.Title,
+			Description:  op.Update.Description,
+			Priority:     op.Update.Priority,
+			StartAt:      op.Update.StartAt,
+			DueAt:        op.Update.DueAt,
+			TagIDs:       op.Update.TagIDs,
+			CustomFields: op.Update.CustomFields,
+			Version:      op.Update.Version,
+		}
+	}
+
+	if op.Status != nil {
+		input.Status = &task.TaskUpdateStatusInput{
+			Status:  op.Status.Status,
+			Version: op.Status.Version,
+		}
+	}
+
+	if op.Delete != nil {
+		input.Delete = &task.TaskDeleteInput{
+			Strategy: op.Delete.Strategy,
+			Version:  op.Delete.Version,
+		}
+	}
+
+	return input
+}
+
+func toTaskBulkResultResponse(index int, result *task.TaskBulkResult) dto.TaskBulkResultResponse {
+	response := dto.TaskBulkResultResponse{
+		Index:      index,
+		Action:     result.Action.String(),
+		TaskID:     optionalString(result.TaskID),
+		Status:     http.StatusOK,
+		RolledBack: result.RolledBack,
+	}
+
+	if result.Action == enums.TaskBulkActionCreate {
+		response.Status = http.StatusCreated
+	}
+
+	if result.Err != nil {
+		response.Status = result.Err.Code.HTTPStatus()
+		response.Error = &dto.ErrorResponse{
+			Code:    result.Err.Code.String(),
+			Message: result.Err.Message,
+		}
+	}
+
+	return response
+}
+
+// Wrapper methods for WrapWithStatus compatibility
+
+func (h *handler) BulkTasksWrapped(ctx context.Context, req *dto.TaskBulkRequest) (*dto.TaskBulkResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.BulkTasks(ctx, req, userID)
+}
@@ -0,0 +1,95 @@
+package task
+
+import (
+	"context"
+
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/task"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+)
+
+func (h *handler) AddTaskDependency(ctx context.Context, taskID string, req *dto.TaskDependencyRequest, userID string) (*dto.MessageResponse, error) {
+	serviceInput := task.TaskDependencyInput{
+		BlockedByID: req.BlockedByID,
+	}
+
+	err := h.taskService.AddTaskDependency(ctx, taskID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Task dependency added successfully",
+	}, nil
+}
+
+func (h *handler) RemoveTaskDependency(ctx context.Context, taskID string, req *dto.TaskDependencyRequest, userID string) (*dto.MessageResponse, error) {
+	serviceInput := task.TaskDependencyInput{
+		BlockedByID: req.BlockedByID,
+	}
+
+	err := h.taskService.RemoveTaskDependency(ctx, taskID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Task dependency removed successfully",
+	}, nil
+}
+
+func (h *handler) GetTaskBlockersByID(ctx context.Context, taskID string, userID string) ([]dto.TaskResponse, error) {
+	blockers, err := h.taskService.FindTaskBlockersByID(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskResponses := make([]dto.TaskResponse, len(blockers))
+	for i := range blockers {
+		taskResponses[i] = toTaskResponse(&blockers[i])
+	}
+
+	return taskResponses, nil
+}
+
+// Wrapper methods for WrapWithStatus compatibility
+
+func (h *handler) AddTaskDependencyWrapped(ctx context.Context, req *dto.TaskDependencyWithIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	dependencyReq := &dto.TaskDependencyRequest{
+		BlockedByID: req.BlockedByID,
+	}
+	return h.AddTaskDependency(ctx, req.ID, dependencyReq, userID)
+}
+
+func (h *handler) RemoveTaskDependencyWrapped(ctx context.Context, req *dto.TaskDependencyWithIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	dependencyReq := &dto.TaskDependencyRequest{
+		BlockedByID: req.BlockedByID,
+	}
+	return h.RemoveTaskDependency(ctx, req.ID, dependencyReq, userID)
+}
+
+func (h *handler) GetTaskBlockersByIDWrapped(ctx context.Context, req *dto.TaskGetByIDRequest) ([]dto.TaskResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetTaskBlockersByID(ctx, req.ID, userID)
+}
@@ -0,0 +1,209 @@
+package task
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/icalutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/importutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+)
+
+// taskEachFunc hands every exported task to fn as it is read
+type taskEachFunc func(ctx context.Context, fn func(task *entities.Task) error) error
+
+// taskExporter writes tasks in one export format
+type taskExporter struct {
+	extension   string
+	contentType string
+	write       func(ctx context.Context, w io.Writer, each taskEachFunc) error
+}
+
+var taskExporters = map[string]taskExporter{
+	"csv":  {extension: "csv", contentType: "text/csv; charset=utf-8", write: writeTasksCSV},
+	"json": {extension: "json", contentType: "application/json", write: writeTasksJSON},
+	"md":   {extension: "md", contentType: "text/markdown; charset=utf-8", write: writeTasksMarkdown},
+	"ics":  {extension: "ics", contentType: "text/calendar; charset=utf-8", write: writeTasksICal},
+}
+
+// exportColumns are the CSV columns of an export, named like the import fields
+// so an exported file can be imported again
+var exportColumns = []string{
+	"id", "title", "description", "status", "statusCategory", "priority", "startAt", "dueAt",
+	"workspaceId", "projectId", "parentId", "createdAt", "updatedAt",
+}
+
+func (h *handler) ExportTasks(ctx context.Context, req *dto.TaskExportRequest, userID string) (*dto.FileResponse, error) {
+	exporter, ok := taskExporters[req.Format]
+	if !ok {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid format. Format must be csv, json, md or ics",
+		)
+	}
+
+	serviceInput := toTaskListInput(&req.TaskListRequest)
+
+	output, err := h.taskService.ExportTasks(ctx, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tasks are written to the response while they are read
+	return echoutil.StreamFile(ctx, "tasks."+exporter.extension, exporter.contentType, func(w io.Writer) error {
+		return exporter.write(ctx, w, output.ForEach)
+	}), nil
+}
+
+func writeTasksCSV(ctx context.Context, w io.Writer, each taskEachFunc) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(exportColumns); err != nil {
+		return err
+	}
+
+	err := each(ctx, func(task *entities.Task) error {
+		return writer.Write([]string{
+			task.ID,
+			importutil.EscapeCell(task.Title),
+			importutil.EscapeCell(task.Description),
+			task.Status.String(),
+			task.StatusCategory.String(),
+			strconv.Itoa(task.Priority.Int()),
+			formatExportTime(task.StartAt),
+			formatExportTime(task.DueAt),
+			derefString(task.WorkspaceID),
+			derefString(task.ProjectID),
+			derefString(task.ParentID),
+			formatExportTime(&task.CreatedAt),
+			formatExportTime(&task.UpdatedAt),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func writeTasksJSON(ctx context.Context, w io.Writer, each taskEachFunc) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+
+	separator := "\n"
+	err := each(ctx, func(task *entities.Task) error {
+		encoded, err := json.Marshal(toTaskResponse(task))
+		if err != nil {
+			return err
+		}
+
+		if _, err := io.WriteString(w, separator); err != nil {
+			return err
+		}
+		separator = ",\n"
+
+		_, err = w.Write(encoded)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "\n]\n")
+	return err
+}
+
+func writeTasksMarkdown(ctx context.Context, w io.Writer, each taskEachFunc) error {
+	header := "| Title | Status | Priority | Start | Due | Description |\n" +
+		"| --- | --- | --- | --- | --- | --- |\n"
+	if _, err := io.WriteString(w, header); err != nil {
+		return err
+	}
+
+	return each(ctx, func(task *entities.Task) error {
+		_, err := fmt.Fprintf(w, "| %s | %s | %s | %s | %s | %s |\n",
+			markdownCell(task.Title),
+			markdownCell(task.Status.String()),
+			priorityName(task.Priority),
+			formatExportTime(task.StartAt),
+			formatExportTime(task.DueAt),
+			markdownCell(task.Description),
+		)
+		return err
+	})
+}
+
+func writeTasksICal(ctx context.Context, w io.Writer, each taskEachFunc) error {
+	return icalutil.WriteCalendar(w, nil, func(cal *icalutil.Writer) error {
+		return each(ctx, func(task *entities.Task) error {
+			icalutil.WriteTaskTodo(cal, task)
+			return cal.Err()
+		})
+	})
+}
+
+func priorityName(priority enums.TaskPriority) string {
+	switch priority {
+	case enums.TaskPriorityHigh:
+		return "High"
+	case enums.TaskPriorityMedium:
+		return "Medium"
+	case enums.TaskPriorityLow:
+		return "Low"
+	default:
+		return strconv.Itoa(priority.Int())
+	}
+}
+
+var markdownCellEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"|", `\|`,
+	"\r\n", "<br>",
+	"\n", "<br>",
+	"\r", "<br>",
+)
+
+// markdownCell escapes a value so it stays inside its table cell
+func markdownCell(value string) string {
+	return markdownCellEscaper.Replace(value)
+}
+
+func formatExportTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+// Wrapper methods for WrapWithStatus compatibility
+
+func (h *handler) ExportTasksWrapped(ctx context.Context, req *dto.TaskExportRequest) (*dto.FileResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.ExportTasks(ctx, req, userID)
+}
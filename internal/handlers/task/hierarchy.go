@@ -0,0 +1,94 @@
+package task
+
+import (
+	"context"
+
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/task"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+)
+
+func (h *handler) GetSubtasksByID(ctx context.Context, taskID string, userID string) ([]dto.TaskResponse, error) {
+	subtasks, err := h.taskService.FindSubtasksByID(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskResponses := make([]dto.TaskResponse, len(subtasks))
+	for i := range subtasks {
+		taskResponses[i] = toTaskResponse(&subtasks[i])
+	}
+
+	return taskResponses, nil
+}
+
+func (h *handler) GetTaskTreeByID(ctx context.Context, taskID string, userID string) (*dto.TaskTreeResponse, error) {
+	tree, err := h.taskService.FindTaskTreeByID(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	treeResponse := toTaskTreeResponse(tree)
+
+	return &treeResponse, nil
+}
+
+func (h *handler) UpdateTaskParentByID(ctx context.Context, taskID string, req *dto.TaskUpdateParentRequest, userID string) (*dto.MessageResponse, error) {
+	serviceInput := task.TaskUpdateParentInput{
+		ParentID: req.ParentID,
+		Version:  req.Version,
+	}
+
+	err := h.taskService.UpdateTaskParentByID(ctx, taskID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Task parent updated successfully",
+	}, nil
+}
+
+// Wrapper methods for WrapWithStatus compatibility
+
+func (h *handler) GetSubtasksByIDWrapped(ctx context.Context, req *dto.TaskGetByIDRequest) ([]dto.TaskResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetSubtasksByID(ctx, req.ID, userID)
+}
+
+func (h *handler) GetTaskTreeByIDWrapped(ctx context.Context, req *dto.TaskGetByIDRequest) (*dto.TaskTreeResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetTaskTreeByID(ctx, req.ID, userID)
+}
+
+func (h *handler) UpdateTaskParentByIDWrapped(ctx context.Context, req *dto.TaskUpdateParentWithIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	version, err := requireVersion(req.IfMatch, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	parentReq := &dto.TaskUpdateParentRequest{
+		ParentID: req.ParentID,
+		Version:  version,
+	}
+	return h.UpdateTaskParentByID(ctx, req.ID, parentReq, userID)
+}
@@ -0,0 +1,148 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/task"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/importutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/rs/zerolog/log"
+)
+
+func (h *handler) ImportTasks(ctx context.Context, req *dto.TaskImportRequest, userID string) (*dto.TaskImportResponse, error) {
+	format, err := importutil.ResolveFormat(req.Format, req.File.Filename, req.File.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, err
+	}
+
+	mapping, err := importutil.ParseMapping(req.Mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	// The route limits the request body as a whole, this names the limit of the file
+	if req.File.Size > task.MaxImportSizeBytes {
+		log.Warn().
+			Str("fileName", req.File.Filename).
+			Int64("size", req.File.Size).
+			Msg("Import file is too large")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			fmt.Sprintf("Invalid file. An import can be at most %d bytes", task.MaxImportSizeBytes),
+		)
+	}
+
+	content, err := req.File.Open()
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("fileName", req.File.Filename).
+			Msg("Failed to open uploaded file")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid file",
+		)
+	}
+	defer content.Close()
+
+	rows, err := importutil.ReadTasks(content, &importutil.Options{
+		Format:      format,
+		Mapping:     mapping,
+		MaxRows:     task.MaxImportRows,
+		WorkspaceID: req.WorkspaceID,
+		ProjectID:   req.ProjectID,
+	}, h.validator)
+	if err != nil {
+		return nil, err
+	}
+
+	// Send the valid rows on, the others are reported as they are
+	serviceInput := task.TaskImportInput{
+		DryRun: req.DryRun,
+	}
+	var pending []int
+	for i := range rows {
+		if rows[i].Skipped || rows[i].Err != nil {
+			continue
+		}
+
+		pending = append(pending, i)
+		serviceInput.Rows = append(serviceInput.Rows, toTaskCreateInput(&rows[i].Request))
+	}
+
+	response := dto.TaskImportResponse{
+		DryRun: req.DryRun,
+		Rows:   make([]dto.TaskImportRowResponse, len(rows)),
+	}
+
+	taskIDs := make([]string, len(rows))
+	if len(pending) > 0 {
+		output, err := h.taskService.ImportTasks(ctx, &serviceInput, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		response.Committed = output.Committed
+		for i, result := range output.Results {
+			taskIDs[pending[i]] = result.TaskID
+			rows[pending[i]].Err = result.Err
+		}
+	}
+
+	for i := range rows {
+		response.Rows[i] = toTaskImportRowResponse(&rows[i], taskIDs[i], response.Committed)
+
+		switch enums.TaskImportRowStatus(response.Rows[i].Status) {
+		case enums.TaskImportRowStatusCreated:
+			response.Created++
+		case enums.TaskImportRowStatusSkipped:
+			response.Skipped++
+		default:
+			response.Failed++
+		}
+	}
+
+	return &response, nil
+}
+
+func toTaskImportRowResponse(row *importutil.Row, taskID string, committed bool) dto.TaskImportRowResponse {
+	response := dto.TaskImportRowResponse{
+		Row:    row.Row,
+		Status: enums.TaskImportRowStatusCreated.String(),
+	}
+
+	switch {
+	case row.Skipped:
+		response.Status = enums.TaskImportRowStatusSkipped.String()
+	case row.Err != nil:
+		response.Status = enums.TaskImportRowStatusFailed.String()
+		response.Error = &dto.ErrorResponse{
+			Code:    row.Err.Code.String(),
+			Message: row.Err.Message,
+		}
+	case committed:
+		// A dry run reports no IDs, its tasks were rolled back
+		response.TaskID = optionalString(taskID)
+	}
+
+	return response
+}
+
+// Wrapper methods for WrapWithStatus compatibility
+
+func (h *handler) ImportTasksWrapped(ctx context.Context, req *dto.TaskImportRequest) (*dto.TaskImportResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.ImportTasks(ctx, req, userID)
+}
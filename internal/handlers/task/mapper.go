@@ -0,0 +1,189 @@
+package task
+
+import (
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/task"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+)
+
+func toTaskResponse(task *entities.Task) dto.TaskResponse {
+	response := dto.TaskResponse{
+		ID:             task.ID,
+		UserID:         task.UserID,
+		WorkspaceID:    task.WorkspaceID,
+		ProjectID:      task.ProjectID,
+		ParentID:       task.ParentID,
+		Title:          task.Title,
+		Description:    task.Description,
+		Priority:       task.Priority,
+		Status:         task.Status,
+		StatusCategory: task.StatusCategory,
+		BoardRank:      task.BoardRank,
+		Version:        task.Version,
+		StartAt:        task.StartAt,
+		DueAt:          task.DueAt,
+		IsOverdue:      task.IsOverdue(timeutil.BangkokNow()),
+		CreatedAt:      task.CreatedAt,
+		UpdatedAt:      task.UpdatedAt,
+		DeletedAt:      task.DeletedAt,
+		SeriesID:       task.SeriesID,
+
+		LoggedSeconds: task.LoggedSeconds,
+	}
+
+	if task.Subtasks != nil {
+		response.Subtasks = &dto.SubtaskRollupResponse{
+			Total:     task.Subtasks.Total,
+			Completed: task.Subtasks.Completed,
+		}
+	}
+
+	if task.Tags != nil {
+		response.Tags = make([]dto.TagResponse, len(task.Tags))
+		for i, tag := range task.Tags {
+			response.Tags[i] = dto.TagResponse{
+				ID:        tag.ID,
+				Name:      tag.Name,
+				Color:     tag.Color,
+				CreatedAt: tag.CreatedAt,
+				UpdatedAt: tag.UpdatedAt,
+			}
+		}
+	}
+
+	if task.CustomFields != nil {
+		response.CustomFields = make([]dto.TaskCustomFieldValueResponse, len(task.CustomFields))
+		for i, value := range task.CustomFields {
+			response.CustomFields[i] = dto.TaskCustomFieldValueResponse{
+				FieldID: value.FieldID,
+				Name:    value.Name,
+				Type:    value.Type.String(),
+				Value:   value.Value,
+			}
+		}
+	}
+
+	if task.Creator != nil {
+		creator := toTaskUserResponse(task.Creator)
+		response.Creator = &creator
+	}
+
+	if task.Assignees != nil {
+		response.Assignees = make([]dto.TaskAssigneeResponse, len(task.Assignees))
+		for i, assignee := range task.Assignees {
+			response.Assignees[i] = dto.TaskAssigneeResponse{
+				TaskUserResponse: toTaskUserResponse(&assignee.UserSummary),
+				AssignedAt:       assignee.AssignedAt,
+			}
+		}
+	}
+
+	return response
+}
+
+func toTaskActivityResponse(activity *entities.TaskActivity) dto.TaskActivityResponse {
+	response := dto.TaskActivityResponse{
+		ID:        activity.ID,
+		TaskID:    activity.TaskID,
+		Action:    activity.Action.String(),
+		Changes:   make([]dto.TaskFieldChangeResponse, len(activity.Changes)),
+		CreatedAt: activity.CreatedAt,
+	}
+
+	for i, change := range activity.Changes {
+		response.Changes[i] = dto.TaskFieldChangeResponse{
+			Field:  change.Field,
+			Before: change.Before,
+			After:  change.After,
+		}
+	}
+
+	if activity.Actor != nil {
+		actor := toTaskUserResponse(activity.Actor)
+		response.Actor = &actor
+	}
+
+	return response
+}
+
+func toTaskUserResponse(user *entities.UserSummary) dto.TaskUserResponse {
+	return dto.TaskUserResponse{
+		ID:    user.ID,
+		Name:  user.Name,
+		Email: user.Email,
+	}
+}
+
+func toTaskListInput(req *dto.TaskListRequest) task.TaskListInput {
+	return task.TaskListInput{
+		Cursor:           req.Cursor,
+		Limit:            req.Limit,
+		Statuses:         req.Status,
+		StatusCategories: req.StatusCategory,
+		Priorities:       req.Priority,
+		CreatedFrom:      req.CreatedFrom,
+		CreatedTo:        req.CreatedTo,
+		UpdatedFrom:      req.UpdatedFrom,
+		UpdatedTo:        req.UpdatedTo,
+		Overdue:          req.Overdue,
+		TagIDs:           req.Tag,
+		TagMode:          req.TagMode,
+		AssigneeIDs:      req.Assignee,
+		CustomFields:     req.CustomField,
+		IncludeArchived:  req.IncludeArchived,
+		SortBy:           req.SortBy,
+		SortOrder:        req.SortOrder,
+	}
+}
+
+func toTaskListResponse(output *task.TaskListOutput) dto.TaskListResponse {
+	taskResponses := make([]dto.TaskResponse, len(output.Tasks))
+	for i := range output.Tasks {
+		taskResponses[i] = toTaskResponse(&output.Tasks[i])
+	}
+
+	return dto.TaskListResponse{
+		Items:      taskResponses,
+		NextCursor: optionalString(output.NextCursor),
+		PrevCursor: optionalString(output.PrevCursor),
+		TotalCount: output.TotalCount,
+	}
+}
+
+func toTaskTreeResponse(tree *entities.TaskTree) dto.TaskTreeResponse {
+	response := dto.TaskTreeResponse{
+		TaskResponse: toTaskResponse(&tree.Task),
+		Children:     make([]dto.TaskTreeResponse, len(tree.Children)),
+	}
+
+	for i := range tree.Children {
+		response.Children[i] = toTaskTreeResponse(&tree.Children[i])
+	}
+
+	return response
+}
+
+// optionalString maps an empty string to nil so it is rendered as null
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+func toTaskCreateInput(req *dto.TaskCreateRequest) task.TaskCreateInput {
+	return task.TaskCreateInput{
+		WorkspaceID:  req.WorkspaceID,
+		ProjectID:    req.ProjectID,
+		ParentID:     req.ParentID,
+		Title:        req.Title,
+		Description:  req.Description,
+		Priority:     req.Priority,
+		StartAt:      req.StartAt,
+		DueAt:        req.DueAt,
+		TagIDs:       req.TagIDs,
+		CustomFields: req.CustomFields,
+	}
+}
@@ -38,56 +38,3814 @@ func (_m *MockHandler) EXPECT() *MockHandler_Expecter {
 	return &MockHandler_Expecter{mock: &_m.Mock}
 }
 
+// AddTaskDependency provides a mock function for the type MockHandler
+func (_mock *MockHandler) AddTaskDependency(ctx context.Context, taskID string, req *dto.TaskDependencyRequest, userID string) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, taskID, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTaskDependency")
+	}
+
+	var r0 *dto.MessageResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskDependencyRequest, string) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, taskID, req, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskDependencyRequest, string) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, taskID, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MessageResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *dto.TaskDependencyRequest, string) error); ok {
+		r1 = returnFunc(ctx, taskID, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_AddTaskDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTaskDependency'
+type MockHandler_AddTaskDependency_Call struct {
+	*mock.Call
+}
+
+// AddTaskDependency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - req *dto.TaskDependencyRequest
+//   - userID string
+func (_e *MockHandler_Expecter) AddTaskDependency(ctx interface{}, taskID interface{}, req interface{}, userID interface{}) *MockHandler_AddTaskDependency_Call {
+	return &MockHandler_AddTaskDependency_Call{Call: _e.mock.On("AddTaskDependency", ctx, taskID, req, userID)}
+}
+
+func (_c *MockHandler_AddTaskDependency_Call) Run(run func(ctx context.Context, taskID string, req *dto.TaskDependencyRequest, userID string)) *MockHandler_AddTaskDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *dto.TaskDependencyRequest
+		if args[2] != nil {
+			arg2 = args[2].(*dto.TaskDependencyRequest)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_AddTaskDependency_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_AddTaskDependency_Call {
+	_c.Call.Return(messageResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_AddTaskDependency_Call) RunAndReturn(run func(ctx context.Context, taskID string, req *dto.TaskDependencyRequest, userID string) (*dto.MessageResponse, error)) *MockHandler_AddTaskDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddTaskDependencyWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) AddTaskDependencyWrapped(ctx context.Context, req *dto.TaskDependencyWithIDRequest) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTaskDependencyWrapped")
+	}
+
+	var r0 *dto.MessageResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskDependencyWithIDRequest) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskDependencyWithIDRequest) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MessageResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskDependencyWithIDRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_AddTaskDependencyWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTaskDependencyWrapped'
+type MockHandler_AddTaskDependencyWrapped_Call struct {
+	*mock.Call
+}
+
+// AddTaskDependencyWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskDependencyWithIDRequest
+func (_e *MockHandler_Expecter) AddTaskDependencyWrapped(ctx interface{}, req interface{}) *MockHandler_AddTaskDependencyWrapped_Call {
+	return &MockHandler_AddTaskDependencyWrapped_Call{Call: _e.mock.On("AddTaskDependencyWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_AddTaskDependencyWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskDependencyWithIDRequest)) *MockHandler_AddTaskDependencyWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskDependencyWithIDRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskDependencyWithIDRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_AddTaskDependencyWrapped_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_AddTaskDependencyWrapped_Call {
+	_c.Call.Return(messageResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_AddTaskDependencyWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskDependencyWithIDRequest) (*dto.MessageResponse, error)) *MockHandler_AddTaskDependencyWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssignTask provides a mock function for the type MockHandler
+func (_mock *MockHandler) AssignTask(ctx context.Context, taskID string, req *dto.TaskAssigneeRequest, userID string) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, taskID, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignTask")
+	}
+
+	var r0 *dto.MessageResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskAssigneeRequest, string) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, taskID, req, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskAssigneeRequest, string) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, taskID, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MessageResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *dto.TaskAssigneeRequest, string) error); ok {
+		r1 = returnFunc(ctx, taskID, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_AssignTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignTask'
+type MockHandler_AssignTask_Call struct {
+	*mock.Call
+}
+
+// AssignTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - req *dto.TaskAssigneeRequest
+//   - userID string
+func (_e *MockHandler_Expecter) AssignTask(ctx interface{}, taskID interface{}, req interface{}, userID interface{}) *MockHandler_AssignTask_Call {
+	return &MockHandler_AssignTask_Call{Call: _e.mock.On("AssignTask", ctx, taskID, req, userID)}
+}
+
+func (_c *MockHandler_AssignTask_Call) Run(run func(ctx context.Context, taskID string, req *dto.TaskAssigneeRequest, userID string)) *MockHandler_AssignTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *dto.TaskAssigneeRequest
+		if args[2] != nil {
+			arg2 = args[2].(*dto.TaskAssigneeRequest)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_AssignTask_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_AssignTask_Call {
+	_c.Call.Return(messageResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_AssignTask_Call) RunAndReturn(run func(ctx context.Context, taskID string, req *dto.TaskAssigneeRequest, userID string) (*dto.MessageResponse, error)) *MockHandler_AssignTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssignTaskWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) AssignTaskWrapped(ctx context.Context, req *dto.TaskAssigneeWithIDRequest) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignTaskWrapped")
+	}
+
+	var r0 *dto.MessageResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskAssigneeWithIDRequest) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskAssigneeWithIDRequest) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MessageResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskAssigneeWithIDRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_AssignTaskWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignTaskWrapped'
+type MockHandler_AssignTaskWrapped_Call struct {
+	*mock.Call
+}
+
+// AssignTaskWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskAssigneeWithIDRequest
+func (_e *MockHandler_Expecter) AssignTaskWrapped(ctx interface{}, req interface{}) *MockHandler_AssignTaskWrapped_Call {
+	return &MockHandler_AssignTaskWrapped_Call{Call: _e.mock.On("AssignTaskWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_AssignTaskWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskAssigneeWithIDRequest)) *MockHandler_AssignTaskWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskAssigneeWithIDRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskAssigneeWithIDRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_AssignTaskWrapped_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_AssignTaskWrapped_Call {
+	_c.Call.Return(messageResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_AssignTaskWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskAssigneeWithIDRequest) (*dto.MessageResponse, error)) *MockHandler_AssignTaskWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkTasks provides a mock function for the type MockHandler
+func (_mock *MockHandler) BulkTasks(ctx context.Context, req *dto.TaskBulkRequest, userID string) (*dto.TaskBulkResponse, error) {
+	ret := _mock.Called(ctx, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkTasks")
+	}
+
+	var r0 *dto.TaskBulkResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskBulkRequest, string) (*dto.TaskBulkResponse, error)); ok {
+		return returnFunc(ctx, req, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskBulkRequest, string) *dto.TaskBulkResponse); ok {
+		r0 = returnFunc(ctx, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskBulkResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskBulkRequest, string) error); ok {
+		r1 = returnFunc(ctx, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_BulkTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkTasks'
+type MockHandler_BulkTasks_Call struct {
+	*mock.Call
+}
+
+// BulkTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskBulkRequest
+//   - userID string
+func (_e *MockHandler_Expecter) BulkTasks(ctx interface{}, req interface{}, userID interface{}) *MockHandler_BulkTasks_Call {
+	return &MockHandler_BulkTasks_Call{Call: _e.mock.On("BulkTasks", ctx, req, userID)}
+}
+
+func (_c *MockHandler_BulkTasks_Call) Run(run func(ctx context.Context, req *dto.TaskBulkRequest, userID string)) *MockHandler_BulkTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskBulkRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskBulkRequest)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_BulkTasks_Call) Return(taskBulkResponse *dto.TaskBulkResponse, err error) *MockHandler_BulkTasks_Call {
+	_c.Call.Return(taskBulkResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_BulkTasks_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskBulkRequest, userID string) (*dto.TaskBulkResponse, error)) *MockHandler_BulkTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkTasksWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) BulkTasksWrapped(ctx context.Context, req *dto.TaskBulkRequest) (*dto.TaskBulkResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkTasksWrapped")
+	}
+
+	var r0 *dto.TaskBulkResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskBulkRequest) (*dto.TaskBulkResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskBulkRequest) *dto.TaskBulkResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskBulkResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskBulkRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_BulkTasksWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkTasksWrapped'
+type MockHandler_BulkTasksWrapped_Call struct {
+	*mock.Call
+}
+
+// BulkTasksWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskBulkRequest
+func (_e *MockHandler_Expecter) BulkTasksWrapped(ctx interface{}, req interface{}) *MockHandler_BulkTasksWrapped_Call {
+	return &MockHandler_BulkTasksWrapped_Call{Call: _e.mock.On("BulkTasksWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_BulkTasksWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskBulkRequest)) *MockHandler_BulkTasksWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskBulkRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskBulkRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_BulkTasksWrapped_Call) Return(taskBulkResponse *dto.TaskBulkResponse, err error) *MockHandler_BulkTasksWrapped_Call {
+	_c.Call.Return(taskBulkResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_BulkTasksWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskBulkRequest) (*dto.TaskBulkResponse, error)) *MockHandler_BulkTasksWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateTask provides a mock function for the type MockHandler
 func (_mock *MockHandler) CreateTask(ctx context.Context, req *dto.TaskCreateRequest, userID string) (*dto.MessageResponse, error) {
 	ret := _mock.Called(ctx, req, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateTask")
+		panic("no return value specified for CreateTask")
+	}
+
+	var r0 *dto.MessageResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskCreateRequest, string) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, req, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskCreateRequest, string) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MessageResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskCreateRequest, string) error); ok {
+		r1 = returnFunc(ctx, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_CreateTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTask'
+type MockHandler_CreateTask_Call struct {
+	*mock.Call
+}
+
+// CreateTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskCreateRequest
+//   - userID string
+func (_e *MockHandler_Expecter) CreateTask(ctx interface{}, req interface{}, userID interface{}) *MockHandler_CreateTask_Call {
+	return &MockHandler_CreateTask_Call{Call: _e.mock.On("CreateTask", ctx, req, userID)}
+}
+
+func (_c *MockHandler_CreateTask_Call) Run(run func(ctx context.Context, req *dto.TaskCreateRequest, userID string)) *MockHandler_CreateTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskCreateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskCreateRequest)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_CreateTask_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_CreateTask_Call {
+	_c.Call.Return(messageResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_CreateTask_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskCreateRequest, userID string) (*dto.MessageResponse, error)) *MockHandler_CreateTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTaskWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) CreateTaskWrapped(ctx context.Context, req *dto.TaskCreateRequest) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTaskWrapped")
+	}
+
+	var r0 *dto.MessageResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskCreateRequest) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskCreateRequest) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MessageResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskCreateRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_CreateTaskWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTaskWrapped'
+type MockHandler_CreateTaskWrapped_Call struct {
+	*mock.Call
+}
+
+// CreateTaskWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskCreateRequest
+func (_e *MockHandler_Expecter) CreateTaskWrapped(ctx interface{}, req interface{}) *MockHandler_CreateTaskWrapped_Call {
+	return &MockHandler_CreateTaskWrapped_Call{Call: _e.mock.On("CreateTaskWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_CreateTaskWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskCreateRequest)) *MockHandler_CreateTaskWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskCreateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskCreateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_CreateTaskWrapped_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_CreateTaskWrapped_Call {
+	_c.Call.Return(messageResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_CreateTaskWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskCreateRequest) (*dto.MessageResponse, error)) *MockHandler_CreateTaskWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTaskByID provides a mock function for the type MockHandler
+func (_mock *MockHandler) DeleteTaskByID(ctx context.Context, taskID string, req *dto.TaskDeleteStrategyRequest, userID string) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, taskID, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTaskByID")
+	}
+
+	var r0 *dto.MessageResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskDeleteStrategyRequest, string) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, taskID, req, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskDeleteStrategyRequest, string) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, taskID, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MessageResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *dto.TaskDeleteStrategyRequest, string) error); ok {
+		r1 = returnFunc(ctx, taskID, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_DeleteTaskByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTaskByID'
+type MockHandler_DeleteTaskByID_Call struct {
+	*mock.Call
+}
+
+// DeleteTaskByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - req *dto.TaskDeleteStrategyRequest
+//   - userID string
+func (_e *MockHandler_Expecter) DeleteTaskByID(ctx interface{}, taskID interface{}, req interface{}, userID interface{}) *MockHandler_DeleteTaskByID_Call {
+	return &MockHandler_DeleteTaskByID_Call{Call: _e.mock.On("DeleteTaskByID", ctx, taskID, req, userID)}
+}
+
+func (_c *MockHandler_DeleteTaskByID_Call) Run(run func(ctx context.Context, taskID string, req *dto.TaskDeleteStrategyRequest, userID string)) *MockHandler_DeleteTaskByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *dto.TaskDeleteStrategyRequest
+		if args[2] != nil {
+			arg2 = args[2].(*dto.TaskDeleteStrategyRequest)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_DeleteTaskByID_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_DeleteTaskByID_Call {
+	_c.Call.Return(messageResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_DeleteTaskByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, req *dto.TaskDeleteStrategyRequest, userID string) (*dto.MessageResponse, error)) *MockHandler_DeleteTaskByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTaskByIDWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) DeleteTaskByIDWrapped(ctx context.Context, req *dto.TaskDeleteRequest) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTaskByIDWrapped")
+	}
+
+	var r0 *dto.MessageResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskDeleteRequest) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskDeleteRequest) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MessageResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskDeleteRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_DeleteTaskByIDWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTaskByIDWrapped'
+type MockHandler_DeleteTaskByIDWrapped_Call struct {
+	*mock.Call
+}
+
+// DeleteTaskByIDWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskDeleteRequest
+func (_e *MockHandler_Expecter) DeleteTaskByIDWrapped(ctx interface{}, req interface{}) *MockHandler_DeleteTaskByIDWrapped_Call {
+	return &MockHandler_DeleteTaskByIDWrapped_Call{Call: _e.mock.On("DeleteTaskByIDWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_DeleteTaskByIDWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskDeleteRequest)) *MockHandler_DeleteTaskByIDWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskDeleteRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskDeleteRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_DeleteTaskByIDWrapped_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_DeleteTaskByIDWrapped_Call {
+	_c.Call.Return(messageResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_DeleteTaskByIDWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskDeleteRequest) (*dto.MessageResponse, error)) *MockHandler_DeleteTaskByIDWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportTasks provides a mock function for the type MockHandler
+func (_mock *MockHandler) ExportTasks(ctx context.Context, req *dto.TaskExportRequest, userID string) (*dto.FileResponse, error) {
+	ret := _mock.Called(ctx, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportTasks")
+	}
+
+	var r0 *dto.FileResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskExportRequest, string) (*dto.FileResponse, error)); ok {
+		return returnFunc(ctx, req, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskExportRequest, string) *dto.FileResponse); ok {
+		r0 = returnFunc(ctx, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.FileResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskExportRequest, string) error); ok {
+		r1 = returnFunc(ctx, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_ExportTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportTasks'
+type MockHandler_ExportTasks_Call struct {
+	*mock.Call
+}
+
+// ExportTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskExportRequest
+//   - userID string
+func (_e *MockHandler_Expecter) ExportTasks(ctx interface{}, req interface{}, userID interface{}) *MockHandler_ExportTasks_Call {
+	return &MockHandler_ExportTasks_Call{Call: _e.mock.On("ExportTasks", ctx, req, userID)}
+}
+
+func (_c *MockHandler_ExportTasks_Call) Run(run func(ctx context.Context, req *dto.TaskExportRequest, userID string)) *MockHandler_ExportTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskExportRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskExportRequest)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_ExportTasks_Call) Return(fileResponse *dto.FileResponse, err error) *MockHandler_ExportTasks_Call {
+	_c.Call.Return(fileResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_ExportTasks_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskExportRequest, userID string) (*dto.FileResponse, error)) *MockHandler_ExportTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportTasksWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) ExportTasksWrapped(ctx context.Context, req *dto.TaskExportRequest) (*dto.FileResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportTasksWrapped")
+	}
+
+	var r0 *dto.FileResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskExportRequest) (*dto.FileResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskExportRequest) *dto.FileResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.FileResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskExportRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_ExportTasksWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportTasksWrapped'
+type MockHandler_ExportTasksWrapped_Call struct {
+	*mock.Call
+}
+
+// ExportTasksWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskExportRequest
+func (_e *MockHandler_Expecter) ExportTasksWrapped(ctx interface{}, req interface{}) *MockHandler_ExportTasksWrapped_Call {
+	return &MockHandler_ExportTasksWrapped_Call{Call: _e.mock.On("ExportTasksWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_ExportTasksWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskExportRequest)) *MockHandler_ExportTasksWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskExportRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskExportRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_ExportTasksWrapped_Call) Return(fileResponse *dto.FileResponse, err error) *MockHandler_ExportTasksWrapped_Call {
+	_c.Call.Return(fileResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_ExportTasksWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskExportRequest) (*dto.FileResponse, error)) *MockHandler_ExportTasksWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAssignedTasks provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetAssignedTasks(ctx context.Context, req *dto.TaskListRequest, userID string) (*dto.TaskListResponse, error) {
+	ret := _mock.Called(ctx, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAssignedTasks")
+	}
+
+	var r0 *dto.TaskListResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskListRequest, string) (*dto.TaskListResponse, error)); ok {
+		return returnFunc(ctx, req, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskListRequest, string) *dto.TaskListResponse); ok {
+		r0 = returnFunc(ctx, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskListRequest, string) error); ok {
+		r1 = returnFunc(ctx, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_GetAssignedTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAssignedTasks'
+type MockHandler_GetAssignedTasks_Call struct {
+	*mock.Call
+}
+
+// GetAssignedTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskListRequest
+//   - userID string
+func (_e *MockHandler_Expecter) GetAssignedTasks(ctx interface{}, req interface{}, userID interface{}) *MockHandler_GetAssignedTasks_Call {
+	return &MockHandler_GetAssignedTasks_Call{Call: _e.mock.On("GetAssignedTasks", ctx, req, userID)}
+}
+
+func (_c *MockHandler_GetAssignedTasks_Call) Run(run func(ctx context.Context, req *dto.TaskListRequest, userID string)) *MockHandler_GetAssignedTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskListRequest)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetAssignedTasks_Call) Return(taskListResponse *dto.TaskListResponse, err error) *MockHandler_GetAssignedTasks_Call {
+	_c.Call.Return(taskListResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_GetAssignedTasks_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskListRequest, userID string) (*dto.TaskListResponse, error)) *MockHandler_GetAssignedTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAssignedTasksWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetAssignedTasksWrapped(ctx context.Context, req *dto.TaskListRequest) (*dto.TaskListResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAssignedTasksWrapped")
+	}
+
+	var r0 *dto.TaskListResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskListRequest) (*dto.TaskListResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskListRequest) *dto.TaskListResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskListRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_GetAssignedTasksWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAssignedTasksWrapped'
+type MockHandler_GetAssignedTasksWrapped_Call struct {
+	*mock.Call
+}
+
+// GetAssignedTasksWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskListRequest
+func (_e *MockHandler_Expecter) GetAssignedTasksWrapped(ctx interface{}, req interface{}) *MockHandler_GetAssignedTasksWrapped_Call {
+	return &MockHandler_GetAssignedTasksWrapped_Call{Call: _e.mock.On("GetAssignedTasksWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_GetAssignedTasksWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskListRequest)) *MockHandler_GetAssignedTasksWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetAssignedTasksWrapped_Call) Return(taskListResponse *dto.TaskListResponse, err error) *MockHandler_GetAssignedTasksWrapped_Call {
+	_c.Call.Return(taskListResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_GetAssignedTasksWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskListRequest) (*dto.TaskListResponse, error)) *MockHandler_GetAssignedTasksWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBoard provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetBoard(ctx context.Context, req *dto.TaskBoardRequest, userID string) (*dto.TaskBoardResponse, error) {
+	ret := _mock.Called(ctx, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoard")
+	}
+
+	var r0 *dto.TaskBoardResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskBoardRequest, string) (*dto.TaskBoardResponse, error)); ok {
+		return returnFunc(ctx, req, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskBoardRequest, string) *dto.TaskBoardResponse); ok {
+		r0 = returnFunc(ctx, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskBoardResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskBoardRequest, string) error); ok {
+		r1 = returnFunc(ctx, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_GetBoard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBoard'
+type MockHandler_GetBoard_Call struct {
+	*mock.Call
+}
+
+// GetBoard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskBoardRequest
+//   - userID string
+func (_e *MockHandler_Expecter) GetBoard(ctx interface{}, req interface{}, userID interface{}) *MockHandler_GetBoard_Call {
+	return &MockHandler_GetBoard_Call{Call: _e.mock.On("GetBoard", ctx, req, userID)}
+}
+
+func (_c *MockHandler_GetBoard_Call) Run(run func(ctx context.Context, req *dto.TaskBoardRequest, userID string)) *MockHandler_GetBoard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskBoardRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskBoardRequest)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetBoard_Call) Return(taskBoardResponse *dto.TaskBoardResponse, err error) *MockHandler_GetBoard_Call {
+	_c.Call.Return(taskBoardResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_GetBoard_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskBoardRequest, userID string) (*dto.TaskBoardResponse, error)) *MockHandler_GetBoard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBoardWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetBoardWrapped(ctx context.Context, req *dto.TaskBoardRequest) (*dto.TaskBoardResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoardWrapped")
+	}
+
+	var r0 *dto.TaskBoardResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskBoardRequest) (*dto.TaskBoardResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskBoardRequest) *dto.TaskBoardResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskBoardResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskBoardRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_GetBoardWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBoardWrapped'
+type MockHandler_GetBoardWrapped_Call struct {
+	*mock.Call
+}
+
+// GetBoardWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskBoardRequest
+func (_e *MockHandler_Expecter) GetBoardWrapped(ctx interface{}, req interface{}) *MockHandler_GetBoardWrapped_Call {
+	return &MockHandler_GetBoardWrapped_Call{Call: _e.mock.On("GetBoardWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_GetBoardWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskBoardRequest)) *MockHandler_GetBoardWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskBoardRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskBoardRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetBoardWrapped_Call) Return(taskBoardResponse *dto.TaskBoardResponse, err error) *MockHandler_GetBoardWrapped_Call {
+	_c.Call.Return(taskBoardResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_GetBoardWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskBoardRequest) (*dto.TaskBoardResponse, error)) *MockHandler_GetBoardWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubtasksByID provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetSubtasksByID(ctx context.Context, taskID string, userID string) ([]dto.TaskResponse, error) {
+	ret := _mock.Called(ctx, taskID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubtasksByID")
+	}
+
+	var r0 []dto.TaskResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]dto.TaskResponse, error)); ok {
+		return returnFunc(ctx, taskID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []dto.TaskResponse); ok {
+		r0 = returnFunc(ctx, taskID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.TaskResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, taskID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_GetSubtasksByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubtasksByID'
+type MockHandler_GetSubtasksByID_Call struct {
+	*mock.Call
+}
+
+// GetSubtasksByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - userID string
+func (_e *MockHandler_Expecter) GetSubtasksByID(ctx interface{}, taskID interface{}, userID interface{}) *MockHandler_GetSubtasksByID_Call {
+	return &MockHandler_GetSubtasksByID_Call{Call: _e.mock.On("GetSubtasksByID", ctx, taskID, userID)}
+}
+
+func (_c *MockHandler_GetSubtasksByID_Call) Run(run func(ctx context.Context, taskID string, userID string)) *MockHandler_GetSubtasksByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetSubtasksByID_Call) Return(taskResponses []dto.TaskResponse, err error) *MockHandler_GetSubtasksByID_Call {
+	_c.Call.Return(taskResponses, err)
+	return _c
+}
+
+func (_c *MockHandler_GetSubtasksByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, userID string) ([]dto.TaskResponse, error)) *MockHandler_GetSubtasksByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubtasksByIDWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetSubtasksByIDWrapped(ctx context.Context, req *dto.TaskGetByIDRequest) ([]dto.TaskResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubtasksByIDWrapped")
+	}
+
+	var r0 []dto.TaskResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskGetByIDRequest) ([]dto.TaskResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskGetByIDRequest) []dto.TaskResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.TaskResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskGetByIDRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_GetSubtasksByIDWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubtasksByIDWrapped'
+type MockHandler_GetSubtasksByIDWrapped_Call struct {
+	*mock.Call
+}
+
+// GetSubtasksByIDWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskGetByIDRequest
+func (_e *MockHandler_Expecter) GetSubtasksByIDWrapped(ctx interface{}, req interface{}) *MockHandler_GetSubtasksByIDWrapped_Call {
+	return &MockHandler_GetSubtasksByIDWrapped_Call{Call: _e.mock.On("GetSubtasksByIDWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_GetSubtasksByIDWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskGetByIDRequest)) *MockHandler_GetSubtasksByIDWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskGetByIDRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskGetByIDRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetSubtasksByIDWrapped_Call) Return(taskResponses []dto.TaskResponse, err error) *MockHandler_GetSubtasksByIDWrapped_Call {
+	_c.Call.Return(taskResponses, err)
+	return _c
+}
+
+func (_c *MockHandler_GetSubtasksByIDWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskGetByIDRequest) ([]dto.TaskResponse, error)) *MockHandler_GetSubtasksByIDWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTaskActivityByID provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetTaskActivityByID(ctx context.Context, taskID string, req *dto.TaskActivityListRequest, userID string) (*dto.TaskActivityListResponse, error) {
+	ret := _mock.Called(ctx, taskID, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTaskActivityByID")
+	}
+
+	var r0 *dto.TaskActivityListResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskActivityListRequest, string) (*dto.TaskActivityListResponse, error)); ok {
+		return returnFunc(ctx, taskID, req, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskActivityListRequest, string) *dto.TaskActivityListResponse); ok {
+		r0 = returnFunc(ctx, taskID, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskActivityListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *dto.TaskActivityListRequest, string) error); ok {
+		r1 = returnFunc(ctx, taskID, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_GetTaskActivityByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTaskActivityByID'
+type MockHandler_GetTaskActivityByID_Call struct {
+	*mock.Call
+}
+
+// GetTaskActivityByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - req *dto.TaskActivityListRequest
+//   - userID string
+func (_e *MockHandler_Expecter) GetTaskActivityByID(ctx interface{}, taskID interface{}, req interface{}, userID interface{}) *MockHandler_GetTaskActivityByID_Call {
+	return &MockHandler_GetTaskActivityByID_Call{Call: _e.mock.On("GetTaskActivityByID", ctx, taskID, req, userID)}
+}
+
+func (_c *MockHandler_GetTaskActivityByID_Call) Run(run func(ctx context.Context, taskID string, req *dto.TaskActivityListRequest, userID string)) *MockHandler_GetTaskActivityByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *dto.TaskActivityListRequest
+		if args[2] != nil {
+			arg2 = args[2].(*dto.TaskActivityListRequest)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetTaskActivityByID_Call) Return(taskActivityListResponse *dto.TaskActivityListResponse, err error) *MockHandler_GetTaskActivityByID_Call {
+	_c.Call.Return(taskActivityListResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_GetTaskActivityByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, req *dto.TaskActivityListRequest, userID string) (*dto.TaskActivityListResponse, error)) *MockHandler_GetTaskActivityByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTaskActivityByIDWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetTaskActivityByIDWrapped(ctx context.Context, req *dto.TaskActivityListRequest) (*dto.TaskActivityListResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTaskActivityByIDWrapped")
+	}
+
+	var r0 *dto.TaskActivityListResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskActivityListRequest) (*dto.TaskActivityListResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskActivityListRequest) *dto.TaskActivityListResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskActivityListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskActivityListRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_GetTaskActivityByIDWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTaskActivityByIDWrapped'
+type MockHandler_GetTaskActivityByIDWrapped_Call struct {
+	*mock.Call
+}
+
+// GetTaskActivityByIDWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskActivityListRequest
+func (_e *MockHandler_Expecter) GetTaskActivityByIDWrapped(ctx interface{}, req interface{}) *MockHandler_GetTaskActivityByIDWrapped_Call {
+	return &MockHandler_GetTaskActivityByIDWrapped_Call{Call: _e.mock.On("GetTaskActivityByIDWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_GetTaskActivityByIDWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskActivityListRequest)) *MockHandler_GetTaskActivityByIDWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskActivityListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskActivityListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetTaskActivityByIDWrapped_Call) Return(taskActivityListResponse *dto.TaskActivityListResponse, err error) *MockHandler_GetTaskActivityByIDWrapped_Call {
+	_c.Call.Return(taskActivityListResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_GetTaskActivityByIDWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskActivityListRequest) (*dto.TaskActivityListResponse, error)) *MockHandler_GetTaskActivityByIDWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTaskBlockersByID provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetTaskBlockersByID(ctx context.Context, taskID string, userID string) ([]dto.TaskResponse, error) {
+	ret := _mock.Called(ctx, taskID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTaskBlockersByID")
+	}
+
+	var r0 []dto.TaskResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]dto.TaskResponse, error)); ok {
+		return returnFunc(ctx, taskID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []dto.TaskResponse); ok {
+		r0 = returnFunc(ctx, taskID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.TaskResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, taskID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_GetTaskBlockersByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTaskBlockersByID'
+type MockHandler_GetTaskBlockersByID_Call struct {
+	*mock.Call
+}
+
+// GetTaskBlockersByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - userID string
+func (_e *MockHandler_Expecter) GetTaskBlockersByID(ctx interface{}, taskID interface{}, userID interface{}) *MockHandler_GetTaskBlockersByID_Call {
+	return &MockHandler_GetTaskBlockersByID_Call{Call: _e.mock.On("GetTaskBlockersByID", ctx, taskID, userID)}
+}
+
+func (_c *MockHandler_GetTaskBlockersByID_Call) Run(run func(ctx context.Context, taskID string, userID string)) *MockHandler_GetTaskBlockersByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetTaskBlockersByID_Call) Return(taskResponses []dto.TaskResponse, err error) *MockHandler_GetTaskBlockersByID_Call {
+	_c.Call.Return(taskResponses, err)
+	return _c
+}
+
+func (_c *MockHandler_GetTaskBlockersByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, userID string) ([]dto.TaskResponse, error)) *MockHandler_GetTaskBlockersByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTaskBlockersByIDWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetTaskBlockersByIDWrapped(ctx context.Context, req *dto.TaskGetByIDRequest) ([]dto.TaskResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTaskBlockersByIDWrapped")
+	}
+
+	var r0 []dto.TaskResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskGetByIDRequest) ([]dto.TaskResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskGetByIDRequest) []dto.TaskResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.TaskResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskGetByIDRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_GetTaskBlockersByIDWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTaskBlockersByIDWrapped'
+type MockHandler_GetTaskBlockersByIDWrapped_Call struct {
+	*mock.Call
+}
+
+// GetTaskBlockersByIDWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskGetByIDRequest
+func (_e *MockHandler_Expecter) GetTaskBlockersByIDWrapped(ctx interface{}, req interface{}) *MockHandler_GetTaskBlockersByIDWrapped_Call {
+	return &MockHandler_GetTaskBlockersByIDWrapped_Call{Call: _e.mock.On("GetTaskBlockersByIDWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_GetTaskBlockersByIDWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskGetByIDRequest)) *MockHandler_GetTaskBlockersByIDWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskGetByIDRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskGetByIDRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetTaskBlockersByIDWrapped_Call) Return(taskResponses []dto.TaskResponse, err error) *MockHandler_GetTaskBlockersByIDWrapped_Call {
+	_c.Call.Return(taskResponses, err)
+	return _c
+}
+
+func (_c *MockHandler_GetTaskBlockersByIDWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskGetByIDRequest) ([]dto.TaskResponse, error)) *MockHandler_GetTaskBlockersByIDWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTaskByID provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetTaskByID(ctx context.Context, taskID string, userID string) (*dto.TaskResponse, error) {
+	ret := _mock.Called(ctx, taskID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTaskByID")
+	}
+
+	var r0 *dto.TaskResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*dto.TaskResponse, error)); ok {
+		return returnFunc(ctx, taskID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *dto.TaskResponse); ok {
+		r0 = returnFunc(ctx, taskID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, taskID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_GetTaskByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTaskByID'
+type MockHandler_GetTaskByID_Call struct {
+	*mock.Call
+}
+
+// GetTaskByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - userID string
+func (_e *MockHandler_Expecter) GetTaskByID(ctx interface{}, taskID interface{}, userID interface{}) *MockHandler_GetTaskByID_Call {
+	return &MockHandler_GetTaskByID_Call{Call: _e.mock.On("GetTaskByID", ctx, taskID, userID)}
+}
+
+func (_c *MockHandler_GetTaskByID_Call) Run(run func(ctx context.Context, taskID string, userID string)) *MockHandler_GetTaskByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetTaskByID_Call) Return(taskResponse *dto.TaskResponse, err error) *MockHandler_GetTaskByID_Call {
+	_c.Call.Return(taskResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_GetTaskByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, userID string) (*dto.TaskResponse, error)) *MockHandler_GetTaskByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTaskByIDWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetTaskByIDWrapped(ctx context.Context, req *dto.TaskGetByIDRequest) (*dto.TaskResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTaskByIDWrapped")
+	}
+
+	var r0 *dto.TaskResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskGetByIDRequest) (*dto.TaskResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskGetByIDRequest) *dto.TaskResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskGetByIDRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_GetTaskByIDWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTaskByIDWrapped'
+type MockHandler_GetTaskByIDWrapped_Call struct {
+	*mock.Call
+}
+
+// GetTaskByIDWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskGetByIDRequest
+func (_e *MockHandler_Expecter) GetTaskByIDWrapped(ctx interface{}, req interface{}) *MockHandler_GetTaskByIDWrapped_Call {
+	return &MockHandler_GetTaskByIDWrapped_Call{Call: _e.mock.On("GetTaskByIDWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_GetTaskByIDWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskGetByIDRequest)) *MockHandler_GetTaskByIDWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskGetByIDRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskGetByIDRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetTaskByIDWrapped_Call) Return(taskResponse *dto.TaskResponse, err error) *MockHandler_GetTaskByIDWrapped_Call {
+	_c.Call.Return(taskResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_GetTaskByIDWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskGetByIDRequest) (*dto.TaskResponse, error)) *MockHandler_GetTaskByIDWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTaskRecurrence provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetTaskRecurrence(ctx context.Context, taskID string, req *dto.TaskRecurrencePreviewRequest, userID string) (*dto.TaskRecurrenceResponse, error) {
+	ret := _mock.Called(ctx, taskID, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTaskRecurrence")
+	}
+
+	var r0 *dto.TaskRecurrenceResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskRecurrencePreviewRequest, string) (*dto.TaskRecurrenceResponse, error)); ok {
+		return returnFunc(ctx, taskID, req, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskRecurrencePreviewRequest, string) *dto.TaskRecurrenceResponse); ok {
+		r0 = returnFunc(ctx, taskID, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskRecurrenceResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *dto.TaskRecurrencePreviewRequest, string) error); ok {
+		r1 = returnFunc(ctx, taskID, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_GetTaskRecurrence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTaskRecurrence'
+type MockHandler_GetTaskRecurrence_Call struct {
+	*mock.Call
+}
+
+// GetTaskRecurrence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - req *dto.TaskRecurrencePreviewRequest
+//   - userID string
+func (_e *MockHandler_Expecter) GetTaskRecurrence(ctx interface{}, taskID interface{}, req interface{}, userID interface{}) *MockHandler_GetTaskRecurrence_Call {
+	return &MockHandler_GetTaskRecurrence_Call{Call: _e.mock.On("GetTaskRecurrence", ctx, taskID, req, userID)}
+}
+
+func (_c *MockHandler_GetTaskRecurrence_Call) Run(run func(ctx context.Context, taskID string, req *dto.TaskRecurrencePreviewRequest, userID string)) *MockHandler_GetTaskRecurrence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *dto.TaskRecurrencePreviewRequest
+		if args[2] != nil {
+			arg2 = args[2].(*dto.TaskRecurrencePreviewRequest)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetTaskRecurrence_Call) Return(taskRecurrenceResponse *dto.TaskRecurrenceResponse, err error) *MockHandler_GetTaskRecurrence_Call {
+	_c.Call.Return(taskRecurrenceResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_GetTaskRecurrence_Call) RunAndReturn(run func(ctx context.Context, taskID string, req *dto.TaskRecurrencePreviewRequest, userID string) (*dto.TaskRecurrenceResponse, error)) *MockHandler_GetTaskRecurrence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTaskRecurrenceWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetTaskRecurrenceWrapped(ctx context.Context, req *dto.TaskRecurrencePreviewRequest) (*dto.TaskRecurrenceResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTaskRecurrenceWrapped")
+	}
+
+	var r0 *dto.TaskRecurrenceResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskRecurrencePreviewRequest) (*dto.TaskRecurrenceResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskRecurrencePreviewRequest) *dto.TaskRecurrenceResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskRecurrenceResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskRecurrencePreviewRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_GetTaskRecurrenceWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTaskRecurrenceWrapped'
+type MockHandler_GetTaskRecurrenceWrapped_Call struct {
+	*mock.Call
+}
+
+// GetTaskRecurrenceWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskRecurrencePreviewRequest
+func (_e *MockHandler_Expecter) GetTaskRecurrenceWrapped(ctx interface{}, req interface{}) *MockHandler_GetTaskRecurrenceWrapped_Call {
+	return &MockHandler_GetTaskRecurrenceWrapped_Call{Call: _e.mock.On("GetTaskRecurrenceWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_GetTaskRecurrenceWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskRecurrencePreviewRequest)) *MockHandler_GetTaskRecurrenceWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskRecurrencePreviewRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskRecurrencePreviewRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetTaskRecurrenceWrapped_Call) Return(taskRecurrenceResponse *dto.TaskRecurrenceResponse, err error) *MockHandler_GetTaskRecurrenceWrapped_Call {
+	_c.Call.Return(taskRecurrenceResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_GetTaskRecurrenceWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskRecurrencePreviewRequest) (*dto.TaskRecurrenceResponse, error)) *MockHandler_GetTaskRecurrenceWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTaskTreeByID provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetTaskTreeByID(ctx context.Context, taskID string, userID string) (*dto.TaskTreeResponse, error) {
+	ret := _mock.Called(ctx, taskID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTaskTreeByID")
+	}
+
+	var r0 *dto.TaskTreeResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*dto.TaskTreeResponse, error)); ok {
+		return returnFunc(ctx, taskID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *dto.TaskTreeResponse); ok {
+		r0 = returnFunc(ctx, taskID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskTreeResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, taskID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_GetTaskTreeByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTaskTreeByID'
+type MockHandler_GetTaskTreeByID_Call struct {
+	*mock.Call
+}
+
+// GetTaskTreeByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - userID string
+func (_e *MockHandler_Expecter) GetTaskTreeByID(ctx interface{}, taskID interface{}, userID interface{}) *MockHandler_GetTaskTreeByID_Call {
+	return &MockHandler_GetTaskTreeByID_Call{Call: _e.mock.On("GetTaskTreeByID", ctx, taskID, userID)}
+}
+
+func (_c *MockHandler_GetTaskTreeByID_Call) Run(run func(ctx context.Context, taskID string, userID string)) *MockHandler_GetTaskTreeByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetTaskTreeByID_Call) Return(taskTreeResponse *dto.TaskTreeResponse, err error) *MockHandler_GetTaskTreeByID_Call {
+	_c.Call.Return(taskTreeResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_GetTaskTreeByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, userID string) (*dto.TaskTreeResponse, error)) *MockHandler_GetTaskTreeByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTaskTreeByIDWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetTaskTreeByIDWrapped(ctx context.Context, req *dto.TaskGetByIDRequest) (*dto.TaskTreeResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTaskTreeByIDWrapped")
+	}
+
+	var r0 *dto.TaskTreeResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskGetByIDRequest) (*dto.TaskTreeResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskGetByIDRequest) *dto.TaskTreeResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskTreeResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskGetByIDRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_GetTaskTreeByIDWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTaskTreeByIDWrapped'
+type MockHandler_GetTaskTreeByIDWrapped_Call struct {
+	*mock.Call
+}
+
+// GetTaskTreeByIDWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskGetByIDRequest
+func (_e *MockHandler_Expecter) GetTaskTreeByIDWrapped(ctx interface{}, req interface{}) *MockHandler_GetTaskTreeByIDWrapped_Call {
+	return &MockHandler_GetTaskTreeByIDWrapped_Call{Call: _e.mock.On("GetTaskTreeByIDWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_GetTaskTreeByIDWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskGetByIDRequest)) *MockHandler_GetTaskTreeByIDWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskGetByIDRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskGetByIDRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetTaskTreeByIDWrapped_Call) Return(taskTreeResponse *dto.TaskTreeResponse, err error) *MockHandler_GetTaskTreeByIDWrapped_Call {
+	_c.Call.Return(taskTreeResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_GetTaskTreeByIDWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskGetByIDRequest) (*dto.TaskTreeResponse, error)) *MockHandler_GetTaskTreeByIDWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTasksByProjectID provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetTasksByProjectID(ctx context.Context, projectID string, req *dto.TaskListRequest, userID string) (*dto.TaskListResponse, error) {
+	ret := _mock.Called(ctx, projectID, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTasksByProjectID")
+	}
+
+	var r0 *dto.TaskListResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskListRequest, string) (*dto.TaskListResponse, error)); ok {
+		return returnFunc(ctx, projectID, req, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskListRequest, string) *dto.TaskListResponse); ok {
+		r0 = returnFunc(ctx, projectID, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *dto.TaskListRequest, string) error); ok {
+		r1 = returnFunc(ctx, projectID, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_GetTasksByProjectID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTasksByProjectID'
+type MockHandler_GetTasksByProjectID_Call struct {
+	*mock.Call
+}
+
+// GetTasksByProjectID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - req *dto.TaskListRequest
+//   - userID string
+func (_e *MockHandler_Expecter) GetTasksByProjectID(ctx interface{}, projectID interface{}, req interface{}, userID interface{}) *MockHandler_GetTasksByProjectID_Call {
+	return &MockHandler_GetTasksByProjectID_Call{Call: _e.mock.On("GetTasksByProjectID", ctx, projectID, req, userID)}
+}
+
+func (_c *MockHandler_GetTasksByProjectID_Call) Run(run func(ctx context.Context, projectID string, req *dto.TaskListRequest, userID string)) *MockHandler_GetTasksByProjectID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *dto.TaskListRequest
+		if args[2] != nil {
+			arg2 = args[2].(*dto.TaskListRequest)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetTasksByProjectID_Call) Return(taskListResponse *dto.TaskListResponse, err error) *MockHandler_GetTasksByProjectID_Call {
+	_c.Call.Return(taskListResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_GetTasksByProjectID_Call) RunAndReturn(run func(ctx context.Context, projectID string, req *dto.TaskListRequest, userID string) (*dto.TaskListResponse, error)) *MockHandler_GetTasksByProjectID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTasksByProjectIDWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetTasksByProjectIDWrapped(ctx context.Context, req *dto.TaskListByProjectRequest) (*dto.TaskListResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTasksByProjectIDWrapped")
+	}
+
+	var r0 *dto.TaskListResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskListByProjectRequest) (*dto.TaskListResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskListByProjectRequest) *dto.TaskListResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskListByProjectRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_GetTasksByProjectIDWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTasksByProjectIDWrapped'
+type MockHandler_GetTasksByProjectIDWrapped_Call struct {
+	*mock.Call
+}
+
+// GetTasksByProjectIDWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskListByProjectRequest
+func (_e *MockHandler_Expecter) GetTasksByProjectIDWrapped(ctx interface{}, req interface{}) *MockHandler_GetTasksByProjectIDWrapped_Call {
+	return &MockHandler_GetTasksByProjectIDWrapped_Call{Call: _e.mock.On("GetTasksByProjectIDWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_GetTasksByProjectIDWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskListByProjectRequest)) *MockHandler_GetTasksByProjectIDWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskListByProjectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskListByProjectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetTasksByProjectIDWrapped_Call) Return(taskListResponse *dto.TaskListResponse, err error) *MockHandler_GetTasksByProjectIDWrapped_Call {
+	_c.Call.Return(taskListResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_GetTasksByProjectIDWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskListByProjectRequest) (*dto.TaskListResponse, error)) *MockHandler_GetTasksByProjectIDWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTasksByUserID provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetTasksByUserID(ctx context.Context, req *dto.TaskListRequest, userID string) (*dto.TaskListResponse, error) {
+	ret := _mock.Called(ctx, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTasksByUserID")
+	}
+
+	var r0 *dto.TaskListResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskListRequest, string) (*dto.TaskListResponse, error)); ok {
+		return returnFunc(ctx, req, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskListRequest, string) *dto.TaskListResponse); ok {
+		r0 = returnFunc(ctx, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskListRequest, string) error); ok {
+		r1 = returnFunc(ctx, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_GetTasksByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTasksByUserID'
+type MockHandler_GetTasksByUserID_Call struct {
+	*mock.Call
+}
+
+// GetTasksByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskListRequest
+//   - userID string
+func (_e *MockHandler_Expecter) GetTasksByUserID(ctx interface{}, req interface{}, userID interface{}) *MockHandler_GetTasksByUserID_Call {
+	return &MockHandler_GetTasksByUserID_Call{Call: _e.mock.On("GetTasksByUserID", ctx, req, userID)}
+}
+
+func (_c *MockHandler_GetTasksByUserID_Call) Run(run func(ctx context.Context, req *dto.TaskListRequest, userID string)) *MockHandler_GetTasksByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskListRequest)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetTasksByUserID_Call) Return(taskListResponse *dto.TaskListResponse, err error) *MockHandler_GetTasksByUserID_Call {
+	_c.Call.Return(taskListResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_GetTasksByUserID_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskListRequest, userID string) (*dto.TaskListResponse, error)) *MockHandler_GetTasksByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTasksByUserIDWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetTasksByUserIDWrapped(ctx context.Context, req *dto.TaskListRequest) (*dto.TaskListResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTasksByUserIDWrapped")
+	}
+
+	var r0 *dto.TaskListResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskListRequest) (*dto.TaskListResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskListRequest) *dto.TaskListResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskListRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_GetTasksByUserIDWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTasksByUserIDWrapped'
+type MockHandler_GetTasksByUserIDWrapped_Call struct {
+	*mock.Call
+}
+
+// GetTasksByUserIDWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskListRequest
+func (_e *MockHandler_Expecter) GetTasksByUserIDWrapped(ctx interface{}, req interface{}) *MockHandler_GetTasksByUserIDWrapped_Call {
+	return &MockHandler_GetTasksByUserIDWrapped_Call{Call: _e.mock.On("GetTasksByUserIDWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_GetTasksByUserIDWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskListRequest)) *MockHandler_GetTasksByUserIDWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetTasksByUserIDWrapped_Call) Return(taskListResponse *dto.TaskListResponse, err error) *MockHandler_GetTasksByUserIDWrapped_Call {
+	_c.Call.Return(taskListResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_GetTasksByUserIDWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskListRequest) (*dto.TaskListResponse, error)) *MockHandler_GetTasksByUserIDWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTasksByWorkspaceID provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetTasksByWorkspaceID(ctx context.Context, workspaceID string, req *dto.TaskListRequest, userID string) (*dto.TaskListResponse, error) {
+	ret := _mock.Called(ctx, workspaceID, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTasksByWorkspaceID")
+	}
+
+	var r0 *dto.TaskListResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskListRequest, string) (*dto.TaskListResponse, error)); ok {
+		return returnFunc(ctx, workspaceID, req, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskListRequest, string) *dto.TaskListResponse); ok {
+		r0 = returnFunc(ctx, workspaceID, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *dto.TaskListRequest, string) error); ok {
+		r1 = returnFunc(ctx, workspaceID, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_GetTasksByWorkspaceID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTasksByWorkspaceID'
+type MockHandler_GetTasksByWorkspaceID_Call struct {
+	*mock.Call
+}
+
+// GetTasksByWorkspaceID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workspaceID string
+//   - req *dto.TaskListRequest
+//   - userID string
+func (_e *MockHandler_Expecter) GetTasksByWorkspaceID(ctx interface{}, workspaceID interface{}, req interface{}, userID interface{}) *MockHandler_GetTasksByWorkspaceID_Call {
+	return &MockHandler_GetTasksByWorkspaceID_Call{Call: _e.mock.On("GetTasksByWorkspaceID", ctx, workspaceID, req, userID)}
+}
+
+func (_c *MockHandler_GetTasksByWorkspaceID_Call) Run(run func(ctx context.Context, workspaceID string, req *dto.TaskListRequest, userID string)) *MockHandler_GetTasksByWorkspaceID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *dto.TaskListRequest
+		if args[2] != nil {
+			arg2 = args[2].(*dto.TaskListRequest)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetTasksByWorkspaceID_Call) Return(taskListResponse *dto.TaskListResponse, err error) *MockHandler_GetTasksByWorkspaceID_Call {
+	_c.Call.Return(taskListResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_GetTasksByWorkspaceID_Call) RunAndReturn(run func(ctx context.Context, workspaceID string, req *dto.TaskListRequest, userID string) (*dto.TaskListResponse, error)) *MockHandler_GetTasksByWorkspaceID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTasksByWorkspaceIDWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetTasksByWorkspaceIDWrapped(ctx context.Context, req *dto.TaskListByWorkspaceRequest) (*dto.TaskListResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTasksByWorkspaceIDWrapped")
+	}
+
+	var r0 *dto.TaskListResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskListByWorkspaceRequest) (*dto.TaskListResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskListByWorkspaceRequest) *dto.TaskListResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskListByWorkspaceRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_GetTasksByWorkspaceIDWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTasksByWorkspaceIDWrapped'
+type MockHandler_GetTasksByWorkspaceIDWrapped_Call struct {
+	*mock.Call
+}
+
+// GetTasksByWorkspaceIDWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskListByWorkspaceRequest
+func (_e *MockHandler_Expecter) GetTasksByWorkspaceIDWrapped(ctx interface{}, req interface{}) *MockHandler_GetTasksByWorkspaceIDWrapped_Call {
+	return &MockHandler_GetTasksByWorkspaceIDWrapped_Call{Call: _e.mock.On("GetTasksByWorkspaceIDWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_GetTasksByWorkspaceIDWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskListByWorkspaceRequest)) *MockHandler_GetTasksByWorkspaceIDWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskListByWorkspaceRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskListByWorkspaceRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetTasksByWorkspaceIDWrapped_Call) Return(taskListResponse *dto.TaskListResponse, err error) *MockHandler_GetTasksByWorkspaceIDWrapped_Call {
+	_c.Call.Return(taskListResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_GetTasksByWorkspaceIDWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskListByWorkspaceRequest) (*dto.TaskListResponse, error)) *MockHandler_GetTasksByWorkspaceIDWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTrashedTasks provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetTrashedTasks(ctx context.Context, req *dto.TaskListRequest, userID string) (*dto.TaskListResponse, error) {
+	ret := _mock.Called(ctx, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrashedTasks")
+	}
+
+	var r0 *dto.TaskListResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskListRequest, string) (*dto.TaskListResponse, error)); ok {
+		return returnFunc(ctx, req, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskListRequest, string) *dto.TaskListResponse); ok {
+		r0 = returnFunc(ctx, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskListRequest, string) error); ok {
+		r1 = returnFunc(ctx, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_GetTrashedTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrashedTasks'
+type MockHandler_GetTrashedTasks_Call struct {
+	*mock.Call
+}
+
+// GetTrashedTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskListRequest
+//   - userID string
+func (_e *MockHandler_Expecter) GetTrashedTasks(ctx interface{}, req interface{}, userID interface{}) *MockHandler_GetTrashedTasks_Call {
+	return &MockHandler_GetTrashedTasks_Call{Call: _e.mock.On("GetTrashedTasks", ctx, req, userID)}
+}
+
+func (_c *MockHandler_GetTrashedTasks_Call) Run(run func(ctx context.Context, req *dto.TaskListRequest, userID string)) *MockHandler_GetTrashedTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskListRequest)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetTrashedTasks_Call) Return(taskListResponse *dto.TaskListResponse, err error) *MockHandler_GetTrashedTasks_Call {
+	_c.Call.Return(taskListResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_GetTrashedTasks_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskListRequest, userID string) (*dto.TaskListResponse, error)) *MockHandler_GetTrashedTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTrashedTasksWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetTrashedTasksWrapped(ctx context.Context, req *dto.TaskListRequest) (*dto.TaskListResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrashedTasksWrapped")
+	}
+
+	var r0 *dto.TaskListResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskListRequest) (*dto.TaskListResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskListRequest) *dto.TaskListResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskListRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_GetTrashedTasksWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrashedTasksWrapped'
+type MockHandler_GetTrashedTasksWrapped_Call struct {
+	*mock.Call
+}
+
+// GetTrashedTasksWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskListRequest
+func (_e *MockHandler_Expecter) GetTrashedTasksWrapped(ctx interface{}, req interface{}) *MockHandler_GetTrashedTasksWrapped_Call {
+	return &MockHandler_GetTrashedTasksWrapped_Call{Call: _e.mock.On("GetTrashedTasksWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_GetTrashedTasksWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskListRequest)) *MockHandler_GetTrashedTasksWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetTrashedTasksWrapped_Call) Return(taskListResponse *dto.TaskListResponse, err error) *MockHandler_GetTrashedTasksWrapped_Call {
+	_c.Call.Return(taskListResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_GetTrashedTasksWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskListRequest) (*dto.TaskListResponse, error)) *MockHandler_GetTrashedTasksWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportTasks provides a mock function for the type MockHandler
+func (_mock *MockHandler) ImportTasks(ctx context.Context, req *dto.TaskImportRequest, userID string) (*dto.TaskImportResponse, error) {
+	ret := _mock.Called(ctx, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportTasks")
+	}
+
+	var r0 *dto.TaskImportResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskImportRequest, string) (*dto.TaskImportResponse, error)); ok {
+		return returnFunc(ctx, req, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskImportRequest, string) *dto.TaskImportResponse); ok {
+		r0 = returnFunc(ctx, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskImportResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskImportRequest, string) error); ok {
+		r1 = returnFunc(ctx, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_ImportTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportTasks'
+type MockHandler_ImportTasks_Call struct {
+	*mock.Call
+}
+
+// ImportTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskImportRequest
+//   - userID string
+func (_e *MockHandler_Expecter) ImportTasks(ctx interface{}, req interface{}, userID interface{}) *MockHandler_ImportTasks_Call {
+	return &MockHandler_ImportTasks_Call{Call: _e.mock.On("ImportTasks", ctx, req, userID)}
+}
+
+func (_c *MockHandler_ImportTasks_Call) Run(run func(ctx context.Context, req *dto.TaskImportRequest, userID string)) *MockHandler_ImportTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskImportRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskImportRequest)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_ImportTasks_Call) Return(taskImportResponse *dto.TaskImportResponse, err error) *MockHandler_ImportTasks_Call {
+	_c.Call.Return(taskImportResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_ImportTasks_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskImportRequest, userID string) (*dto.TaskImportResponse, error)) *MockHandler_ImportTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportTasksWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) ImportTasksWrapped(ctx context.Context, req *dto.TaskImportRequest) (*dto.TaskImportResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportTasksWrapped")
+	}
+
+	var r0 *dto.TaskImportResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskImportRequest) (*dto.TaskImportResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskImportRequest) *dto.TaskImportResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskImportResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskImportRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_ImportTasksWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportTasksWrapped'
+type MockHandler_ImportTasksWrapped_Call struct {
+	*mock.Call
+}
+
+// ImportTasksWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskImportRequest
+func (_e *MockHandler_Expecter) ImportTasksWrapped(ctx interface{}, req interface{}) *MockHandler_ImportTasksWrapped_Call {
+	return &MockHandler_ImportTasksWrapped_Call{Call: _e.mock.On("ImportTasksWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_ImportTasksWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskImportRequest)) *MockHandler_ImportTasksWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskImportRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskImportRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_ImportTasksWrapped_Call) Return(taskImportResponse *dto.TaskImportResponse, err error) *MockHandler_ImportTasksWrapped_Call {
+	_c.Call.Return(taskImportResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_ImportTasksWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskImportRequest) (*dto.TaskImportResponse, error)) *MockHandler_ImportTasksWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveTask provides a mock function for the type MockHandler
+func (_mock *MockHandler) MoveTask(ctx context.Context, taskID string, req *dto.TaskMoveRequest, userID string) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, taskID, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveTask")
+	}
+
+	var r0 *dto.MessageResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskMoveRequest, string) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, taskID, req, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskMoveRequest, string) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, taskID, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MessageResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *dto.TaskMoveRequest, string) error); ok {
+		r1 = returnFunc(ctx, taskID, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_MoveTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveTask'
+type MockHandler_MoveTask_Call struct {
+	*mock.Call
+}
+
+// MoveTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - req *dto.TaskMoveRequest
+//   - userID string
+func (_e *MockHandler_Expecter) MoveTask(ctx interface{}, taskID interface{}, req interface{}, userID interface{}) *MockHandler_MoveTask_Call {
+	return &MockHandler_MoveTask_Call{Call: _e.mock.On("MoveTask", ctx, taskID, req, userID)}
+}
+
+func (_c *MockHandler_MoveTask_Call) Run(run func(ctx context.Context, taskID string, req *dto.TaskMoveRequest, userID string)) *MockHandler_MoveTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *dto.TaskMoveRequest
+		if args[2] != nil {
+			arg2 = args[2].(*dto.TaskMoveRequest)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_MoveTask_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_MoveTask_Call {
+	_c.Call.Return(messageResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_MoveTask_Call) RunAndReturn(run func(ctx context.Context, taskID string, req *dto.TaskMoveRequest, userID string) (*dto.MessageResponse, error)) *MockHandler_MoveTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveTaskWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) MoveTaskWrapped(ctx context.Context, req *dto.TaskMoveWithIDRequest) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveTaskWrapped")
+	}
+
+	var r0 *dto.MessageResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskMoveWithIDRequest) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskMoveWithIDRequest) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MessageResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskMoveWithIDRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_MoveTaskWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveTaskWrapped'
+type MockHandler_MoveTaskWrapped_Call struct {
+	*mock.Call
+}
+
+// MoveTaskWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskMoveWithIDRequest
+func (_e *MockHandler_Expecter) MoveTaskWrapped(ctx interface{}, req interface{}) *MockHandler_MoveTaskWrapped_Call {
+	return &MockHandler_MoveTaskWrapped_Call{Call: _e.mock.On("MoveTaskWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_MoveTaskWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskMoveWithIDRequest)) *MockHandler_MoveTaskWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskMoveWithIDRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskMoveWithIDRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_MoveTaskWrapped_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_MoveTaskWrapped_Call {
+	_c.Call.Return(messageResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_MoveTaskWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskMoveWithIDRequest) (*dto.MessageResponse, error)) *MockHandler_MoveTaskWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchTaskByID provides a mock function for the type MockHandler
+func (_mock *MockHandler) PatchTaskByID(ctx context.Context, taskID string, req *dto.TaskPatchRequest, userID string) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, taskID, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchTaskByID")
+	}
+
+	var r0 *dto.MessageResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskPatchRequest, string) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, taskID, req, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskPatchRequest, string) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, taskID, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MessageResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *dto.TaskPatchRequest, string) error); ok {
+		r1 = returnFunc(ctx, taskID, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_PatchTaskByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchTaskByID'
+type MockHandler_PatchTaskByID_Call struct {
+	*mock.Call
+}
+
+// PatchTaskByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - req *dto.TaskPatchRequest
+//   - userID string
+func (_e *MockHandler_Expecter) PatchTaskByID(ctx interface{}, taskID interface{}, req interface{}, userID interface{}) *MockHandler_PatchTaskByID_Call {
+	return &MockHandler_PatchTaskByID_Call{Call: _e.mock.On("PatchTaskByID", ctx, taskID, req, userID)}
+}
+
+func (_c *MockHandler_PatchTaskByID_Call) Run(run func(ctx context.Context, taskID string, req *dto.TaskPatchRequest, userID string)) *MockHandler_PatchTaskByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *dto.TaskPatchRequest
+		if args[2] != nil {
+			arg2 = args[2].(*dto.TaskPatchRequest)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PatchTaskByID_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_PatchTaskByID_Call {
+	_c.Call.Return(messageResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_PatchTaskByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, req *dto.TaskPatchRequest, userID string) (*dto.MessageResponse, error)) *MockHandler_PatchTaskByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchTaskByIDWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) PatchTaskByIDWrapped(ctx context.Context, req *dto.TaskPatchWithIDRequest) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchTaskByIDWrapped")
+	}
+
+	var r0 *dto.MessageResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskPatchWithIDRequest) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskPatchWithIDRequest) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MessageResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskPatchWithIDRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_PatchTaskByIDWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchTaskByIDWrapped'
+type MockHandler_PatchTaskByIDWrapped_Call struct {
+	*mock.Call
+}
+
+// PatchTaskByIDWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskPatchWithIDRequest
+func (_e *MockHandler_Expecter) PatchTaskByIDWrapped(ctx interface{}, req interface{}) *MockHandler_PatchTaskByIDWrapped_Call {
+	return &MockHandler_PatchTaskByIDWrapped_Call{Call: _e.mock.On("PatchTaskByIDWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_PatchTaskByIDWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskPatchWithIDRequest)) *MockHandler_PatchTaskByIDWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskPatchWithIDRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskPatchWithIDRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PatchTaskByIDWrapped_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_PatchTaskByIDWrapped_Call {
+	_c.Call.Return(messageResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_PatchTaskByIDWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskPatchWithIDRequest) (*dto.MessageResponse, error)) *MockHandler_PatchTaskByIDWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeTaskByID provides a mock function for the type MockHandler
+func (_mock *MockHandler) PurgeTaskByID(ctx context.Context, taskID string, userID string) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, taskID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeTaskByID")
+	}
+
+	var r0 *dto.MessageResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, taskID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, taskID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MessageResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, taskID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_PurgeTaskByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeTaskByID'
+type MockHandler_PurgeTaskByID_Call struct {
+	*mock.Call
+}
+
+// PurgeTaskByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - userID string
+func (_e *MockHandler_Expecter) PurgeTaskByID(ctx interface{}, taskID interface{}, userID interface{}) *MockHandler_PurgeTaskByID_Call {
+	return &MockHandler_PurgeTaskByID_Call{Call: _e.mock.On("PurgeTaskByID", ctx, taskID, userID)}
+}
+
+func (_c *MockHandler_PurgeTaskByID_Call) Run(run func(ctx context.Context, taskID string, userID string)) *MockHandler_PurgeTaskByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PurgeTaskByID_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_PurgeTaskByID_Call {
+	_c.Call.Return(messageResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_PurgeTaskByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, userID string) (*dto.MessageResponse, error)) *MockHandler_PurgeTaskByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeTaskByIDWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) PurgeTaskByIDWrapped(ctx context.Context, req *dto.TaskGetByIDRequest) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeTaskByIDWrapped")
+	}
+
+	var r0 *dto.MessageResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskGetByIDRequest) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskGetByIDRequest) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MessageResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskGetByIDRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_PurgeTaskByIDWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeTaskByIDWrapped'
+type MockHandler_PurgeTaskByIDWrapped_Call struct {
+	*mock.Call
+}
+
+// PurgeTaskByIDWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskGetByIDRequest
+func (_e *MockHandler_Expecter) PurgeTaskByIDWrapped(ctx interface{}, req interface{}) *MockHandler_PurgeTaskByIDWrapped_Call {
+	return &MockHandler_PurgeTaskByIDWrapped_Call{Call: _e.mock.On("PurgeTaskByIDWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_PurgeTaskByIDWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskGetByIDRequest)) *MockHandler_PurgeTaskByIDWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskGetByIDRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskGetByIDRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_PurgeTaskByIDWrapped_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_PurgeTaskByIDWrapped_Call {
+	_c.Call.Return(messageResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_PurgeTaskByIDWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskGetByIDRequest) (*dto.MessageResponse, error)) *MockHandler_PurgeTaskByIDWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTaskDependency provides a mock function for the type MockHandler
+func (_mock *MockHandler) RemoveTaskDependency(ctx context.Context, taskID string, req *dto.TaskDependencyRequest, userID string) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, taskID, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTaskDependency")
+	}
+
+	var r0 *dto.MessageResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskDependencyRequest, string) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, taskID, req, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskDependencyRequest, string) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, taskID, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MessageResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *dto.TaskDependencyRequest, string) error); ok {
+		r1 = returnFunc(ctx, taskID, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_RemoveTaskDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTaskDependency'
+type MockHandler_RemoveTaskDependency_Call struct {
+	*mock.Call
+}
+
+// RemoveTaskDependency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - req *dto.TaskDependencyRequest
+//   - userID string
+func (_e *MockHandler_Expecter) RemoveTaskDependency(ctx interface{}, taskID interface{}, req interface{}, userID interface{}) *MockHandler_RemoveTaskDependency_Call {
+	return &MockHandler_RemoveTaskDependency_Call{Call: _e.mock.On("RemoveTaskDependency", ctx, taskID, req, userID)}
+}
+
+func (_c *MockHandler_RemoveTaskDependency_Call) Run(run func(ctx context.Context, taskID string, req *dto.TaskDependencyRequest, userID string)) *MockHandler_RemoveTaskDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *dto.TaskDependencyRequest
+		if args[2] != nil {
+			arg2 = args[2].(*dto.TaskDependencyRequest)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_RemoveTaskDependency_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_RemoveTaskDependency_Call {
+	_c.Call.Return(messageResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_RemoveTaskDependency_Call) RunAndReturn(run func(ctx context.Context, taskID string, req *dto.TaskDependencyRequest, userID string) (*dto.MessageResponse, error)) *MockHandler_RemoveTaskDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTaskDependencyWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) RemoveTaskDependencyWrapped(ctx context.Context, req *dto.TaskDependencyWithIDRequest) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTaskDependencyWrapped")
+	}
+
+	var r0 *dto.MessageResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskDependencyWithIDRequest) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskDependencyWithIDRequest) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MessageResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskDependencyWithIDRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_RemoveTaskDependencyWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTaskDependencyWrapped'
+type MockHandler_RemoveTaskDependencyWrapped_Call struct {
+	*mock.Call
+}
+
+// RemoveTaskDependencyWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskDependencyWithIDRequest
+func (_e *MockHandler_Expecter) RemoveTaskDependencyWrapped(ctx interface{}, req interface{}) *MockHandler_RemoveTaskDependencyWrapped_Call {
+	return &MockHandler_RemoveTaskDependencyWrapped_Call{Call: _e.mock.On("RemoveTaskDependencyWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_RemoveTaskDependencyWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskDependencyWithIDRequest)) *MockHandler_RemoveTaskDependencyWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskDependencyWithIDRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskDependencyWithIDRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_RemoveTaskDependencyWrapped_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_RemoveTaskDependencyWrapped_Call {
+	_c.Call.Return(messageResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_RemoveTaskDependencyWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskDependencyWithIDRequest) (*dto.MessageResponse, error)) *MockHandler_RemoveTaskDependencyWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreTaskByID provides a mock function for the type MockHandler
+func (_mock *MockHandler) RestoreTaskByID(ctx context.Context, taskID string, userID string) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, taskID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreTaskByID")
+	}
+
+	var r0 *dto.MessageResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, taskID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, taskID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MessageResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, taskID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_RestoreTaskByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreTaskByID'
+type MockHandler_RestoreTaskByID_Call struct {
+	*mock.Call
+}
+
+// RestoreTaskByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - userID string
+func (_e *MockHandler_Expecter) RestoreTaskByID(ctx interface{}, taskID interface{}, userID interface{}) *MockHandler_RestoreTaskByID_Call {
+	return &MockHandler_RestoreTaskByID_Call{Call: _e.mock.On("RestoreTaskByID", ctx, taskID, userID)}
+}
+
+func (_c *MockHandler_RestoreTaskByID_Call) Run(run func(ctx context.Context, taskID string, userID string)) *MockHandler_RestoreTaskByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_RestoreTaskByID_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_RestoreTaskByID_Call {
+	_c.Call.Return(messageResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_RestoreTaskByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, userID string) (*dto.MessageResponse, error)) *MockHandler_RestoreTaskByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreTaskByIDWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) RestoreTaskByIDWrapped(ctx context.Context, req *dto.TaskGetByIDRequest) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreTaskByIDWrapped")
+	}
+
+	var r0 *dto.MessageResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskGetByIDRequest) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskGetByIDRequest) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MessageResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskGetByIDRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_RestoreTaskByIDWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreTaskByIDWrapped'
+type MockHandler_RestoreTaskByIDWrapped_Call struct {
+	*mock.Call
+}
+
+// RestoreTaskByIDWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskGetByIDRequest
+func (_e *MockHandler_Expecter) RestoreTaskByIDWrapped(ctx interface{}, req interface{}) *MockHandler_RestoreTaskByIDWrapped_Call {
+	return &MockHandler_RestoreTaskByIDWrapped_Call{Call: _e.mock.On("RestoreTaskByIDWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_RestoreTaskByIDWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskGetByIDRequest)) *MockHandler_RestoreTaskByIDWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskGetByIDRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskGetByIDRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_RestoreTaskByIDWrapped_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_RestoreTaskByIDWrapped_Call {
+	_c.Call.Return(messageResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_RestoreTaskByIDWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskGetByIDRequest) (*dto.MessageResponse, error)) *MockHandler_RestoreTaskByIDWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchTasks provides a mock function for the type MockHandler
+func (_mock *MockHandler) SearchTasks(ctx context.Context, req *dto.TaskSearchRequest, userID string) (*dto.TaskSearchResponse, error) {
+	ret := _mock.Called(ctx, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchTasks")
+	}
+
+	var r0 *dto.TaskSearchResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskSearchRequest, string) (*dto.TaskSearchResponse, error)); ok {
+		return returnFunc(ctx, req, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskSearchRequest, string) *dto.TaskSearchResponse); ok {
+		r0 = returnFunc(ctx, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskSearchResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskSearchRequest, string) error); ok {
+		r1 = returnFunc(ctx, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_SearchTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchTasks'
+type MockHandler_SearchTasks_Call struct {
+	*mock.Call
+}
+
+// SearchTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskSearchRequest
+//   - userID string
+func (_e *MockHandler_Expecter) SearchTasks(ctx interface{}, req interface{}, userID interface{}) *MockHandler_SearchTasks_Call {
+	return &MockHandler_SearchTasks_Call{Call: _e.mock.On("SearchTasks", ctx, req, userID)}
+}
+
+func (_c *MockHandler_SearchTasks_Call) Run(run func(ctx context.Context, req *dto.TaskSearchRequest, userID string)) *MockHandler_SearchTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskSearchRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskSearchRequest)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_SearchTasks_Call) Return(taskSearchResponse *dto.TaskSearchResponse, err error) *MockHandler_SearchTasks_Call {
+	_c.Call.Return(taskSearchResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_SearchTasks_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskSearchRequest, userID string) (*dto.TaskSearchResponse, error)) *MockHandler_SearchTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchTasksWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) SearchTasksWrapped(ctx context.Context, req *dto.TaskSearchRequest) (*dto.TaskSearchResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchTasksWrapped")
+	}
+
+	var r0 *dto.TaskSearchResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskSearchRequest) (*dto.TaskSearchResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskSearchRequest) *dto.TaskSearchResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TaskSearchResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskSearchRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_SearchTasksWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchTasksWrapped'
+type MockHandler_SearchTasksWrapped_Call struct {
+	*mock.Call
+}
+
+// SearchTasksWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskSearchRequest
+func (_e *MockHandler_Expecter) SearchTasksWrapped(ctx interface{}, req interface{}) *MockHandler_SearchTasksWrapped_Call {
+	return &MockHandler_SearchTasksWrapped_Call{Call: _e.mock.On("SearchTasksWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_SearchTasksWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskSearchRequest)) *MockHandler_SearchTasksWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskSearchRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskSearchRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_SearchTasksWrapped_Call) Return(taskSearchResponse *dto.TaskSearchResponse, err error) *MockHandler_SearchTasksWrapped_Call {
+	_c.Call.Return(taskSearchResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_SearchTasksWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskSearchRequest) (*dto.TaskSearchResponse, error)) *MockHandler_SearchTasksWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTaskRecurrence provides a mock function for the type MockHandler
+func (_mock *MockHandler) SetTaskRecurrence(ctx context.Context, taskID string, req *dto.TaskRecurrenceRequest, userID string) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, taskID, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTaskRecurrence")
+	}
+
+	var r0 *dto.MessageResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskRecurrenceRequest, string) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, taskID, req, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskRecurrenceRequest, string) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, taskID, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MessageResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *dto.TaskRecurrenceRequest, string) error); ok {
+		r1 = returnFunc(ctx, taskID, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_SetTaskRecurrence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTaskRecurrence'
+type MockHandler_SetTaskRecurrence_Call struct {
+	*mock.Call
+}
+
+// SetTaskRecurrence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - req *dto.TaskRecurrenceRequest
+//   - userID string
+func (_e *MockHandler_Expecter) SetTaskRecurrence(ctx interface{}, taskID interface{}, req interface{}, userID interface{}) *MockHandler_SetTaskRecurrence_Call {
+	return &MockHandler_SetTaskRecurrence_Call{Call: _e.mock.On("SetTaskRecurrence", ctx, taskID, req, userID)}
+}
+
+func (_c *MockHandler_SetTaskRecurrence_Call) Run(run func(ctx context.Context, taskID string, req *dto.TaskRecurrenceRequest, userID string)) *MockHandler_SetTaskRecurrence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *dto.TaskRecurrenceRequest
+		if args[2] != nil {
+			arg2 = args[2].(*dto.TaskRecurrenceRequest)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_SetTaskRecurrence_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_SetTaskRecurrence_Call {
+	_c.Call.Return(messageResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_SetTaskRecurrence_Call) RunAndReturn(run func(ctx context.Context, taskID string, req *dto.TaskRecurrenceRequest, userID string) (*dto.MessageResponse, error)) *MockHandler_SetTaskRecurrence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTaskRecurrenceWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) SetTaskRecurrenceWrapped(ctx context.Context, req *dto.TaskRecurrenceWithIDRequest) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTaskRecurrenceWrapped")
 	}
 
 	var r0 *dto.MessageResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskCreateRequest, string) (*dto.MessageResponse, error)); ok {
-		return returnFunc(ctx, req, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskRecurrenceWithIDRequest) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, req)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskCreateRequest, string) *dto.MessageResponse); ok {
-		r0 = returnFunc(ctx, req, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskRecurrenceWithIDRequest) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dto.MessageResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskCreateRequest, string) error); ok {
-		r1 = returnFunc(ctx, req, userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskRecurrenceWithIDRequest) error); ok {
+		r1 = returnFunc(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockHandler_CreateTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTask'
-type MockHandler_CreateTask_Call struct {
+// MockHandler_SetTaskRecurrenceWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTaskRecurrenceWrapped'
+type MockHandler_SetTaskRecurrenceWrapped_Call struct {
 	*mock.Call
 }
 
-// CreateTask is a helper method to define mock.On call
+// SetTaskRecurrenceWrapped is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *dto.TaskCreateRequest
+//   - req *dto.TaskRecurrenceWithIDRequest
+func (_e *MockHandler_Expecter) SetTaskRecurrenceWrapped(ctx interface{}, req interface{}) *MockHandler_SetTaskRecurrenceWrapped_Call {
+	return &MockHandler_SetTaskRecurrenceWrapped_Call{Call: _e.mock.On("SetTaskRecurrenceWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_SetTaskRecurrenceWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskRecurrenceWithIDRequest)) *MockHandler_SetTaskRecurrenceWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskRecurrenceWithIDRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskRecurrenceWithIDRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_SetTaskRecurrenceWrapped_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_SetTaskRecurrenceWrapped_Call {
+	_c.Call.Return(messageResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_SetTaskRecurrenceWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskRecurrenceWithIDRequest) (*dto.MessageResponse, error)) *MockHandler_SetTaskRecurrenceWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StopTaskRecurrence provides a mock function for the type MockHandler
+func (_mock *MockHandler) StopTaskRecurrence(ctx context.Context, taskID string, userID string) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, taskID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopTaskRecurrence")
+	}
+
+	var r0 *dto.MessageResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, taskID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, taskID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MessageResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, taskID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_StopTaskRecurrence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopTaskRecurrence'
+type MockHandler_StopTaskRecurrence_Call struct {
+	*mock.Call
+}
+
+// StopTaskRecurrence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
 //   - userID string
-func (_e *MockHandler_Expecter) CreateTask(ctx interface{}, req interface{}, userID interface{}) *MockHandler_CreateTask_Call {
-	return &MockHandler_CreateTask_Call{Call: _e.mock.On("CreateTask", ctx, req, userID)}
+func (_e *MockHandler_Expecter) StopTaskRecurrence(ctx interface{}, taskID interface{}, userID interface{}) *MockHandler_StopTaskRecurrence_Call {
+	return &MockHandler_StopTaskRecurrence_Call{Call: _e.mock.On("StopTaskRecurrence", ctx, taskID, userID)}
 }
 
-func (_c *MockHandler_CreateTask_Call) Run(run func(ctx context.Context, req *dto.TaskCreateRequest, userID string)) *MockHandler_CreateTask_Call {
+func (_c *MockHandler_StopTaskRecurrence_Call) Run(run func(ctx context.Context, taskID string, userID string)) *MockHandler_StopTaskRecurrence_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dto.TaskCreateRequest
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*dto.TaskCreateRequest)
+			arg1 = args[1].(string)
 		}
 		var arg2 string
 		if args[2] != nil {
@@ -102,37 +3860,37 @@ func (_c *MockHandler_CreateTask_Call) Run(run func(ctx context.Context, req *dt
 	return _c
 }
 
-func (_c *MockHandler_CreateTask_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_CreateTask_Call {
+func (_c *MockHandler_StopTaskRecurrence_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_StopTaskRecurrence_Call {
 	_c.Call.Return(messageResponse, err)
 	return _c
 }
 
-func (_c *MockHandler_CreateTask_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskCreateRequest, userID string) (*dto.MessageResponse, error)) *MockHandler_CreateTask_Call {
+func (_c *MockHandler_StopTaskRecurrence_Call) RunAndReturn(run func(ctx context.Context, taskID string, userID string) (*dto.MessageResponse, error)) *MockHandler_StopTaskRecurrence_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateTaskWrapped provides a mock function for the type MockHandler
-func (_mock *MockHandler) CreateTaskWrapped(ctx context.Context, req *dto.TaskCreateRequest) (*dto.MessageResponse, error) {
+// StopTaskRecurrenceWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) StopTaskRecurrenceWrapped(ctx context.Context, req *dto.TaskGetByIDRequest) (*dto.MessageResponse, error) {
 	ret := _mock.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateTaskWrapped")
+		panic("no return value specified for StopTaskRecurrenceWrapped")
 	}
 
 	var r0 *dto.MessageResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskCreateRequest) (*dto.MessageResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskGetByIDRequest) (*dto.MessageResponse, error)); ok {
 		return returnFunc(ctx, req)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskCreateRequest) *dto.MessageResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskGetByIDRequest) *dto.MessageResponse); ok {
 		r0 = returnFunc(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dto.MessageResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskCreateRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskGetByIDRequest) error); ok {
 		r1 = returnFunc(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -140,27 +3898,27 @@ func (_mock *MockHandler) CreateTaskWrapped(ctx context.Context, req *dto.TaskCr
 	return r0, r1
 }
 
-// MockHandler_CreateTaskWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTaskWrapped'
-type MockHandler_CreateTaskWrapped_Call struct {
+// MockHandler_StopTaskRecurrenceWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopTaskRecurrenceWrapped'
+type MockHandler_StopTaskRecurrenceWrapped_Call struct {
 	*mock.Call
 }
 
-// CreateTaskWrapped is a helper method to define mock.On call
+// StopTaskRecurrenceWrapped is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *dto.TaskCreateRequest
-func (_e *MockHandler_Expecter) CreateTaskWrapped(ctx interface{}, req interface{}) *MockHandler_CreateTaskWrapped_Call {
-	return &MockHandler_CreateTaskWrapped_Call{Call: _e.mock.On("CreateTaskWrapped", ctx, req)}
+//   - req *dto.TaskGetByIDRequest
+func (_e *MockHandler_Expecter) StopTaskRecurrenceWrapped(ctx interface{}, req interface{}) *MockHandler_StopTaskRecurrenceWrapped_Call {
+	return &MockHandler_StopTaskRecurrenceWrapped_Call{Call: _e.mock.On("StopTaskRecurrenceWrapped", ctx, req)}
 }
 
-func (_c *MockHandler_CreateTaskWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskCreateRequest)) *MockHandler_CreateTaskWrapped_Call {
+func (_c *MockHandler_StopTaskRecurrenceWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskGetByIDRequest)) *MockHandler_StopTaskRecurrenceWrapped_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dto.TaskCreateRequest
+		var arg1 *dto.TaskGetByIDRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dto.TaskCreateRequest)
+			arg1 = args[1].(*dto.TaskGetByIDRequest)
 		}
 		run(
 			arg0,
@@ -170,58 +3928,207 @@ func (_c *MockHandler_CreateTaskWrapped_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockHandler_CreateTaskWrapped_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_CreateTaskWrapped_Call {
+func (_c *MockHandler_StopTaskRecurrenceWrapped_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_StopTaskRecurrenceWrapped_Call {
 	_c.Call.Return(messageResponse, err)
 	return _c
 }
 
-func (_c *MockHandler_CreateTaskWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskCreateRequest) (*dto.MessageResponse, error)) *MockHandler_CreateTaskWrapped_Call {
+func (_c *MockHandler_StopTaskRecurrenceWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskGetByIDRequest) (*dto.MessageResponse, error)) *MockHandler_StopTaskRecurrenceWrapped_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteTaskByID provides a mock function for the type MockHandler
-func (_mock *MockHandler) DeleteTaskByID(ctx context.Context, taskID string, userID string) (*dto.MessageResponse, error) {
-	ret := _mock.Called(ctx, taskID, userID)
+// UnassignTask provides a mock function for the type MockHandler
+func (_mock *MockHandler) UnassignTask(ctx context.Context, taskID string, req *dto.TaskAssigneeRequest, userID string) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, taskID, req, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteTaskByID")
+		panic("no return value specified for UnassignTask")
 	}
 
 	var r0 *dto.MessageResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*dto.MessageResponse, error)); ok {
-		return returnFunc(ctx, taskID, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskAssigneeRequest, string) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, taskID, req, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *dto.MessageResponse); ok {
-		r0 = returnFunc(ctx, taskID, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskAssigneeRequest, string) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, taskID, req, userID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dto.MessageResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = returnFunc(ctx, taskID, userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *dto.TaskAssigneeRequest, string) error); ok {
+		r1 = returnFunc(ctx, taskID, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_UnassignTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnassignTask'
+type MockHandler_UnassignTask_Call struct {
+	*mock.Call
+}
+
+// UnassignTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - req *dto.TaskAssigneeRequest
+//   - userID string
+func (_e *MockHandler_Expecter) UnassignTask(ctx interface{}, taskID interface{}, req interface{}, userID interface{}) *MockHandler_UnassignTask_Call {
+	return &MockHandler_UnassignTask_Call{Call: _e.mock.On("UnassignTask", ctx, taskID, req, userID)}
+}
+
+func (_c *MockHandler_UnassignTask_Call) Run(run func(ctx context.Context, taskID string, req *dto.TaskAssigneeRequest, userID string)) *MockHandler_UnassignTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *dto.TaskAssigneeRequest
+		if args[2] != nil {
+			arg2 = args[2].(*dto.TaskAssigneeRequest)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_UnassignTask_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_UnassignTask_Call {
+	_c.Call.Return(messageResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_UnassignTask_Call) RunAndReturn(run func(ctx context.Context, taskID string, req *dto.TaskAssigneeRequest, userID string) (*dto.MessageResponse, error)) *MockHandler_UnassignTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnassignTaskWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) UnassignTaskWrapped(ctx context.Context, req *dto.TaskAssigneeWithIDRequest) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnassignTaskWrapped")
+	}
+
+	var r0 *dto.MessageResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskAssigneeWithIDRequest) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskAssigneeWithIDRequest) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MessageResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskAssigneeWithIDRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHandler_UnassignTaskWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnassignTaskWrapped'
+type MockHandler_UnassignTaskWrapped_Call struct {
+	*mock.Call
+}
+
+// UnassignTaskWrapped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *dto.TaskAssigneeWithIDRequest
+func (_e *MockHandler_Expecter) UnassignTaskWrapped(ctx interface{}, req interface{}) *MockHandler_UnassignTaskWrapped_Call {
+	return &MockHandler_UnassignTaskWrapped_Call{Call: _e.mock.On("UnassignTaskWrapped", ctx, req)}
+}
+
+func (_c *MockHandler_UnassignTaskWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskAssigneeWithIDRequest)) *MockHandler_UnassignTaskWrapped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dto.TaskAssigneeWithIDRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dto.TaskAssigneeWithIDRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHandler_UnassignTaskWrapped_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_UnassignTaskWrapped_Call {
+	_c.Call.Return(messageResponse, err)
+	return _c
+}
+
+func (_c *MockHandler_UnassignTaskWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskAssigneeWithIDRequest) (*dto.MessageResponse, error)) *MockHandler_UnassignTaskWrapped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTaskByID provides a mock function for the type MockHandler
+func (_mock *MockHandler) UpdateTaskByID(ctx context.Context, taskID string, req *dto.TaskUpdateRequest, userID string) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, taskID, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTaskByID")
+	}
+
+	var r0 *dto.MessageResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskUpdateRequest, string) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, taskID, req, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskUpdateRequest, string) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, taskID, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MessageResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *dto.TaskUpdateRequest, string) error); ok {
+		r1 = returnFunc(ctx, taskID, req, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockHandler_DeleteTaskByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTaskByID'
-type MockHandler_DeleteTaskByID_Call struct {
+// MockHandler_UpdateTaskByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTaskByID'
+type MockHandler_UpdateTaskByID_Call struct {
 	*mock.Call
 }
 
-// DeleteTaskByID is a helper method to define mock.On call
+// UpdateTaskByID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - taskID string
+//   - req *dto.TaskUpdateRequest
 //   - userID string
-func (_e *MockHandler_Expecter) DeleteTaskByID(ctx interface{}, taskID interface{}, userID interface{}) *MockHandler_DeleteTaskByID_Call {
-	return &MockHandler_DeleteTaskByID_Call{Call: _e.mock.On("DeleteTaskByID", ctx, taskID, userID)}
+func (_e *MockHandler_Expecter) UpdateTaskByID(ctx interface{}, taskID interface{}, req interface{}, userID interface{}) *MockHandler_UpdateTaskByID_Call {
+	return &MockHandler_UpdateTaskByID_Call{Call: _e.mock.On("UpdateTaskByID", ctx, taskID, req, userID)}
 }
 
-func (_c *MockHandler_DeleteTaskByID_Call) Run(run func(ctx context.Context, taskID string, userID string)) *MockHandler_DeleteTaskByID_Call {
+func (_c *MockHandler_UpdateTaskByID_Call) Run(run func(ctx context.Context, taskID string, req *dto.TaskUpdateRequest, userID string)) *MockHandler_UpdateTaskByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -231,50 +4138,55 @@ func (_c *MockHandler_DeleteTaskByID_Call) Run(run func(ctx context.Context, tas
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 string
+		var arg2 *dto.TaskUpdateRequest
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(*dto.TaskUpdateRequest)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockHandler_DeleteTaskByID_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_DeleteTaskByID_Call {
+func (_c *MockHandler_UpdateTaskByID_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_UpdateTaskByID_Call {
 	_c.Call.Return(messageResponse, err)
 	return _c
 }
 
-func (_c *MockHandler_DeleteTaskByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, userID string) (*dto.MessageResponse, error)) *MockHandler_DeleteTaskByID_Call {
+func (_c *MockHandler_UpdateTaskByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, req *dto.TaskUpdateRequest, userID string) (*dto.MessageResponse, error)) *MockHandler_UpdateTaskByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteTaskByIDWrapped provides a mock function for the type MockHandler
-func (_mock *MockHandler) DeleteTaskByIDWrapped(ctx context.Context, req *dto.TaskDeleteRequest) (*dto.MessageResponse, error) {
+// UpdateTaskByIDWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) UpdateTaskByIDWrapped(ctx context.Context, req *dto.TaskUpdateWithIDRequest) (*dto.MessageResponse, error) {
 	ret := _mock.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteTaskByIDWrapped")
+		panic("no return value specified for UpdateTaskByIDWrapped")
 	}
 
 	var r0 *dto.MessageResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskDeleteRequest) (*dto.MessageResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskUpdateWithIDRequest) (*dto.MessageResponse, error)); ok {
 		return returnFunc(ctx, req)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskDeleteRequest) *dto.MessageResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskUpdateWithIDRequest) *dto.MessageResponse); ok {
 		r0 = returnFunc(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dto.MessageResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskDeleteRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskUpdateWithIDRequest) error); ok {
 		r1 = returnFunc(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -282,27 +4194,27 @@ func (_mock *MockHandler) DeleteTaskByIDWrapped(ctx context.Context, req *dto.Ta
 	return r0, r1
 }
 
-// MockHandler_DeleteTaskByIDWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTaskByIDWrapped'
-type MockHandler_DeleteTaskByIDWrapped_Call struct {
+// MockHandler_UpdateTaskByIDWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTaskByIDWrapped'
+type MockHandler_UpdateTaskByIDWrapped_Call struct {
 	*mock.Call
 }
 
-// DeleteTaskByIDWrapped is a helper method to define mock.On call
+// UpdateTaskByIDWrapped is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *dto.TaskDeleteRequest
-func (_e *MockHandler_Expecter) DeleteTaskByIDWrapped(ctx interface{}, req interface{}) *MockHandler_DeleteTaskByIDWrapped_Call {
-	return &MockHandler_DeleteTaskByIDWrapped_Call{Call: _e.mock.On("DeleteTaskByIDWrapped", ctx, req)}
+//   - req *dto.TaskUpdateWithIDRequest
+func (_e *MockHandler_Expecter) UpdateTaskByIDWrapped(ctx interface{}, req interface{}) *MockHandler_UpdateTaskByIDWrapped_Call {
+	return &MockHandler_UpdateTaskByIDWrapped_Call{Call: _e.mock.On("UpdateTaskByIDWrapped", ctx, req)}
 }
 
-func (_c *MockHandler_DeleteTaskByIDWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskDeleteRequest)) *MockHandler_DeleteTaskByIDWrapped_Call {
+func (_c *MockHandler_UpdateTaskByIDWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskUpdateWithIDRequest)) *MockHandler_UpdateTaskByIDWrapped_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dto.TaskDeleteRequest
+		var arg1 *dto.TaskUpdateWithIDRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dto.TaskDeleteRequest)
+			arg1 = args[1].(*dto.TaskUpdateWithIDRequest)
 		}
 		run(
 			arg0,
@@ -312,58 +4224,59 @@ func (_c *MockHandler_DeleteTaskByIDWrapped_Call) Run(run func(ctx context.Conte
 	return _c
 }
 
-func (_c *MockHandler_DeleteTaskByIDWrapped_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_DeleteTaskByIDWrapped_Call {
+func (_c *MockHandler_UpdateTaskByIDWrapped_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_UpdateTaskByIDWrapped_Call {
 	_c.Call.Return(messageResponse, err)
 	return _c
 }
 
-func (_c *MockHandler_DeleteTaskByIDWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskDeleteRequest) (*dto.MessageResponse, error)) *MockHandler_DeleteTaskByIDWrapped_Call {
+func (_c *MockHandler_UpdateTaskByIDWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskUpdateWithIDRequest) (*dto.MessageResponse, error)) *MockHandler_UpdateTaskByIDWrapped_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTaskByID provides a mock function for the type MockHandler
-func (_mock *MockHandler) GetTaskByID(ctx context.Context, taskID string, userID string) (*dto.TaskResponse, error) {
-	ret := _mock.Called(ctx, taskID, userID)
+// UpdateTaskParentByID provides a mock function for the type MockHandler
+func (_mock *MockHandler) UpdateTaskParentByID(ctx context.Context, taskID string, req *dto.TaskUpdateParentRequest, userID string) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, taskID, req, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTaskByID")
+		panic("no return value specified for UpdateTaskParentByID")
 	}
 
-	var r0 *dto.TaskResponse
+	var r0 *dto.MessageResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*dto.TaskResponse, error)); ok {
-		return returnFunc(ctx, taskID, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskUpdateParentRequest, string) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, taskID, req, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *dto.TaskResponse); ok {
-		r0 = returnFunc(ctx, taskID, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskUpdateParentRequest, string) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, taskID, req, userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.TaskResponse)
+			r0 = ret.Get(0).(*dto.MessageResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = returnFunc(ctx, taskID, userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *dto.TaskUpdateParentRequest, string) error); ok {
+		r1 = returnFunc(ctx, taskID, req, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockHandler_GetTaskByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTaskByID'
-type MockHandler_GetTaskByID_Call struct {
+// MockHandler_UpdateTaskParentByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTaskParentByID'
+type MockHandler_UpdateTaskParentByID_Call struct {
 	*mock.Call
 }
 
-// GetTaskByID is a helper method to define mock.On call
+// UpdateTaskParentByID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - taskID string
+//   - req *dto.TaskUpdateParentRequest
 //   - userID string
-func (_e *MockHandler_Expecter) GetTaskByID(ctx interface{}, taskID interface{}, userID interface{}) *MockHandler_GetTaskByID_Call {
-	return &MockHandler_GetTaskByID_Call{Call: _e.mock.On("GetTaskByID", ctx, taskID, userID)}
+func (_e *MockHandler_Expecter) UpdateTaskParentByID(ctx interface{}, taskID interface{}, req interface{}, userID interface{}) *MockHandler_UpdateTaskParentByID_Call {
+	return &MockHandler_UpdateTaskParentByID_Call{Call: _e.mock.On("UpdateTaskParentByID", ctx, taskID, req, userID)}
 }
 
-func (_c *MockHandler_GetTaskByID_Call) Run(run func(ctx context.Context, taskID string, userID string)) *MockHandler_GetTaskByID_Call {
+func (_c *MockHandler_UpdateTaskParentByID_Call) Run(run func(ctx context.Context, taskID string, req *dto.TaskUpdateParentRequest, userID string)) *MockHandler_UpdateTaskParentByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -373,50 +4286,55 @@ func (_c *MockHandler_GetTaskByID_Call) Run(run func(ctx context.Context, taskID
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 string
+		var arg2 *dto.TaskUpdateParentRequest
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(*dto.TaskUpdateParentRequest)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockHandler_GetTaskByID_Call) Return(taskResponse *dto.TaskResponse, err error) *MockHandler_GetTaskByID_Call {
-	_c.Call.Return(taskResponse, err)
+func (_c *MockHandler_UpdateTaskParentByID_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_UpdateTaskParentByID_Call {
+	_c.Call.Return(messageResponse, err)
 	return _c
 }
 
-func (_c *MockHandler_GetTaskByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, userID string) (*dto.TaskResponse, error)) *MockHandler_GetTaskByID_Call {
+func (_c *MockHandler_UpdateTaskParentByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, req *dto.TaskUpdateParentRequest, userID string) (*dto.MessageResponse, error)) *MockHandler_UpdateTaskParentByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTaskByIDWrapped provides a mock function for the type MockHandler
-func (_mock *MockHandler) GetTaskByIDWrapped(ctx context.Context, req *dto.TaskGetByIDRequest) (*dto.TaskResponse, error) {
+// UpdateTaskParentByIDWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) UpdateTaskParentByIDWrapped(ctx context.Context, req *dto.TaskUpdateParentWithIDRequest) (*dto.MessageResponse, error) {
 	ret := _mock.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTaskByIDWrapped")
+		panic("no return value specified for UpdateTaskParentByIDWrapped")
 	}
 
-	var r0 *dto.TaskResponse
+	var r0 *dto.MessageResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskGetByIDRequest) (*dto.TaskResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskUpdateParentWithIDRequest) (*dto.MessageResponse, error)); ok {
 		return returnFunc(ctx, req)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskGetByIDRequest) *dto.TaskResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskUpdateParentWithIDRequest) *dto.MessageResponse); ok {
 		r0 = returnFunc(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.TaskResponse)
+			r0 = ret.Get(0).(*dto.MessageResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskGetByIDRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskUpdateParentWithIDRequest) error); ok {
 		r1 = returnFunc(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -424,27 +4342,27 @@ func (_mock *MockHandler) GetTaskByIDWrapped(ctx context.Context, req *dto.TaskG
 	return r0, r1
 }
 
-// MockHandler_GetTaskByIDWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTaskByIDWrapped'
-type MockHandler_GetTaskByIDWrapped_Call struct {
+// MockHandler_UpdateTaskParentByIDWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTaskParentByIDWrapped'
+type MockHandler_UpdateTaskParentByIDWrapped_Call struct {
 	*mock.Call
 }
 
-// GetTaskByIDWrapped is a helper method to define mock.On call
+// UpdateTaskParentByIDWrapped is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *dto.TaskGetByIDRequest
-func (_e *MockHandler_Expecter) GetTaskByIDWrapped(ctx interface{}, req interface{}) *MockHandler_GetTaskByIDWrapped_Call {
-	return &MockHandler_GetTaskByIDWrapped_Call{Call: _e.mock.On("GetTaskByIDWrapped", ctx, req)}
+//   - req *dto.TaskUpdateParentWithIDRequest
+func (_e *MockHandler_Expecter) UpdateTaskParentByIDWrapped(ctx interface{}, req interface{}) *MockHandler_UpdateTaskParentByIDWrapped_Call {
+	return &MockHandler_UpdateTaskParentByIDWrapped_Call{Call: _e.mock.On("UpdateTaskParentByIDWrapped", ctx, req)}
 }
 
-func (_c *MockHandler_GetTaskByIDWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskGetByIDRequest)) *MockHandler_GetTaskByIDWrapped_Call {
+func (_c *MockHandler_UpdateTaskParentByIDWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskUpdateParentWithIDRequest)) *MockHandler_UpdateTaskParentByIDWrapped_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dto.TaskGetByIDRequest
+		var arg1 *dto.TaskUpdateParentWithIDRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dto.TaskGetByIDRequest)
+			arg1 = args[1].(*dto.TaskUpdateParentWithIDRequest)
 		}
 		run(
 			arg0,
@@ -454,57 +4372,59 @@ func (_c *MockHandler_GetTaskByIDWrapped_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockHandler_GetTaskByIDWrapped_Call) Return(taskResponse *dto.TaskResponse, err error) *MockHandler_GetTaskByIDWrapped_Call {
-	_c.Call.Return(taskResponse, err)
+func (_c *MockHandler_UpdateTaskParentByIDWrapped_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_UpdateTaskParentByIDWrapped_Call {
+	_c.Call.Return(messageResponse, err)
 	return _c
 }
 
-func (_c *MockHandler_GetTaskByIDWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskGetByIDRequest) (*dto.TaskResponse, error)) *MockHandler_GetTaskByIDWrapped_Call {
+func (_c *MockHandler_UpdateTaskParentByIDWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskUpdateParentWithIDRequest) (*dto.MessageResponse, error)) *MockHandler_UpdateTaskParentByIDWrapped_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTasksByUserID provides a mock function for the type MockHandler
-func (_mock *MockHandler) GetTasksByUserID(ctx context.Context, userID string) ([]dto.TaskResponse, error) {
-	ret := _mock.Called(ctx, userID)
+// UpdateTaskProjectByID provides a mock function for the type MockHandler
+func (_mock *MockHandler) UpdateTaskProjectByID(ctx context.Context, taskID string, req *dto.TaskUpdateProjectRequest, userID string) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, taskID, req, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTasksByUserID")
+		panic("no return value specified for UpdateTaskProjectByID")
 	}
 
-	var r0 []dto.TaskResponse
+	var r0 *dto.MessageResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]dto.TaskResponse, error)); ok {
-		return returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskUpdateProjectRequest, string) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, taskID, req, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []dto.TaskResponse); ok {
-		r0 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskUpdateProjectRequest, string) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, taskID, req, userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]dto.TaskResponse)
+			r0 = ret.Get(0).(*dto.MessageResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *dto.TaskUpdateProjectRequest, string) error); ok {
+		r1 = returnFunc(ctx, taskID, req, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockHandler_GetTasksByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTasksByUserID'
-type MockHandler_GetTasksByUserID_Call struct {
+// MockHandler_UpdateTaskProjectByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTaskProjectByID'
+type MockHandler_UpdateTaskProjectByID_Call struct {
 	*mock.Call
 }
 
-// GetTasksByUserID is a helper method to define mock.On call
+// UpdateTaskProjectByID is a helper method to define mock.On call
 //   - ctx context.Context
+//   - taskID string
+//   - req *dto.TaskUpdateProjectRequest
 //   - userID string
-func (_e *MockHandler_Expecter) GetTasksByUserID(ctx interface{}, userID interface{}) *MockHandler_GetTasksByUserID_Call {
-	return &MockHandler_GetTasksByUserID_Call{Call: _e.mock.On("GetTasksByUserID", ctx, userID)}
+func (_e *MockHandler_Expecter) UpdateTaskProjectByID(ctx interface{}, taskID interface{}, req interface{}, userID interface{}) *MockHandler_UpdateTaskProjectByID_Call {
+	return &MockHandler_UpdateTaskProjectByID_Call{Call: _e.mock.On("UpdateTaskProjectByID", ctx, taskID, req, userID)}
 }
 
-func (_c *MockHandler_GetTasksByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockHandler_GetTasksByUserID_Call {
+func (_c *MockHandler_UpdateTaskProjectByID_Call) Run(run func(ctx context.Context, taskID string, req *dto.TaskUpdateProjectRequest, userID string)) *MockHandler_UpdateTaskProjectByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -514,73 +4434,83 @@ func (_c *MockHandler_GetTasksByUserID_Call) Run(run func(ctx context.Context, u
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 *dto.TaskUpdateProjectRequest
+		if args[2] != nil {
+			arg2 = args[2].(*dto.TaskUpdateProjectRequest)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockHandler_GetTasksByUserID_Call) Return(taskResponses []dto.TaskResponse, err error) *MockHandler_GetTasksByUserID_Call {
-	_c.Call.Return(taskResponses, err)
+func (_c *MockHandler_UpdateTaskProjectByID_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_UpdateTaskProjectByID_Call {
+	_c.Call.Return(messageResponse, err)
 	return _c
 }
 
-func (_c *MockHandler_GetTasksByUserID_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]dto.TaskResponse, error)) *MockHandler_GetTasksByUserID_Call {
+func (_c *MockHandler_UpdateTaskProjectByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, req *dto.TaskUpdateProjectRequest, userID string) (*dto.MessageResponse, error)) *MockHandler_UpdateTaskProjectByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTasksByUserIDWrapped provides a mock function for the type MockHandler
-func (_mock *MockHandler) GetTasksByUserIDWrapped(ctx context.Context, v any) ([]dto.TaskResponse, error) {
-	ret := _mock.Called(ctx, v)
+// UpdateTaskProjectByIDWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) UpdateTaskProjectByIDWrapped(ctx context.Context, req *dto.TaskUpdateProjectWithIDRequest) (*dto.MessageResponse, error) {
+	ret := _mock.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTasksByUserIDWrapped")
+		panic("no return value specified for UpdateTaskProjectByIDWrapped")
 	}
 
-	var r0 []dto.TaskResponse
+	var r0 *dto.MessageResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, any) ([]dto.TaskResponse, error)); ok {
-		return returnFunc(ctx, v)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskUpdateProjectWithIDRequest) (*dto.MessageResponse, error)); ok {
+		return returnFunc(ctx, req)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, any) []dto.TaskResponse); ok {
-		r0 = returnFunc(ctx, v)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskUpdateProjectWithIDRequest) *dto.MessageResponse); ok {
+		r0 = returnFunc(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]dto.TaskResponse)
+			r0 = ret.Get(0).(*dto.MessageResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, any) error); ok {
-		r1 = returnFunc(ctx, v)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskUpdateProjectWithIDRequest) error); ok {
+		r1 = returnFunc(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockHandler_GetTasksByUserIDWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTasksByUserIDWrapped'
-type MockHandler_GetTasksByUserIDWrapped_Call struct {
+// MockHandler_UpdateTaskProjectByIDWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTaskProjectByIDWrapped'
+type MockHandler_UpdateTaskProjectByIDWrapped_Call struct {
 	*mock.Call
 }
 
-// GetTasksByUserIDWrapped is a helper method to define mock.On call
+// UpdateTaskProjectByIDWrapped is a helper method to define mock.On call
 //   - ctx context.Context
-//   - v any
-func (_e *MockHandler_Expecter) GetTasksByUserIDWrapped(ctx interface{}, v interface{}) *MockHandler_GetTasksByUserIDWrapped_Call {
-	return &MockHandler_GetTasksByUserIDWrapped_Call{Call: _e.mock.On("GetTasksByUserIDWrapped", ctx, v)}
+//   - req *dto.TaskUpdateProjectWithIDRequest
+func (_e *MockHandler_Expecter) UpdateTaskProjectByIDWrapped(ctx interface{}, req interface{}) *MockHandler_UpdateTaskProjectByIDWrapped_Call {
+	return &MockHandler_UpdateTaskProjectByIDWrapped_Call{Call: _e.mock.On("UpdateTaskProjectByIDWrapped", ctx, req)}
 }
 
-func (_c *MockHandler_GetTasksByUserIDWrapped_Call) Run(run func(ctx context.Context, v any)) *MockHandler_GetTasksByUserIDWrapped_Call {
+func (_c *MockHandler_UpdateTaskProjectByIDWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskUpdateProjectWithIDRequest)) *MockHandler_UpdateTaskProjectByIDWrapped_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 any
+		var arg1 *dto.TaskUpdateProjectWithIDRequest
 		if args[1] != nil {
-			arg1 = args[1].(any)
+			arg1 = args[1].(*dto.TaskUpdateProjectWithIDRequest)
 		}
 		run(
 			arg0,
@@ -590,37 +4520,37 @@ func (_c *MockHandler_GetTasksByUserIDWrapped_Call) Run(run func(ctx context.Con
 	return _c
 }
 
-func (_c *MockHandler_GetTasksByUserIDWrapped_Call) Return(taskResponses []dto.TaskResponse, err error) *MockHandler_GetTasksByUserIDWrapped_Call {
-	_c.Call.Return(taskResponses, err)
+func (_c *MockHandler_UpdateTaskProjectByIDWrapped_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_UpdateTaskProjectByIDWrapped_Call {
+	_c.Call.Return(messageResponse, err)
 	return _c
 }
 
-func (_c *MockHandler_GetTasksByUserIDWrapped_Call) RunAndReturn(run func(ctx context.Context, v any) ([]dto.TaskResponse, error)) *MockHandler_GetTasksByUserIDWrapped_Call {
+func (_c *MockHandler_UpdateTaskProjectByIDWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskUpdateProjectWithIDRequest) (*dto.MessageResponse, error)) *MockHandler_UpdateTaskProjectByIDWrapped_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateTaskByID provides a mock function for the type MockHandler
-func (_mock *MockHandler) UpdateTaskByID(ctx context.Context, taskID string, req *dto.TaskUpdateRequest, userID string) (*dto.MessageResponse, error) {
+// UpdateTaskStatusByID provides a mock function for the type MockHandler
+func (_mock *MockHandler) UpdateTaskStatusByID(ctx context.Context, taskID string, req *dto.TaskUpdateStatusRequest, userID string) (*dto.MessageResponse, error) {
 	ret := _mock.Called(ctx, taskID, req, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateTaskByID")
+		panic("no return value specified for UpdateTaskStatusByID")
 	}
 
 	var r0 *dto.MessageResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskUpdateRequest, string) (*dto.MessageResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskUpdateStatusRequest, string) (*dto.MessageResponse, error)); ok {
 		return returnFunc(ctx, taskID, req, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskUpdateRequest, string) *dto.MessageResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskUpdateStatusRequest, string) *dto.MessageResponse); ok {
 		r0 = returnFunc(ctx, taskID, req, userID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dto.MessageResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *dto.TaskUpdateRequest, string) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *dto.TaskUpdateStatusRequest, string) error); ok {
 		r1 = returnFunc(ctx, taskID, req, userID)
 	} else {
 		r1 = ret.Error(1)
@@ -628,21 +4558,21 @@ func (_mock *MockHandler) UpdateTaskByID(ctx context.Context, taskID string, req
 	return r0, r1
 }
 
-// MockHandler_UpdateTaskByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTaskByID'
-type MockHandler_UpdateTaskByID_Call struct {
+// MockHandler_UpdateTaskStatusByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTaskStatusByID'
+type MockHandler_UpdateTaskStatusByID_Call struct {
 	*mock.Call
 }
 
-// UpdateTaskByID is a helper method to define mock.On call
+// UpdateTaskStatusByID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - taskID string
-//   - req *dto.TaskUpdateRequest
+//   - req *dto.TaskUpdateStatusRequest
 //   - userID string
-func (_e *MockHandler_Expecter) UpdateTaskByID(ctx interface{}, taskID interface{}, req interface{}, userID interface{}) *MockHandler_UpdateTaskByID_Call {
-	return &MockHandler_UpdateTaskByID_Call{Call: _e.mock.On("UpdateTaskByID", ctx, taskID, req, userID)}
+func (_e *MockHandler_Expecter) UpdateTaskStatusByID(ctx interface{}, taskID interface{}, req interface{}, userID interface{}) *MockHandler_UpdateTaskStatusByID_Call {
+	return &MockHandler_UpdateTaskStatusByID_Call{Call: _e.mock.On("UpdateTaskStatusByID", ctx, taskID, req, userID)}
 }
 
-func (_c *MockHandler_UpdateTaskByID_Call) Run(run func(ctx context.Context, taskID string, req *dto.TaskUpdateRequest, userID string)) *MockHandler_UpdateTaskByID_Call {
+func (_c *MockHandler_UpdateTaskStatusByID_Call) Run(run func(ctx context.Context, taskID string, req *dto.TaskUpdateStatusRequest, userID string)) *MockHandler_UpdateTaskStatusByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -652,9 +4582,9 @@ func (_c *MockHandler_UpdateTaskByID_Call) Run(run func(ctx context.Context, tas
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 *dto.TaskUpdateRequest
+		var arg2 *dto.TaskUpdateStatusRequest
 		if args[2] != nil {
-			arg2 = args[2].(*dto.TaskUpdateRequest)
+			arg2 = args[2].(*dto.TaskUpdateStatusRequest)
 		}
 		var arg3 string
 		if args[3] != nil {
@@ -670,37 +4600,37 @@ func (_c *MockHandler_UpdateTaskByID_Call) Run(run func(ctx context.Context, tas
 	return _c
 }
 
-func (_c *MockHandler_UpdateTaskByID_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_UpdateTaskByID_Call {
+func (_c *MockHandler_UpdateTaskStatusByID_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_UpdateTaskStatusByID_Call {
 	_c.Call.Return(messageResponse, err)
 	return _c
 }
 
-func (_c *MockHandler_UpdateTaskByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, req *dto.TaskUpdateRequest, userID string) (*dto.MessageResponse, error)) *MockHandler_UpdateTaskByID_Call {
+func (_c *MockHandler_UpdateTaskStatusByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, req *dto.TaskUpdateStatusRequest, userID string) (*dto.MessageResponse, error)) *MockHandler_UpdateTaskStatusByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateTaskByIDWrapped provides a mock function for the type MockHandler
-func (_mock *MockHandler) UpdateTaskByIDWrapped(ctx context.Context, req *dto.TaskUpdateWithIDRequest) (*dto.MessageResponse, error) {
+// UpdateTaskStatusByIDWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) UpdateTaskStatusByIDWrapped(ctx context.Context, req *dto.TaskUpdateStatusWithIDRequest) (*dto.MessageResponse, error) {
 	ret := _mock.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateTaskByIDWrapped")
+		panic("no return value specified for UpdateTaskStatusByIDWrapped")
 	}
 
 	var r0 *dto.MessageResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskUpdateWithIDRequest) (*dto.MessageResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskUpdateStatusWithIDRequest) (*dto.MessageResponse, error)); ok {
 		return returnFunc(ctx, req)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskUpdateWithIDRequest) *dto.MessageResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskUpdateStatusWithIDRequest) *dto.MessageResponse); ok {
 		r0 = returnFunc(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dto.MessageResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskUpdateWithIDRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskUpdateStatusWithIDRequest) error); ok {
 		r1 = returnFunc(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -708,27 +4638,27 @@ func (_mock *MockHandler) UpdateTaskByIDWrapped(ctx context.Context, req *dto.Ta
 	return r0, r1
 }
 
-// MockHandler_UpdateTaskByIDWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTaskByIDWrapped'
-type MockHandler_UpdateTaskByIDWrapped_Call struct {
+// MockHandler_UpdateTaskStatusByIDWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTaskStatusByIDWrapped'
+type MockHandler_UpdateTaskStatusByIDWrapped_Call struct {
 	*mock.Call
 }
 
-// UpdateTaskByIDWrapped is a helper method to define mock.On call
+// UpdateTaskStatusByIDWrapped is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *dto.TaskUpdateWithIDRequest
-func (_e *MockHandler_Expecter) UpdateTaskByIDWrapped(ctx interface{}, req interface{}) *MockHandler_UpdateTaskByIDWrapped_Call {
-	return &MockHandler_UpdateTaskByIDWrapped_Call{Call: _e.mock.On("UpdateTaskByIDWrapped", ctx, req)}
+//   - req *dto.TaskUpdateStatusWithIDRequest
+func (_e *MockHandler_Expecter) UpdateTaskStatusByIDWrapped(ctx interface{}, req interface{}) *MockHandler_UpdateTaskStatusByIDWrapped_Call {
+	return &MockHandler_UpdateTaskStatusByIDWrapped_Call{Call: _e.mock.On("UpdateTaskStatusByIDWrapped", ctx, req)}
 }
 
-func (_c *MockHandler_UpdateTaskByIDWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskUpdateWithIDRequest)) *MockHandler_UpdateTaskByIDWrapped_Call {
+func (_c *MockHandler_UpdateTaskStatusByIDWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskUpdateStatusWithIDRequest)) *MockHandler_UpdateTaskStatusByIDWrapped_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dto.TaskUpdateWithIDRequest
+		var arg1 *dto.TaskUpdateStatusWithIDRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dto.TaskUpdateWithIDRequest)
+			arg1 = args[1].(*dto.TaskUpdateStatusWithIDRequest)
 		}
 		run(
 			arg0,
@@ -738,37 +4668,37 @@ func (_c *MockHandler_UpdateTaskByIDWrapped_Call) Run(run func(ctx context.Conte
 	return _c
 }
 
-func (_c *MockHandler_UpdateTaskByIDWrapped_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_UpdateTaskByIDWrapped_Call {
+func (_c *MockHandler_UpdateTaskStatusByIDWrapped_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_UpdateTaskStatusByIDWrapped_Call {
 	_c.Call.Return(messageResponse, err)
 	return _c
 }
 
-func (_c *MockHandler_UpdateTaskByIDWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskUpdateWithIDRequest) (*dto.MessageResponse, error)) *MockHandler_UpdateTaskByIDWrapped_Call {
+func (_c *MockHandler_UpdateTaskStatusByIDWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskUpdateStatusWithIDRequest) (*dto.MessageResponse, error)) *MockHandler_UpdateTaskStatusByIDWrapped_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateTaskStatusByID provides a mock function for the type MockHandler
-func (_mock *MockHandler) UpdateTaskStatusByID(ctx context.Context, taskID string, req *dto.TaskUpdateStatusRequest, userID string) (*dto.MessageResponse, error) {
+// UpdateTaskWorkspaceByID provides a mock function for the type MockHandler
+func (_mock *MockHandler) UpdateTaskWorkspaceByID(ctx context.Context, taskID string, req *dto.TaskUpdateWorkspaceRequest, userID string) (*dto.MessageResponse, error) {
 	ret := _mock.Called(ctx, taskID, req, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateTaskStatusByID")
+		panic("no return value specified for UpdateTaskWorkspaceByID")
 	}
 
 	var r0 *dto.MessageResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskUpdateStatusRequest, string) (*dto.MessageResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskUpdateWorkspaceRequest, string) (*dto.MessageResponse, error)); ok {
 		return returnFunc(ctx, taskID, req, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskUpdateStatusRequest, string) *dto.MessageResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *dto.TaskUpdateWorkspaceRequest, string) *dto.MessageResponse); ok {
 		r0 = returnFunc(ctx, taskID, req, userID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dto.MessageResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *dto.TaskUpdateStatusRequest, string) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *dto.TaskUpdateWorkspaceRequest, string) error); ok {
 		r1 = returnFunc(ctx, taskID, req, userID)
 	} else {
 		r1 = ret.Error(1)
@@ -776,21 +4706,21 @@ func (_mock *MockHandler) UpdateTaskStatusByID(ctx context.Context, taskID strin
 	return r0, r1
 }
 
-// MockHandler_UpdateTaskStatusByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTaskStatusByID'
-type MockHandler_UpdateTaskStatusByID_Call struct {
+// MockHandler_UpdateTaskWorkspaceByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTaskWorkspaceByID'
+type MockHandler_UpdateTaskWorkspaceByID_Call struct {
 	*mock.Call
 }
 
-// UpdateTaskStatusByID is a helper method to define mock.On call
+// UpdateTaskWorkspaceByID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - taskID string
-//   - req *dto.TaskUpdateStatusRequest
+//   - req *dto.TaskUpdateWorkspaceRequest
 //   - userID string
-func (_e *MockHandler_Expecter) UpdateTaskStatusByID(ctx interface{}, taskID interface{}, req interface{}, userID interface{}) *MockHandler_UpdateTaskStatusByID_Call {
-	return &MockHandler_UpdateTaskStatusByID_Call{Call: _e.mock.On("UpdateTaskStatusByID", ctx, taskID, req, userID)}
+func (_e *MockHandler_Expecter) UpdateTaskWorkspaceByID(ctx interface{}, taskID interface{}, req interface{}, userID interface{}) *MockHandler_UpdateTaskWorkspaceByID_Call {
+	return &MockHandler_UpdateTaskWorkspaceByID_Call{Call: _e.mock.On("UpdateTaskWorkspaceByID", ctx, taskID, req, userID)}
 }
 
-func (_c *MockHandler_UpdateTaskStatusByID_Call) Run(run func(ctx context.Context, taskID string, req *dto.TaskUpdateStatusRequest, userID string)) *MockHandler_UpdateTaskStatusByID_Call {
+func (_c *MockHandler_UpdateTaskWorkspaceByID_Call) Run(run func(ctx context.Context, taskID string, req *dto.TaskUpdateWorkspaceRequest, userID string)) *MockHandler_UpdateTaskWorkspaceByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -800,9 +4730,9 @@ func (_c *MockHandler_UpdateTaskStatusByID_Call) Run(run func(ctx context.Contex
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 *dto.TaskUpdateStatusRequest
+		var arg2 *dto.TaskUpdateWorkspaceRequest
 		if args[2] != nil {
-			arg2 = args[2].(*dto.TaskUpdateStatusRequest)
+			arg2 = args[2].(*dto.TaskUpdateWorkspaceRequest)
 		}
 		var arg3 string
 		if args[3] != nil {
@@ -818,37 +4748,37 @@ func (_c *MockHandler_UpdateTaskStatusByID_Call) Run(run func(ctx context.Contex
 	return _c
 }
 
-func (_c *MockHandler_UpdateTaskStatusByID_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_UpdateTaskStatusByID_Call {
+func (_c *MockHandler_UpdateTaskWorkspaceByID_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_UpdateTaskWorkspaceByID_Call {
 	_c.Call.Return(messageResponse, err)
 	return _c
 }
 
-func (_c *MockHandler_UpdateTaskStatusByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, req *dto.TaskUpdateStatusRequest, userID string) (*dto.MessageResponse, error)) *MockHandler_UpdateTaskStatusByID_Call {
+func (_c *MockHandler_UpdateTaskWorkspaceByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, req *dto.TaskUpdateWorkspaceRequest, userID string) (*dto.MessageResponse, error)) *MockHandler_UpdateTaskWorkspaceByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateTaskStatusByIDWrapped provides a mock function for the type MockHandler
-func (_mock *MockHandler) UpdateTaskStatusByIDWrapped(ctx context.Context, req *dto.TaskUpdateStatusWithIDRequest) (*dto.MessageResponse, error) {
+// UpdateTaskWorkspaceByIDWrapped provides a mock function for the type MockHandler
+func (_mock *MockHandler) UpdateTaskWorkspaceByIDWrapped(ctx context.Context, req *dto.TaskUpdateWorkspaceWithIDRequest) (*dto.MessageResponse, error) {
 	ret := _mock.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateTaskStatusByIDWrapped")
+		panic("no return value specified for UpdateTaskWorkspaceByIDWrapped")
 	}
 
 	var r0 *dto.MessageResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskUpdateStatusWithIDRequest) (*dto.MessageResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskUpdateWorkspaceWithIDRequest) (*dto.MessageResponse, error)); ok {
 		return returnFunc(ctx, req)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskUpdateStatusWithIDRequest) *dto.MessageResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dto.TaskUpdateWorkspaceWithIDRequest) *dto.MessageResponse); ok {
 		r0 = returnFunc(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dto.MessageResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskUpdateStatusWithIDRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dto.TaskUpdateWorkspaceWithIDRequest) error); ok {
 		r1 = returnFunc(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -856,27 +4786,27 @@ func (_mock *MockHandler) UpdateTaskStatusByIDWrapped(ctx context.Context, req *
 	return r0, r1
 }
 
-// MockHandler_UpdateTaskStatusByIDWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTaskStatusByIDWrapped'
-type MockHandler_UpdateTaskStatusByIDWrapped_Call struct {
+// MockHandler_UpdateTaskWorkspaceByIDWrapped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTaskWorkspaceByIDWrapped'
+type MockHandler_UpdateTaskWorkspaceByIDWrapped_Call struct {
 	*mock.Call
 }
 
-// UpdateTaskStatusByIDWrapped is a helper method to define mock.On call
+// UpdateTaskWorkspaceByIDWrapped is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *dto.TaskUpdateStatusWithIDRequest
-func (_e *MockHandler_Expecter) UpdateTaskStatusByIDWrapped(ctx interface{}, req interface{}) *MockHandler_UpdateTaskStatusByIDWrapped_Call {
-	return &MockHandler_UpdateTaskStatusByIDWrapped_Call{Call: _e.mock.On("UpdateTaskStatusByIDWrapped", ctx, req)}
+//   - req *dto.TaskUpdateWorkspaceWithIDRequest
+func (_e *MockHandler_Expecter) UpdateTaskWorkspaceByIDWrapped(ctx interface{}, req interface{}) *MockHandler_UpdateTaskWorkspaceByIDWrapped_Call {
+	return &MockHandler_UpdateTaskWorkspaceByIDWrapped_Call{Call: _e.mock.On("UpdateTaskWorkspaceByIDWrapped", ctx, req)}
 }
 
-func (_c *MockHandler_UpdateTaskStatusByIDWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskUpdateStatusWithIDRequest)) *MockHandler_UpdateTaskStatusByIDWrapped_Call {
+func (_c *MockHandler_UpdateTaskWorkspaceByIDWrapped_Call) Run(run func(ctx context.Context, req *dto.TaskUpdateWorkspaceWithIDRequest)) *MockHandler_UpdateTaskWorkspaceByIDWrapped_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dto.TaskUpdateStatusWithIDRequest
+		var arg1 *dto.TaskUpdateWorkspaceWithIDRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dto.TaskUpdateStatusWithIDRequest)
+			arg1 = args[1].(*dto.TaskUpdateWorkspaceWithIDRequest)
 		}
 		run(
 			arg0,
@@ -886,12 +4816,12 @@ func (_c *MockHandler_UpdateTaskStatusByIDWrapped_Call) Run(run func(ctx context
 	return _c
 }
 
-func (_c *MockHandler_UpdateTaskStatusByIDWrapped_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_UpdateTaskStatusByIDWrapped_Call {
+func (_c *MockHandler_UpdateTaskWorkspaceByIDWrapped_Call) Return(messageResponse *dto.MessageResponse, err error) *MockHandler_UpdateTaskWorkspaceByIDWrapped_Call {
 	_c.Call.Return(messageResponse, err)
 	return _c
 }
 
-func (_c *MockHandler_UpdateTaskStatusByIDWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskUpdateStatusWithIDRequest) (*dto.MessageResponse, error)) *MockHandler_UpdateTaskStatusByIDWrapped_Call {
+func (_c *MockHandler_UpdateTaskWorkspaceByIDWrapped_Call) RunAndReturn(run func(ctx context.Context, req *dto.TaskUpdateWorkspaceWithIDRequest) (*dto.MessageResponse, error)) *MockHandler_UpdateTaskWorkspaceByIDWrapped_Call {
 	_c.Call.Return(run)
 	return _c
 }
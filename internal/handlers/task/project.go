@@ -0,0 +1,71 @@
+package task
+
+import (
+	"context"
+
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/task"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+)
+
+func (h *handler) GetTasksByProjectID(ctx context.Context, projectID string, req *dto.TaskListRequest, userID string) (*dto.TaskListResponse, error) {
+	serviceInput := toTaskListInput(req)
+
+	output, err := h.taskService.FindTasksByProjectID(ctx, projectID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskListResponse := toTaskListResponse(output)
+
+	return &taskListResponse, nil
+}
+
+func (h *handler) UpdateTaskProjectByID(ctx context.Context, taskID string, req *dto.TaskUpdateProjectRequest, userID string) (*dto.MessageResponse, error) {
+	serviceInput := task.TaskUpdateProjectInput{
+		ProjectID: req.ProjectID,
+		Version:   req.Version,
+	}
+
+	err := h.taskService.UpdateTaskProjectByID(ctx, taskID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Task project updated successfully",
+	}, nil
+}
+
+// Wrapper methods for WrapWithStatus compatibility
+
+func (h *handler) GetTasksByProjectIDWrapped(ctx context.Context, req *dto.TaskListByProjectRequest) (*dto.TaskListResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetTasksByProjectID(ctx, req.ID, &req.TaskListRequest, userID)
+}
+
+func (h *handler) UpdateTaskProjectByIDWrapped(ctx context.Context, req *dto.TaskUpdateProjectWithIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	version, err := requireVersion(req.IfMatch, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	updateReq := &dto.TaskUpdateProjectRequest{
+		ProjectID: req.ProjectID,
+		Version:   version,
+	}
+	return h.UpdateTaskProjectByID(ctx, req.ID, updateReq, userID)
+}
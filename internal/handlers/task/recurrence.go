@@ -0,0 +1,102 @@
+package task
+
+import (
+	"context"
+
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/task"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+)
+
+func (h *handler) SetTaskRecurrence(ctx context.Context, taskID string, req *dto.TaskRecurrenceRequest, userID string) (*dto.MessageResponse, error) {
+	serviceInput := task.TaskRecurrenceInput{
+		Rule: req.Rule,
+	}
+
+	err := h.taskService.SetTaskRecurrence(ctx, taskID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Task recurrence updated successfully",
+	}, nil
+}
+
+func (h *handler) GetTaskRecurrence(ctx context.Context, taskID string, req *dto.TaskRecurrencePreviewRequest, userID string) (*dto.TaskRecurrenceResponse, error) {
+	serviceInput := task.TaskRecurrencePreviewInput{
+		Count: req.Count,
+	}
+
+	output, err := h.taskService.FindTaskRecurrence(ctx, taskID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := dto.TaskRecurrenceResponse{
+		SeriesID:  output.Series.ID,
+		Rule:      output.Series.Rule,
+		AnchorAt:  output.Series.AnchorAt,
+		StoppedAt: output.Series.StoppedAt,
+		Upcoming:  make([]dto.TaskOccurrenceResponse, len(output.Upcoming)),
+	}
+
+	for i, occurrence := range output.Upcoming {
+		response.Upcoming[i] = dto.TaskOccurrenceResponse{
+			StartAt: occurrence.StartAt,
+			DueAt:   occurrence.DueAt,
+		}
+	}
+
+	return &response, nil
+}
+
+func (h *handler) StopTaskRecurrence(ctx context.Context, taskID string, userID string) (*dto.MessageResponse, error) {
+	err := h.taskService.StopTaskRecurrence(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Task recurrence stopped successfully",
+	}, nil
+}
+
+// Wrapper methods for WrapWithStatus compatibility
+
+func (h *handler) SetTaskRecurrenceWrapped(ctx context.Context, req *dto.TaskRecurrenceWithIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	recurrenceReq := &dto.TaskRecurrenceRequest{
+		Rule: req.Rule,
+	}
+	return h.SetTaskRecurrence(ctx, req.ID, recurrenceReq, userID)
+}
+
+func (h *handler) GetTaskRecurrenceWrapped(ctx context.Context, req *dto.TaskRecurrencePreviewRequest) (*dto.TaskRecurrenceResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetTaskRecurrence(ctx, req.ID, req, userID)
+}
+
+func (h *handler) StopTaskRecurrenceWrapped(ctx context.Context, req *dto.TaskGetByIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.StopTaskRecurrence(ctx, req.ID, userID)
+}
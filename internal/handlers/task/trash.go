@@ -0,0 +1,79 @@
+package task
+
+import (
+	"context"
+
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+)
+
+func (h *handler) GetTrashedTasks(ctx context.Context, req *dto.TaskListRequest, userID string) (*dto.TaskListResponse, error) {
+	serviceInput := toTaskListInput(req)
+
+	output, err := h.taskService.FindTrashedTasks(ctx, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskListResponse := toTaskListResponse(output)
+
+	return &taskListResponse, nil
+}
+
+func (h *handler) RestoreTaskByID(ctx context.Context, taskID string, userID string) (*dto.MessageResponse, error) {
+	err := h.taskService.RestoreTaskByID(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Task restored successfully",
+	}, nil
+}
+
+func (h *handler) PurgeTaskByID(ctx context.Context, taskID string, userID string) (*dto.MessageResponse, error) {
+	err := h.taskService.PurgeTaskByID(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Task permanently deleted successfully",
+	}, nil
+}
+
+// Wrapper methods for WrapWithStatus compatibility
+
+func (h *handler) GetTrashedTasksWrapped(ctx context.Context, req *dto.TaskListRequest) (*dto.TaskListResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetTrashedTasks(ctx, req, userID)
+}
+
+func (h *handler) RestoreTaskByIDWrapped(ctx context.Context, req *dto.TaskGetByIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.RestoreTaskByID(ctx, req.ID, userID)
+}
+
+func (h *handler) PurgeTaskByIDWrapped(ctx context.Context, req *dto.TaskGetByIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.PurgeTaskByID(ctx, req.ID, userID)
+}
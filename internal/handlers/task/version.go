@@ -0,0 +1,41 @@
+package task
+
+import (
+	"github.com/graphzc/sdd-task-management-example/internal/utils/etagutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/rs/zerolog/log"
+)
+
+// requireVersion returns the task version a change is conditioned on, taken from the
+// If-Match header or else from the version field. Changes sent without either are
+// refused, so an edit based on a stale copy of the task cannot go through unnoticed.
+// A nil version is returned for If-Match: *, which matches any version.
+func requireVersion(ifMatch string, version *int) (*int, error) {
+	if ifMatch != "" {
+		matched, err := etagutil.Parse(ifMatch)
+		if err != nil {
+			log.Warn().
+				Str("ifMatch", ifMatch).
+				Msg("Invalid If-Match header")
+
+			return nil, servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				`Invalid If-Match header. Expected a single ETag such as "3"`,
+			)
+		}
+
+		return matched, nil
+	}
+
+	if version != nil {
+		return version, nil
+	}
+
+	log.Warn().
+		Msg("Task change without If-Match header or version")
+
+	return nil, servererr.NewError(
+		servererr.ErrorCodePreconditionRequired,
+		"If-Match header or version is required. Fetch the task to get its current version",
+	)
+}
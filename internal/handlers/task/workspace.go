@@ -0,0 +1,71 @@
+package task
+
+import (
+	"context"
+
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/task"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+)
+
+func (h *handler) GetTasksByWorkspaceID(ctx context.Context, workspaceID string, req *dto.TaskListRequest, userID string) (*dto.TaskListResponse, error) {
+	serviceInput := toTaskListInput(req)
+
+	output, err := h.taskService.FindTasksByWorkspaceID(ctx, workspaceID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskListResponse := toTaskListResponse(output)
+
+	return &taskListResponse, nil
+}
+
+func (h *handler) UpdateTaskWorkspaceByID(ctx context.Context, taskID string, req *dto.TaskUpdateWorkspaceRequest, userID string) (*dto.MessageResponse, error) {
+	serviceInput := task.TaskUpdateWorkspaceInput{
+		WorkspaceID: req.WorkspaceID,
+		Version:     req.Version,
+	}
+
+	err := h.taskService.UpdateTaskWorkspaceByID(ctx, taskID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Task workspace updated successfully",
+	}, nil
+}
+
+// Wrapper methods for WrapWithStatus compatibility
+
+func (h *handler) GetTasksByWorkspaceIDWrapped(ctx context.Context, req *dto.TaskListByWorkspaceRequest) (*dto.TaskListResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetTasksByWorkspaceID(ctx, req.ID, &req.TaskListRequest, userID)
+}
+
+func (h *handler) UpdateTaskWorkspaceByIDWrapped(ctx context.Context, req *dto.TaskUpdateWorkspaceWithIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	version, err := requireVersion(req.IfMatch, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	updateReq := &dto.TaskUpdateWorkspaceRequest{
+		WorkspaceID: req.WorkspaceID,
+		Version:     version,
+	}
+	return h.UpdateTaskWorkspaceByID(ctx, req.ID, updateReq, userID)
+}
@@ -0,0 +1,241 @@
+package timeentry
+
+import (
+	"context"
+
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/timeentry"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+)
+
+type Handler interface {
+	StartTimer(ctx context.Context, taskID string, req *dto.TimerRequest, userID string) (*dto.TimeEntryResponse, error)
+	StopTimer(ctx context.Context, taskID string, req *dto.TimerRequest, userID string) (*dto.TimeEntryResponse, error)
+	CreateTimeEntry(ctx context.Context, taskID string, req *dto.TimeEntryCreateRequest, userID string) (*dto.TimeEntryResponse, error)
+	GetTimeEntriesByTaskID(ctx context.Context, taskID string, userID string) ([]dto.TimeEntryResponse, error)
+	UpdateTimeEntryByID(ctx context.Context, taskID string, entryID string, req *dto.TimeEntryUpdateRequest, userID string) (*dto.MessageResponse, error)
+	DeleteTimeEntryByID(ctx context.Context, taskID string, entryID string, userID string) (*dto.MessageResponse, error)
+	GetTimeReport(ctx context.Context, req *dto.TimeReportRequest, userID string) (*dto.TimeReportResponse, error)
+
+	// Wrapper methods for WrapWithStatus compatibility
+	StartTimerWrapped(ctx context.Context, req *dto.TimerWithTaskIDRequest) (*dto.TimeEntryResponse, error)
+	StopTimerWrapped(ctx context.Context, req *dto.TimerWithTaskIDRequest) (*dto.TimeEntryResponse, error)
+	CreateTimeEntryWrapped(ctx context.Context, req *dto.TimeEntryCreateWithTaskIDRequest) (*dto.TimeEntryResponse, error)
+	GetTimeEntriesByTaskIDWrapped(ctx context.Context, req *dto.TimeEntryListRequest) ([]dto.TimeEntryResponse, error)
+	UpdateTimeEntryByIDWrapped(ctx context.Context, req *dto.TimeEntryUpdateWithIDRequest) (*dto.MessageResponse, error)
+	DeleteTimeEntryByIDWrapped(ctx context.Context, req *dto.TimeEntryDeleteRequest) (*dto.MessageResponse, error)
+	GetTimeReportWrapped(ctx context.Context, req *dto.TimeReportRequest) (*dto.TimeReportResponse, error)
+}
+
+type handler struct {
+	timeEntryService timeentry.Service
+}
+
+// @WireSet("Handler")
+func New(timeEntryService timeentry.Service) Handler {
+	return &handler{
+		timeEntryService: timeEntryService,
+	}
+}
+
+func (h *handler) StartTimer(ctx context.Context, taskID string, req *dto.TimerRequest, userID string) (*dto.TimeEntryResponse, error) {
+	serviceInput := timeentry.TimerInput{
+		Note: req.Note,
+	}
+
+	startedEntry, err := h.timeEntryService.StartTimer(ctx, taskID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	entryResponse := toTimeEntryResponse(startedEntry)
+
+	return &entryResponse, nil
+}
+
+func (h *handler) StopTimer(ctx context.Context, taskID string, req *dto.TimerRequest, userID string) (*dto.TimeEntryResponse, error) {
+	serviceInput := timeentry.TimerInput{
+		Note: req.Note,
+	}
+
+	stoppedEntry, err := h.timeEntryService.StopTimer(ctx, taskID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	entryResponse := toTimeEntryResponse(stoppedEntry)
+
+	return &entryResponse, nil
+}
+
+func (h *handler) CreateTimeEntry(ctx context.Context, taskID string, req *dto.TimeEntryCreateRequest, userID string) (*dto.TimeEntryResponse, error) {
+	serviceInput := timeentry.TimeEntryInput{
+		StartedAt: req.StartedAt,
+		EndedAt:   req.EndedAt,
+		Note:      req.Note,
+	}
+
+	createdEntry, err := h.timeEntryService.CreateTimeEntry(ctx, taskID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	entryResponse := toTimeEntryResponse(createdEntry)
+
+	return &entryResponse, nil
+}
+
+func (h *handler) GetTimeEntriesByTaskID(ctx context.Context, taskID string, userID string) ([]dto.TimeEntryResponse, error) {
+	entries, err := h.timeEntryService.FindTimeEntriesByTaskID(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	entryResponses := make([]dto.TimeEntryResponse, len(entries))
+	for i := range entries {
+		entryResponses[i] = toTimeEntryResponse(&entries[i])
+	}
+
+	return entryResponses, nil
+}
+
+func (h *handler) UpdateTimeEntryByID(ctx context.Context, taskID string, entryID string, req *dto.TimeEntryUpdateRequest, userID string) (*dto.MessageResponse, error) {
+	serviceInput := timeentry.TimeEntryInput{
+		StartedAt: req.StartedAt,
+		EndedAt:   req.EndedAt,
+		Note:      req.Note,
+	}
+
+	err := h.timeEntryService.UpdateTimeEntryByID(ctx, taskID, entryID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Time entry updated successfully",
+	}, nil
+}
+
+func (h *handler) DeleteTimeEntryByID(ctx context.Context, taskID string, entryID string, userID string) (*dto.MessageResponse, error) {
+	err := h.timeEntryService.DeleteTimeEntryByID(ctx, taskID, entryID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Time entry deleted successfully",
+	}, nil
+}
+
+func (h *handler) GetTimeReport(ctx context.Context, req *dto.TimeReportRequest, userID string) (*dto.TimeReportResponse, error) {
+	serviceInput := timeentry.TimeReportInput{
+		From:    req.From,
+		To:      req.To,
+		GroupBy: req.GroupBy,
+	}
+
+	output, err := h.timeEntryService.GetTimeReport(ctx, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	reportResponse := toTimeReportResponse(output)
+
+	return &reportResponse, nil
+}
+
+// Wrapper methods for WrapWithStatus compatibility
+
+func (h *handler) StartTimerWrapped(ctx context.Context, req *dto.TimerWithTaskIDRequest) (*dto.TimeEntryResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	timerReq := &dto.TimerRequest{
+		Note: req.Note,
+	}
+	return h.StartTimer(ctx, req.ID, timerReq, userID)
+}
+
+func (h *handler) StopTimerWrapped(ctx context.Context, req *dto.TimerWithTaskIDRequest) (*dto.TimeEntryResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	timerReq := &dto.TimerRequest{
+		Note: req.Note,
+	}
+	return h.StopTimer(ctx, req.ID, timerReq, userID)
+}
+
+func (h *handler) CreateTimeEntryWrapped(ctx context.Context, req *dto.TimeEntryCreateWithTaskIDRequest) (*dto.TimeEntryResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	createReq := &dto.TimeEntryCreateRequest{
+		StartedAt: req.StartedAt,
+		EndedAt:   req.EndedAt,
+		Note:      req.Note,
+	}
+	return h.CreateTimeEntry(ctx, req.ID, createReq, userID)
+}
+
+func (h *handler) GetTimeEntriesByTaskIDWrapped(ctx context.Context, req *dto.TimeEntryListRequest) ([]dto.TimeEntryResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetTimeEntriesByTaskID(ctx, req.ID, userID)
+}
+
+func (h *handler) UpdateTimeEntryByIDWrapped(ctx context.Context, req *dto.TimeEntryUpdateWithIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	updateReq := &dto.TimeEntryUpdateRequest{
+		StartedAt: req.StartedAt,
+		EndedAt:   req.EndedAt,
+		Note:      req.Note,
+	}
+	return h.UpdateTimeEntryByID(ctx, req.ID, req.EntryID, updateReq, userID)
+}
+
+func (h *handler) DeleteTimeEntryByIDWrapped(ctx context.Context, req *dto.TimeEntryDeleteRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.DeleteTimeEntryByID(ctx, req.ID, req.EntryID, userID)
+}
+
+func (h *handler) GetTimeReportWrapped(ctx context.Context, req *dto.TimeReportRequest) (*dto.TimeReportResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetTimeReport(ctx, req, userID)
+}
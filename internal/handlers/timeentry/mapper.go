@@ -0,0 +1,63 @@
+package timeentry
+
+import (
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/timeentry"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+)
+
+func toTimeEntryResponse(entry *entities.TimeEntry) dto.TimeEntryResponse {
+	response := dto.TimeEntryResponse{
+		ID:              entry.ID,
+		TaskID:          entry.TaskID,
+		StartedAt:       entry.StartedAt,
+		EndedAt:         entry.EndedAt,
+		Running:         entry.IsRunning(),
+		DurationSeconds: int64(entry.Duration(timeutil.BangkokNow()).Seconds()),
+		Note:            entry.Note,
+		CreatedAt:       entry.CreatedAt,
+		UpdatedAt:       entry.UpdatedAt,
+	}
+
+	if entry.User != nil {
+		response.User = &dto.TaskUserResponse{
+			ID:    entry.User.ID,
+			Name:  entry.User.Name,
+			Email: entry.User.Email,
+		}
+	}
+
+	return response
+}
+
+func toTimeReportResponse(output *timeentry.TimeReportOutput) dto.TimeReportResponse {
+	response := dto.TimeReportResponse{
+		From:         output.From,
+		To:           output.To,
+		GroupBy:      output.GroupBy.String(),
+		TotalSeconds: output.TotalSeconds,
+		TotalHours:   secondsToHours(output.TotalSeconds),
+		Rows:         make([]dto.TimeReportRowResponse, len(output.Rows)),
+	}
+
+	for i, row := range output.Rows {
+		response.Rows[i] = dto.TimeReportRowResponse{
+			Label:   row.Label,
+			Seconds: row.Seconds,
+			Hours:   secondsToHours(row.Seconds),
+		}
+
+		if row.Key != "" {
+			key := row.Key
+			response.Rows[i].Key = &key
+		}
+	}
+
+	return response
+}
+
+// secondsToHours converts seconds to hours rounded to two decimals
+func secondsToHours(seconds int64) float64 {
+	return float64(seconds*100/3600) / 100
+}
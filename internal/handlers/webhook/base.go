@@ -0,0 +1,257 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/webhook"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+)
+
+type Handler interface {
+	CreateWebhook(ctx context.Context, req *dto.WebhookCreateRequest, userID string) (*dto.WebhookCreatedResponse, error)
+	GetWebhooks(ctx context.Context, userID string) ([]dto.WebhookResponse, error)
+	CreateWorkspaceWebhook(ctx context.Context, workspaceID string, req *dto.WebhookCreateRequest, userID string) (*dto.WebhookCreatedResponse, error)
+	GetWebhooksByWorkspaceID(ctx context.Context, workspaceID string, userID string) ([]dto.WebhookResponse, error)
+	GetWebhookByID(ctx context.Context, webhookID string, userID string) (*dto.WebhookResponse, error)
+	UpdateWebhookByID(ctx context.Context, webhookID string, req *dto.WebhookUpdateRequest, userID string) (*dto.MessageResponse, error)
+	DeleteWebhookByID(ctx context.Context, webhookID string, userID string) (*dto.MessageResponse, error)
+	GetDeliveriesByWebhookID(ctx context.Context, webhookID string, req *dto.WebhookDeliveryListRequest, userID string) (*dto.WebhookDeliveryListResponse, error)
+	RedeliverByID(ctx context.Context, webhookID string, deliveryID string, userID string) (*dto.WebhookDeliveryResponse, error)
+
+	// Wrapper methods for WrapWithStatus compatibility
+	CreateWebhookWrapped(ctx context.Context, req *dto.WebhookCreateRequest) (*dto.WebhookCreatedResponse, error)
+	GetWebhooksWrapped(ctx context.Context, _ any) ([]dto.WebhookResponse, error)
+	CreateWorkspaceWebhookWrapped(ctx context.Context, req *dto.WebhookCreateWithWorkspaceIDRequest) (*dto.WebhookCreatedResponse, error)
+	GetWebhooksByWorkspaceIDWrapped(ctx context.Context, req *dto.WebhookListByWorkspaceIDRequest) ([]dto.WebhookResponse, error)
+	GetWebhookByIDWrapped(ctx context.Context, req *dto.WebhookGetByIDRequest) (*dto.WebhookResponse, error)
+	UpdateWebhookByIDWrapped(ctx context.Context, req *dto.WebhookUpdateWithIDRequest) (*dto.MessageResponse, error)
+	DeleteWebhookByIDWrapped(ctx context.Context, req *dto.WebhookDeleteRequest) (*dto.MessageResponse, error)
+	GetDeliveriesByWebhookIDWrapped(ctx context.Context, req *dto.WebhookDeliveryListRequest) (*dto.WebhookDeliveryListResponse, error)
+	RedeliverByIDWrapped(ctx context.Context, req *dto.WebhookRedeliverRequest) (*dto.WebhookDeliveryResponse, error)
+}
+
+type handler struct {
+	webhookService webhook.Service
+}
+
+// @WireSet("Handler")
+func New(webhookService webhook.Service) Handler {
+	return &handler{
+		webhookService: webhookService,
+	}
+}
+
+func (h *handler) CreateWebhook(ctx context.Context, req *dto.WebhookCreateRequest, userID string) (*dto.WebhookCreatedResponse, error) {
+	serviceInput := toWebhookCreateInput(req)
+
+	createdWebhook, err := h.webhookService.CreateWebhook(ctx, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookResponse := toWebhookCreatedResponse(createdWebhook)
+
+	return &webhookResponse, nil
+}
+
+func (h *handler) GetWebhooks(ctx context.Context, userID string) ([]dto.WebhookResponse, error) {
+	webhooks, err := h.webhookService.FindWebhooksByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toWebhookResponses(webhooks), nil
+}
+
+func (h *handler) CreateWorkspaceWebhook(ctx context.Context, workspaceID string, req *dto.WebhookCreateRequest, userID string) (*dto.WebhookCreatedResponse, error) {
+	serviceInput := toWebhookCreateInput(req)
+
+	createdWebhook, err := h.webhookService.CreateWorkspaceWebhook(ctx, workspaceID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookResponse := toWebhookCreatedResponse(createdWebhook)
+
+	return &webhookResponse, nil
+}
+
+func (h *handler) GetWebhooksByWorkspaceID(ctx context.Context, workspaceID string, userID string) ([]dto.WebhookResponse, error) {
+	webhooks, err := h.webhookService.FindWebhooksByWorkspaceID(ctx, workspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toWebhookResponses(webhooks), nil
+}
+
+func (h *handler) GetWebhookByID(ctx context.Context, webhookID string, userID string) (*dto.WebhookResponse, error) {
+	foundWebhook, err := h.webhookService.FindWebhookByID(ctx, webhookID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookResponse := toWebhookResponse(foundWebhook)
+
+	return &webhookResponse, nil
+}
+
+func (h *handler) UpdateWebhookByID(ctx context.Context, webhookID string, req *dto.WebhookUpdateRequest, userID string) (*dto.MessageResponse, error) {
+	serviceInput := webhook.WebhookUpdateInput{
+		URL:     req.URL,
+		Secret:  req.Secret,
+		Events:  req.Events,
+		Enabled: *req.Enabled,
+	}
+
+	err := h.webhookService.UpdateWebhookByID(ctx, webhookID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Webhook updated successfully",
+	}, nil
+}
+
+func (h *handler) DeleteWebhookByID(ctx context.Context, webhookID string, userID string) (*dto.MessageResponse, error) {
+	err := h.webhookService.DeleteWebhookByID(ctx, webhookID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Webhook deleted successfully",
+	}, nil
+}
+
+func (h *handler) GetDeliveriesByWebhookID(ctx context.Context, webhookID string, req *dto.WebhookDeliveryListRequest, userID string) (*dto.WebhookDeliveryListResponse, error) {
+	serviceInput := webhook.WebhookDeliveryListInput{
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+	}
+
+	output, err := h.webhookService.FindDeliveriesByWebhookID(ctx, webhookID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.WebhookDeliveryListResponse{
+		Items:      toWebhookDeliveryResponses(output.Deliveries),
+		NextCursor: optionalString(output.NextCursor),
+	}, nil
+}
+
+func (h *handler) RedeliverByID(ctx context.Context, webhookID string, deliveryID string, userID string) (*dto.WebhookDeliveryResponse, error) {
+	redelivery, err := h.webhookService.RedeliverByID(ctx, webhookID, deliveryID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveryResponse := toWebhookDeliveryResponse(redelivery)
+
+	return &deliveryResponse, nil
+}
+
+// Wrapper methods for WrapWithStatus compatibility
+
+func (h *handler) CreateWebhookWrapped(ctx context.Context, req *dto.WebhookCreateRequest) (*dto.WebhookCreatedResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.CreateWebhook(ctx, req, userID)
+}
+
+func (h *handler) GetWebhooksWrapped(ctx context.Context, _ any) ([]dto.WebhookResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetWebhooks(ctx, userID)
+}
+
+func (h *handler) CreateWorkspaceWebhookWrapped(ctx context.Context, req *dto.WebhookCreateWithWorkspaceIDRequest) (*dto.WebhookCreatedResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.CreateWorkspaceWebhook(ctx, req.ID, &req.WebhookCreateRequest, userID)
+}
+
+func (h *handler) GetWebhooksByWorkspaceIDWrapped(ctx context.Context, req *dto.WebhookListByWorkspaceIDRequest) ([]dto.WebhookResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetWebhooksByWorkspaceID(ctx, req.ID, userID)
+}
+
+func (h *handler) GetWebhookByIDWrapped(ctx context.Context, req *dto.WebhookGetByIDRequest) (*dto.WebhookResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetWebhookByID(ctx, req.ID, userID)
+}
+
+func (h *handler) UpdateWebhookByIDWrapped(ctx context.Context, req *dto.WebhookUpdateWithIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.UpdateWebhookByID(ctx, req.ID, &req.WebhookUpdateRequest, userID)
+}
+
+func (h *handler) DeleteWebhookByIDWrapped(ctx context.Context, req *dto.WebhookDeleteRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.DeleteWebhookByID(ctx, req.ID, userID)
+}
+
+func (h *handler) GetDeliveriesByWebhookIDWrapped(ctx context.Context, req *dto.WebhookDeliveryListRequest) (*dto.WebhookDeliveryListResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetDeliveriesByWebhookID(ctx, req.ID, req, userID)
+}
+
+func (h *handler) RedeliverByIDWrapped(ctx context.Context, req *dto.WebhookRedeliverRequest) (*dto.WebhookDeliveryResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.RedeliverByID(ctx, req.ID, req.DeliveryID, userID)
+}
@@ -0,0 +1,84 @@
+package webhook
+
+import (
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/webhook"
+)
+
+func toWebhookResponse(hook *entities.Webhook) dto.WebhookResponse {
+	events := make([]string, len(hook.Events))
+	for i, event := range hook.Events {
+		events[i] = event.String()
+	}
+
+	return dto.WebhookResponse{
+		ID:                  hook.ID,
+		WorkspaceID:         hook.WorkspaceID,
+		URL:                 hook.URL,
+		Events:              events,
+		Enabled:             hook.Enabled,
+		ConsecutiveFailures: hook.ConsecutiveFailures,
+		DisabledAt:          hook.DisabledAt,
+		CreatedAt:           hook.CreatedAt,
+		UpdatedAt:           hook.UpdatedAt,
+	}
+}
+
+func toWebhookResponses(webhooks []entities.Webhook) []dto.WebhookResponse {
+	responses := make([]dto.WebhookResponse, len(webhooks))
+	for i := range webhooks {
+		responses[i] = toWebhookResponse(&webhooks[i])
+	}
+
+	return responses
+}
+
+func toWebhookCreatedResponse(hook *entities.Webhook) dto.WebhookCreatedResponse {
+	return dto.WebhookCreatedResponse{
+		WebhookResponse: toWebhookResponse(hook),
+		Secret:          hook.Secret,
+	}
+}
+
+func toWebhookDeliveryResponse(delivery *entities.WebhookDelivery) dto.WebhookDeliveryResponse {
+	return dto.WebhookDeliveryResponse{
+		ID:             delivery.ID,
+		WebhookID:      delivery.WebhookID,
+		EventID:        delivery.EventID,
+		Event:          delivery.Event.String(),
+		Status:         delivery.Status.String(),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastAttemptAt:  delivery.LastAttemptAt,
+		ResponseStatus: delivery.ResponseStatus,
+		LastError:      delivery.LastError,
+		RedeliveryOf:   delivery.RedeliveryOf,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
+
+func toWebhookDeliveryResponses(deliveries []entities.WebhookDelivery) []dto.WebhookDeliveryResponse {
+	responses := make([]dto.WebhookDeliveryResponse, len(deliveries))
+	for i := range deliveries {
+		responses[i] = toWebhookDeliveryResponse(&deliveries[i])
+	}
+
+	return responses
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+func toWebhookCreateInput(req *dto.WebhookCreateRequest) webhook.WebhookCreateInput {
+	return webhook.WebhookCreateInput{
+		URL:    req.URL,
+		Secret: req.Secret,
+		Events: req.Events,
+	}
+}
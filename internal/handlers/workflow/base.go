@@ -0,0 +1,176 @@
+package workflow
+
+import (
+	"context"
+
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/workflow"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+)
+
+type Handler interface {
+	GetWorkflowByWorkspaceID(ctx context.Context, workspaceID string, userID string) (*dto.WorkflowResponse, error)
+	SaveWorkspaceWorkflow(ctx context.Context, workspaceID string, req *dto.WorkflowSaveRequest, userID string) (*dto.WorkflowResponse, error)
+	ResetWorkspaceWorkflow(ctx context.Context, workspaceID string, userID string) (*dto.MessageResponse, error)
+	GetWorkflowByProjectID(ctx context.Context, projectID string, userID string) (*dto.WorkflowResponse, error)
+	SaveProjectWorkflow(ctx context.Context, projectID string, req *dto.WorkflowSaveRequest, userID string) (*dto.WorkflowResponse, error)
+	ResetProjectWorkflow(ctx context.Context, projectID string, userID string) (*dto.MessageResponse, error)
+
+	// Wrapper methods for WrapWithStatus compatibility
+	GetWorkflowByWorkspaceIDWrapped(ctx context.Context, req *dto.WorkflowByScopeIDRequest) (*dto.WorkflowResponse, error)
+	SaveWorkspaceWorkflowWrapped(ctx context.Context, req *dto.WorkflowSaveWithScopeIDRequest) (*dto.WorkflowResponse, error)
+	ResetWorkspaceWorkflowWrapped(ctx context.Context, req *dto.WorkflowByScopeIDRequest) (*dto.MessageResponse, error)
+	GetWorkflowByProjectIDWrapped(ctx context.Context, req *dto.WorkflowByScopeIDRequest) (*dto.WorkflowResponse, error)
+	SaveProjectWorkflowWrapped(ctx context.Context, req *dto.WorkflowSaveWithScopeIDRequest) (*dto.WorkflowResponse, error)
+	ResetProjectWorkflowWrapped(ctx context.Context, req *dto.WorkflowByScopeIDRequest) (*dto.MessageResponse, error)
+}
+
+type handler struct {
+	workflowService workflow.Service
+}
+
+// @WireSet("Handler")
+func New(workflowService workflow.Service) Handler {
+	return &handler{
+		workflowService: workflowService,
+	}
+}
+
+func (h *handler) GetWorkflowByWorkspaceID(ctx context.Context, workspaceID string, userID string) (*dto.WorkflowResponse, error) {
+	foundWorkflow, err := h.workflowService.FindWorkflowByWorkspaceID(ctx, workspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	workflowResponse := toWorkflowResponse(foundWorkflow)
+
+	return &workflowResponse, nil
+}
+
+func (h *handler) SaveWorkspaceWorkflow(ctx context.Context, workspaceID string, req *dto.WorkflowSaveRequest, userID string) (*dto.WorkflowResponse, error) {
+	serviceInput := toWorkflowSaveInput(req)
+
+	savedWorkflow, err := h.workflowService.SaveWorkspaceWorkflow(ctx, workspaceID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	workflowResponse := toWorkflowResponse(savedWorkflow)
+
+	return &workflowResponse, nil
+}
+
+func (h *handler) ResetWorkspaceWorkflow(ctx context.Context, workspaceID string, userID string) (*dto.MessageResponse, error) {
+	err := h.workflowService.ResetWorkspaceWorkflow(ctx, workspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Workflow reset to default successfully",
+	}, nil
+}
+
+func (h *handler) GetWorkflowByProjectID(ctx context.Context, projectID string, userID string) (*dto.WorkflowResponse, error) {
+	foundWorkflow, err := h.workflowService.FindWorkflowByProjectID(ctx, projectID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	workflowResponse := toWorkflowResponse(foundWorkflow)
+
+	return &workflowResponse, nil
+}
+
+func (h *handler) SaveProjectWorkflow(ctx context.Context, projectID string, req *dto.WorkflowSaveRequest, userID string) (*dto.WorkflowResponse, error) {
+	serviceInput := toWorkflowSaveInput(req)
+
+	savedWorkflow, err := h.workflowService.SaveProjectWorkflow(ctx, projectID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	workflowResponse := toWorkflowResponse(savedWorkflow)
+
+	return &workflowResponse, nil
+}
+
+func (h *handler) ResetProjectWorkflow(ctx context.Context, projectID string, userID string) (*dto.MessageResponse, error) {
+	err := h.workflowService.ResetProjectWorkflow(ctx, projectID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Workflow reset to default successfully",
+	}, nil
+}
+
+// Wrapper methods for WrapWithStatus compatibility
+
+func (h *handler) GetWorkflowByWorkspaceIDWrapped(ctx context.Context, req *dto.WorkflowByScopeIDRequest) (*dto.WorkflowResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetWorkflowByWorkspaceID(ctx, req.ID, userID)
+}
+
+func (h *handler) SaveWorkspaceWorkflowWrapped(ctx context.Context, req *dto.WorkflowSaveWithScopeIDRequest) (*dto.WorkflowResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.SaveWorkspaceWorkflow(ctx, req.ID, &req.WorkflowSaveRequest, userID)
+}
+
+func (h *handler) ResetWorkspaceWorkflowWrapped(ctx context.Context, req *dto.WorkflowByScopeIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.ResetWorkspaceWorkflow(ctx, req.ID, userID)
+}
+
+func (h *handler) GetWorkflowByProjectIDWrapped(ctx context.Context, req *dto.WorkflowByScopeIDRequest) (*dto.WorkflowResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetWorkflowByProjectID(ctx, req.ID, userID)
+}
+
+func (h *handler) SaveProjectWorkflowWrapped(ctx context.Context, req *dto.WorkflowSaveWithScopeIDRequest) (*dto.WorkflowResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.SaveProjectWorkflow(ctx, req.ID, &req.WorkflowSaveRequest, userID)
+}
+
+func (h *handler) ResetProjectWorkflowWrapped(ctx context.Context, req *dto.WorkflowByScopeIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.ResetProjectWorkflow(ctx, req.ID, userID)
+}
@@ -0,0 +1,67 @@
+package workflow
+
+import (
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/workflow"
+)
+
+func toWorkflowResponse(taskWorkflow *entities.Workflow) dto.WorkflowResponse {
+	response := dto.WorkflowResponse{
+		ID:          taskWorkflow.ID,
+		WorkspaceID: taskWorkflow.WorkspaceID,
+		ProjectID:   taskWorkflow.ProjectID,
+		Name:        taskWorkflow.Name,
+		IsDefault:   taskWorkflow.IsDefault(),
+		Statuses:    make([]dto.WorkflowStatusResponse, len(taskWorkflow.Statuses)),
+		Transitions: make([]dto.WorkflowTransitionResponse, len(taskWorkflow.Transitions)),
+	}
+
+	if !taskWorkflow.IsDefault() {
+		response.CreatedAt = &taskWorkflow.CreatedAt
+		response.UpdatedAt = &taskWorkflow.UpdatedAt
+	}
+
+	for i, status := range taskWorkflow.Statuses {
+		response.Statuses[i] = dto.WorkflowStatusResponse{
+			Key:      status.Key.String(),
+			Name:     status.Name,
+			Category: status.Category.String(),
+			Position: status.Position,
+		}
+	}
+
+	for i, transition := range taskWorkflow.Transitions {
+		response.Transitions[i] = dto.WorkflowTransitionResponse{
+			From: transition.From.String(),
+			To:   transition.To.String(),
+		}
+	}
+
+	return response
+}
+
+func toWorkflowSaveInput(req *dto.WorkflowSaveRequest) workflow.WorkflowSaveInput {
+	input := workflow.WorkflowSaveInput{
+		Name:        req.Name,
+		Statuses:    make([]workflow.WorkflowStatusInput, len(req.Statuses)),
+		Transitions: make([]workflow.WorkflowTransitionInput, len(req.Transitions)),
+	}
+
+	for i, status := range req.Statuses {
+		input.Statuses[i] = workflow.WorkflowStatusInput{
+			Key:      status.Key,
+			Name:     status.Name,
+			Category: status.Category,
+		}
+	}
+
+	for i, transition := range req.Transitions {
+		input.Transitions[i] = workflow.WorkflowTransitionInput{
+			From: transition.From,
+			To:   transition.To,
+		}
+	}
+
+	return input
+}
@@ -0,0 +1,277 @@
+package workspace
+
+import (
+	"context"
+
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/services/workspace"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+)
+
+type Handler interface {
+	CreateWorkspace(ctx context.Context, req *dto.WorkspaceCreateRequest, userID string) (*dto.WorkspaceResponse, error)
+	GetWorkspaceByID(ctx context.Context, workspaceID string, userID string) (*dto.WorkspaceResponse, error)
+	GetWorkspacesByUserID(ctx context.Context, userID string) ([]dto.WorkspaceResponse, error)
+	UpdateWorkspaceByID(ctx context.Context, workspaceID string, req *dto.WorkspaceUpdateRequest, userID string) (*dto.MessageResponse, error)
+	DeleteWorkspaceByID(ctx context.Context, workspaceID string, userID string) (*dto.MessageResponse, error)
+	GetMembersByWorkspaceID(ctx context.Context, workspaceID string, userID string) ([]dto.WorkspaceMemberResponse, error)
+	AddMember(ctx context.Context, workspaceID string, req *dto.WorkspaceMemberAddRequest, userID string) (*dto.MessageResponse, error)
+	UpdateMemberRole(ctx context.Context, workspaceID string, memberUserID string, req *dto.WorkspaceMemberUpdateRoleRequest, userID string) (*dto.MessageResponse, error)
+	RemoveMember(ctx context.Context, workspaceID string, memberUserID string, userID string) (*dto.MessageResponse, error)
+
+	// Wrapper methods for WrapWithStatus compatibility
+	CreateWorkspaceWrapped(ctx context.Context, req *dto.WorkspaceCreateRequest) (*dto.WorkspaceResponse, error)
+	GetWorkspaceByIDWrapped(ctx context.Context, req *dto.WorkspaceGetByIDRequest) (*dto.WorkspaceResponse, error)
+	GetWorkspacesByUserIDWrapped(ctx context.Context, _ any) ([]dto.WorkspaceResponse, error)
+	UpdateWorkspaceByIDWrapped(ctx context.Context, req *dto.WorkspaceUpdateWithIDRequest) (*dto.MessageResponse, error)
+	DeleteWorkspaceByIDWrapped(ctx context.Context, req *dto.WorkspaceDeleteRequest) (*dto.MessageResponse, error)
+	GetMembersByWorkspaceIDWrapped(ctx context.Context, req *dto.WorkspaceGetByIDRequest) ([]dto.WorkspaceMemberResponse, error)
+	AddMemberWrapped(ctx context.Context, req *dto.WorkspaceMemberAddWithIDRequest) (*dto.MessageResponse, error)
+	UpdateMemberRoleWrapped(ctx context.Context, req *dto.WorkspaceMemberUpdateRoleWithIDRequest) (*dto.MessageResponse, error)
+	RemoveMemberWrapped(ctx context.Context, req *dto.WorkspaceMemberDeleteRequest) (*dto.MessageResponse, error)
+}
+
+type handler struct {
+	workspaceService workspace.Service
+}
+
+// @WireSet("Handler")
+func New(workspaceService workspace.Service) Handler {
+	return &handler{
+		workspaceService: workspaceService,
+	}
+}
+
+func (h *handler) CreateWorkspace(ctx context.Context, req *dto.WorkspaceCreateRequest, userID string) (*dto.WorkspaceResponse, error) {
+	serviceInput := workspace.WorkspaceCreateInput{
+		Name: req.Name,
+	}
+
+	createdWorkspace, err := h.workspaceService.CreateWorkspace(ctx, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceResponse := toWorkspaceResponse(createdWorkspace)
+
+	return &workspaceResponse, nil
+}
+
+func (h *handler) GetWorkspaceByID(ctx context.Context, workspaceID string, userID string) (*dto.WorkspaceResponse, error) {
+	foundWorkspace, err := h.workspaceService.FindWorkspaceByID(ctx, workspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceResponse := toWorkspaceResponse(foundWorkspace)
+
+	return &workspaceResponse, nil
+}
+
+func (h *handler) GetWorkspacesByUserID(ctx context.Context, userID string) ([]dto.WorkspaceResponse, error) {
+	workspaces, err := h.workspaceService.FindWorkspacesByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceResponses := make([]dto.WorkspaceResponse, len(workspaces))
+	for i := range workspaces {
+		workspaceResponses[i] = toWorkspaceResponse(&workspaces[i])
+	}
+
+	return workspaceResponses, nil
+}
+
+func (h *handler) UpdateWorkspaceByID(ctx context.Context, workspaceID string, req *dto.WorkspaceUpdateRequest, userID string) (*dto.MessageResponse, error) {
+	serviceInput := workspace.WorkspaceUpdateInput{
+		Name: req.Name,
+	}
+
+	err := h.workspaceService.UpdateWorkspaceByID(ctx, workspaceID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Workspace updated successfully",
+	}, nil
+}
+
+func (h *handler) DeleteWorkspaceByID(ctx context.Context, workspaceID string, userID string) (*dto.MessageResponse, error) {
+	err := h.workspaceService.DeleteWorkspaceByID(ctx, workspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Workspace deleted successfully",
+	}, nil
+}
+
+func (h *handler) GetMembersByWorkspaceID(ctx context.Context, workspaceID string, userID string) ([]dto.WorkspaceMemberResponse, error) {
+	members, err := h.workspaceService.FindMembersByWorkspaceID(ctx, workspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	memberResponses := make([]dto.WorkspaceMemberResponse, len(members))
+	for i := range members {
+		memberResponses[i] = toWorkspaceMemberResponse(&members[i])
+	}
+
+	return memberResponses, nil
+}
+
+func (h *handler) AddMember(ctx context.Context, workspaceID string, req *dto.WorkspaceMemberAddRequest, userID string) (*dto.MessageResponse, error) {
+	serviceInput := workspace.MemberAddInput{
+		Email: req.Email,
+		Role:  req.Role,
+	}
+
+	err := h.workspaceService.AddMember(ctx, workspaceID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Workspace member added successfully",
+	}, nil
+}
+
+func (h *handler) UpdateMemberRole(ctx context.Context, workspaceID string, memberUserID string, req *dto.WorkspaceMemberUpdateRoleRequest, userID string) (*dto.MessageResponse, error) {
+	serviceInput := workspace.MemberUpdateRoleInput{
+		Role: req.Role,
+	}
+
+	err := h.workspaceService.UpdateMemberRole(ctx, workspaceID, memberUserID, &serviceInput, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Workspace member updated successfully",
+	}, nil
+}
+
+func (h *handler) RemoveMember(ctx context.Context, workspaceID string, memberUserID string, userID string) (*dto.MessageResponse, error) {
+	err := h.workspaceService.RemoveMember(ctx, workspaceID, memberUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Workspace member removed successfully",
+	}, nil
+}
+
+// Wrapper methods for WrapWithStatus compatibility
+
+func (h *handler) CreateWorkspaceWrapped(ctx context.Context, req *dto.WorkspaceCreateRequest) (*dto.WorkspaceResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.CreateWorkspace(ctx, req, userID)
+}
+
+func (h *handler) GetWorkspaceByIDWrapped(ctx context.Context, req *dto.WorkspaceGetByIDRequest) (*dto.WorkspaceResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetWorkspaceByID(ctx, req.ID, userID)
+}
+
+func (h *handler) GetWorkspacesByUserIDWrapped(ctx context.Context, _ any) ([]dto.WorkspaceResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetWorkspacesByUserID(ctx, userID)
+}
+
+func (h *handler) UpdateWorkspaceByIDWrapped(ctx context.Context, req *dto.WorkspaceUpdateWithIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	updateReq := &dto.WorkspaceUpdateRequest{
+		Name: req.Name,
+	}
+	return h.UpdateWorkspaceByID(ctx, req.ID, updateReq, userID)
+}
+
+func (h *handler) DeleteWorkspaceByIDWrapped(ctx context.Context, req *dto.WorkspaceDeleteRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.DeleteWorkspaceByID(ctx, req.ID, userID)
+}
+
+func (h *handler) GetMembersByWorkspaceIDWrapped(ctx context.Context, req *dto.WorkspaceGetByIDRequest) ([]dto.WorkspaceMemberResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.GetMembersByWorkspaceID(ctx, req.ID, userID)
+}
+
+func (h *handler) AddMemberWrapped(ctx context.Context, req *dto.WorkspaceMemberAddWithIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	addReq := &dto.WorkspaceMemberAddRequest{
+		Email: req.Email,
+		Role:  req.Role,
+	}
+	return h.AddMember(ctx, req.ID, addReq, userID)
+}
+
+func (h *handler) UpdateMemberRoleWrapped(ctx context.Context, req *dto.WorkspaceMemberUpdateRoleWithIDRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	updateReq := &dto.WorkspaceMemberUpdateRoleRequest{
+		Role: req.Role,
+	}
+	return h.UpdateMemberRole(ctx, req.ID, req.UserID, updateReq, userID)
+}
+
+func (h *handler) RemoveMemberWrapped(ctx context.Context, req *dto.WorkspaceMemberDeleteRequest) (*dto.MessageResponse, error) {
+	userID, err := echoutil.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeUnauthorized,
+			"user ID not found in context",
+		)
+	}
+	return h.RemoveMember(ctx, req.ID, req.UserID, userID)
+}
@@ -0,0 +1,27 @@
+package workspace
+
+import (
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+)
+
+func toWorkspaceResponse(workspace *entities.Workspace) dto.WorkspaceResponse {
+	return dto.WorkspaceResponse{
+		ID:        workspace.ID,
+		Name:      workspace.Name,
+		Role:      workspace.Role,
+		CreatedAt: workspace.CreatedAt,
+		UpdatedAt: workspace.UpdatedAt,
+	}
+}
+
+func toWorkspaceMemberResponse(member *entities.WorkspaceMember) dto.WorkspaceMemberResponse {
+	return dto.WorkspaceMemberResponse{
+		UserID:    member.UserID,
+		Name:      member.Name,
+		Email:     member.Email,
+		Role:      member.Role,
+		CreatedAt: member.CreatedAt,
+		UpdatedAt: member.UpdatedAt,
+	}
+}
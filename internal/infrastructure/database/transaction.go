@@ -0,0 +1,105 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// Executor runs queries, it is implemented by both *sqlx.DB and *sqlx.Tx
+type Executor interface {
+	GetContext(ctx context.Context, dest any, query string, args ...any) error
+	SelectContext(ctx context.Context, dest any, query string, args ...any) error
+	QueryxContext(ctx context.Context, query string, args ...any) (*sqlx.Rows, error)
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	NamedExecContext(ctx context.Context, query string, arg any) (sql.Result, error)
+}
+
+// Transactor runs a function inside a database transaction. Repositories that
+// query through Conn take part in the transaction bound to the context.
+type Transactor interface {
+	// WithinTransaction commits when fn returns nil and rolls back otherwise.
+	// Inside another transaction fn runs in a savepoint, so only its own
+	// changes are rolled back.
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type txContextKey struct{}
+
+type txContext struct {
+	tx    *sqlx.Tx
+	depth int
+}
+
+// Conn returns the transaction bound to ctx, or db when there is none
+func Conn(ctx context.Context, db *sqlx.DB) Executor {
+	if txCtx, ok := ctx.Value(txContextKey{}).(*txContext); ok {
+		return txCtx.tx
+	}
+
+	return db
+}
+
+type transactor struct {
+	db *sqlx.DB
+}
+
+// @WireSet("Infrastructure")
+func NewTransactor(db *sqlx.DB) Transactor {
+	return &transactor{
+		db: db,
+	}
+}
+
+func (t *transactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if parent, ok := ctx.Value(txContextKey{}).(*txContext); ok {
+		return withinSavepoint(ctx, parent, fn)
+	}
+
+	tx, err := t.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(context.WithValue(ctx, txContextKey{}, &txContext{tx: tx})); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Error().
+				Err(rollbackErr).
+				Msg("Failed to roll back transaction")
+		}
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func withinSavepoint(ctx context.Context, parent *txContext, fn func(ctx context.Context) error) error {
+	current := &txContext{tx: parent.tx, depth: parent.depth + 1}
+	savepoint := fmt.Sprintf("sp_%d", current.depth)
+
+	if _, err := parent.tx.ExecContext(ctx, "SAVEPOINT "+savepoint); err != nil {
+		return err
+	}
+
+	err := fn(context.WithValue(ctx, txContextKey{}, current))
+	if err == nil {
+		// Releasing fails when a statement inside fn aborted the transaction
+		_, err = parent.tx.ExecContext(ctx, "RELEASE SAVEPOINT "+savepoint)
+		if err == nil {
+			return nil
+		}
+	}
+
+	if _, rollbackErr := parent.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+savepoint); rollbackErr != nil {
+		log.Error().
+			Err(rollbackErr).
+			Str("savepoint", savepoint).
+			Msg("Failed to roll back to savepoint")
+	}
+
+	return err
+}
@@ -0,0 +1,55 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/graphzc/sdd-task-management-example/internal/config"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	DriverLocal = "local"
+	DriverGCS   = "gcs"
+)
+
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore keeps binary content addressed by a key
+type BlobStore interface {
+	Put(ctx context.Context, key string, content io.Reader, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Exists(ctx context.Context, key string) (bool, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// @WireSet("Infrastructure")
+func NewBlobStore(ctx context.Context, config *config.Config) BlobStore {
+	switch config.Attachment.StorageDriver {
+	case DriverGCS:
+		store, err := NewGCSBlobStore(ctx, config.GoogleAppCredentials, config.UploadSlipBucket)
+		if err != nil {
+			log.Panic().
+				Err(err).
+				Msg("Failed to create the GCS blob store")
+		}
+
+		return store
+	case DriverLocal, "":
+		store, err := NewLocalBlobStore(config.Attachment.LocalDir)
+		if err != nil {
+			log.Panic().
+				Err(err).
+				Msg("Failed to create the local blob store")
+		}
+
+		return store
+	default:
+		log.Panic().
+			Str("driver", config.Attachment.StorageDriver).
+			Msg("Unknown storage driver")
+	}
+
+	return nil
+}
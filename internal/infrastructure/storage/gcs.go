@@ -0,0 +1,80 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	gcs "cloud.google.com/go/storage"
+	"google.golang.org/api/option"
+)
+
+// GCSBlobStore keeps blobs as objects of a Google Cloud Storage bucket
+type GCSBlobStore struct {
+	bucket *gcs.BucketHandle
+}
+
+// NewGCSBlobStore connects to the bucket using the credentials file when given,
+// otherwise the application default credentials
+func NewGCSBlobStore(ctx context.Context, credentialsFile string, bucket string) (*GCSBlobStore, error) {
+	if bucket == "" {
+		return nil, errors.New("GCS bucket is not configured")
+	}
+
+	var opts []option.ClientOption
+	if credentialsFile != "" {
+		opts = append(opts, option.WithCredentialsFile(credentialsFile))
+	}
+
+	client, err := gcs.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GCSBlobStore{
+		bucket: client.Bucket(bucket),
+	}, nil
+}
+
+func (s *GCSBlobStore) Put(ctx context.Context, key string, content io.Reader, contentType string) error {
+	writer := s.bucket.Object(key).NewWriter(ctx)
+	writer.ContentType = contentType
+
+	if _, err := io.Copy(writer, content); err != nil {
+		writer.Close()
+		return err
+	}
+
+	return writer.Close()
+}
+
+func (s *GCSBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	reader, err := s.bucket.Object(key).NewReader(ctx)
+	if err != nil {
+		if errors.Is(err, gcs.ErrObjectNotExist) {
+			return nil, ErrBlobNotFound
+		}
+		return nil, err
+	}
+
+	return reader, nil
+}
+
+func (s *GCSBlobStore) Exists(ctx context.Context, key string) (bool, error) {
+	if _, err := s.bucket.Object(key).Attrs(ctx); err != nil {
+		if errors.Is(err, gcs.ErrObjectNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *GCSBlobStore) Delete(ctx context.Context, key string) error {
+	if err := s.bucket.Object(key).Delete(ctx); err != nil && !errors.Is(err, gcs.ErrObjectNotExist) {
+		return err
+	}
+
+	return nil
+}
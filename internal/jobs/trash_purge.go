@@ -0,0 +1,63 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/config"
+	"github.com/graphzc/sdd-task-management-example/internal/services/task"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/rs/zerolog/log"
+)
+
+// TrashPurgeJob periodically deletes the tasks kept in the trash longer than
+// the configured retention period
+type TrashPurgeJob struct {
+	config      *config.Config
+	taskService task.Service
+}
+
+// @WireSet("Job")
+func NewTrashPurgeJob(config *config.Config, taskService task.Service) *TrashPurgeJob {
+	return &TrashPurgeJob{
+		config:      config,
+		taskService: taskService,
+	}
+}
+
+// Run purges the trash right away and then on every interval until ctx is done
+func (j *TrashPurgeJob) Run(ctx context.Context) {
+	interval := j.config.Task.TrashPurgeInterval
+	if interval <= 0 {
+		log.Warn().
+			Msg("Trash purge interval is not positive, trash purge is disabled")
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		j.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *TrashPurgeJob) purge(ctx context.Context) {
+	purged, err := j.taskService.PurgeExpiredTrash(ctx, timeutil.BangkokNow())
+	if err != nil {
+		// The service already logged the failure, the next run retries
+		return
+	}
+
+	if purged > 0 {
+		log.Info().
+			Int64("purged", purged).
+			Msg("Purged expired tasks from the trash")
+	}
+}
@@ -0,0 +1,63 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/config"
+	"github.com/graphzc/sdd-task-management-example/internal/services/webhook"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/rs/zerolog/log"
+)
+
+// WebhookDeliveryJob periodically sends the queued webhook deliveries whose
+// next attempt is due
+type WebhookDeliveryJob struct {
+	config         *config.Config
+	webhookService webhook.Service
+}
+
+// @WireSet("Job")
+func NewWebhookDeliveryJob(config *config.Config, webhookService webhook.Service) *WebhookDeliveryJob {
+	return &WebhookDeliveryJob{
+		config:         config,
+		webhookService: webhookService,
+	}
+}
+
+// Run delivers the due webhooks right away and then on every interval until ctx is done
+func (j *WebhookDeliveryJob) Run(ctx context.Context) {
+	interval := j.config.Webhook.DeliveryInterval
+	if interval <= 0 {
+		log.Warn().
+			Msg("Webhook delivery interval is not positive, webhook delivery is disabled")
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		j.deliver(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *WebhookDeliveryJob) deliver(ctx context.Context) {
+	delivered, err := j.webhookService.DeliverDue(ctx, timeutil.BangkokNow())
+	if err != nil {
+		// The service already logged the failure, the next run retries
+		return
+	}
+
+	if delivered > 0 {
+		log.Info().
+			Int("delivered", delivered).
+			Msg("Attempted due webhook deliveries")
+	}
+}
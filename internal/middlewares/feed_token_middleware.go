@@ -0,0 +1,41 @@
+package middlewares
+
+import (
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/services/calendarfeed"
+	"github.com/labstack/echo/v4"
+)
+
+// FeedTokenParam is the path parameter holding a calendar feed token
+const FeedTokenParam = "token"
+
+type feedTokenMiddleware struct {
+	calendarFeedService calendarfeed.Service
+}
+
+// FeedTokenMiddleware authenticates calendar feed requests by the secret
+// token in their URL, as calendar clients cannot send an Authorization header
+type FeedTokenMiddleware interface {
+	Middleware(next echo.HandlerFunc) echo.HandlerFunc
+}
+
+// @WireSet("Middleware")
+func NewFeedTokenMiddleware(calendarFeedService calendarfeed.Service) FeedTokenMiddleware {
+	return &feedTokenMiddleware{
+		calendarFeedService: calendarFeedService,
+	}
+}
+
+func (m *feedTokenMiddleware) Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		userID, err := m.calendarFeedService.AuthenticateFeedToken(c.Request().Context(), c.Param(FeedTokenParam))
+		if err != nil {
+			return err
+		}
+
+		// Set user ID to context
+		c.Set(string(enums.UserIDContextKey), userID)
+
+		return next(c)
+	}
+}
@@ -0,0 +1,105 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	mock_calendarfeed "github.com/graphzc/sdd-task-management-example/internal/services/calendarfeed/mock"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type FeedTokenMiddlewareTestSuite struct {
+	suite.Suite
+	calendarFeedService *mock_calendarfeed.MockService
+	middleware          FeedTokenMiddleware
+	echo                *echo.Echo
+}
+
+func (suite *FeedTokenMiddlewareTestSuite) SetupTest() {
+	suite.calendarFeedService = mock_calendarfeed.NewMockService(suite.T())
+	suite.middleware = NewFeedTokenMiddleware(suite.calendarFeedService)
+	suite.echo = echo.New()
+}
+
+func (suite *FeedTokenMiddlewareTestSuite) newContext(token string) echo.Context {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/calendar/"+token+"/tasks.ics", nil)
+	rec := httptest.NewRecorder()
+	c := suite.echo.NewContext(req, rec)
+	c.SetParamNames(FeedTokenParam)
+	c.SetParamValues(token)
+	return c
+}
+
+func (suite *FeedTokenMiddlewareTestSuite) TestMiddleware_ValidToken() {
+	// Arrange
+	userID := "test-user-id"
+	c := suite.newContext("feed-token")
+	suite.calendarFeedService.EXPECT().
+		AuthenticateFeedToken(mock.Anything, "feed-token").
+		Return(userID, nil)
+
+	called := false
+	nextHandler := func(c echo.Context) error {
+		called = true
+		// Check if user ID is set in context
+		assert.Equal(suite.T(), userID, c.Get(string(enums.UserIDContextKey)))
+		return nil
+	}
+
+	// Act
+	err := suite.middleware.Middleware(nextHandler)(c)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), called)
+}
+
+func (suite *FeedTokenMiddlewareTestSuite) TestMiddleware_InvalidToken() {
+	// Arrange
+	c := suite.newContext("revoked-token")
+	suite.calendarFeedService.EXPECT().
+		AuthenticateFeedToken(mock.Anything, "revoked-token").
+		Return("", servererr.NewError(servererr.ErrorCodeUnauthorized, "Invalid calendar feed token"))
+
+	nextHandler := func(c echo.Context) error {
+		suite.T().Error("Next handler should not be called")
+		return nil
+	}
+
+	// Act
+	err := suite.middleware.Middleware(nextHandler)(c)
+
+	// Assert
+	assert.Error(suite.T(), err)
+	serverErr, ok := err.(*servererr.ServerError)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), servererr.ErrorCodeUnauthorized, serverErr.Code)
+	assert.Nil(suite.T(), c.Get(string(enums.UserIDContextKey)))
+}
+
+func (suite *FeedTokenMiddlewareTestSuite) TestMiddleware_IgnoresAuthorizationHeader() {
+	// Arrange
+	userID := "test-user-id"
+	c := suite.newContext("feed-token")
+	c.Request().Header.Set("Authorization", "Bearer not-a-feed-token")
+	suite.calendarFeedService.EXPECT().
+		AuthenticateFeedToken(mock.Anything, "feed-token").
+		Return(userID, nil)
+
+	// Act
+	err := suite.middleware.Middleware(func(c echo.Context) error { return nil })(c)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), userID, c.Get(string(enums.UserIDContextKey)))
+}
+
+func TestFeedTokenMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(FeedTokenMiddlewareTestSuite))
+}
@@ -0,0 +1,112 @@
+package activity
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/infrastructure/database"
+	"github.com/jmoiron/sqlx"
+)
+
+type Repository interface {
+	Create(ctx context.Context, activity *entities.TaskActivity) error
+	FindByTaskID(ctx context.Context, taskID string, opts *ListOptions) ([]entities.TaskActivity, error)
+}
+
+// Keyset identifies the activity a page starts after
+type Keyset struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// ListOptions describes a page of a task's activity, newest first
+type ListOptions struct {
+	After *Keyset
+	Limit int
+}
+
+type repository struct {
+	db *sqlx.DB
+}
+
+// @WireSet("Repository")
+func NewRepository(db *sqlx.DB) Repository {
+	return &repository{
+		db: db,
+	}
+}
+
+// conn joins the transaction bound to ctx when there is one
+func (r *repository) conn(ctx context.Context) database.Executor {
+	return database.Conn(ctx, r.db)
+}
+
+func (r *repository) Create(ctx context.Context, activity *entities.TaskActivity) error {
+	activityModel, err := FromTaskActivityEntity(activity)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO task_activities (id, task_id, actor_id, action, changes, created_at)
+		VALUES (:id, :task_id, :actor_id, :action, :changes, :created_at)
+	`
+	result, err := r.conn(ctx).NamedExecContext(ctx, query, activityModel)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+func (r *repository) FindByTaskID(ctx context.Context, taskID string, opts *ListOptions) ([]entities.TaskActivity, error) {
+	query := `
+		SELECT 
+			a.id, a.task_id, a.actor_id, a.action, a.changes, a.created_at,
+			u.name AS actor_name, u.email AS actor_email
+		FROM task_activities a
+		LEFT JOIN users u ON u.id = a.actor_id
+		WHERE a.task_id = $1
+	`
+	args := []any{taskID}
+
+	if opts.After != nil {
+		query += ` AND (a.created_at, a.id) < ($2, $3)`
+		args = append(args, opts.After.CreatedAt, opts.After.ID)
+	}
+
+	query += ` ORDER BY a.created_at DESC, a.id DESC`
+
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		query += ` LIMIT $` + strconv.Itoa(len(args))
+	}
+
+	var activityModels []WithActorModel
+	err := r.conn(ctx).SelectContext(ctx, &activityModels, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	activities := make([]entities.TaskActivity, len(activityModels))
+	for i, model := range activityModels {
+		activity, err := model.ToTaskActivityEntity()
+		if err != nil {
+			return nil, err
+		}
+		activities[i] = *activity
+	}
+
+	return activities, nil
+}
@@ -0,0 +1,8 @@
+package activity
+
+import "errors"
+
+var (
+	ErrNullActivity   = errors.New("activity entity cannot be null")
+	ErrNoRowsAffected = errors.New("no rows affected")
+)
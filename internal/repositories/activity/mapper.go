@@ -0,0 +1,87 @@
+package activity
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+)
+
+func FromTaskActivityEntity(entity *entities.TaskActivity) (*Model, error) {
+	if entity == nil {
+		return nil, ErrNullActivity
+	}
+
+	activityUUID, err := uuid.Parse(entity.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskUUID, err := uuid.Parse(entity.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	actorUUID, err := uuid.Parse(entity.ActorID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := entity.Changes
+	if changes == nil {
+		changes = []entities.TaskFieldChange{}
+	}
+
+	changesJSON, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Model{
+		ID:        activityUUID,
+		TaskID:    taskUUID,
+		ActorID:   uuid.NullUUID{UUID: actorUUID, Valid: true},
+		Action:    entity.Action.String(),
+		Changes:   changesJSON,
+		CreatedAt: entity.CreatedAt,
+	}, nil
+}
+
+func (m *Model) ToTaskActivityEntity() (*entities.TaskActivity, error) {
+	var changes []entities.TaskFieldChange
+	if err := json.Unmarshal(m.Changes, &changes); err != nil {
+		return nil, err
+	}
+
+	activity := &entities.TaskActivity{
+		ID:        m.ID.String(),
+		TaskID:    m.TaskID.String(),
+		Action:    enums.TaskActivityAction(m.Action),
+		Changes:   changes,
+		CreatedAt: m.CreatedAt,
+	}
+
+	if m.ActorID.Valid {
+		activity.ActorID = m.ActorID.UUID.String()
+	}
+
+	return activity, nil
+}
+
+func (m *WithActorModel) ToTaskActivityEntity() (*entities.TaskActivity, error) {
+	activity, err := m.Model.ToTaskActivityEntity()
+	if err != nil {
+		return nil, err
+	}
+
+	if m.ActorID.Valid && m.ActorName.Valid {
+		activity.Actor = &entities.UserSummary{
+			ID:    activity.ActorID,
+			Name:  m.ActorName.String,
+			Email: m.ActorEmail.String,
+		}
+	}
+
+	return activity, nil
+}
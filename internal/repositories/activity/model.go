@@ -0,0 +1,23 @@
+package activity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Model struct {
+	ID        uuid.UUID     `json:"id" db:"id"`
+	TaskID    uuid.UUID     `json:"taskId" db:"task_id"`
+	ActorID   uuid.NullUUID `json:"actorId" db:"actor_id"`
+	Action    string        `json:"action" db:"action"`
+	Changes   []byte        `json:"changes" db:"changes"`
+	CreatedAt time.Time     `json:"createdAt" db:"created_at"`
+}
+
+type WithActorModel struct {
+	Model
+	ActorName  sql.NullString `db:"actor_name"`
+	ActorEmail sql.NullString `db:"actor_email"`
+}
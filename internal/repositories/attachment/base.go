@@ -0,0 +1,133 @@
+package attachment
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/jmoiron/sqlx"
+)
+
+type Repository interface {
+	Create(ctx context.Context, attachment *entities.Attachment) error
+	FindByID(ctx context.Context, attachmentID string) (*entities.Attachment, error)
+	FindByTaskID(ctx context.Context, taskID string) ([]entities.Attachment, error)
+	CountByContentHash(ctx context.Context, contentHash string) (int, error)
+	DeleteByID(ctx context.Context, attachmentID string) error
+}
+
+type repository struct {
+	db *sqlx.DB
+}
+
+// @WireSet("Repository")
+func NewRepository(db *sqlx.DB) Repository {
+	return &repository{
+		db: db,
+	}
+}
+
+// Create inserts the attachment, reporting the same content already attached
+// to the task as no rows affected
+func (r *repository) Create(ctx context.Context, attachment *entities.Attachment) error {
+	attachmentModel, err := FromAttachmentEntity(attachment)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO task_attachments (id, task_id, user_id, file_name, content_type, size, content_hash, created_at)
+		VALUES (:id, :task_id, :user_id, :file_name, :content_type, :size, :content_hash, :created_at)
+		ON CONFLICT (task_id, content_hash) DO NOTHING
+	`
+	result, err := r.db.NamedExecContext(ctx, query, attachmentModel)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+func (r *repository) FindByID(ctx context.Context, attachmentID string) (*entities.Attachment, error) {
+	query := `
+		SELECT 
+			id, task_id, user_id, file_name, content_type, size, content_hash, created_at
+		FROM task_attachments
+		WHERE id = $1
+	`
+
+	var attachmentModel Model
+	err := r.db.GetContext(ctx, &attachmentModel, query, attachmentID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return attachmentModel.ToAttachmentEntity(), nil
+}
+
+func (r *repository) FindByTaskID(ctx context.Context, taskID string) ([]entities.Attachment, error) {
+	query := `
+		SELECT 
+			id, task_id, user_id, file_name, content_type, size, content_hash, created_at
+		FROM task_attachments
+		WHERE task_id = $1
+		ORDER BY created_at ASC, id ASC
+	`
+
+	var attachmentModels []Model
+	err := r.db.SelectContext(ctx, &attachmentModels, query, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments := make([]entities.Attachment, len(attachmentModels))
+	for i, model := range attachmentModels {
+		attachments[i] = *model.ToAttachmentEntity()
+	}
+
+	return attachments, nil
+}
+
+func (r *repository) CountByContentHash(ctx context.Context, contentHash string) (int, error) {
+	query := `SELECT COUNT(*) FROM task_attachments WHERE content_hash = $1`
+
+	var count int
+	if err := r.db.GetContext(ctx, &count, query, contentHash); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *repository) DeleteByID(ctx context.Context, attachmentID string) error {
+	query := `DELETE FROM task_attachments WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, attachmentID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
@@ -0,0 +1,8 @@
+package attachment
+
+import "errors"
+
+var (
+	ErrNullAttachment = errors.New("attachment entity cannot be null")
+	ErrNoRowsAffected = errors.New("no rows affected")
+)
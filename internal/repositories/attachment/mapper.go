@@ -0,0 +1,51 @@
+package attachment
+
+import (
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+)
+
+func FromAttachmentEntity(entity *entities.Attachment) (*Model, error) {
+	if entity == nil {
+		return nil, ErrNullAttachment
+	}
+
+	attachmentUUID, err := uuid.Parse(entity.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskUUID, err := uuid.Parse(entity.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	userUUID, err := uuid.Parse(entity.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Model{
+		ID:          attachmentUUID,
+		TaskID:      taskUUID,
+		UserID:      userUUID,
+		FileName:    entity.FileName,
+		ContentType: entity.ContentType,
+		Size:        entity.Size,
+		ContentHash: entity.ContentHash,
+		CreatedAt:   entity.CreatedAt,
+	}, nil
+}
+
+func (m *Model) ToAttachmentEntity() *entities.Attachment {
+	return &entities.Attachment{
+		ID:          m.ID.String(),
+		TaskID:      m.TaskID.String(),
+		UserID:      m.UserID.String(),
+		FileName:    m.FileName,
+		ContentType: m.ContentType,
+		Size:        m.Size,
+		ContentHash: m.ContentHash,
+		CreatedAt:   m.CreatedAt,
+	}
+}
@@ -0,0 +1,18 @@
+package attachment
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Model struct {
+	ID          uuid.UUID `json:"id" db:"id"`
+	TaskID      uuid.UUID `json:"taskId" db:"task_id"`
+	UserID      uuid.UUID `json:"userId" db:"user_id"`
+	FileName    string    `json:"fileName" db:"file_name"`
+	ContentType string    `json:"contentType" db:"content_type"`
+	Size        int64     `json:"size" db:"size"`
+	ContentHash string    `json:"contentHash" db:"content_hash"`
+	CreatedAt   time.Time `json:"createdAt" db:"created_at"`
+}
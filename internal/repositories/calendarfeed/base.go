@@ -0,0 +1,113 @@
+package calendarfeed
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/jmoiron/sqlx"
+)
+
+type Repository interface {
+	Save(ctx context.Context, feed *entities.CalendarFeed) error
+	FindByUserID(ctx context.Context, userID string) (*entities.CalendarFeed, error)
+	FindByTokenHash(ctx context.Context, tokenHash string) (*entities.CalendarFeed, error)
+	DeleteByUserID(ctx context.Context, userID string) error
+}
+
+type repository struct {
+	db *sqlx.DB
+}
+
+// @WireSet("Repository")
+func NewRepository(db *sqlx.DB) Repository {
+	return &repository{
+		db: db,
+	}
+}
+
+// Save stores the feed of a user, replacing the one they had so its old
+// token stops working
+func (r *repository) Save(ctx context.Context, feed *entities.CalendarFeed) error {
+	feedModel, err := FromCalendarFeedEntity(feed)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO calendar_feeds (user_id, token_hash, created_at)
+		VALUES (:user_id, :token_hash, :created_at)
+		ON CONFLICT (user_id) DO UPDATE
+		SET token_hash = EXCLUDED.token_hash, created_at = EXCLUDED.created_at
+	`
+	result, err := r.db.NamedExecContext(ctx, query, feedModel)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+func (r *repository) FindByUserID(ctx context.Context, userID string) (*entities.CalendarFeed, error) {
+	query := `
+		SELECT user_id, token_hash, created_at
+		FROM calendar_feeds
+		WHERE user_id = $1
+	`
+
+	return r.findOne(ctx, query, userID)
+}
+
+func (r *repository) FindByTokenHash(ctx context.Context, tokenHash string) (*entities.CalendarFeed, error) {
+	query := `
+		SELECT user_id, token_hash, created_at
+		FROM calendar_feeds
+		WHERE token_hash = $1
+	`
+
+	return r.findOne(ctx, query, tokenHash)
+}
+
+func (r *repository) DeleteByUserID(ctx context.Context, userID string) error {
+	query := `DELETE FROM calendar_feeds WHERE user_id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// findOne reads a single feed, a missing one is returned as nil
+func (r *repository) findOne(ctx context.Context, query string, arg any) (*entities.CalendarFeed, error) {
+	var feedModel Model
+	err := r.db.GetContext(ctx, &feedModel, query, arg)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return feedModel.ToCalendarFeedEntity(), nil
+}
@@ -0,0 +1,8 @@
+package calendarfeed
+
+import "errors"
+
+var (
+	ErrNullCalendarFeed = errors.New("calendar feed entity cannot be null")
+	ErrNoRowsAffected   = errors.New("no rows affected")
+)
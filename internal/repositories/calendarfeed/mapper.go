@@ -0,0 +1,31 @@
+package calendarfeed
+
+import (
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+)
+
+func FromCalendarFeedEntity(entity *entities.CalendarFeed) (*Model, error) {
+	if entity == nil {
+		return nil, ErrNullCalendarFeed
+	}
+
+	userUUID, err := uuid.Parse(entity.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Model{
+		UserID:    userUUID,
+		TokenHash: entity.TokenHash,
+		CreatedAt: entity.CreatedAt,
+	}, nil
+}
+
+func (m *Model) ToCalendarFeedEntity() *entities.CalendarFeed {
+	return &entities.CalendarFeed{
+		UserID:    m.UserID.String(),
+		TokenHash: m.TokenHash,
+		CreatedAt: m.CreatedAt,
+	}
+}
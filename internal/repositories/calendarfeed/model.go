@@ -0,0 +1,13 @@
+package calendarfeed
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Model struct {
+	UserID    uuid.UUID `json:"userId" db:"user_id"`
+	TokenHash string    `json:"tokenHash" db:"token_hash"`
+	CreatedAt time.Time `json:"createdAt" db:"created_at"`
+}
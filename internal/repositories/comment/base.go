@@ -0,0 +1,148 @@
+package comment
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/jmoiron/sqlx"
+)
+
+type Repository interface {
+	Create(ctx context.Context, comment *entities.Comment) error
+	FindByID(ctx context.Context, commentID string) (*entities.Comment, error)
+	FindByTaskID(ctx context.Context, taskID string) ([]entities.Comment, error)
+	UpdateBodyByID(ctx context.Context, commentID string, body string, editedAt time.Time) error
+	DeleteByID(ctx context.Context, commentID string) error
+}
+
+type repository struct {
+	db *sqlx.DB
+}
+
+// @WireSet("Repository")
+func NewRepository(db *sqlx.DB) Repository {
+	return &repository{
+		db: db,
+	}
+}
+
+func (r *repository) Create(ctx context.Context, comment *entities.Comment) error {
+	commentModel, err := FromCommentEntity(comment)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO task_comments (id, task_id, user_id, body, created_at, updated_at, edited_at)
+		VALUES (:id, :task_id, :user_id, :body, :created_at, :updated_at, :edited_at)
+	`
+	result, err := r.db.NamedExecContext(ctx, query, commentModel)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+func (r *repository) FindByID(ctx context.Context, commentID string) (*entities.Comment, error) {
+	query := `
+		SELECT 
+			c.id, c.task_id, c.user_id, c.body, c.created_at, c.updated_at, c.edited_at,
+			u.name AS author_name, u.email AS author_email
+		FROM task_comments c
+		JOIN users u ON u.id = c.user_id
+		WHERE c.id = $1
+	`
+
+	var commentModel WithAuthorModel
+	err := r.db.GetContext(ctx, &commentModel, query, commentID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return commentModel.ToCommentEntity(), nil
+}
+
+func (r *repository) FindByTaskID(ctx context.Context, taskID string) ([]entities.Comment, error) {
+	query := `
+		SELECT 
+			c.id, c.task_id, c.user_id, c.body, c.created_at, c.updated_at, c.edited_at,
+			u.name AS author_name, u.email AS author_email
+		FROM task_comments c
+		JOIN users u ON u.id = c.user_id
+		WHERE c.task_id = $1
+		ORDER BY c.created_at ASC, c.id ASC
+	`
+
+	var commentModels []WithAuthorModel
+	err := r.db.SelectContext(ctx, &commentModels, query, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]entities.Comment, len(commentModels))
+	for i, model := range commentModels {
+		comments[i] = *model.ToCommentEntity()
+	}
+
+	return comments, nil
+}
+
+func (r *repository) UpdateBodyByID(ctx context.Context, commentID string, body string, editedAt time.Time) error {
+	query := `
+		UPDATE task_comments 
+		SET body = $1, edited_at = $2, updated_at = $2
+		WHERE id = $3
+	`
+
+	result, err := r.db.ExecContext(ctx, query, body, editedAt, commentID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+func (r *repository) DeleteByID(ctx context.Context, commentID string) error {
+	query := `DELETE FROM task_comments WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, commentID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
@@ -0,0 +1,8 @@
+package comment
+
+import "errors"
+
+var (
+	ErrNullComment    = errors.New("comment entity cannot be null")
+	ErrNoRowsAffected = errors.New("no rows affected")
+)
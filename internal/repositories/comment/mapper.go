@@ -0,0 +1,72 @@
+package comment
+
+import (
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+)
+
+func FromCommentEntity(entity *entities.Comment) (*Model, error) {
+	if entity == nil {
+		return nil, ErrNullComment
+	}
+
+	commentUUID, err := uuid.Parse(entity.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskUUID, err := uuid.Parse(entity.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	userUUID, err := uuid.Parse(entity.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var editedAt sql.NullTime
+	if entity.EditedAt != nil {
+		editedAt = sql.NullTime{Time: *entity.EditedAt, Valid: true}
+	}
+
+	return &Model{
+		ID:        commentUUID,
+		TaskID:    taskUUID,
+		UserID:    userUUID,
+		Body:      entity.Body,
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+		EditedAt:  editedAt,
+	}, nil
+}
+
+func (m *Model) ToCommentEntity() *entities.Comment {
+	comment := &entities.Comment{
+		ID:        m.ID.String(),
+		TaskID:    m.TaskID.String(),
+		UserID:    m.UserID.String(),
+		Body:      m.Body,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+
+	if m.EditedAt.Valid {
+		comment.EditedAt = &m.EditedAt.Time
+	}
+
+	return comment
+}
+
+func (m *WithAuthorModel) ToCommentEntity() *entities.Comment {
+	comment := m.Model.ToCommentEntity()
+	comment.Author = &entities.UserSummary{
+		ID:    comment.UserID,
+		Name:  m.AuthorName,
+		Email: m.AuthorEmail,
+	}
+
+	return comment
+}
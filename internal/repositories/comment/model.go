@@ -0,0 +1,24 @@
+package comment
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Model struct {
+	ID        uuid.UUID    `json:"id" db:"id"`
+	TaskID    uuid.UUID    `json:"taskId" db:"task_id"`
+	UserID    uuid.UUID    `json:"userId" db:"user_id"`
+	Body      string       `json:"body" db:"body"`
+	CreatedAt time.Time    `json:"createdAt" db:"created_at"`
+	UpdatedAt time.Time    `json:"updatedAt" db:"updated_at"`
+	EditedAt  sql.NullTime `json:"editedAt" db:"edited_at"`
+}
+
+type WithAuthorModel struct {
+	Model
+	AuthorName  string `db:"author_name"`
+	AuthorEmail string `db:"author_email"`
+}
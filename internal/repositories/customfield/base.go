@@ -0,0 +1,198 @@
+package customfield
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type Repository interface {
+	Create(ctx context.Context, field *entities.CustomField) error
+	FindByID(ctx context.Context, fieldID string) (*entities.CustomField, error)
+	FindByWorkspaceID(ctx context.Context, workspaceID string) ([]entities.CustomField, error)
+	FindByProjectID(ctx context.Context, projectID string) ([]entities.CustomField, error)
+	FindByWorkspaceIDAndName(ctx context.Context, workspaceID string, name string) (*entities.CustomField, error)
+	FindByProjectIDAndName(ctx context.Context, projectID string, name string) (*entities.CustomField, error)
+	UpdateByID(ctx context.Context, field *entities.CustomField) error
+	DeleteByID(ctx context.Context, fieldID string) error
+}
+
+type repository struct {
+	db *sqlx.DB
+}
+
+// @WireSet("Repository")
+func NewRepository(db *sqlx.DB) Repository {
+	return &repository{
+		db: db,
+	}
+}
+
+// fieldColumns lists the columns scanned into Model
+const fieldColumns = `
+	id, workspace_id, project_id, name, type, required, options,
+	min_value, max_value, created_at, updated_at
+`
+
+func (r *repository) Create(ctx context.Context, field *entities.CustomField) error {
+	fieldModel, err := FromCustomFieldEntity(field)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO custom_fields (` + fieldColumns + `)
+		VALUES (:id, :workspace_id, :project_id, :name, :type, :required, :options,
+			:min_value, :max_value, :created_at, :updated_at)
+	`
+	result, err := r.db.NamedExecContext(ctx, query, fieldModel)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+func (r *repository) FindByID(ctx context.Context, fieldID string) (*entities.CustomField, error) {
+	query := `SELECT ` + fieldColumns + ` FROM custom_fields WHERE id = $1`
+
+	return r.findOne(ctx, query, fieldID)
+}
+
+func (r *repository) FindByWorkspaceID(ctx context.Context, workspaceID string) ([]entities.CustomField, error) {
+	query := `
+		SELECT ` + fieldColumns + `
+		FROM custom_fields
+		WHERE workspace_id = $1
+		ORDER BY created_at ASC, id ASC
+	`
+
+	return r.findMany(ctx, query, workspaceID)
+}
+
+func (r *repository) FindByProjectID(ctx context.Context, projectID string) ([]entities.CustomField, error) {
+	query := `
+		SELECT ` + fieldColumns + `
+		FROM custom_fields
+		WHERE project_id = $1
+		ORDER BY created_at ASC, id ASC
+	`
+
+	return r.findMany(ctx, query, projectID)
+}
+
+func (r *repository) FindByWorkspaceIDAndName(ctx context.Context, workspaceID string, name string) (*entities.CustomField, error) {
+	query := `
+		SELECT ` + fieldColumns + `
+		FROM custom_fields
+		WHERE workspace_id = $1 AND LOWER(name) = LOWER($2)
+	`
+
+	return r.findOne(ctx, query, workspaceID, name)
+}
+
+func (r *repository) FindByProjectIDAndName(ctx context.Context, projectID string, name string) (*entities.CustomField, error) {
+	query := `
+		SELECT ` + fieldColumns + `
+		FROM custom_fields
+		WHERE project_id = $1 AND LOWER(name) = LOWER($2)
+	`
+
+	return r.findOne(ctx, query, projectID, name)
+}
+
+// UpdateByID saves the rules of a field, its scope and type never change
+func (r *repository) UpdateByID(ctx context.Context, field *entities.CustomField) error {
+	fieldModel, err := FromCustomFieldEntity(field)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE custom_fields
+		SET name = $1, required = $2, options = $3, min_value = $4, max_value = $5, updated_at = $6
+		WHERE id = $7
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		fieldModel.Name, fieldModel.Required, pq.Array(fieldModel.Options),
+		fieldModel.MinValue, fieldModel.MaxValue, timeutil.BangkokNow(), fieldModel.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// DeleteByID removes the field together with every value tasks hold for it
+func (r *repository) DeleteByID(ctx context.Context, fieldID string) error {
+	query := `DELETE FROM custom_fields WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, fieldID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+func (r *repository) findOne(ctx context.Context, query string, args ...any) (*entities.CustomField, error) {
+	var fieldModel Model
+	err := r.db.GetContext(ctx, &fieldModel, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return fieldModel.ToCustomFieldEntity(), nil
+}
+
+func (r *repository) findMany(ctx context.Context, query string, args ...any) ([]entities.CustomField, error) {
+	var fieldModels []Model
+	err := r.db.SelectContext(ctx, &fieldModels, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]entities.CustomField, len(fieldModels))
+	for i, model := range fieldModels {
+		fields[i] = *model.ToCustomFieldEntity()
+	}
+
+	return fields, nil
+}
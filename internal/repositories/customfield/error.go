@@ -0,0 +1,8 @@
+package customfield
+
+import "errors"
+
+var (
+	ErrNullCustomField = errors.New("custom field entity cannot be null")
+	ErrNoRowsAffected  = errors.New("no rows affected")
+)
@@ -0,0 +1,90 @@
+package customfield
+
+import (
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+)
+
+func FromCustomFieldEntity(entity *entities.CustomField) (*Model, error) {
+	if entity == nil {
+		return nil, ErrNullCustomField
+	}
+
+	fieldUUID, err := uuid.Parse(entity.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceUUID, err := parseOptionalUUID(entity.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	projectUUID, err := parseOptionalUUID(entity.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	options := entity.Options
+	if options == nil {
+		options = []string{}
+	}
+
+	return &Model{
+		ID:          fieldUUID,
+		WorkspaceID: workspaceUUID,
+		ProjectID:   projectUUID,
+		Name:        entity.Name,
+		Type:        entity.Type.String(),
+		Required:    entity.Required,
+		Options:     options,
+		MinValue:    entity.Min,
+		MaxValue:    entity.Max,
+		CreatedAt:   entity.CreatedAt,
+		UpdatedAt:   entity.UpdatedAt,
+	}, nil
+}
+
+func (m *Model) ToCustomFieldEntity() *entities.CustomField {
+	options := []string(m.Options)
+	if options == nil {
+		options = []string{}
+	}
+
+	return &entities.CustomField{
+		ID:          m.ID.String(),
+		WorkspaceID: optionalUUIDString(m.WorkspaceID),
+		ProjectID:   optionalUUIDString(m.ProjectID),
+		Name:        m.Name,
+		Type:        enums.CustomFieldType(m.Type),
+		Required:    m.Required,
+		Options:     options,
+		Min:         m.MinValue,
+		Max:         m.MaxValue,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func parseOptionalUUID(id *string) (*uuid.UUID, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	parsed, err := uuid.Parse(*id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsed, nil
+}
+
+func optionalUUIDString(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+
+	s := id.String()
+	return &s
+}
@@ -0,0 +1,22 @@
+package customfield
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type Model struct {
+	ID          uuid.UUID      `json:"id" db:"id"`
+	WorkspaceID *uuid.UUID     `json:"workspaceId" db:"workspace_id"`
+	ProjectID   *uuid.UUID     `json:"projectId" db:"project_id"`
+	Name        string         `json:"name" db:"name"`
+	Type        string         `json:"type" db:"type"`
+	Required    bool           `json:"required" db:"required"`
+	Options     pq.StringArray `json:"options" db:"options"`
+	MinValue    *float64       `json:"minValue" db:"min_value"`
+	MaxValue    *float64       `json:"maxValue" db:"max_value"`
+	CreatedAt   time.Time      `json:"createdAt" db:"created_at"`
+	UpdatedAt   time.Time      `json:"updatedAt" db:"updated_at"`
+}
@@ -0,0 +1,171 @@
+package project
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/jmoiron/sqlx"
+)
+
+type Repository interface {
+	Create(ctx context.Context, project *entities.Project) error
+	FindByID(ctx context.Context, projectID string) (*entities.Project, error)
+	FindByUserID(ctx context.Context, userID string, includeArchived bool) ([]entities.Project, error)
+	UpdateByID(ctx context.Context, projectID string, name, description string) error
+	UpdateArchivedAtByID(ctx context.Context, projectID string, archivedAt *time.Time) error
+	DeleteByID(ctx context.Context, projectID string) error
+}
+
+type repository struct {
+	db *sqlx.DB
+}
+
+// @WireSet("Repository")
+func NewRepository(db *sqlx.DB) Repository {
+	return &repository{
+		db: db,
+	}
+}
+
+func (r *repository) Create(ctx context.Context, project *entities.Project) error {
+	projectModel, err := FromProjectEntity(project)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO projects (id, user_id, name, description, archived_at, created_at, updated_at)
+		VALUES (:id, :user_id, :name, :description, :archived_at, :created_at, :updated_at)
+	`
+	result, err := r.db.NamedExecContext(ctx, query, projectModel)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+func (r *repository) FindByID(ctx context.Context, projectID string) (*entities.Project, error) {
+	query := `
+		SELECT 
+			id, user_id, name, description, archived_at, created_at, updated_at
+		FROM projects
+		WHERE id = $1
+	`
+
+	var projectModel Model
+	err := r.db.GetContext(ctx, &projectModel, query, projectID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return projectModel.ToProjectEntity(), nil
+}
+
+func (r *repository) FindByUserID(ctx context.Context, userID string, includeArchived bool) ([]entities.Project, error) {
+	query := `
+		SELECT 
+			id, user_id, name, description, archived_at, created_at, updated_at
+		FROM projects
+		WHERE user_id = $1 AND ($2 OR archived_at IS NULL)
+		ORDER BY created_at DESC, id DESC
+	`
+
+	var projectModels []Model
+	err := r.db.SelectContext(ctx, &projectModels, query, userID, includeArchived)
+	if err != nil {
+		return nil, err
+	}
+
+	projects := make([]entities.Project, len(projectModels))
+	for i, model := range projectModels {
+		projects[i] = *model.ToProjectEntity()
+	}
+
+	return projects, nil
+}
+
+func (r *repository) UpdateByID(ctx context.Context, projectID string, name, description string) error {
+	query := `
+		UPDATE projects 
+		SET name = $1, description = $2, updated_at = $3
+		WHERE id = $4
+	`
+
+	result, err := r.db.ExecContext(ctx, query, name, description, timeutil.BangkokNow(), projectID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// UpdateArchivedAtByID archives the project, or restores it when archivedAt is nil
+func (r *repository) UpdateArchivedAtByID(ctx context.Context, projectID string, archivedAt *time.Time) error {
+	query := `
+		UPDATE projects 
+		SET archived_at = $1, updated_at = $2
+		WHERE id = $3
+	`
+
+	result, err := r.db.ExecContext(ctx, query, archivedAt, timeutil.BangkokNow(), projectID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+func (r *repository) DeleteByID(ctx context.Context, projectID string) error {
+	query := `DELETE FROM projects WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, projectID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
@@ -0,0 +1,8 @@
+package project
+
+import "errors"
+
+var (
+	ErrNullProject    = errors.New("project entity cannot be null")
+	ErrNoRowsAffected = errors.New("no rows affected")
+)
@@ -0,0 +1,44 @@
+package project
+
+import (
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+)
+
+func FromProjectEntity(entity *entities.Project) (*Model, error) {
+	if entity == nil {
+		return nil, ErrNullProject
+	}
+
+	projectUUID, err := uuid.Parse(entity.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	userUUID, err := uuid.Parse(entity.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Model{
+		ID:          projectUUID,
+		UserID:      userUUID,
+		Name:        entity.Name,
+		Description: entity.Description,
+		ArchivedAt:  entity.ArchivedAt,
+		CreatedAt:   entity.CreatedAt,
+		UpdatedAt:   entity.UpdatedAt,
+	}, nil
+}
+
+func (m *Model) ToProjectEntity() *entities.Project {
+	return &entities.Project{
+		ID:          m.ID.String(),
+		UserID:      m.UserID.String(),
+		Name:        m.Name,
+		Description: m.Description,
+		ArchivedAt:  m.ArchivedAt,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
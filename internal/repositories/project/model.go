@@ -0,0 +1,17 @@
+package project
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Model struct {
+	ID          uuid.UUID  `json:"id" db:"id"`
+	UserID      uuid.UUID  `json:"userId" db:"user_id"`
+	Name        string     `json:"name" db:"name"`
+	Description string     `json:"description" db:"description"`
+	ArchivedAt  *time.Time `json:"archivedAt" db:"archived_at"`
+	CreatedAt   time.Time  `json:"createdAt" db:"created_at"`
+	UpdatedAt   time.Time  `json:"updatedAt" db:"updated_at"`
+}
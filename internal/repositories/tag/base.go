@@ -0,0 +1,194 @@
+package tag
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type Repository interface {
+	Create(ctx context.Context, tag *entities.Tag) error
+	FindByID(ctx context.Context, tagID string) (*entities.Tag, error)
+	FindByIDs(ctx context.Context, tagIDs []string) ([]entities.Tag, error)
+	FindByUserID(ctx context.Context, userID string) ([]entities.Tag, error)
+	FindByUserIDAndName(ctx context.Context, userID string, name string) (*entities.Tag, error)
+	UpdateByID(ctx context.Context, tagID string, name, color string) error
+	DeleteByID(ctx context.Context, tagID string) error
+}
+
+type repository struct {
+	db *sqlx.DB
+}
+
+// @WireSet("Repository")
+func NewRepository(db *sqlx.DB) Repository {
+	return &repository{
+		db: db,
+	}
+}
+
+func (r *repository) Create(ctx context.Context, tag *entities.Tag) error {
+	tagModel, err := FromTagEntity(tag)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO tags (id, user_id, name, color, created_at, updated_at)
+		VALUES (:id, :user_id, :name, :color, :created_at, :updated_at)
+	`
+	result, err := r.db.NamedExecContext(ctx, query, tagModel)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+func (r *repository) FindByID(ctx context.Context, tagID string) (*entities.Tag, error) {
+	query := `
+		SELECT 
+			id, user_id, name, color, created_at, updated_at
+		FROM tags
+		WHERE id = $1
+	`
+
+	var tagModel Model
+	err := r.db.GetContext(ctx, &tagModel, query, tagID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return tagModel.ToTagEntity(), nil
+}
+
+func (r *repository) FindByIDs(ctx context.Context, tagIDs []string) ([]entities.Tag, error) {
+	if len(tagIDs) == 0 {
+		return []entities.Tag{}, nil
+	}
+
+	query := `
+		SELECT 
+			id, user_id, name, color, created_at, updated_at
+		FROM tags
+		WHERE id = ANY($1)
+		ORDER BY name ASC
+	`
+
+	var tagModels []Model
+	err := r.db.SelectContext(ctx, &tagModels, query, pq.Array(tagIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]entities.Tag, len(tagModels))
+	for i, model := range tagModels {
+		tags[i] = *model.ToTagEntity()
+	}
+
+	return tags, nil
+}
+
+func (r *repository) FindByUserID(ctx context.Context, userID string) ([]entities.Tag, error) {
+	query := `
+		SELECT 
+			id, user_id, name, color, created_at, updated_at
+		FROM tags
+		WHERE user_id = $1
+		ORDER BY name ASC
+	`
+
+	var tagModels []Model
+	err := r.db.SelectContext(ctx, &tagModels, query, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]entities.Tag, len(tagModels))
+	for i, model := range tagModels {
+		tags[i] = *model.ToTagEntity()
+	}
+
+	return tags, nil
+}
+
+func (r *repository) FindByUserIDAndName(ctx context.Context, userID string, name string) (*entities.Tag, error) {
+	query := `
+		SELECT 
+			id, user_id, name, color, created_at, updated_at
+		FROM tags
+		WHERE user_id = $1 AND LOWER(name) = LOWER($2)
+	`
+
+	var tagModel Model
+	err := r.db.GetContext(ctx, &tagModel, query, userID, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return tagModel.ToTagEntity(), nil
+}
+
+func (r *repository) UpdateByID(ctx context.Context, tagID string, name, color string) error {
+	query := `
+		UPDATE tags 
+		SET name = $1, color = $2, updated_at = $3
+		WHERE id = $4
+	`
+
+	result, err := r.db.ExecContext(ctx, query, name, color, timeutil.BangkokNow(), tagID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+func (r *repository) DeleteByID(ctx context.Context, tagID string) error {
+	query := `DELETE FROM tags WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, tagID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
@@ -0,0 +1,8 @@
+package tag
+
+import "errors"
+
+var (
+	ErrNullTag        = errors.New("tag entity cannot be null")
+	ErrNoRowsAffected = errors.New("no rows affected")
+)
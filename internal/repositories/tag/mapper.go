@@ -0,0 +1,42 @@
+package tag
+
+import (
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+)
+
+func FromTagEntity(entity *entities.Tag) (*Model, error) {
+	if entity == nil {
+		return nil, ErrNullTag
+	}
+
+	tagUUID, err := uuid.Parse(entity.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	userUUID, err := uuid.Parse(entity.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Model{
+		ID:        tagUUID,
+		UserID:    userUUID,
+		Name:      entity.Name,
+		Color:     entity.Color,
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+	}, nil
+}
+
+func (m *Model) ToTagEntity() *entities.Tag {
+	return &entities.Tag{
+		ID:        m.ID.String(),
+		UserID:    m.UserID.String(),
+		Name:      m.Name,
+		Color:     m.Color,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
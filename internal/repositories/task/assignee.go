@@ -0,0 +1,85 @@
+package task
+
+import (
+	"context"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/lib/pq"
+)
+
+// CreateAssignee assigns a user to a task.
+// Returns ErrNoRowsAffected when the user is already assigned.
+func (r *repository) CreateAssignee(ctx context.Context, taskID string, userID string) error {
+	query := `
+		INSERT INTO task_assignees (task_id, user_id, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (task_id, user_id) DO NOTHING
+	`
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, taskID, userID, timeutil.BangkokNow())
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+func (r *repository) DeleteAssignee(ctx context.Context, taskID string, userID string) error {
+	query := `DELETE FROM task_assignees WHERE task_id = $1 AND user_id = $2`
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, taskID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// FindAssigneesByTaskIDs returns the assignees of each task keyed by task ID,
+// oldest assignment first. Tasks without assignees are absent from the map.
+func (r *repository) FindAssigneesByTaskIDs(ctx context.Context, taskIDs []string) (map[string][]entities.TaskAssignee, error) {
+	if len(taskIDs) == 0 {
+		return map[string][]entities.TaskAssignee{}, nil
+	}
+
+	query := `
+		SELECT ta.task_id, u.id, u.name, u.email, ta.created_at
+		FROM task_assignees ta
+		JOIN users u ON u.id = ta.user_id
+		WHERE ta.task_id = ANY($1)
+		ORDER BY ta.created_at ASC, u.id ASC
+	`
+
+	var assigneeModels []TaskAssigneeModel
+	err := r.conn(ctx).SelectContext(ctx, &assigneeModels, query, pq.Array(taskIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	assignees := make(map[string][]entities.TaskAssignee, len(taskIDs))
+	for _, model := range assigneeModels {
+		taskID := model.TaskID.String()
+		assignees[taskID] = append(assignees[taskID], *model.ToTaskAssigneeEntity())
+	}
+
+	return assignees, nil
+}
@@ -3,10 +3,14 @@ package task
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
 	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/infrastructure/database"
 	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
 	"github.com/jmoiron/sqlx"
 )
@@ -14,12 +18,77 @@ import (
 type Repository interface {
 	Create(ctx context.Context, task *entities.Task) (string, error)
 	FindByID(ctx context.Context, taskID string) (*entities.Task, error)
-	FindByUserID(ctx context.Context, userID string) ([]entities.Task, error)
-	UpdateByID(ctx context.Context, taskID string, title, description string, priority enums.TaskPriority) error
-	UpdateStatusByID(ctx context.Context, taskID string, status enums.TaskStatus) error
+	LockVersionByID(ctx context.Context, taskID string) (int, error)
+	FindAll(ctx context.Context, opts *ListOptions) ([]entities.Task, error)
+	StreamAll(ctx context.Context, opts *ListOptions, fn func(task *entities.Task) error) error
+	Count(ctx context.Context, filter *ListFilter) (int, error)
+	Search(ctx context.Context, userID string, query string, limit, offset int) ([]entities.TaskSearchResult, error)
+	UpdateByID(ctx context.Context, taskID string, title, description string, priority enums.TaskPriority, startAt, dueAt *time.Time) error
+	PatchByID(ctx context.Context, taskID string, patch *Patch) error
+	UpdateStatusByID(ctx context.Context, taskID string, status enums.TaskStatus, category enums.TaskStatusCategory) error
+	UpdateProjectByID(ctx context.Context, taskID string, projectID *string) error
+	UpdateWorkspaceByID(ctx context.Context, taskID string, workspaceID *string) error
 	DeleteByID(ctx context.Context, taskID string) error
+
+	// Trash
+	FindDeletedByID(ctx context.Context, taskID string) (*entities.Task, error)
+	RestoreByID(ctx context.Context, taskID string) error
+	PurgeByID(ctx context.Context, taskID string) ([]string, error)
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, []string, error)
+
+	// Series
+	CreateSeries(ctx context.Context, series *entities.TaskSeries) error
+	FindSeriesByID(ctx context.Context, seriesID string) (*entities.TaskSeries, error)
+	UpdateSeriesIDByID(ctx context.Context, taskID string, seriesID *string) error
+	StopSeriesByID(ctx context.Context, seriesID string) error
+	ClaimSeriesOccurrence(ctx context.Context, seriesID string, occurrenceAt time.Time) (bool, error)
+
+	// Hierarchy
+	FindByParentID(ctx context.Context, parentID string) ([]entities.Task, error)
+	FindSubtreeByID(ctx context.Context, taskID string) ([]entities.Task, error)
+	FindAncestorIDs(ctx context.Context, taskID string) ([]string, error)
+	LockAncestorIDs(ctx context.Context, taskID string) ([]string, error)
+	CountSubtasksByIDs(ctx context.Context, taskIDs []string) (map[string]entities.SubtaskRollup, error)
+	UpdateParentByID(ctx context.Context, taskID string, parentID *string) error
+	DeleteTreeByID(ctx context.Context, taskID string) error
+	DeleteByIDAndReparentChildren(ctx context.Context, taskID string, newParentID *string) error
+
+	// Dependencies
+	CreateDependency(ctx context.Context, taskID string, blockedByID string) error
+	DeleteDependency(ctx context.Context, taskID string, blockedByID string) error
+	FindBlockersByID(ctx context.Context, taskID string) ([]entities.Task, error)
+	LockDependencies(ctx context.Context, workspaceID *string) error
+	FindTransitiveBlockerIDs(ctx context.Context, taskID string) ([]string, error)
+
+	// Tags
+	ReplaceTags(ctx context.Context, taskID string, tagIDs []string) error
+	FindTagsByTaskIDs(ctx context.Context, taskIDs []string) (map[string][]entities.Tag, error)
+
+	// Assignees
+	CreateAssignee(ctx context.Context, taskID string, userID string) error
+	DeleteAssignee(ctx context.Context, taskID string, userID string) error
+	FindAssigneesByTaskIDs(ctx context.Context, taskIDs []string) (map[string][]entities.TaskAssignee, error)
+
+	// Time entries
+	SumLoggedSecondsByTaskIDs(ctx context.Context, taskIDs []string) (map[string]int64, error)
+
+	// Custom fields
+	SetCustomFieldValues(ctx context.Context, taskID string, values map[string]json.RawMessage) error
+	FindCustomFieldValuesByTaskIDs(ctx context.Context, taskIDs []string) (map[string][]entities.TaskCustomFieldValue, error)
+	DeleteStaleCustomFieldValues(ctx context.Context, taskIDs []string) error
+
+	// Board
+	LockBoardColumn(ctx context.Context, column *BoardColumn) error
+	FindBoardRankAfter(ctx context.Context, column *BoardColumn, rank string, excludeTaskID string) (string, error)
+	FindBoardRankBefore(ctx context.Context, column *BoardColumn, rank string, excludeTaskID string) (string, error)
+	UpdateBoardRankByID(ctx context.Context, taskID string, rank string) error
+	RebalanceBoardColumn(ctx context.Context, column *BoardColumn) error
+	CountByStatus(ctx context.Context, filter *ListFilter) (map[enums.TaskStatus]int, error)
 }
 
+// taskColumns lists the columns scanned into Model
+const taskColumns = `id, user_id, workspace_id, project_id, parent_id, title, description, priority, status, status_category, start_at, due_at, created_at, updated_at, deleted_at, series_id, board_rank, version`
+
 type repository struct {
 	db *sqlx.DB
 }
@@ -31,6 +100,11 @@ func NewRepository(db *sqlx.DB) Repository {
 	}
 }
 
+// conn joins the transaction bound to ctx when there is one
+func (r *repository) conn(ctx context.Context) database.Executor {
+	return database.Conn(ctx, r.db)
+}
+
 func (r *repository) Create(ctx context.Context, task *entities.Task) (string, error) {
 	taskModel, err := FromTaskEntity(task)
 	if err != nil {
@@ -38,10 +112,10 @@ func (r *repository) Create(ctx context.Context, task *entities.Task) (string, e
 	}
 
 	query := `
-		INSERT INTO tasks (id, user_id, title, description, priority, status, created_at, updated_at)
-		VALUES (:id, :user_id, :title, :description, :priority, :status, :created_at, :updated_at)
+		INSERT INTO tasks (id, user_id, workspace_id, project_id, parent_id, title, description, priority, status, status_category, start_at, due_at, created_at, updated_at, series_id, board_rank)
+		VALUES (:id, :user_id, :workspace_id, :project_id, :parent_id, :title, :description, :priority, :status, :status_category, :start_at, :due_at, :created_at, :updated_at, :series_id, :board_rank)
 	`
-	result, err := r.db.NamedExecContext(ctx, query, taskModel)
+	result, err := r.conn(ctx).NamedExecContext(ctx, query, taskModel)
 	if err != nil {
 		return "", err
 	}
@@ -60,14 +134,13 @@ func (r *repository) Create(ctx context.Context, task *entities.Task) (string, e
 
 func (r *repository) FindByID(ctx context.Context, taskID string) (*entities.Task, error) {
 	query := `
-		SELECT 
-			id, user_id, title, description, priority, status, created_at, updated_at
+		SELECT ` + taskColumns + `
 		FROM tasks
-		WHERE id = $1
+		WHERE id = $1 AND deleted_at IS NULL
 	`
 
 	var taskModel Model
-	err := r.db.GetContext(ctx, &taskModel, query, taskID)
+	err := r.conn(ctx).GetContext(ctx, &taskModel, query, taskID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -78,17 +151,54 @@ func (r *repository) FindByID(ctx context.Context, taskID string) (*entities.Tas
 	return taskModel.ToTaskEntity(), nil
 }
 
-func (r *repository) FindByUserID(ctx context.Context, userID string) ([]entities.Task, error) {
+// LockVersionByID returns the current version of a task and locks its row until the
+// transaction of ctx ends, so the version cannot change before the caller's update.
+// Returns 0 when the task does not exist.
+func (r *repository) LockVersionByID(ctx context.Context, taskID string) (int, error) {
 	query := `
-		SELECT 
-			id, user_id, title, description, priority, status, created_at, updated_at
+		SELECT version
 		FROM tasks
-		WHERE user_id = $1
-		ORDER BY created_at DESC
+		WHERE id = $1 AND deleted_at IS NULL
+		FOR UPDATE
 	`
 
+	var version int
+	err := r.conn(ctx).GetContext(ctx, &version, query, taskID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func (r *repository) FindAll(ctx context.Context, opts *ListOptions) ([]entities.Task, error) {
+	where := &whereBuilder{}
+	where.applyFilter(&opts.Filter)
+
+	direction := "ASC"
+	comparator := ">"
+	if opts.Descending {
+		direction = "DESC"
+		comparator = "<"
+	}
+
+	if opts.After != nil {
+		where.add(fmt.Sprintf("(%s, id) %s (%%s, %%s)", opts.SortField, comparator), opts.After.Value, opts.After.ID)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM tasks
+		%s
+		ORDER BY %s %s, id %s
+		LIMIT %d
+	`, taskColumns, where.String(), opts.SortField, direction, direction, opts.Limit)
+
 	var taskModels []Model
-	err := r.db.SelectContext(ctx, &taskModels, query, userID)
+	err := r.conn(ctx).SelectContext(ctx, &taskModels, query, where.args...)
 	if err != nil {
 		return nil, err
 	}
@@ -101,14 +211,105 @@ func (r *repository) FindByUserID(ctx context.Context, userID string) ([]entitie
 	return tasks, nil
 }
 
-func (r *repository) UpdateByID(ctx context.Context, taskID string, title, description string, priority enums.TaskPriority) error {
+// StreamAll runs the listing without a limit or keyset and hands each task to fn
+// as its row is read, so large results are never held in memory. An error from
+// fn stops the iteration and is returned.
+func (r *repository) StreamAll(ctx context.Context, opts *ListOptions, fn func(task *entities.Task) error) error {
+	where := &whereBuilder{}
+	where.applyFilter(&opts.Filter)
+
+	direction := "ASC"
+	if opts.Descending {
+		direction = "DESC"
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM tasks
+		%s
+		ORDER BY %s %s, id %s
+	`, taskColumns, where.String(), opts.SortField, direction, direction)
+
+	rows, err := r.conn(ctx).QueryxContext(ctx, query, where.args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var model Model
+		if err := rows.StructScan(&model); err != nil {
+			return err
+		}
+
+		if err := fn(model.ToTaskEntity()); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+func (r *repository) Count(ctx context.Context, filter *ListFilter) (int, error) {
+	where := &whereBuilder{}
+	where.applyFilter(filter)
+
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM tasks %s`, where.String())
+
+	var count int
+	if err := r.conn(ctx).GetContext(ctx, &count, query, where.args...); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *repository) Search(ctx context.Context, userID string, query string, limit, offset int) ([]entities.TaskSearchResult, error) {
+	sqlQuery := `
+		SELECT ` + taskColumns + `,
+			ts_rank(search_vector, query) AS rank,
+			ts_headline('simple', ` + htmlEscaped("title") + `, query, 'StartSel=<mark>, StopSel=</mark>, HighlightAll=true') AS title_highlight,
+			ts_headline('simple', ` + htmlEscaped("description") + `, query, 'StartSel=<mark>, StopSel=</mark>, MaxFragments=3, FragmentDelimiter=" ... "') AS description_highlight
+		FROM tasks, websearch_to_tsquery('simple', $2) AS query
+		WHERE search_vector @@ query
+			AND deleted_at IS NULL
+			AND (
+				(user_id = $1 AND workspace_id IS NULL)
+				OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE user_id = $1)
+			)
+		ORDER BY rank DESC, created_at DESC, id DESC
+		LIMIT $3 OFFSET $4
+	`
+
+	var resultModels []SearchResultModel
+	err := r.conn(ctx).SelectContext(ctx, &resultModels, sqlQuery, userID, query, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]entities.TaskSearchResult, len(resultModels))
+	for i, model := range resultModels {
+		results[i] = *model.ToTaskSearchResultEntity()
+	}
+
+	return results, nil
+}
+
+// htmlEscaped returns an SQL expression escaping the HTML special characters
+// of column, so the <mark> tags are the only markup in a search highlight
+func htmlEscaped(column string) string {
+	return `replace(replace(replace(replace(replace(` + column + `,
+		'&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;')`
+}
+
+func (r *repository) UpdateByID(ctx context.Context, taskID string, title, description string, priority enums.TaskPriority, startAt, dueAt *time.Time) error {
 	query := `
 		UPDATE tasks 
-		SET title = $1, description = $2, priority = $3, updated_at = $4
-		WHERE id = $5
+		SET title = $1, description = $2, priority = $3, start_at = $4, due_at = $5, updated_at = $6, version = version + 1
+		WHERE id = $7 AND deleted_at IS NULL
 	`
 
-	result, err := r.db.ExecContext(ctx, query, title, description, priority, timeutil.BangkokNow(), taskID)
+	result, err := r.conn(ctx).ExecContext(ctx, query, title, description, priority, startAt, dueAt, timeutil.BangkokNow(), taskID)
 	if err != nil {
 		return err
 	}
@@ -125,14 +326,14 @@ func (r *repository) UpdateByID(ctx context.Context, taskID string, title, descr
 	return nil
 }
 
-func (r *repository) UpdateStatusByID(ctx context.Context, taskID string, status enums.TaskStatus) error {
+func (r *repository) UpdateStatusByID(ctx context.Context, taskID string, status enums.TaskStatus, category enums.TaskStatusCategory) error {
 	query := `
 		UPDATE tasks 
-		SET status = $1, updated_at = $2
-		WHERE id = $3
+		SET status = $1, status_category = $2, updated_at = $3, version = version + 1
+		WHERE id = $4 AND deleted_at IS NULL
 	`
 
-	result, err := r.db.ExecContext(ctx, query, status, timeutil.BangkokNow(), taskID)
+	result, err := r.conn(ctx).ExecContext(ctx, query, status, category, timeutil.BangkokNow(), taskID)
 	if err != nil {
 		return err
 	}
@@ -149,10 +350,80 @@ func (r *repository) UpdateStatusByID(ctx context.Context, taskID string, status
 	return nil
 }
 
+func (r *repository) UpdateProjectByID(ctx context.Context, taskID string, projectID *string) error {
+	query := `
+		UPDATE tasks 
+		SET project_id = $1, updated_at = $2, version = version + 1
+		WHERE id = $3 AND deleted_at IS NULL
+	`
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, projectID, timeutil.BangkokNow(), taskID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// UpdateWorkspaceByID moves the task and all of its subtasks to a workspace, or back
+// to their owners' personal space when workspaceID is nil. Moving into a workspace
+// detaches the tasks from their personal projects, and assignees who cannot see
+// the tasks at their new place are unassigned.
+func (r *repository) UpdateWorkspaceByID(ctx context.Context, taskID string, workspaceID *string) error {
+	query := `
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM tasks WHERE id = $1
+			UNION ALL
+			SELECT t.id FROM tasks t JOIN subtree s ON t.parent_id = s.id
+		) CYCLE id SET is_cycle USING path, unassigned AS (
+			DELETE FROM task_assignees ta
+			USING tasks t
+			WHERE ta.task_id = t.id
+				AND t.id IN (SELECT id FROM subtree)
+				AND CASE
+					WHEN $2::uuid IS NULL THEN ta.user_id <> t.user_id
+					ELSE ta.user_id NOT IN (SELECT user_id FROM workspace_members WHERE workspace_id = $2)
+				END
+		)
+		UPDATE tasks
+		SET workspace_id = $2,
+			project_id = CASE WHEN $2::uuid IS NULL THEN project_id ELSE NULL END,
+			updated_at = $3,
+			version = version + 1
+		WHERE id IN (SELECT id FROM subtree)
+	`
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, taskID, workspaceID, timeutil.BangkokNow())
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// DeleteByID moves the task to the trash
 func (r *repository) DeleteByID(ctx context.Context, taskID string) error {
-	query := `DELETE FROM tasks WHERE id = $1`
+	query := `UPDATE tasks SET deleted_at = $2 WHERE id = $1 AND deleted_at IS NULL`
 
-	result, err := r.db.ExecContext(ctx, query, taskID)
+	result, err := r.conn(ctx).ExecContext(ctx, query, taskID, timeutil.BangkokNow())
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,150 @@
+package task
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+)
+
+// BoardColumn identifies the tasks ranked against each other: the tasks of a
+// workspace, or the personal tasks of a user, that share a status
+type BoardColumn struct {
+	UserID      string
+	WorkspaceID *string
+	Status      enums.TaskStatus
+}
+
+// key names the column for advisory locks
+func (c *BoardColumn) key() string {
+	if c.WorkspaceID != nil {
+		return "board:workspace:" + *c.WorkspaceID + ":" + c.Status.String()
+	}
+	return "board:user:" + c.UserID + ":" + c.Status.String()
+}
+
+// condition selects the tasks of the column, its arguments are $1 and $2
+func (c *BoardColumn) condition() (string, []any) {
+	if c.WorkspaceID != nil {
+		return "workspace_id = $1 AND status = $2 AND deleted_at IS NULL", []any{*c.WorkspaceID, c.Status}
+	}
+	return "user_id = $1 AND workspace_id IS NULL AND status = $2 AND deleted_at IS NULL", []any{c.UserID, c.Status}
+}
+
+// LockBoardColumn serializes rank changes of a column until the transaction of ctx ends
+func (r *repository) LockBoardColumn(ctx context.Context, column *BoardColumn) error {
+	query := `SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`
+
+	_, err := r.conn(ctx).ExecContext(ctx, query, column.key())
+	return err
+}
+
+// FindBoardRankAfter returns the lowest rank of the column above rank, ignoring
+// excludeTaskID. An empty rank finds the first one. Returns "" when there is none.
+func (r *repository) FindBoardRankAfter(ctx context.Context, column *BoardColumn, rank string, excludeTaskID string) (string, error) {
+	condition, args := column.condition()
+	query := `
+		SELECT board_rank
+		FROM tasks
+		WHERE ` + condition + ` AND board_rank > $3 AND id <> $4
+		ORDER BY board_rank ASC
+		LIMIT 1
+	`
+
+	return r.findBoardRank(ctx, query, append(args, rank, excludeTaskID)...)
+}
+
+// FindBoardRankBefore returns the highest rank of the column below rank, ignoring
+// excludeTaskID. Returns "" when there is none.
+func (r *repository) FindBoardRankBefore(ctx context.Context, column *BoardColumn, rank string, excludeTaskID string) (string, error) {
+	condition, args := column.condition()
+	query := `
+		SELECT board_rank
+		FROM tasks
+		WHERE ` + condition + ` AND board_rank < $3 AND id <> $4
+		ORDER BY board_rank DESC
+		LIMIT 1
+	`
+
+	return r.findBoardRank(ctx, query, append(args, rank, excludeTaskID)...)
+}
+
+func (r *repository) UpdateBoardRankByID(ctx context.Context, taskID string, rank string) error {
+	query := `UPDATE tasks SET board_rank = $1 WHERE id = $2 AND deleted_at IS NULL`
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, rank, taskID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// RebalanceBoardColumn spreads the ranks of a column out evenly, keeping their order
+// and separating tasks that ended up with the same rank
+func (r *repository) RebalanceBoardColumn(ctx context.Context, column *BoardColumn) error {
+	condition, args := column.condition()
+	query := `
+		UPDATE tasks
+		SET board_rank = ranked.board_rank
+		FROM (
+			SELECT id, lpad(to_hex(row_number() OVER (ORDER BY board_rank ASC, id ASC)), 8, '0') || 'i' AS board_rank
+			FROM tasks
+			WHERE ` + condition + `
+		) ranked
+		WHERE tasks.id = ranked.id
+	`
+
+	_, err := r.conn(ctx).ExecContext(ctx, query, args...)
+	return err
+}
+
+// CountByStatus counts the tasks matching filter per status
+func (r *repository) CountByStatus(ctx context.Context, filter *ListFilter) (map[enums.TaskStatus]int, error) {
+	where := &whereBuilder{}
+	where.applyFilter(filter)
+
+	query := fmt.Sprintf(`
+		SELECT status, COUNT(*) AS count
+		FROM tasks
+		%s
+		GROUP BY status
+	`, where.String())
+
+	var countModels []StatusCountModel
+	err := r.conn(ctx).SelectContext(ctx, &countModels, query, where.args...)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[enums.TaskStatus]int, len(countModels))
+	for _, model := range countModels {
+		counts[enums.TaskStatus(model.Status)] = model.Count
+	}
+
+	return counts, nil
+}
+
+func (r *repository) findBoardRank(ctx context.Context, query string, args ...any) (string, error) {
+	var rank string
+	err := r.conn(ctx).GetContext(ctx, &rank, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return rank, nil
+}
@@ -0,0 +1,98 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/lib/pq"
+)
+
+// SetCustomFieldValues stores the values keyed by field ID in a single statement.
+// A nil value removes the task's value for that field, fields left out are unchanged.
+func (r *repository) SetCustomFieldValues(ctx context.Context, taskID string, values map[string]json.RawMessage) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	var clearedIDs, setIDs, setValues []string
+	for fieldID, value := range values {
+		if value == nil {
+			clearedIDs = append(clearedIDs, fieldID)
+			continue
+		}
+		setIDs = append(setIDs, fieldID)
+		setValues = append(setValues, string(value))
+	}
+
+	query := `
+		WITH removed AS (
+			DELETE FROM task_custom_field_values
+			WHERE task_id = $1 AND field_id = ANY($2::uuid[])
+		)
+		INSERT INTO task_custom_field_values (task_id, field_id, value, updated_at)
+		SELECT $1, v.field_id, v.value::jsonb, $5
+		FROM unnest($3::uuid[], $4::text[]) AS v (field_id, value)
+		ON CONFLICT (task_id, field_id) DO UPDATE
+		SET value = EXCLUDED.value, updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.conn(ctx).ExecContext(ctx, query,
+		taskID, pq.Array(clearedIDs), pq.Array(setIDs), pq.Array(setValues), timeutil.BangkokNow(),
+	)
+	return err
+}
+
+// FindCustomFieldValuesByTaskIDs returns the custom field values of each task keyed by task ID.
+// Only fields of the task's current workspace, or of its project for personal tasks, are returned,
+// so values kept from before a move stay hidden. Tasks without values are absent from the map.
+func (r *repository) FindCustomFieldValuesByTaskIDs(ctx context.Context, taskIDs []string) (map[string][]entities.TaskCustomFieldValue, error) {
+	if len(taskIDs) == 0 {
+		return map[string][]entities.TaskCustomFieldValue{}, nil
+	}
+
+	query := `
+		SELECT v.task_id, v.field_id, f.name, f.type, v.value
+		FROM task_custom_field_values v
+		JOIN tasks t ON t.id = v.task_id
+		JOIN custom_fields f ON f.id = v.field_id
+		WHERE v.task_id = ANY($1)
+			AND (f.workspace_id = t.workspace_id OR (t.workspace_id IS NULL AND f.project_id = t.project_id))
+		ORDER BY f.created_at ASC, f.id ASC
+	`
+
+	var valueModels []TaskCustomFieldValueModel
+	err := r.conn(ctx).SelectContext(ctx, &valueModels, query, pq.Array(taskIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string][]entities.TaskCustomFieldValue, len(taskIDs))
+	for _, model := range valueModels {
+		taskID := model.TaskID.String()
+		values[taskID] = append(values[taskID], *model.ToTaskCustomFieldValueEntity())
+	}
+
+	return values, nil
+}
+
+// DeleteStaleCustomFieldValues removes the values of the tasks whose field is not defined
+// at the task's current place, as a move to another workspace or project leaves behind
+func (r *repository) DeleteStaleCustomFieldValues(ctx context.Context, taskIDs []string) error {
+	if len(taskIDs) == 0 {
+		return nil
+	}
+
+	query := `
+		DELETE FROM task_custom_field_values v
+		USING tasks t, custom_fields f
+		WHERE v.task_id = ANY($1)
+			AND t.id = v.task_id
+			AND f.id = v.field_id
+			AND (f.workspace_id = t.workspace_id OR (t.workspace_id IS NULL AND f.project_id = t.project_id)) IS NOT TRUE
+	`
+
+	_, err := r.conn(ctx).ExecContext(ctx, query, pq.Array(taskIDs))
+	return err
+}
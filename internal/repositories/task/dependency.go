@@ -0,0 +1,119 @@
+package task
+
+import (
+	"context"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+)
+
+// CreateDependency records that taskID is blocked by blockedByID.
+// Returns ErrNoRowsAffected when the dependency already exists.
+func (r *repository) CreateDependency(ctx context.Context, taskID string, blockedByID string) error {
+	query := `
+		INSERT INTO task_dependencies (task_id, blocked_by_task_id, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (task_id, blocked_by_task_id) DO NOTHING
+	`
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, taskID, blockedByID, timeutil.BangkokNow())
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+func (r *repository) DeleteDependency(ctx context.Context, taskID string, blockedByID string) error {
+	query := `DELETE FROM task_dependencies WHERE task_id = $1 AND blocked_by_task_id = $2`
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, taskID, blockedByID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// FindBlockersByID returns the tasks that directly block the given task
+func (r *repository) FindBlockersByID(ctx context.Context, taskID string) ([]entities.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks
+		WHERE id IN (
+			SELECT blocked_by_task_id FROM task_dependencies WHERE task_id = $1
+		)
+			AND deleted_at IS NULL
+		ORDER BY created_at ASC, id ASC
+	`
+
+	var taskModels []Model
+	err := r.conn(ctx).SelectContext(ctx, &taskModels, query, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]entities.Task, len(taskModels))
+	for i, model := range taskModels {
+		tasks[i] = *model.ToTaskEntity()
+	}
+
+	return tasks, nil
+}
+
+// LockDependencies serializes dependency changes among the tasks of a workspace
+// until the transaction of ctx ends. Personal tasks of different users can
+// depend on each other, so they share a single lock.
+func (r *repository) LockDependencies(ctx context.Context, workspaceID *string) error {
+	query := `SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`
+
+	key := "dependencies:personal"
+	if workspaceID != nil {
+		key = "dependencies:workspace:" + *workspaceID
+	}
+
+	_, err := r.conn(ctx).ExecContext(ctx, query, key)
+	return err
+}
+
+// FindTransitiveBlockerIDs returns every task the given task depends on, directly or not
+func (r *repository) FindTransitiveBlockerIDs(ctx context.Context, taskID string) ([]string, error) {
+	query := `
+		WITH RECURSIVE blockers AS (
+			SELECT blocked_by_task_id
+			FROM task_dependencies
+			WHERE task_id = $1
+			UNION
+			SELECT d.blocked_by_task_id
+			FROM task_dependencies d
+			JOIN blockers b ON d.task_id = b.blocked_by_task_id
+		)
+		SELECT blocked_by_task_id FROM blockers
+	`
+
+	var blockerIDs []string
+	err := r.conn(ctx).SelectContext(ctx, &blockerIDs, query, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	return blockerIDs, nil
+}
@@ -3,5 +3,7 @@ package task
 import "errors"
 
 var (
-	ErrNullTask = errors.New("task entity cannot be null")
+	ErrNullTask       = errors.New("task entity cannot be null")
+	ErrNullTaskSeries = errors.New("task series entity cannot be null")
+	ErrNoRowsAffected = errors.New("no rows affected")
 )
@@ -0,0 +1,186 @@
+package task
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/lib/pq"
+)
+
+type SortField string
+
+const (
+	SortFieldPriority  SortField = "priority"
+	SortFieldTitle     SortField = "title"
+	SortFieldCreatedAt SortField = "created_at"
+	SortFieldUpdatedAt SortField = "updated_at"
+	SortFieldBoardRank SortField = "board_rank"
+)
+
+// ListFilter narrows down the tasks returned by a listing query.
+// Listings are always scoped: to a workspace when WorkspaceID is set,
+// otherwise to the personal tasks of OwnerID, widened to every workspace
+// OwnerID belongs to when IncludeWorkspaces is set. Trashed tasks are
+// left out, or listed alone when Deleted is set. Other zero values are ignored.
+type ListFilter struct {
+	OwnerID           string
+	WorkspaceID       *string
+	IncludeWorkspaces bool
+	Deleted           bool
+
+	Statuses []enums.TaskStatus
+	// StatusCategories keeps tasks whose status falls in any of the categories
+	StatusCategories []enums.TaskStatusCategory
+	Priorities       []enums.TaskPriority
+	CreatedFrom      *time.Time
+	CreatedTo        *time.Time
+	UpdatedFrom      *time.Time
+	UpdatedTo        *time.Time
+	// OverdueAt keeps only tasks not done whose due date is before this instant
+	OverdueAt *time.Time
+	// TagIDs keeps tasks carrying any of the tags, or all of them when MatchAllTags is set
+	TagIDs       []string
+	MatchAllTags bool
+	// AssigneeIDs keeps tasks assigned to any of the users
+	AssigneeIDs []string
+	// ProjectID keeps only the tasks of a single project
+	ProjectID *string
+	// ExcludeArchivedProjects hides tasks belonging to archived projects
+	ExcludeArchivedProjects bool
+	// CustomFields keeps tasks whose values contain every filter value
+	CustomFields []CustomFieldFilter
+}
+
+// CustomFieldFilter matches tasks whose value of a field contains Value,
+// a JSON scalar for most fields or an array of options for multi-select fields
+type CustomFieldFilter struct {
+	FieldID string
+	Value   json.RawMessage
+}
+
+// Keyset identifies the row a page starts after
+type Keyset struct {
+	Value any
+	ID    string
+}
+
+// ListOptions describes a single keyset-paginated listing query
+type ListOptions struct {
+	Filter     ListFilter
+	SortField  SortField
+	Descending bool
+	After      *Keyset
+	Limit      int
+}
+
+// whereBuilder accumulates SQL conditions with positional arguments
+type whereBuilder struct {
+	conditions []string
+	args       []any
+}
+
+func (b *whereBuilder) add(condition string, args ...any) {
+	placeholders := make([]any, len(args))
+	for i, arg := range args {
+		b.args = append(b.args, arg)
+		placeholders[i] = fmt.Sprintf("$%d", len(b.args))
+	}
+
+	b.conditions = append(b.conditions, fmt.Sprintf(condition, placeholders...))
+}
+
+func (b *whereBuilder) String() string {
+	if len(b.conditions) == 0 {
+		return ""
+	}
+
+	return "WHERE " + strings.Join(b.conditions, " AND ")
+}
+
+func (b *whereBuilder) applyFilter(filter *ListFilter) {
+	switch {
+	case filter.WorkspaceID != nil:
+		b.add("workspace_id = %s", *filter.WorkspaceID)
+	case filter.IncludeWorkspaces:
+		b.add("((user_id = %s AND workspace_id IS NULL) OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE user_id = %s))", filter.OwnerID, filter.OwnerID)
+	default:
+		b.add("user_id = %s AND workspace_id IS NULL", filter.OwnerID)
+	}
+
+	if filter.Deleted {
+		b.add("deleted_at IS NOT NULL")
+	} else {
+		b.add("deleted_at IS NULL")
+	}
+
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, len(filter.Statuses))
+		for i, status := range filter.Statuses {
+			statuses[i] = status.String()
+		}
+		b.add("status = ANY(%s)", pq.Array(statuses))
+	}
+
+	if len(filter.StatusCategories) > 0 {
+		categories := make([]string, len(filter.StatusCategories))
+		for i, category := range filter.StatusCategories {
+			categories[i] = category.String()
+		}
+		b.add("status_category = ANY(%s)", pq.Array(categories))
+	}
+
+	if len(filter.Priorities) > 0 {
+		priorities := make([]int64, len(filter.Priorities))
+		for i, priority := range filter.Priorities {
+			priorities[i] = int64(priority.Int())
+		}
+		b.add("priority = ANY(%s)", pq.Array(priorities))
+	}
+
+	if filter.CreatedFrom != nil {
+		b.add("created_at >= %s", *filter.CreatedFrom)
+	}
+
+	if filter.CreatedTo != nil {
+		b.add("created_at <= %s", *filter.CreatedTo)
+	}
+
+	if filter.UpdatedFrom != nil {
+		b.add("updated_at >= %s", *filter.UpdatedFrom)
+	}
+
+	if filter.UpdatedTo != nil {
+		b.add("updated_at <= %s", *filter.UpdatedTo)
+	}
+
+	if filter.OverdueAt != nil {
+		b.add("due_at < %s AND status_category <> %s", *filter.OverdueAt, enums.TaskStatusCategoryDone.String())
+	}
+
+	if len(filter.AssigneeIDs) > 0 {
+		b.add("id IN (SELECT task_id FROM task_assignees WHERE user_id = ANY(%s))", pq.Array(filter.AssigneeIDs))
+	}
+
+	if filter.ProjectID != nil {
+		b.add("project_id = %s", *filter.ProjectID)
+	}
+
+	if filter.ExcludeArchivedProjects {
+		b.add("(project_id IS NULL OR project_id NOT IN (SELECT id FROM projects WHERE archived_at IS NOT NULL))")
+	}
+
+	if len(filter.TagIDs) > 0 {
+		if filter.MatchAllTags {
+			b.add("id IN (SELECT task_id FROM task_tags WHERE tag_id = ANY(%s) GROUP BY task_id HAVING COUNT(DISTINCT tag_id) = %s)", pq.Array(filter.TagIDs), len(filter.TagIDs))
+		} else {
+			b.add("id IN (SELECT task_id FROM task_tags WHERE tag_id = ANY(%s))", pq.Array(filter.TagIDs))
+		}
+	}
+
+	for _, field := range filter.CustomFields {
+		b.add("id IN (SELECT task_id FROM task_custom_field_values WHERE field_id = %s AND value @> %s::jsonb)", field.FieldID, string(field.Value))
+	}
+}
@@ -0,0 +1,251 @@
+package task
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/lib/pq"
+)
+
+func (r *repository) FindByParentID(ctx context.Context, parentID string) ([]entities.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks
+		WHERE parent_id = $1 AND deleted_at IS NULL
+		ORDER BY created_at ASC, id ASC
+	`
+
+	var taskModels []Model
+	err := r.conn(ctx).SelectContext(ctx, &taskModels, query, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]entities.Task, len(taskModels))
+	for i, model := range taskModels {
+		tasks[i] = *model.ToTaskEntity()
+	}
+
+	return tasks, nil
+}
+
+// FindSubtreeByID returns the task itself followed by all of its descendants
+func (r *repository) FindSubtreeByID(ctx context.Context, taskID string) ([]entities.Task, error) {
+	query := `
+		WITH RECURSIVE subtree AS (
+			SELECT id, 0 AS depth
+			FROM tasks
+			WHERE id = $1 AND deleted_at IS NULL
+			UNION ALL
+			SELECT t.id, s.depth + 1
+			FROM tasks t
+			JOIN subtree s ON t.parent_id = s.id
+			WHERE t.deleted_at IS NULL
+		) CYCLE id SET is_cycle USING path
+		SELECT ` + taskColumns + `
+		FROM tasks
+		JOIN subtree USING (id)
+		WHERE NOT is_cycle
+		ORDER BY depth ASC, created_at ASC, id ASC
+	`
+
+	var taskModels []Model
+	err := r.conn(ctx).SelectContext(ctx, &taskModels, query, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]entities.Task, len(taskModels))
+	for i, model := range taskModels {
+		tasks[i] = *model.ToTaskEntity()
+	}
+
+	return tasks, nil
+}
+
+// FindAncestorIDs returns the IDs from the task's parent up to its root
+func (r *repository) FindAncestorIDs(ctx context.Context, taskID string) ([]string, error) {
+	query := `
+		WITH RECURSIVE ancestors AS (
+			SELECT parent_id, 1 AS depth
+			FROM tasks
+			WHERE id = $1
+			UNION ALL
+			SELECT t.parent_id, a.depth + 1
+			FROM tasks t
+			JOIN ancestors a ON t.id = a.parent_id
+		) CYCLE parent_id SET is_cycle USING path
+		SELECT parent_id
+		FROM ancestors
+		WHERE parent_id IS NOT NULL AND NOT is_cycle
+		ORDER BY depth ASC
+	`
+
+	var ancestorIDs []string
+	err := r.conn(ctx).SelectContext(ctx, &ancestorIDs, query, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ancestorIDs, nil
+}
+
+// LockAncestorIDs locks the task's ancestors from its parent up to its root and
+// returns their IDs. The rows are locked one at a time, each parent is read from
+// a row already locked so the chain cannot change until the transaction ends.
+func (r *repository) LockAncestorIDs(ctx context.Context, taskID string) ([]string, error) {
+	query := `SELECT parent_id FROM tasks WHERE id = $1 FOR UPDATE`
+
+	var ancestorIDs []string
+	visited := map[string]bool{taskID: true}
+	id := taskID
+	for {
+		var parentID *string
+		err := r.conn(ctx).GetContext(ctx, &parentID, query, id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ancestorIDs, nil
+			}
+			return nil, err
+		}
+
+		// Stop at the root, or where corrupt data loops back
+		if parentID == nil || visited[*parentID] {
+			return ancestorIDs, nil
+		}
+
+		visited[*parentID] = true
+		ancestorIDs = append(ancestorIDs, *parentID)
+		id = *parentID
+	}
+}
+
+// CountSubtasksByIDs counts all descendants of each given task, keyed by task ID.
+// Tasks without subtasks are absent from the result.
+func (r *repository) CountSubtasksByIDs(ctx context.Context, taskIDs []string) (map[string]entities.SubtaskRollup, error) {
+	rollups := make(map[string]entities.SubtaskRollup)
+	if len(taskIDs) == 0 {
+		return rollups, nil
+	}
+
+	query := `
+		WITH RECURSIVE descendants AS (
+			SELECT parent_id AS root_id, id, status_category
+			FROM tasks
+			WHERE parent_id = ANY($1) AND deleted_at IS NULL
+			UNION ALL
+			SELECT d.root_id, t.id, t.status_category
+			FROM tasks t
+			JOIN descendants d ON t.parent_id = d.id
+			WHERE t.deleted_at IS NULL
+		) CYCLE id SET is_cycle USING path
+		SELECT
+			root_id,
+			COUNT(*) AS total,
+			COUNT(*) FILTER (WHERE status_category = $2) AS completed
+		FROM descendants
+		WHERE NOT is_cycle
+		GROUP BY root_id
+	`
+
+	var rollupModels []SubtaskRollupModel
+	err := r.conn(ctx).SelectContext(ctx, &rollupModels, query, pq.Array(taskIDs), enums.TaskStatusCategoryDone.String())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, model := range rollupModels {
+		rollups[model.RootID.String()] = entities.SubtaskRollup{
+			Total:     model.Total,
+			Completed: model.Completed,
+		}
+	}
+
+	return rollups, nil
+}
+
+func (r *repository) UpdateParentByID(ctx context.Context, taskID string, parentID *string) error {
+	query := `
+		UPDATE tasks 
+		SET parent_id = $1, updated_at = $2, version = version + 1
+		WHERE id = $3 AND deleted_at IS NULL
+	`
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, parentID, timeutil.BangkokNow(), taskID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return errors.New("no rows affected")
+	}
+
+	return nil
+}
+
+// DeleteTreeByID moves the task together with all of its descendants to the trash.
+// They share the same deletion time so they can be restored together.
+func (r *repository) DeleteTreeByID(ctx context.Context, taskID string) error {
+	query := `
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM tasks WHERE id = $1 AND deleted_at IS NULL
+			UNION ALL
+			SELECT t.id FROM tasks t JOIN subtree s ON t.parent_id = s.id WHERE t.deleted_at IS NULL
+		) CYCLE id SET is_cycle USING path
+		UPDATE tasks SET deleted_at = $2 WHERE id IN (SELECT id FROM subtree)
+	`
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, taskID, timeutil.BangkokNow())
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return errors.New("no rows affected")
+	}
+
+	return nil
+}
+
+// DeleteByIDAndReparentChildren moves the task's live children under newParentID
+// and the task to the trash in a single statement
+func (r *repository) DeleteByIDAndReparentChildren(ctx context.Context, taskID string, newParentID *string) error {
+	query := `
+		WITH reparented AS (
+			UPDATE tasks
+			SET parent_id = $2, updated_at = $3, version = version + 1
+			WHERE parent_id = $1 AND deleted_at IS NULL
+		)
+		UPDATE tasks SET deleted_at = $3 WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, taskID, newParentID, timeutil.BangkokNow())
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return errors.New("no rows affected")
+	}
+
+	return nil
+}
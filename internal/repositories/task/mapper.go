@@ -21,27 +21,169 @@ func FromTaskEntity(entity *entities.Task) (*Model, error) {
 		return nil, err
 	}
 
+	workspaceUUID, err := parseOptionalUUID(entity.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	projectUUID, err := parseOptionalUUID(entity.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	parentUUID, err := parseOptionalUUID(entity.ParentID)
+	if err != nil {
+		return nil, err
+	}
+
+	seriesUUID, err := parseOptionalUUID(entity.SeriesID)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Model{
-		ID:          taskUUID,
-		UserID:      userUUID,
-		Title:       entity.Title,
-		Description: entity.Description,
-		Priority:    entity.Priority.Int(),
-		Status:      entity.Status.String(),
-		CreatedAt:   entity.CreatedAt,
-		UpdatedAt:   entity.UpdatedAt,
+		ID:             taskUUID,
+		UserID:         userUUID,
+		WorkspaceID:    workspaceUUID,
+		ProjectID:      projectUUID,
+		ParentID:       parentUUID,
+		Title:          entity.Title,
+		Description:    entity.Description,
+		Priority:       entity.Priority.Int(),
+		Status:         entity.Status.String(),
+		StatusCategory: entity.StatusCategory.String(),
+		StartAt:        entity.StartAt,
+		DueAt:          entity.DueAt,
+		CreatedAt:      entity.CreatedAt,
+		UpdatedAt:      entity.UpdatedAt,
+		DeletedAt:      entity.DeletedAt,
+		SeriesID:       seriesUUID,
+		BoardRank:      entity.BoardRank,
+		Version:        entity.Version,
 	}, nil
 }
 
 func (m *Model) ToTaskEntity() *entities.Task {
 	return &entities.Task{
-		ID:          m.ID.String(),
-		UserID:      m.UserID.String(),
-		Title:       m.Title,
-		Description: m.Description,
-		Priority:    enums.TaskPriority(m.Priority),
-		Status:      enums.TaskStatus(m.Status),
-		CreatedAt:   m.CreatedAt,
-		UpdatedAt:   m.UpdatedAt,
+		ID:             m.ID.String(),
+		UserID:         m.UserID.String(),
+		WorkspaceID:    optionalUUIDString(m.WorkspaceID),
+		ProjectID:      optionalUUIDString(m.ProjectID),
+		ParentID:       optionalUUIDString(m.ParentID),
+		Title:          m.Title,
+		Description:    m.Description,
+		Priority:       enums.TaskPriority(m.Priority),
+		Status:         enums.TaskStatus(m.Status),
+		StatusCategory: enums.TaskStatusCategory(m.StatusCategory),
+		StartAt:        m.StartAt,
+		DueAt:          m.DueAt,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+		DeletedAt:      m.DeletedAt,
+		SeriesID:       optionalUUIDString(m.SeriesID),
+		BoardRank:      m.BoardRank,
+		Version:        m.Version,
+	}
+}
+
+func FromTaskSeriesEntity(entity *entities.TaskSeries) (*SeriesModel, error) {
+	if entity == nil {
+		return nil, ErrNullTaskSeries
+	}
+
+	seriesUUID, err := uuid.Parse(entity.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	userUUID, err := uuid.Parse(entity.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SeriesModel{
+		ID:               seriesUUID,
+		UserID:           userUUID,
+		Rule:             entity.Rule,
+		AnchorAt:         entity.AnchorAt,
+		LastOccurrenceAt: entity.LastOccurrenceAt,
+		StoppedAt:        entity.StoppedAt,
+		CreatedAt:        entity.CreatedAt,
+		UpdatedAt:        entity.UpdatedAt,
+	}, nil
+}
+
+func (m *SeriesModel) ToTaskSeriesEntity() *entities.TaskSeries {
+	return &entities.TaskSeries{
+		ID:               m.ID.String(),
+		UserID:           m.UserID.String(),
+		Rule:             m.Rule,
+		AnchorAt:         m.AnchorAt,
+		LastOccurrenceAt: m.LastOccurrenceAt,
+		StoppedAt:        m.StoppedAt,
+		CreatedAt:        m.CreatedAt,
+		UpdatedAt:        m.UpdatedAt,
+	}
+}
+
+func (m *SearchResultModel) ToTaskSearchResultEntity() *entities.TaskSearchResult {
+	return &entities.TaskSearchResult{
+		Task:                 *m.Model.ToTaskEntity(),
+		Rank:                 m.Rank,
+		TitleHighlight:       m.TitleHighlight,
+		DescriptionHighlight: m.DescriptionHighlight,
+	}
+}
+
+func (m *TaskTagModel) ToTagEntity() *entities.Tag {
+	return &entities.Tag{
+		ID:        m.ID.String(),
+		UserID:    m.UserID.String(),
+		Name:      m.Name,
+		Color:     m.Color,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+func (m *TaskAssigneeModel) ToTaskAssigneeEntity() *entities.TaskAssignee {
+	return &entities.TaskAssignee{
+		UserSummary: entities.UserSummary{
+			ID:    m.UserID.String(),
+			Name:  m.Name,
+			Email: m.Email,
+		},
+		AssignedAt: m.CreatedAt,
+	}
+}
+
+func parseOptionalUUID(id *string) (*uuid.UUID, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	parsed, err := uuid.Parse(*id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsed, nil
+}
+
+func optionalUUIDString(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+
+	s := id.String()
+	return &s
+}
+
+func (m *TaskCustomFieldValueModel) ToTaskCustomFieldValueEntity() *entities.TaskCustomFieldValue {
+	return &entities.TaskCustomFieldValue{
+		FieldID: m.FieldID.String(),
+		Name:    m.Name,
+		Type:    enums.CustomFieldType(m.Type),
+		Value:   m.Value,
 	}
 }
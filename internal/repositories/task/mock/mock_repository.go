@@ -6,9 +6,12 @@ package mock_task
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
 	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/task"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -39,53 +42,2809 @@ func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
 	return &MockRepository_Expecter{mock: &_m.Mock}
 }
 
+// ClaimSeriesOccurrence provides a mock function for the type MockRepository
+func (_mock *MockRepository) ClaimSeriesOccurrence(ctx context.Context, seriesID string, occurrenceAt time.Time) (bool, error) {
+	ret := _mock.Called(ctx, seriesID, occurrenceAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimSeriesOccurrence")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) (bool, error)); ok {
+		return returnFunc(ctx, seriesID, occurrenceAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) bool); ok {
+		r0 = returnFunc(ctx, seriesID, occurrenceAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, seriesID, occurrenceAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_ClaimSeriesOccurrence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimSeriesOccurrence'
+type MockRepository_ClaimSeriesOccurrence_Call struct {
+	*mock.Call
+}
+
+// ClaimSeriesOccurrence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - seriesID string
+//   - occurrenceAt time.Time
+func (_e *MockRepository_Expecter) ClaimSeriesOccurrence(ctx interface{}, seriesID interface{}, occurrenceAt interface{}) *MockRepository_ClaimSeriesOccurrence_Call {
+	return &MockRepository_ClaimSeriesOccurrence_Call{Call: _e.mock.On("ClaimSeriesOccurrence", ctx, seriesID, occurrenceAt)}
+}
+
+func (_c *MockRepository_ClaimSeriesOccurrence_Call) Run(run func(ctx context.Context, seriesID string, occurrenceAt time.Time)) *MockRepository_ClaimSeriesOccurrence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_ClaimSeriesOccurrence_Call) Return(b bool, err error) *MockRepository_ClaimSeriesOccurrence_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockRepository_ClaimSeriesOccurrence_Call) RunAndReturn(run func(ctx context.Context, seriesID string, occurrenceAt time.Time) (bool, error)) *MockRepository_ClaimSeriesOccurrence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Count provides a mock function for the type MockRepository
+func (_mock *MockRepository) Count(ctx context.Context, filter *task.ListFilter) (int, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.ListFilter) (int, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.ListFilter) int); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *task.ListFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type MockRepository_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *task.ListFilter
+func (_e *MockRepository_Expecter) Count(ctx interface{}, filter interface{}) *MockRepository_Count_Call {
+	return &MockRepository_Count_Call{Call: _e.mock.On("Count", ctx, filter)}
+}
+
+func (_c *MockRepository_Count_Call) Run(run func(ctx context.Context, filter *task.ListFilter)) *MockRepository_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *task.ListFilter
+		if args[1] != nil {
+			arg1 = args[1].(*task.ListFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_Count_Call) Return(n int, err error) *MockRepository_Count_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRepository_Count_Call) RunAndReturn(run func(ctx context.Context, filter *task.ListFilter) (int, error)) *MockRepository_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountByStatus provides a mock function for the type MockRepository
+func (_mock *MockRepository) CountByStatus(ctx context.Context, filter *task.ListFilter) (map[enums.TaskStatus]int, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByStatus")
+	}
+
+	var r0 map[enums.TaskStatus]int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.ListFilter) (map[enums.TaskStatus]int, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.ListFilter) map[enums.TaskStatus]int); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[enums.TaskStatus]int)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *task.ListFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_CountByStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByStatus'
+type MockRepository_CountByStatus_Call struct {
+	*mock.Call
+}
+
+// CountByStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *task.ListFilter
+func (_e *MockRepository_Expecter) CountByStatus(ctx interface{}, filter interface{}) *MockRepository_CountByStatus_Call {
+	return &MockRepository_CountByStatus_Call{Call: _e.mock.On("CountByStatus", ctx, filter)}
+}
+
+func (_c *MockRepository_CountByStatus_Call) Run(run func(ctx context.Context, filter *task.ListFilter)) *MockRepository_CountByStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *task.ListFilter
+		if args[1] != nil {
+			arg1 = args[1].(*task.ListFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_CountByStatus_Call) Return(m map[enums.TaskStatus]int, err error) *MockRepository_CountByStatus_Call {
+	_c.Call.Return(m, err)
+	return _c
+}
+
+func (_c *MockRepository_CountByStatus_Call) RunAndReturn(run func(ctx context.Context, filter *task.ListFilter) (map[enums.TaskStatus]int, error)) *MockRepository_CountByStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountSubtasksByIDs provides a mock function for the type MockRepository
+func (_mock *MockRepository) CountSubtasksByIDs(ctx context.Context, taskIDs []string) (map[string]entities.SubtaskRollup, error) {
+	ret := _mock.Called(ctx, taskIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountSubtasksByIDs")
+	}
+
+	var r0 map[string]entities.SubtaskRollup
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) (map[string]entities.SubtaskRollup, error)); ok {
+		return returnFunc(ctx, taskIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) map[string]entities.SubtaskRollup); ok {
+		r0 = returnFunc(ctx, taskIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]entities.SubtaskRollup)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, taskIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_CountSubtasksByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSubtasksByIDs'
+type MockRepository_CountSubtasksByIDs_Call struct {
+	*mock.Call
+}
+
+// CountSubtasksByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskIDs []string
+func (_e *MockRepository_Expecter) CountSubtasksByIDs(ctx interface{}, taskIDs interface{}) *MockRepository_CountSubtasksByIDs_Call {
+	return &MockRepository_CountSubtasksByIDs_Call{Call: _e.mock.On("CountSubtasksByIDs", ctx, taskIDs)}
+}
+
+func (_c *MockRepository_CountSubtasksByIDs_Call) Run(run func(ctx context.Context, taskIDs []string)) *MockRepository_CountSubtasksByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_CountSubtasksByIDs_Call) Return(m map[string]entities.SubtaskRollup, err error) *MockRepository_CountSubtasksByIDs_Call {
+	_c.Call.Return(m, err)
+	return _c
+}
+
+func (_c *MockRepository_CountSubtasksByIDs_Call) RunAndReturn(run func(ctx context.Context, taskIDs []string) (map[string]entities.SubtaskRollup, error)) *MockRepository_CountSubtasksByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockRepository
 func (_mock *MockRepository) Create(ctx context.Context, task *entities.Task) (string, error) {
 	ret := _mock.Called(ctx, task)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for Create")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Task) (string, error)); ok {
+		return returnFunc(ctx, task)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Task) string); ok {
+		r0 = returnFunc(ctx, task)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Task) error); ok {
+		r1 = returnFunc(ctx, task)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - task *entities.Task
+func (_e *MockRepository_Expecter) Create(ctx interface{}, task interface{}) *MockRepository_Create_Call {
+	return &MockRepository_Create_Call{Call: _e.mock.On("Create", ctx, task)}
+}
+
+func (_c *MockRepository_Create_Call) Run(run func(ctx context.Context, task *entities.Task)) *MockRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Task
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Task)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_Create_Call) Return(s string, err error) *MockRepository_Create_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockRepository_Create_Call) RunAndReturn(run func(ctx context.Context, task *entities.Task) (string, error)) *MockRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAssignee provides a mock function for the type MockRepository
+func (_mock *MockRepository) CreateAssignee(ctx context.Context, taskID string, userID string) error {
+	ret := _mock.Called(ctx, taskID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAssignee")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, taskID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_CreateAssignee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAssignee'
+type MockRepository_CreateAssignee_Call struct {
+	*mock.Call
+}
+
+// CreateAssignee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - userID string
+func (_e *MockRepository_Expecter) CreateAssignee(ctx interface{}, taskID interface{}, userID interface{}) *MockRepository_CreateAssignee_Call {
+	return &MockRepository_CreateAssignee_Call{Call: _e.mock.On("CreateAssignee", ctx, taskID, userID)}
+}
+
+func (_c *MockRepository_CreateAssignee_Call) Run(run func(ctx context.Context, taskID string, userID string)) *MockRepository_CreateAssignee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_CreateAssignee_Call) Return(err error) *MockRepository_CreateAssignee_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_CreateAssignee_Call) RunAndReturn(run func(ctx context.Context, taskID string, userID string) error) *MockRepository_CreateAssignee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateDependency provides a mock function for the type MockRepository
+func (_mock *MockRepository) CreateDependency(ctx context.Context, taskID string, blockedByID string) error {
+	ret := _mock.Called(ctx, taskID, blockedByID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDependency")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, taskID, blockedByID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_CreateDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDependency'
+type MockRepository_CreateDependency_Call struct {
+	*mock.Call
+}
+
+// CreateDependency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - blockedByID string
+func (_e *MockRepository_Expecter) CreateDependency(ctx interface{}, taskID interface{}, blockedByID interface{}) *MockRepository_CreateDependency_Call {
+	return &MockRepository_CreateDependency_Call{Call: _e.mock.On("CreateDependency", ctx, taskID, blockedByID)}
+}
+
+func (_c *MockRepository_CreateDependency_Call) Run(run func(ctx context.Context, taskID string, blockedByID string)) *MockRepository_CreateDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_CreateDependency_Call) Return(err error) *MockRepository_CreateDependency_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_CreateDependency_Call) RunAndReturn(run func(ctx context.Context, taskID string, blockedByID string) error) *MockRepository_CreateDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSeries provides a mock function for the type MockRepository
+func (_mock *MockRepository) CreateSeries(ctx context.Context, series *entities.TaskSeries) error {
+	ret := _mock.Called(ctx, series)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSeries")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.TaskSeries) error); ok {
+		r0 = returnFunc(ctx, series)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_CreateSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSeries'
+type MockRepository_CreateSeries_Call struct {
+	*mock.Call
+}
+
+// CreateSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - series *entities.TaskSeries
+func (_e *MockRepository_Expecter) CreateSeries(ctx interface{}, series interface{}) *MockRepository_CreateSeries_Call {
+	return &MockRepository_CreateSeries_Call{Call: _e.mock.On("CreateSeries", ctx, series)}
+}
+
+func (_c *MockRepository_CreateSeries_Call) Run(run func(ctx context.Context, series *entities.TaskSeries)) *MockRepository_CreateSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.TaskSeries
+		if args[1] != nil {
+			arg1 = args[1].(*entities.TaskSeries)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_CreateSeries_Call) Return(err error) *MockRepository_CreateSeries_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_CreateSeries_Call) RunAndReturn(run func(ctx context.Context, series *entities.TaskSeries) error) *MockRepository_CreateSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAssignee provides a mock function for the type MockRepository
+func (_mock *MockRepository) DeleteAssignee(ctx context.Context, taskID string, userID string) error {
+	ret := _mock.Called(ctx, taskID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAssignee")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, taskID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_DeleteAssignee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAssignee'
+type MockRepository_DeleteAssignee_Call struct {
+	*mock.Call
+}
+
+// DeleteAssignee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - userID string
+func (_e *MockRepository_Expecter) DeleteAssignee(ctx interface{}, taskID interface{}, userID interface{}) *MockRepository_DeleteAssignee_Call {
+	return &MockRepository_DeleteAssignee_Call{Call: _e.mock.On("DeleteAssignee", ctx, taskID, userID)}
+}
+
+func (_c *MockRepository_DeleteAssignee_Call) Run(run func(ctx context.Context, taskID string, userID string)) *MockRepository_DeleteAssignee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteAssignee_Call) Return(err error) *MockRepository_DeleteAssignee_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_DeleteAssignee_Call) RunAndReturn(run func(ctx context.Context, taskID string, userID string) error) *MockRepository_DeleteAssignee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByID provides a mock function for the type MockRepository
+func (_mock *MockRepository) DeleteByID(ctx context.Context, taskID string) error {
+	ret := _mock.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, taskID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_DeleteByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByID'
+type MockRepository_DeleteByID_Call struct {
+	*mock.Call
+}
+
+// DeleteByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+func (_e *MockRepository_Expecter) DeleteByID(ctx interface{}, taskID interface{}) *MockRepository_DeleteByID_Call {
+	return &MockRepository_DeleteByID_Call{Call: _e.mock.On("DeleteByID", ctx, taskID)}
+}
+
+func (_c *MockRepository_DeleteByID_Call) Run(run func(ctx context.Context, taskID string)) *MockRepository_DeleteByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteByID_Call) Return(err error) *MockRepository_DeleteByID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_DeleteByID_Call) RunAndReturn(run func(ctx context.Context, taskID string) error) *MockRepository_DeleteByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByIDAndReparentChildren provides a mock function for the type MockRepository
+func (_mock *MockRepository) DeleteByIDAndReparentChildren(ctx context.Context, taskID string, newParentID *string) error {
+	ret := _mock.Called(ctx, taskID, newParentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByIDAndReparentChildren")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *string) error); ok {
+		r0 = returnFunc(ctx, taskID, newParentID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_DeleteByIDAndReparentChildren_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByIDAndReparentChildren'
+type MockRepository_DeleteByIDAndReparentChildren_Call struct {
+	*mock.Call
+}
+
+// DeleteByIDAndReparentChildren is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - newParentID *string
+func (_e *MockRepository_Expecter) DeleteByIDAndReparentChildren(ctx interface{}, taskID interface{}, newParentID interface{}) *MockRepository_DeleteByIDAndReparentChildren_Call {
+	return &MockRepository_DeleteByIDAndReparentChildren_Call{Call: _e.mock.On("DeleteByIDAndReparentChildren", ctx, taskID, newParentID)}
+}
+
+func (_c *MockRepository_DeleteByIDAndReparentChildren_Call) Run(run func(ctx context.Context, taskID string, newParentID *string)) *MockRepository_DeleteByIDAndReparentChildren_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *string
+		if args[2] != nil {
+			arg2 = args[2].(*string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteByIDAndReparentChildren_Call) Return(err error) *MockRepository_DeleteByIDAndReparentChildren_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_DeleteByIDAndReparentChildren_Call) RunAndReturn(run func(ctx context.Context, taskID string, newParentID *string) error) *MockRepository_DeleteByIDAndReparentChildren_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDependency provides a mock function for the type MockRepository
+func (_mock *MockRepository) DeleteDependency(ctx context.Context, taskID string, blockedByID string) error {
+	ret := _mock.Called(ctx, taskID, blockedByID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDependency")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, taskID, blockedByID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_DeleteDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDependency'
+type MockRepository_DeleteDependency_Call struct {
+	*mock.Call
+}
+
+// DeleteDependency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - blockedByID string
+func (_e *MockRepository_Expecter) DeleteDependency(ctx interface{}, taskID interface{}, blockedByID interface{}) *MockRepository_DeleteDependency_Call {
+	return &MockRepository_DeleteDependency_Call{Call: _e.mock.On("DeleteDependency", ctx, taskID, blockedByID)}
+}
+
+func (_c *MockRepository_DeleteDependency_Call) Run(run func(ctx context.Context, taskID string, blockedByID string)) *MockRepository_DeleteDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteDependency_Call) Return(err error) *MockRepository_DeleteDependency_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_DeleteDependency_Call) RunAndReturn(run func(ctx context.Context, taskID string, blockedByID string) error) *MockRepository_DeleteDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStaleCustomFieldValues provides a mock function for the type MockRepository
+func (_mock *MockRepository) DeleteStaleCustomFieldValues(ctx context.Context, taskIDs []string) error {
+	ret := _mock.Called(ctx, taskIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStaleCustomFieldValues")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = returnFunc(ctx, taskIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_DeleteStaleCustomFieldValues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStaleCustomFieldValues'
+type MockRepository_DeleteStaleCustomFieldValues_Call struct {
+	*mock.Call
+}
+
+// DeleteStaleCustomFieldValues is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskIDs []string
+func (_e *MockRepository_Expecter) DeleteStaleCustomFieldValues(ctx interface{}, taskIDs interface{}) *MockRepository_DeleteStaleCustomFieldValues_Call {
+	return &MockRepository_DeleteStaleCustomFieldValues_Call{Call: _e.mock.On("DeleteStaleCustomFieldValues", ctx, taskIDs)}
+}
+
+func (_c *MockRepository_DeleteStaleCustomFieldValues_Call) Run(run func(ctx context.Context, taskIDs []string)) *MockRepository_DeleteStaleCustomFieldValues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteStaleCustomFieldValues_Call) Return(err error) *MockRepository_DeleteStaleCustomFieldValues_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_DeleteStaleCustomFieldValues_Call) RunAndReturn(run func(ctx context.Context, taskIDs []string) error) *MockRepository_DeleteStaleCustomFieldValues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTreeByID provides a mock function for the type MockRepository
+func (_mock *MockRepository) DeleteTreeByID(ctx context.Context, taskID string) error {
+	ret := _mock.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTreeByID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, taskID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_DeleteTreeByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTreeByID'
+type MockRepository_DeleteTreeByID_Call struct {
+	*mock.Call
+}
+
+// DeleteTreeByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+func (_e *MockRepository_Expecter) DeleteTreeByID(ctx interface{}, taskID interface{}) *MockRepository_DeleteTreeByID_Call {
+	return &MockRepository_DeleteTreeByID_Call{Call: _e.mock.On("DeleteTreeByID", ctx, taskID)}
+}
+
+func (_c *MockRepository_DeleteTreeByID_Call) Run(run func(ctx context.Context, taskID string)) *MockRepository_DeleteTreeByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteTreeByID_Call) Return(err error) *MockRepository_DeleteTreeByID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_DeleteTreeByID_Call) RunAndReturn(run func(ctx context.Context, taskID string) error) *MockRepository_DeleteTreeByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindAll(ctx context.Context, opts *task.ListOptions) ([]entities.Task, error) {
+	ret := _mock.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []entities.Task
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.ListOptions) ([]entities.Task, error)); ok {
+		return returnFunc(ctx, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.ListOptions) []entities.Task); ok {
+		r0 = returnFunc(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Task)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *task.ListOptions) error); ok {
+		r1 = returnFunc(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - opts *task.ListOptions
+func (_e *MockRepository_Expecter) FindAll(ctx interface{}, opts interface{}) *MockRepository_FindAll_Call {
+	return &MockRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx, opts)}
+}
+
+func (_c *MockRepository_FindAll_Call) Run(run func(ctx context.Context, opts *task.ListOptions)) *MockRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *task.ListOptions
+		if args[1] != nil {
+			arg1 = args[1].(*task.ListOptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindAll_Call) Return(tasks []entities.Task, err error) *MockRepository_FindAll_Call {
+	_c.Call.Return(tasks, err)
+	return _c
+}
+
+func (_c *MockRepository_FindAll_Call) RunAndReturn(run func(ctx context.Context, opts *task.ListOptions) ([]entities.Task, error)) *MockRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAncestorIDs provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindAncestorIDs(ctx context.Context, taskID string) ([]string, error) {
+	ret := _mock.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAncestorIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return returnFunc(ctx, taskID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = returnFunc(ctx, taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindAncestorIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAncestorIDs'
+type MockRepository_FindAncestorIDs_Call struct {
+	*mock.Call
+}
+
+// FindAncestorIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+func (_e *MockRepository_Expecter) FindAncestorIDs(ctx interface{}, taskID interface{}) *MockRepository_FindAncestorIDs_Call {
+	return &MockRepository_FindAncestorIDs_Call{Call: _e.mock.On("FindAncestorIDs", ctx, taskID)}
+}
+
+func (_c *MockRepository_FindAncestorIDs_Call) Run(run func(ctx context.Context, taskID string)) *MockRepository_FindAncestorIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindAncestorIDs_Call) Return(ss []string, err error) *MockRepository_FindAncestorIDs_Call {
+	_c.Call.Return(ss, err)
+	return _c
+}
+
+func (_c *MockRepository_FindAncestorIDs_Call) RunAndReturn(run func(ctx context.Context, taskID string) ([]string, error)) *MockRepository_FindAncestorIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAssigneesByTaskIDs provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindAssigneesByTaskIDs(ctx context.Context, taskIDs []string) (map[string][]entities.TaskAssignee, error) {
+	ret := _mock.Called(ctx, taskIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAssigneesByTaskIDs")
+	}
+
+	var r0 map[string][]entities.TaskAssignee
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) (map[string][]entities.TaskAssignee, error)); ok {
+		return returnFunc(ctx, taskIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) map[string][]entities.TaskAssignee); ok {
+		r0 = returnFunc(ctx, taskIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]entities.TaskAssignee)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, taskIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindAssigneesByTaskIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAssigneesByTaskIDs'
+type MockRepository_FindAssigneesByTaskIDs_Call struct {
+	*mock.Call
+}
+
+// FindAssigneesByTaskIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskIDs []string
+func (_e *MockRepository_Expecter) FindAssigneesByTaskIDs(ctx interface{}, taskIDs interface{}) *MockRepository_FindAssigneesByTaskIDs_Call {
+	return &MockRepository_FindAssigneesByTaskIDs_Call{Call: _e.mock.On("FindAssigneesByTaskIDs", ctx, taskIDs)}
+}
+
+func (_c *MockRepository_FindAssigneesByTaskIDs_Call) Run(run func(ctx context.Context, taskIDs []string)) *MockRepository_FindAssigneesByTaskIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindAssigneesByTaskIDs_Call) Return(m map[string][]entities.TaskAssignee, err error) *MockRepository_FindAssigneesByTaskIDs_Call {
+	_c.Call.Return(m, err)
+	return _c
+}
+
+func (_c *MockRepository_FindAssigneesByTaskIDs_Call) RunAndReturn(run func(ctx context.Context, taskIDs []string) (map[string][]entities.TaskAssignee, error)) *MockRepository_FindAssigneesByTaskIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBlockersByID provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindBlockersByID(ctx context.Context, taskID string) ([]entities.Task, error) {
+	ret := _mock.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBlockersByID")
+	}
+
+	var r0 []entities.Task
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]entities.Task, error)); ok {
+		return returnFunc(ctx, taskID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []entities.Task); ok {
+		r0 = returnFunc(ctx, taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Task)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindBlockersByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBlockersByID'
+type MockRepository_FindBlockersByID_Call struct {
+	*mock.Call
+}
+
+// FindBlockersByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+func (_e *MockRepository_Expecter) FindBlockersByID(ctx interface{}, taskID interface{}) *MockRepository_FindBlockersByID_Call {
+	return &MockRepository_FindBlockersByID_Call{Call: _e.mock.On("FindBlockersByID", ctx, taskID)}
+}
+
+func (_c *MockRepository_FindBlockersByID_Call) Run(run func(ctx context.Context, taskID string)) *MockRepository_FindBlockersByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindBlockersByID_Call) Return(tasks []entities.Task, err error) *MockRepository_FindBlockersByID_Call {
+	_c.Call.Return(tasks, err)
+	return _c
+}
+
+func (_c *MockRepository_FindBlockersByID_Call) RunAndReturn(run func(ctx context.Context, taskID string) ([]entities.Task, error)) *MockRepository_FindBlockersByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBoardRankAfter provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindBoardRankAfter(ctx context.Context, column *task.BoardColumn, rank string, excludeTaskID string) (string, error) {
+	ret := _mock.Called(ctx, column, rank, excludeTaskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBoardRankAfter")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.BoardColumn, string, string) (string, error)); ok {
+		return returnFunc(ctx, column, rank, excludeTaskID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.BoardColumn, string, string) string); ok {
+		r0 = returnFunc(ctx, column, rank, excludeTaskID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *task.BoardColumn, string, string) error); ok {
+		r1 = returnFunc(ctx, column, rank, excludeTaskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindBoardRankAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBoardRankAfter'
+type MockRepository_FindBoardRankAfter_Call struct {
+	*mock.Call
+}
+
+// FindBoardRankAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - column *task.BoardColumn
+//   - rank string
+//   - excludeTaskID string
+func (_e *MockRepository_Expecter) FindBoardRankAfter(ctx interface{}, column interface{}, rank interface{}, excludeTaskID interface{}) *MockRepository_FindBoardRankAfter_Call {
+	return &MockRepository_FindBoardRankAfter_Call{Call: _e.mock.On("FindBoardRankAfter", ctx, column, rank, excludeTaskID)}
+}
+
+func (_c *MockRepository_FindBoardRankAfter_Call) Run(run func(ctx context.Context, column *task.BoardColumn, rank string, excludeTaskID string)) *MockRepository_FindBoardRankAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *task.BoardColumn
+		if args[1] != nil {
+			arg1 = args[1].(*task.BoardColumn)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindBoardRankAfter_Call) Return(s string, err error) *MockRepository_FindBoardRankAfter_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockRepository_FindBoardRankAfter_Call) RunAndReturn(run func(ctx context.Context, column *task.BoardColumn, rank string, excludeTaskID string) (string, error)) *MockRepository_FindBoardRankAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBoardRankBefore provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindBoardRankBefore(ctx context.Context, column *task.BoardColumn, rank string, excludeTaskID string) (string, error) {
+	ret := _mock.Called(ctx, column, rank, excludeTaskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBoardRankBefore")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.BoardColumn, string, string) (string, error)); ok {
+		return returnFunc(ctx, column, rank, excludeTaskID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.BoardColumn, string, string) string); ok {
+		r0 = returnFunc(ctx, column, rank, excludeTaskID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *task.BoardColumn, string, string) error); ok {
+		r1 = returnFunc(ctx, column, rank, excludeTaskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindBoardRankBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBoardRankBefore'
+type MockRepository_FindBoardRankBefore_Call struct {
+	*mock.Call
+}
+
+// FindBoardRankBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - column *task.BoardColumn
+//   - rank string
+//   - excludeTaskID string
+func (_e *MockRepository_Expecter) FindBoardRankBefore(ctx interface{}, column interface{}, rank interface{}, excludeTaskID interface{}) *MockRepository_FindBoardRankBefore_Call {
+	return &MockRepository_FindBoardRankBefore_Call{Call: _e.mock.On("FindBoardRankBefore", ctx, column, rank, excludeTaskID)}
+}
+
+func (_c *MockRepository_FindBoardRankBefore_Call) Run(run func(ctx context.Context, column *task.BoardColumn, rank string, excludeTaskID string)) *MockRepository_FindBoardRankBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *task.BoardColumn
+		if args[1] != nil {
+			arg1 = args[1].(*task.BoardColumn)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindBoardRankBefore_Call) Return(s string, err error) *MockRepository_FindBoardRankBefore_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockRepository_FindBoardRankBefore_Call) RunAndReturn(run func(ctx context.Context, column *task.BoardColumn, rank string, excludeTaskID string) (string, error)) *MockRepository_FindBoardRankBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindByID(ctx context.Context, taskID string) (*entities.Task, error) {
+	ret := _mock.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *entities.Task
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entities.Task, error)); ok {
+		return returnFunc(ctx, taskID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entities.Task); ok {
+		r0 = returnFunc(ctx, taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Task)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+func (_e *MockRepository_Expecter) FindByID(ctx interface{}, taskID interface{}) *MockRepository_FindByID_Call {
+	return &MockRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, taskID)}
+}
+
+func (_c *MockRepository_FindByID_Call) Run(run func(ctx context.Context, taskID string)) *MockRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindByID_Call) Return(task1 *entities.Task, err error) *MockRepository_FindByID_Call {
+	_c.Call.Return(task1, err)
+	return _c
+}
+
+func (_c *MockRepository_FindByID_Call) RunAndReturn(run func(ctx context.Context, taskID string) (*entities.Task, error)) *MockRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByParentID provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindByParentID(ctx context.Context, parentID string) ([]entities.Task, error) {
+	ret := _mock.Called(ctx, parentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByParentID")
+	}
+
+	var r0 []entities.Task
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]entities.Task, error)); ok {
+		return returnFunc(ctx, parentID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []entities.Task); ok {
+		r0 = returnFunc(ctx, parentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Task)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, parentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindByParentID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByParentID'
+type MockRepository_FindByParentID_Call struct {
+	*mock.Call
+}
+
+// FindByParentID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parentID string
+func (_e *MockRepository_Expecter) FindByParentID(ctx interface{}, parentID interface{}) *MockRepository_FindByParentID_Call {
+	return &MockRepository_FindByParentID_Call{Call: _e.mock.On("FindByParentID", ctx, parentID)}
+}
+
+func (_c *MockRepository_FindByParentID_Call) Run(run func(ctx context.Context, parentID string)) *MockRepository_FindByParentID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindByParentID_Call) Return(tasks []entities.Task, err error) *MockRepository_FindByParentID_Call {
+	_c.Call.Return(tasks, err)
+	return _c
+}
+
+func (_c *MockRepository_FindByParentID_Call) RunAndReturn(run func(ctx context.Context, parentID string) ([]entities.Task, error)) *MockRepository_FindByParentID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindCustomFieldValuesByTaskIDs provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindCustomFieldValuesByTaskIDs(ctx context.Context, taskIDs []string) (map[string][]entities.TaskCustomFieldValue, error) {
+	ret := _mock.Called(ctx, taskIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindCustomFieldValuesByTaskIDs")
+	}
+
+	var r0 map[string][]entities.TaskCustomFieldValue
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) (map[string][]entities.TaskCustomFieldValue, error)); ok {
+		return returnFunc(ctx, taskIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) map[string][]entities.TaskCustomFieldValue); ok {
+		r0 = returnFunc(ctx, taskIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]entities.TaskCustomFieldValue)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, taskIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindCustomFieldValuesByTaskIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCustomFieldValuesByTaskIDs'
+type MockRepository_FindCustomFieldValuesByTaskIDs_Call struct {
+	*mock.Call
+}
+
+// FindCustomFieldValuesByTaskIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskIDs []string
+func (_e *MockRepository_Expecter) FindCustomFieldValuesByTaskIDs(ctx interface{}, taskIDs interface{}) *MockRepository_FindCustomFieldValuesByTaskIDs_Call {
+	return &MockRepository_FindCustomFieldValuesByTaskIDs_Call{Call: _e.mock.On("FindCustomFieldValuesByTaskIDs", ctx, taskIDs)}
+}
+
+func (_c *MockRepository_FindCustomFieldValuesByTaskIDs_Call) Run(run func(ctx context.Context, taskIDs []string)) *MockRepository_FindCustomFieldValuesByTaskIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindCustomFieldValuesByTaskIDs_Call) Return(m map[string][]entities.TaskCustomFieldValue, err error) *MockRepository_FindCustomFieldValuesByTaskIDs_Call {
+	_c.Call.Return(m, err)
+	return _c
+}
+
+func (_c *MockRepository_FindCustomFieldValuesByTaskIDs_Call) RunAndReturn(run func(ctx context.Context, taskIDs []string) (map[string][]entities.TaskCustomFieldValue, error)) *MockRepository_FindCustomFieldValuesByTaskIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDeletedByID provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindDeletedByID(ctx context.Context, taskID string) (*entities.Task, error) {
+	ret := _mock.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDeletedByID")
+	}
+
+	var r0 *entities.Task
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entities.Task, error)); ok {
+		return returnFunc(ctx, taskID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entities.Task); ok {
+		r0 = returnFunc(ctx, taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Task)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindDeletedByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDeletedByID'
+type MockRepository_FindDeletedByID_Call struct {
+	*mock.Call
+}
+
+// FindDeletedByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+func (_e *MockRepository_Expecter) FindDeletedByID(ctx interface{}, taskID interface{}) *MockRepository_FindDeletedByID_Call {
+	return &MockRepository_FindDeletedByID_Call{Call: _e.mock.On("FindDeletedByID", ctx, taskID)}
+}
+
+func (_c *MockRepository_FindDeletedByID_Call) Run(run func(ctx context.Context, taskID string)) *MockRepository_FindDeletedByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindDeletedByID_Call) Return(task1 *entities.Task, err error) *MockRepository_FindDeletedByID_Call {
+	_c.Call.Return(task1, err)
+	return _c
+}
+
+func (_c *MockRepository_FindDeletedByID_Call) RunAndReturn(run func(ctx context.Context, taskID string) (*entities.Task, error)) *MockRepository_FindDeletedByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSeriesByID provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindSeriesByID(ctx context.Context, seriesID string) (*entities.TaskSeries, error) {
+	ret := _mock.Called(ctx, seriesID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSeriesByID")
+	}
+
+	var r0 *entities.TaskSeries
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entities.TaskSeries, error)); ok {
+		return returnFunc(ctx, seriesID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entities.TaskSeries); ok {
+		r0 = returnFunc(ctx, seriesID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.TaskSeries)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, seriesID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindSeriesByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSeriesByID'
+type MockRepository_FindSeriesByID_Call struct {
+	*mock.Call
+}
+
+// FindSeriesByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - seriesID string
+func (_e *MockRepository_Expecter) FindSeriesByID(ctx interface{}, seriesID interface{}) *MockRepository_FindSeriesByID_Call {
+	return &MockRepository_FindSeriesByID_Call{Call: _e.mock.On("FindSeriesByID", ctx, seriesID)}
+}
+
+func (_c *MockRepository_FindSeriesByID_Call) Run(run func(ctx context.Context, seriesID string)) *MockRepository_FindSeriesByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindSeriesByID_Call) Return(taskSeries *entities.TaskSeries, err error) *MockRepository_FindSeriesByID_Call {
+	_c.Call.Return(taskSeries, err)
+	return _c
+}
+
+func (_c *MockRepository_FindSeriesByID_Call) RunAndReturn(run func(ctx context.Context, seriesID string) (*entities.TaskSeries, error)) *MockRepository_FindSeriesByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSubtreeByID provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindSubtreeByID(ctx context.Context, taskID string) ([]entities.Task, error) {
+	ret := _mock.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSubtreeByID")
+	}
+
+	var r0 []entities.Task
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]entities.Task, error)); ok {
+		return returnFunc(ctx, taskID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []entities.Task); ok {
+		r0 = returnFunc(ctx, taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Task)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindSubtreeByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSubtreeByID'
+type MockRepository_FindSubtreeByID_Call struct {
+	*mock.Call
+}
+
+// FindSubtreeByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+func (_e *MockRepository_Expecter) FindSubtreeByID(ctx interface{}, taskID interface{}) *MockRepository_FindSubtreeByID_Call {
+	return &MockRepository_FindSubtreeByID_Call{Call: _e.mock.On("FindSubtreeByID", ctx, taskID)}
+}
+
+func (_c *MockRepository_FindSubtreeByID_Call) Run(run func(ctx context.Context, taskID string)) *MockRepository_FindSubtreeByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindSubtreeByID_Call) Return(tasks []entities.Task, err error) *MockRepository_FindSubtreeByID_Call {
+	_c.Call.Return(tasks, err)
+	return _c
+}
+
+func (_c *MockRepository_FindSubtreeByID_Call) RunAndReturn(run func(ctx context.Context, taskID string) ([]entities.Task, error)) *MockRepository_FindSubtreeByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTagsByTaskIDs provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindTagsByTaskIDs(ctx context.Context, taskIDs []string) (map[string][]entities.Tag, error) {
+	ret := _mock.Called(ctx, taskIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTagsByTaskIDs")
+	}
+
+	var r0 map[string][]entities.Tag
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) (map[string][]entities.Tag, error)); ok {
+		return returnFunc(ctx, taskIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) map[string][]entities.Tag); ok {
+		r0 = returnFunc(ctx, taskIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]entities.Tag)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, taskIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindTagsByTaskIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTagsByTaskIDs'
+type MockRepository_FindTagsByTaskIDs_Call struct {
+	*mock.Call
+}
+
+// FindTagsByTaskIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskIDs []string
+func (_e *MockRepository_Expecter) FindTagsByTaskIDs(ctx interface{}, taskIDs interface{}) *MockRepository_FindTagsByTaskIDs_Call {
+	return &MockRepository_FindTagsByTaskIDs_Call{Call: _e.mock.On("FindTagsByTaskIDs", ctx, taskIDs)}
+}
+
+func (_c *MockRepository_FindTagsByTaskIDs_Call) Run(run func(ctx context.Context, taskIDs []string)) *MockRepository_FindTagsByTaskIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindTagsByTaskIDs_Call) Return(m map[string][]entities.Tag, err error) *MockRepository_FindTagsByTaskIDs_Call {
+	_c.Call.Return(m, err)
+	return _c
+}
+
+func (_c *MockRepository_FindTagsByTaskIDs_Call) RunAndReturn(run func(ctx context.Context, taskIDs []string) (map[string][]entities.Tag, error)) *MockRepository_FindTagsByTaskIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTransitiveBlockerIDs provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindTransitiveBlockerIDs(ctx context.Context, taskID string) ([]string, error) {
+	ret := _mock.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTransitiveBlockerIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return returnFunc(ctx, taskID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = returnFunc(ctx, taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindTransitiveBlockerIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTransitiveBlockerIDs'
+type MockRepository_FindTransitiveBlockerIDs_Call struct {
+	*mock.Call
+}
+
+// FindTransitiveBlockerIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+func (_e *MockRepository_Expecter) FindTransitiveBlockerIDs(ctx interface{}, taskID interface{}) *MockRepository_FindTransitiveBlockerIDs_Call {
+	return &MockRepository_FindTransitiveBlockerIDs_Call{Call: _e.mock.On("FindTransitiveBlockerIDs", ctx, taskID)}
+}
+
+func (_c *MockRepository_FindTransitiveBlockerIDs_Call) Run(run func(ctx context.Context, taskID string)) *MockRepository_FindTransitiveBlockerIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindTransitiveBlockerIDs_Call) Return(ss []string, err error) *MockRepository_FindTransitiveBlockerIDs_Call {
+	_c.Call.Return(ss, err)
+	return _c
+}
+
+func (_c *MockRepository_FindTransitiveBlockerIDs_Call) RunAndReturn(run func(ctx context.Context, taskID string) ([]string, error)) *MockRepository_FindTransitiveBlockerIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockAncestorIDs provides a mock function for the type MockRepository
+func (_mock *MockRepository) LockAncestorIDs(ctx context.Context, taskID string) ([]string, error) {
+	ret := _mock.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockAncestorIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return returnFunc(ctx, taskID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = returnFunc(ctx, taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_LockAncestorIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockAncestorIDs'
+type MockRepository_LockAncestorIDs_Call struct {
+	*mock.Call
+}
+
+// LockAncestorIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+func (_e *MockRepository_Expecter) LockAncestorIDs(ctx interface{}, taskID interface{}) *MockRepository_LockAncestorIDs_Call {
+	return &MockRepository_LockAncestorIDs_Call{Call: _e.mock.On("LockAncestorIDs", ctx, taskID)}
+}
+
+func (_c *MockRepository_LockAncestorIDs_Call) Run(run func(ctx context.Context, taskID string)) *MockRepository_LockAncestorIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_LockAncestorIDs_Call) Return(ss []string, err error) *MockRepository_LockAncestorIDs_Call {
+	_c.Call.Return(ss, err)
+	return _c
+}
+
+func (_c *MockRepository_LockAncestorIDs_Call) RunAndReturn(run func(ctx context.Context, taskID string) ([]string, error)) *MockRepository_LockAncestorIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockBoardColumn provides a mock function for the type MockRepository
+func (_mock *MockRepository) LockBoardColumn(ctx context.Context, column *task.BoardColumn) error {
+	ret := _mock.Called(ctx, column)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockBoardColumn")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.BoardColumn) error); ok {
+		r0 = returnFunc(ctx, column)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_LockBoardColumn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockBoardColumn'
+type MockRepository_LockBoardColumn_Call struct {
+	*mock.Call
+}
+
+// LockBoardColumn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - column *task.BoardColumn
+func (_e *MockRepository_Expecter) LockBoardColumn(ctx interface{}, column interface{}) *MockRepository_LockBoardColumn_Call {
+	return &MockRepository_LockBoardColumn_Call{Call: _e.mock.On("LockBoardColumn", ctx, column)}
+}
+
+func (_c *MockRepository_LockBoardColumn_Call) Run(run func(ctx context.Context, column *task.BoardColumn)) *MockRepository_LockBoardColumn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *task.BoardColumn
+		if args[1] != nil {
+			arg1 = args[1].(*task.BoardColumn)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_LockBoardColumn_Call) Return(err error) *MockRepository_LockBoardColumn_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_LockBoardColumn_Call) RunAndReturn(run func(ctx context.Context, column *task.BoardColumn) error) *MockRepository_LockBoardColumn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockDependencies provides a mock function for the type MockRepository
+func (_mock *MockRepository) LockDependencies(ctx context.Context, workspaceID *string) error {
+	ret := _mock.Called(ctx, workspaceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockDependencies")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *string) error); ok {
+		r0 = returnFunc(ctx, workspaceID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_LockDependencies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockDependencies'
+type MockRepository_LockDependencies_Call struct {
+	*mock.Call
+}
+
+// LockDependencies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workspaceID *string
+func (_e *MockRepository_Expecter) LockDependencies(ctx interface{}, workspaceID interface{}) *MockRepository_LockDependencies_Call {
+	return &MockRepository_LockDependencies_Call{Call: _e.mock.On("LockDependencies", ctx, workspaceID)}
+}
+
+func (_c *MockRepository_LockDependencies_Call) Run(run func(ctx context.Context, workspaceID *string)) *MockRepository_LockDependencies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *string
+		if args[1] != nil {
+			arg1 = args[1].(*string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_LockDependencies_Call) Return(err error) *MockRepository_LockDependencies_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_LockDependencies_Call) RunAndReturn(run func(ctx context.Context, workspaceID *string) error) *MockRepository_LockDependencies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockVersionByID provides a mock function for the type MockRepository
+func (_mock *MockRepository) LockVersionByID(ctx context.Context, taskID string) (int, error) {
+	ret := _mock.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockVersionByID")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return returnFunc(ctx, taskID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = returnFunc(ctx, taskID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_LockVersionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockVersionByID'
+type MockRepository_LockVersionByID_Call struct {
+	*mock.Call
+}
+
+// LockVersionByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+func (_e *MockRepository_Expecter) LockVersionByID(ctx interface{}, taskID interface{}) *MockRepository_LockVersionByID_Call {
+	return &MockRepository_LockVersionByID_Call{Call: _e.mock.On("LockVersionByID", ctx, taskID)}
+}
+
+func (_c *MockRepository_LockVersionByID_Call) Run(run func(ctx context.Context, taskID string)) *MockRepository_LockVersionByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_LockVersionByID_Call) Return(n int, err error) *MockRepository_LockVersionByID_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRepository_LockVersionByID_Call) RunAndReturn(run func(ctx context.Context, taskID string) (int, error)) *MockRepository_LockVersionByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchByID provides a mock function for the type MockRepository
+func (_mock *MockRepository) PatchByID(ctx context.Context, taskID string, patch *task.Patch) error {
+	ret := _mock.Called(ctx, taskID, patch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchByID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *task.Patch) error); ok {
+		r0 = returnFunc(ctx, taskID, patch)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_PatchByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchByID'
+type MockRepository_PatchByID_Call struct {
+	*mock.Call
+}
+
+// PatchByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - patch *task.Patch
+func (_e *MockRepository_Expecter) PatchByID(ctx interface{}, taskID interface{}, patch interface{}) *MockRepository_PatchByID_Call {
+	return &MockRepository_PatchByID_Call{Call: _e.mock.On("PatchByID", ctx, taskID, patch)}
+}
+
+func (_c *MockRepository_PatchByID_Call) Run(run func(ctx context.Context, taskID string, patch *task.Patch)) *MockRepository_PatchByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *task.Patch
+		if args[2] != nil {
+			arg2 = args[2].(*task.Patch)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_PatchByID_Call) Return(err error) *MockRepository_PatchByID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_PatchByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, patch *task.Patch) error) *MockRepository_PatchByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeByID provides a mock function for the type MockRepository
+func (_mock *MockRepository) PurgeByID(ctx context.Context, taskID string) ([]string, error) {
+	ret := _mock.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeByID")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return returnFunc(ctx, taskID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = returnFunc(ctx, taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_PurgeByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeByID'
+type MockRepository_PurgeByID_Call struct {
+	*mock.Call
+}
+
+// PurgeByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+func (_e *MockRepository_Expecter) PurgeByID(ctx interface{}, taskID interface{}) *MockRepository_PurgeByID_Call {
+	return &MockRepository_PurgeByID_Call{Call: _e.mock.On("PurgeByID", ctx, taskID)}
+}
+
+func (_c *MockRepository_PurgeByID_Call) Run(run func(ctx context.Context, taskID string)) *MockRepository_PurgeByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_PurgeByID_Call) Return(ss []string, err error) *MockRepository_PurgeByID_Call {
+	_c.Call.Return(ss, err)
+	return _c
+}
+
+func (_c *MockRepository_PurgeByID_Call) RunAndReturn(run func(ctx context.Context, taskID string) ([]string, error)) *MockRepository_PurgeByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeDeletedBefore provides a mock function for the type MockRepository
+func (_mock *MockRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, []string, error) {
+	ret := _mock.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeletedBefore")
+	}
+
+	var r0 int64
+	var r1 []string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (int64, []string, error)); ok {
+		return returnFunc(ctx, before)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = returnFunc(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) []string); ok {
+		r1 = returnFunc(ctx, before)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, time.Time) error); ok {
+		r2 = returnFunc(ctx, before)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockRepository_PurgeDeletedBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeletedBefore'
+type MockRepository_PurgeDeletedBefore_Call struct {
+	*mock.Call
+}
+
+// PurgeDeletedBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockRepository_Expecter) PurgeDeletedBefore(ctx interface{}, before interface{}) *MockRepository_PurgeDeletedBefore_Call {
+	return &MockRepository_PurgeDeletedBefore_Call{Call: _e.mock.On("PurgeDeletedBefore", ctx, before)}
+}
+
+func (_c *MockRepository_PurgeDeletedBefore_Call) Run(run func(ctx context.Context, before time.Time)) *MockRepository_PurgeDeletedBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_PurgeDeletedBefore_Call) Return(n int64, ss []string, err error) *MockRepository_PurgeDeletedBefore_Call {
+	_c.Call.Return(n, ss, err)
+	return _c
+}
+
+func (_c *MockRepository_PurgeDeletedBefore_Call) RunAndReturn(run func(ctx context.Context, before time.Time) (int64, []string, error)) *MockRepository_PurgeDeletedBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RebalanceBoardColumn provides a mock function for the type MockRepository
+func (_mock *MockRepository) RebalanceBoardColumn(ctx context.Context, column *task.BoardColumn) error {
+	ret := _mock.Called(ctx, column)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RebalanceBoardColumn")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.BoardColumn) error); ok {
+		r0 = returnFunc(ctx, column)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_RebalanceBoardColumn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebalanceBoardColumn'
+type MockRepository_RebalanceBoardColumn_Call struct {
+	*mock.Call
+}
+
+// RebalanceBoardColumn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - column *task.BoardColumn
+func (_e *MockRepository_Expecter) RebalanceBoardColumn(ctx interface{}, column interface{}) *MockRepository_RebalanceBoardColumn_Call {
+	return &MockRepository_RebalanceBoardColumn_Call{Call: _e.mock.On("RebalanceBoardColumn", ctx, column)}
+}
+
+func (_c *MockRepository_RebalanceBoardColumn_Call) Run(run func(ctx context.Context, column *task.BoardColumn)) *MockRepository_RebalanceBoardColumn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *task.BoardColumn
+		if args[1] != nil {
+			arg1 = args[1].(*task.BoardColumn)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_RebalanceBoardColumn_Call) Return(err error) *MockRepository_RebalanceBoardColumn_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_RebalanceBoardColumn_Call) RunAndReturn(run func(ctx context.Context, column *task.BoardColumn) error) *MockRepository_RebalanceBoardColumn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceTags provides a mock function for the type MockRepository
+func (_mock *MockRepository) ReplaceTags(ctx context.Context, taskID string, tagIDs []string) error {
+	ret := _mock.Called(ctx, taskID, tagIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceTags")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = returnFunc(ctx, taskID, tagIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_ReplaceTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceTags'
+type MockRepository_ReplaceTags_Call struct {
+	*mock.Call
+}
+
+// ReplaceTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - tagIDs []string
+func (_e *MockRepository_Expecter) ReplaceTags(ctx interface{}, taskID interface{}, tagIDs interface{}) *MockRepository_ReplaceTags_Call {
+	return &MockRepository_ReplaceTags_Call{Call: _e.mock.On("ReplaceTags", ctx, taskID, tagIDs)}
+}
+
+func (_c *MockRepository_ReplaceTags_Call) Run(run func(ctx context.Context, taskID string, tagIDs []string)) *MockRepository_ReplaceTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_ReplaceTags_Call) Return(err error) *MockRepository_ReplaceTags_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_ReplaceTags_Call) RunAndReturn(run func(ctx context.Context, taskID string, tagIDs []string) error) *MockRepository_ReplaceTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreByID provides a mock function for the type MockRepository
+func (_mock *MockRepository) RestoreByID(ctx context.Context, taskID string) error {
+	ret := _mock.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreByID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, taskID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_RestoreByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreByID'
+type MockRepository_RestoreByID_Call struct {
+	*mock.Call
+}
+
+// RestoreByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+func (_e *MockRepository_Expecter) RestoreByID(ctx interface{}, taskID interface{}) *MockRepository_RestoreByID_Call {
+	return &MockRepository_RestoreByID_Call{Call: _e.mock.On("RestoreByID", ctx, taskID)}
+}
+
+func (_c *MockRepository_RestoreByID_Call) Run(run func(ctx context.Context, taskID string)) *MockRepository_RestoreByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_RestoreByID_Call) Return(err error) *MockRepository_RestoreByID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_RestoreByID_Call) RunAndReturn(run func(ctx context.Context, taskID string) error) *MockRepository_RestoreByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function for the type MockRepository
+func (_mock *MockRepository) Search(ctx context.Context, userID string, query string, limit int, offset int) ([]entities.TaskSearchResult, error) {
+	ret := _mock.Called(ctx, userID, query, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []entities.TaskSearchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int, int) ([]entities.TaskSearchResult, error)); ok {
+		return returnFunc(ctx, userID, query, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int, int) []entities.TaskSearchResult); ok {
+		r0 = returnFunc(ctx, userID, query, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.TaskSearchResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, int, int) error); ok {
+		r1 = returnFunc(ctx, userID, query, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockRepository_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - query string
+//   - limit int
+//   - offset int
+func (_e *MockRepository_Expecter) Search(ctx interface{}, userID interface{}, query interface{}, limit interface{}, offset interface{}) *MockRepository_Search_Call {
+	return &MockRepository_Search_Call{Call: _e.mock.On("Search", ctx, userID, query, limit, offset)}
+}
+
+func (_c *MockRepository_Search_Call) Run(run func(ctx context.Context, userID string, query string, limit int, offset int)) *MockRepository_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_Search_Call) Return(taskSearchResults []entities.TaskSearchResult, err error) *MockRepository_Search_Call {
+	_c.Call.Return(taskSearchResults, err)
+	return _c
+}
+
+func (_c *MockRepository_Search_Call) RunAndReturn(run func(ctx context.Context, userID string, query string, limit int, offset int) ([]entities.TaskSearchResult, error)) *MockRepository_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCustomFieldValues provides a mock function for the type MockRepository
+func (_mock *MockRepository) SetCustomFieldValues(ctx context.Context, taskID string, values map[string]json.RawMessage) error {
+	ret := _mock.Called(ctx, taskID, values)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCustomFieldValues")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, map[string]json.RawMessage) error); ok {
+		r0 = returnFunc(ctx, taskID, values)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_SetCustomFieldValues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCustomFieldValues'
+type MockRepository_SetCustomFieldValues_Call struct {
+	*mock.Call
+}
+
+// SetCustomFieldValues is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - values map[string]json.RawMessage
+func (_e *MockRepository_Expecter) SetCustomFieldValues(ctx interface{}, taskID interface{}, values interface{}) *MockRepository_SetCustomFieldValues_Call {
+	return &MockRepository_SetCustomFieldValues_Call{Call: _e.mock.On("SetCustomFieldValues", ctx, taskID, values)}
+}
+
+func (_c *MockRepository_SetCustomFieldValues_Call) Run(run func(ctx context.Context, taskID string, values map[string]json.RawMessage)) *MockRepository_SetCustomFieldValues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 map[string]json.RawMessage
+		if args[2] != nil {
+			arg2 = args[2].(map[string]json.RawMessage)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_SetCustomFieldValues_Call) Return(err error) *MockRepository_SetCustomFieldValues_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_SetCustomFieldValues_Call) RunAndReturn(run func(ctx context.Context, taskID string, values map[string]json.RawMessage) error) *MockRepository_SetCustomFieldValues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StopSeriesByID provides a mock function for the type MockRepository
+func (_mock *MockRepository) StopSeriesByID(ctx context.Context, seriesID string) error {
+	ret := _mock.Called(ctx, seriesID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopSeriesByID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, seriesID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_StopSeriesByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopSeriesByID'
+type MockRepository_StopSeriesByID_Call struct {
+	*mock.Call
+}
+
+// StopSeriesByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - seriesID string
+func (_e *MockRepository_Expecter) StopSeriesByID(ctx interface{}, seriesID interface{}) *MockRepository_StopSeriesByID_Call {
+	return &MockRepository_StopSeriesByID_Call{Call: _e.mock.On("StopSeriesByID", ctx, seriesID)}
+}
+
+func (_c *MockRepository_StopSeriesByID_Call) Run(run func(ctx context.Context, seriesID string)) *MockRepository_StopSeriesByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_StopSeriesByID_Call) Return(err error) *MockRepository_StopSeriesByID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_StopSeriesByID_Call) RunAndReturn(run func(ctx context.Context, seriesID string) error) *MockRepository_StopSeriesByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamAll provides a mock function for the type MockRepository
+func (_mock *MockRepository) StreamAll(ctx context.Context, opts *task.ListOptions, fn func(task *entities.Task) error) error {
+	ret := _mock.Called(ctx, opts, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamAll")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.ListOptions, func(task *entities.Task) error) error); ok {
+		r0 = returnFunc(ctx, opts, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_StreamAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamAll'
+type MockRepository_StreamAll_Call struct {
+	*mock.Call
+}
+
+// StreamAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - opts *task.ListOptions
+//   - fn func(task *entities.Task) error
+func (_e *MockRepository_Expecter) StreamAll(ctx interface{}, opts interface{}, fn interface{}) *MockRepository_StreamAll_Call {
+	return &MockRepository_StreamAll_Call{Call: _e.mock.On("StreamAll", ctx, opts, fn)}
+}
+
+func (_c *MockRepository_StreamAll_Call) Run(run func(ctx context.Context, opts *task.ListOptions, fn func(task *entities.Task) error)) *MockRepository_StreamAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *task.ListOptions
+		if args[1] != nil {
+			arg1 = args[1].(*task.ListOptions)
+		}
+		var arg2 func(task *entities.Task) error
+		if args[2] != nil {
+			arg2 = args[2].(func(task *entities.Task) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_StreamAll_Call) Return(err error) *MockRepository_StreamAll_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_StreamAll_Call) RunAndReturn(run func(ctx context.Context, opts *task.ListOptions, fn func(task *entities.Task) error) error) *MockRepository_StreamAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SumLoggedSecondsByTaskIDs provides a mock function for the type MockRepository
+func (_mock *MockRepository) SumLoggedSecondsByTaskIDs(ctx context.Context, taskIDs []string) (map[string]int64, error) {
+	ret := _mock.Called(ctx, taskIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SumLoggedSecondsByTaskIDs")
 	}
 
-	var r0 string
+	var r0 map[string]int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Task) (string, error)); ok {
-		return returnFunc(ctx, task)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) (map[string]int64, error)); ok {
+		return returnFunc(ctx, taskIDs)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Task) string); ok {
-		r0 = returnFunc(ctx, task)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) map[string]int64); ok {
+		r0 = returnFunc(ctx, taskIDs)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Task) error); ok {
-		r1 = returnFunc(ctx, task)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, taskIDs)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockRepository_Create_Call struct {
+// MockRepository_SumLoggedSecondsByTaskIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SumLoggedSecondsByTaskIDs'
+type MockRepository_SumLoggedSecondsByTaskIDs_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// SumLoggedSecondsByTaskIDs is a helper method to define mock.On call
 //   - ctx context.Context
-//   - task *entities.Task
-func (_e *MockRepository_Expecter) Create(ctx interface{}, task interface{}) *MockRepository_Create_Call {
-	return &MockRepository_Create_Call{Call: _e.mock.On("Create", ctx, task)}
+//   - taskIDs []string
+func (_e *MockRepository_Expecter) SumLoggedSecondsByTaskIDs(ctx interface{}, taskIDs interface{}) *MockRepository_SumLoggedSecondsByTaskIDs_Call {
+	return &MockRepository_SumLoggedSecondsByTaskIDs_Call{Call: _e.mock.On("SumLoggedSecondsByTaskIDs", ctx, taskIDs)}
 }
 
-func (_c *MockRepository_Create_Call) Run(run func(ctx context.Context, task *entities.Task)) *MockRepository_Create_Call {
+func (_c *MockRepository_SumLoggedSecondsByTaskIDs_Call) Run(run func(ctx context.Context, taskIDs []string)) *MockRepository_SumLoggedSecondsByTaskIDs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *entities.Task
+		var arg1 []string
 		if args[1] != nil {
-			arg1 = args[1].(*entities.Task)
+			arg1 = args[1].([]string)
 		}
 		run(
 			arg0,
@@ -95,46 +2854,47 @@ func (_c *MockRepository_Create_Call) Run(run func(ctx context.Context, task *en
 	return _c
 }
 
-func (_c *MockRepository_Create_Call) Return(s string, err error) *MockRepository_Create_Call {
-	_c.Call.Return(s, err)
+func (_c *MockRepository_SumLoggedSecondsByTaskIDs_Call) Return(m map[string]int64, err error) *MockRepository_SumLoggedSecondsByTaskIDs_Call {
+	_c.Call.Return(m, err)
 	return _c
 }
 
-func (_c *MockRepository_Create_Call) RunAndReturn(run func(ctx context.Context, task *entities.Task) (string, error)) *MockRepository_Create_Call {
+func (_c *MockRepository_SumLoggedSecondsByTaskIDs_Call) RunAndReturn(run func(ctx context.Context, taskIDs []string) (map[string]int64, error)) *MockRepository_SumLoggedSecondsByTaskIDs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteByID provides a mock function for the type MockRepository
-func (_mock *MockRepository) DeleteByID(ctx context.Context, taskID string) error {
-	ret := _mock.Called(ctx, taskID)
+// UpdateBoardRankByID provides a mock function for the type MockRepository
+func (_mock *MockRepository) UpdateBoardRankByID(ctx context.Context, taskID string, rank string) error {
+	ret := _mock.Called(ctx, taskID, rank)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteByID")
+		panic("no return value specified for UpdateBoardRankByID")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, taskID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, taskID, rank)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockRepository_DeleteByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByID'
-type MockRepository_DeleteByID_Call struct {
+// MockRepository_UpdateBoardRankByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBoardRankByID'
+type MockRepository_UpdateBoardRankByID_Call struct {
 	*mock.Call
 }
 
-// DeleteByID is a helper method to define mock.On call
+// UpdateBoardRankByID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - taskID string
-func (_e *MockRepository_Expecter) DeleteByID(ctx interface{}, taskID interface{}) *MockRepository_DeleteByID_Call {
-	return &MockRepository_DeleteByID_Call{Call: _e.mock.On("DeleteByID", ctx, taskID)}
+//   - rank string
+func (_e *MockRepository_Expecter) UpdateBoardRankByID(ctx interface{}, taskID interface{}, rank interface{}) *MockRepository_UpdateBoardRankByID_Call {
+	return &MockRepository_UpdateBoardRankByID_Call{Call: _e.mock.On("UpdateBoardRankByID", ctx, taskID, rank)}
 }
 
-func (_c *MockRepository_DeleteByID_Call) Run(run func(ctx context.Context, taskID string)) *MockRepository_DeleteByID_Call {
+func (_c *MockRepository_UpdateBoardRankByID_Call) Run(run func(ctx context.Context, taskID string, rank string)) *MockRepository_UpdateBoardRankByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -144,65 +2904,64 @@ func (_c *MockRepository_DeleteByID_Call) Run(run func(ctx context.Context, task
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockRepository_DeleteByID_Call) Return(err error) *MockRepository_DeleteByID_Call {
+func (_c *MockRepository_UpdateBoardRankByID_Call) Return(err error) *MockRepository_UpdateBoardRankByID_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockRepository_DeleteByID_Call) RunAndReturn(run func(ctx context.Context, taskID string) error) *MockRepository_DeleteByID_Call {
+func (_c *MockRepository_UpdateBoardRankByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, rank string) error) *MockRepository_UpdateBoardRankByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindByID provides a mock function for the type MockRepository
-func (_mock *MockRepository) FindByID(ctx context.Context, taskID string) (*entities.Task, error) {
-	ret := _mock.Called(ctx, taskID)
+// UpdateByID provides a mock function for the type MockRepository
+func (_mock *MockRepository) UpdateByID(ctx context.Context, taskID string, title string, description string, priority enums.TaskPriority, startAt *time.Time, dueAt *time.Time) error {
+	ret := _mock.Called(ctx, taskID, title, description, priority, startAt, dueAt)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByID")
+		panic("no return value specified for UpdateByID")
 	}
 
-	var r0 *entities.Task
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entities.Task, error)); ok {
-		return returnFunc(ctx, taskID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entities.Task); ok {
-		r0 = returnFunc(ctx, taskID)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.Task)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, taskID)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, enums.TaskPriority, *time.Time, *time.Time) error); ok {
+		r0 = returnFunc(ctx, taskID, title, description, priority, startAt, dueAt)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
-type MockRepository_FindByID_Call struct {
+// MockRepository_UpdateByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateByID'
+type MockRepository_UpdateByID_Call struct {
 	*mock.Call
 }
 
-// FindByID is a helper method to define mock.On call
+// UpdateByID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - taskID string
-func (_e *MockRepository_Expecter) FindByID(ctx interface{}, taskID interface{}) *MockRepository_FindByID_Call {
-	return &MockRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, taskID)}
+//   - title string
+//   - description string
+//   - priority enums.TaskPriority
+//   - startAt *time.Time
+//   - dueAt *time.Time
+func (_e *MockRepository_Expecter) UpdateByID(ctx interface{}, taskID interface{}, title interface{}, description interface{}, priority interface{}, startAt interface{}, dueAt interface{}) *MockRepository_UpdateByID_Call {
+	return &MockRepository_UpdateByID_Call{Call: _e.mock.On("UpdateByID", ctx, taskID, title, description, priority, startAt, dueAt)}
 }
 
-func (_c *MockRepository_FindByID_Call) Run(run func(ctx context.Context, taskID string)) *MockRepository_FindByID_Call {
+func (_c *MockRepository_UpdateByID_Call) Run(run func(ctx context.Context, taskID string, title string, description string, priority enums.TaskPriority, startAt *time.Time, dueAt *time.Time)) *MockRepository_UpdateByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -212,65 +2971,143 @@ func (_c *MockRepository_FindByID_Call) Run(run func(ctx context.Context, taskID
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 enums.TaskPriority
+		if args[4] != nil {
+			arg4 = args[4].(enums.TaskPriority)
+		}
+		var arg5 *time.Time
+		if args[5] != nil {
+			arg5 = args[5].(*time.Time)
+		}
+		var arg6 *time.Time
+		if args[6] != nil {
+			arg6 = args[6].(*time.Time)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+			arg6,
 		)
 	})
 	return _c
 }
 
-func (_c *MockRepository_FindByID_Call) Return(task *entities.Task, err error) *MockRepository_FindByID_Call {
-	_c.Call.Return(task, err)
+func (_c *MockRepository_UpdateByID_Call) Return(err error) *MockRepository_UpdateByID_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockRepository_FindByID_Call) RunAndReturn(run func(ctx context.Context, taskID string) (*entities.Task, error)) *MockRepository_FindByID_Call {
+func (_c *MockRepository_UpdateByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, title string, description string, priority enums.TaskPriority, startAt *time.Time, dueAt *time.Time) error) *MockRepository_UpdateByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindByUserID provides a mock function for the type MockRepository
-func (_mock *MockRepository) FindByUserID(ctx context.Context, userID string) ([]entities.Task, error) {
-	ret := _mock.Called(ctx, userID)
+// UpdateParentByID provides a mock function for the type MockRepository
+func (_mock *MockRepository) UpdateParentByID(ctx context.Context, taskID string, parentID *string) error {
+	ret := _mock.Called(ctx, taskID, parentID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByUserID")
+		panic("no return value specified for UpdateParentByID")
 	}
 
-	var r0 []entities.Task
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]entities.Task, error)); ok {
-		return returnFunc(ctx, userID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []entities.Task); ok {
-		r0 = returnFunc(ctx, userID)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *string) error); ok {
+		r0 = returnFunc(ctx, taskID, parentID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]entities.Task)
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_UpdateParentByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateParentByID'
+type MockRepository_UpdateParentByID_Call struct {
+	*mock.Call
+}
+
+// UpdateParentByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - parentID *string
+func (_e *MockRepository_Expecter) UpdateParentByID(ctx interface{}, taskID interface{}, parentID interface{}) *MockRepository_UpdateParentByID_Call {
+	return &MockRepository_UpdateParentByID_Call{Call: _e.mock.On("UpdateParentByID", ctx, taskID, parentID)}
+}
+
+func (_c *MockRepository_UpdateParentByID_Call) Run(run func(ctx context.Context, taskID string, parentID *string)) *MockRepository_UpdateParentByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *string
+		if args[2] != nil {
+			arg2 = args[2].(*string)
 		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateParentByID_Call) Return(err error) *MockRepository_UpdateParentByID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_UpdateParentByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, parentID *string) error) *MockRepository_UpdateParentByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProjectByID provides a mock function for the type MockRepository
+func (_mock *MockRepository) UpdateProjectByID(ctx context.Context, taskID string, projectID *string) error {
+	ret := _mock.Called(ctx, taskID, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProjectByID")
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, userID)
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *string) error); ok {
+		r0 = returnFunc(ctx, taskID, projectID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockRepository_FindByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserID'
-type MockRepository_FindByUserID_Call struct {
+// MockRepository_UpdateProjectByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProjectByID'
+type MockRepository_UpdateProjectByID_Call struct {
 	*mock.Call
 }
 
-// FindByUserID is a helper method to define mock.On call
+// UpdateProjectByID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-func (_e *MockRepository_Expecter) FindByUserID(ctx interface{}, userID interface{}) *MockRepository_FindByUserID_Call {
-	return &MockRepository_FindByUserID_Call{Call: _e.mock.On("FindByUserID", ctx, userID)}
+//   - taskID string
+//   - projectID *string
+func (_e *MockRepository_Expecter) UpdateProjectByID(ctx interface{}, taskID interface{}, projectID interface{}) *MockRepository_UpdateProjectByID_Call {
+	return &MockRepository_UpdateProjectByID_Call{Call: _e.mock.On("UpdateProjectByID", ctx, taskID, projectID)}
 }
 
-func (_c *MockRepository_FindByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockRepository_FindByUserID_Call {
+func (_c *MockRepository_UpdateProjectByID_Call) Run(run func(ctx context.Context, taskID string, projectID *string)) *MockRepository_UpdateProjectByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -280,57 +3117,60 @@ func (_c *MockRepository_FindByUserID_Call) Run(run func(ctx context.Context, us
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 *string
+		if args[2] != nil {
+			arg2 = args[2].(*string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockRepository_FindByUserID_Call) Return(tasks []entities.Task, err error) *MockRepository_FindByUserID_Call {
-	_c.Call.Return(tasks, err)
+func (_c *MockRepository_UpdateProjectByID_Call) Return(err error) *MockRepository_UpdateProjectByID_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockRepository_FindByUserID_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]entities.Task, error)) *MockRepository_FindByUserID_Call {
+func (_c *MockRepository_UpdateProjectByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, projectID *string) error) *MockRepository_UpdateProjectByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateByID provides a mock function for the type MockRepository
-func (_mock *MockRepository) UpdateByID(ctx context.Context, taskID string, title string, description string, priority enums.TaskPriority) error {
-	ret := _mock.Called(ctx, taskID, title, description, priority)
+// UpdateSeriesIDByID provides a mock function for the type MockRepository
+func (_mock *MockRepository) UpdateSeriesIDByID(ctx context.Context, taskID string, seriesID *string) error {
+	ret := _mock.Called(ctx, taskID, seriesID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateByID")
+		panic("no return value specified for UpdateSeriesIDByID")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, enums.TaskPriority) error); ok {
-		r0 = returnFunc(ctx, taskID, title, description, priority)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *string) error); ok {
+		r0 = returnFunc(ctx, taskID, seriesID)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockRepository_UpdateByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateByID'
-type MockRepository_UpdateByID_Call struct {
+// MockRepository_UpdateSeriesIDByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSeriesIDByID'
+type MockRepository_UpdateSeriesIDByID_Call struct {
 	*mock.Call
 }
 
-// UpdateByID is a helper method to define mock.On call
+// UpdateSeriesIDByID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - taskID string
-//   - title string
-//   - description string
-//   - priority enums.TaskPriority
-func (_e *MockRepository_Expecter) UpdateByID(ctx interface{}, taskID interface{}, title interface{}, description interface{}, priority interface{}) *MockRepository_UpdateByID_Call {
-	return &MockRepository_UpdateByID_Call{Call: _e.mock.On("UpdateByID", ctx, taskID, title, description, priority)}
+//   - seriesID *string
+func (_e *MockRepository_Expecter) UpdateSeriesIDByID(ctx interface{}, taskID interface{}, seriesID interface{}) *MockRepository_UpdateSeriesIDByID_Call {
+	return &MockRepository_UpdateSeriesIDByID_Call{Call: _e.mock.On("UpdateSeriesIDByID", ctx, taskID, seriesID)}
 }
 
-func (_c *MockRepository_UpdateByID_Call) Run(run func(ctx context.Context, taskID string, title string, description string, priority enums.TaskPriority)) *MockRepository_UpdateByID_Call {
+func (_c *MockRepository_UpdateSeriesIDByID_Call) Run(run func(ctx context.Context, taskID string, seriesID *string)) *MockRepository_UpdateSeriesIDByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -340,50 +3180,40 @@ func (_c *MockRepository_UpdateByID_Call) Run(run func(ctx context.Context, task
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 string
+		var arg2 *string
 		if args[2] != nil {
-			arg2 = args[2].(string)
-		}
-		var arg3 string
-		if args[3] != nil {
-			arg3 = args[3].(string)
-		}
-		var arg4 enums.TaskPriority
-		if args[4] != nil {
-			arg4 = args[4].(enums.TaskPriority)
+			arg2 = args[2].(*string)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
-			arg3,
-			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *MockRepository_UpdateByID_Call) Return(err error) *MockRepository_UpdateByID_Call {
+func (_c *MockRepository_UpdateSeriesIDByID_Call) Return(err error) *MockRepository_UpdateSeriesIDByID_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockRepository_UpdateByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, title string, description string, priority enums.TaskPriority) error) *MockRepository_UpdateByID_Call {
+func (_c *MockRepository_UpdateSeriesIDByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, seriesID *string) error) *MockRepository_UpdateSeriesIDByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UpdateStatusByID provides a mock function for the type MockRepository
-func (_mock *MockRepository) UpdateStatusByID(ctx context.Context, taskID string, status enums.TaskStatus) error {
-	ret := _mock.Called(ctx, taskID, status)
+func (_mock *MockRepository) UpdateStatusByID(ctx context.Context, taskID string, status enums.TaskStatus, category enums.TaskStatusCategory) error {
+	ret := _mock.Called(ctx, taskID, status, category)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateStatusByID")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, enums.TaskStatus) error); ok {
-		r0 = returnFunc(ctx, taskID, status)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, enums.TaskStatus, enums.TaskStatusCategory) error); ok {
+		r0 = returnFunc(ctx, taskID, status, category)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -399,11 +3229,12 @@ type MockRepository_UpdateStatusByID_Call struct {
 //   - ctx context.Context
 //   - taskID string
 //   - status enums.TaskStatus
-func (_e *MockRepository_Expecter) UpdateStatusByID(ctx interface{}, taskID interface{}, status interface{}) *MockRepository_UpdateStatusByID_Call {
-	return &MockRepository_UpdateStatusByID_Call{Call: _e.mock.On("UpdateStatusByID", ctx, taskID, status)}
+//   - category enums.TaskStatusCategory
+func (_e *MockRepository_Expecter) UpdateStatusByID(ctx interface{}, taskID interface{}, status interface{}, category interface{}) *MockRepository_UpdateStatusByID_Call {
+	return &MockRepository_UpdateStatusByID_Call{Call: _e.mock.On("UpdateStatusByID", ctx, taskID, status, category)}
 }
 
-func (_c *MockRepository_UpdateStatusByID_Call) Run(run func(ctx context.Context, taskID string, status enums.TaskStatus)) *MockRepository_UpdateStatusByID_Call {
+func (_c *MockRepository_UpdateStatusByID_Call) Run(run func(ctx context.Context, taskID string, status enums.TaskStatus, category enums.TaskStatusCategory)) *MockRepository_UpdateStatusByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -417,10 +3248,15 @@ func (_c *MockRepository_UpdateStatusByID_Call) Run(run func(ctx context.Context
 		if args[2] != nil {
 			arg2 = args[2].(enums.TaskStatus)
 		}
+		var arg3 enums.TaskStatusCategory
+		if args[3] != nil {
+			arg3 = args[3].(enums.TaskStatusCategory)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
@@ -431,7 +3267,70 @@ func (_c *MockRepository_UpdateStatusByID_Call) Return(err error) *MockRepositor
 	return _c
 }
 
-func (_c *MockRepository_UpdateStatusByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, status enums.TaskStatus) error) *MockRepository_UpdateStatusByID_Call {
+func (_c *MockRepository_UpdateStatusByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, status enums.TaskStatus, category enums.TaskStatusCategory) error) *MockRepository_UpdateStatusByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWorkspaceByID provides a mock function for the type MockRepository
+func (_mock *MockRepository) UpdateWorkspaceByID(ctx context.Context, taskID string, workspaceID *string) error {
+	ret := _mock.Called(ctx, taskID, workspaceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWorkspaceByID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *string) error); ok {
+		r0 = returnFunc(ctx, taskID, workspaceID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_UpdateWorkspaceByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWorkspaceByID'
+type MockRepository_UpdateWorkspaceByID_Call struct {
+	*mock.Call
+}
+
+// UpdateWorkspaceByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - workspaceID *string
+func (_e *MockRepository_Expecter) UpdateWorkspaceByID(ctx interface{}, taskID interface{}, workspaceID interface{}) *MockRepository_UpdateWorkspaceByID_Call {
+	return &MockRepository_UpdateWorkspaceByID_Call{Call: _e.mock.On("UpdateWorkspaceByID", ctx, taskID, workspaceID)}
+}
+
+func (_c *MockRepository_UpdateWorkspaceByID_Call) Run(run func(ctx context.Context, taskID string, workspaceID *string)) *MockRepository_UpdateWorkspaceByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *string
+		if args[2] != nil {
+			arg2 = args[2].(*string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateWorkspaceByID_Call) Return(err error) *MockRepository_UpdateWorkspaceByID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_UpdateWorkspaceByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, workspaceID *string) error) *MockRepository_UpdateWorkspaceByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -4,15 +4,92 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type Model struct {
-	ID          uuid.UUID `json:"id" db:"id"`
-	UserID      uuid.UUID `json:"userId" db:"user_id"`
-	Title       string    `json:"title" db:"title"`
-	Description string    `json:"description" db:"description"`
-	Priority    int       `json:"priority" db:"priority"`
-	Status      string    `json:"status" db:"status"`
-	CreatedAt   time.Time `json:"createdAt" db:"created_at"`
-	UpdatedAt   time.Time `json:"updatedAt" db:"updated_at"`
+	ID          uuid.UUID  `json:"id" db:"id"`
+	UserID      uuid.UUID  `json:"userId" db:"user_id"`
+	WorkspaceID *uuid.UUID `json:"workspaceId" db:"workspace_id"`
+	ProjectID   *uuid.UUID `json:"projectId" db:"project_id"`
+	ParentID    *uuid.UUID `json:"parentId" db:"parent_id"`
+	Title       string     `json:"title" db:"title"`
+	Description string     `json:"description" db:"description"`
+	Priority    int        `json:"priority" db:"priority"`
+	Status      string     `json:"status" db:"status"`
+	// StatusCategory is denormalized from the workflow so listings need no join
+	StatusCategory string     `json:"statusCategory" db:"status_category"`
+	StartAt        *time.Time `json:"startAt" db:"start_at"`
+	DueAt          *time.Time `json:"dueAt" db:"due_at"`
+	CreatedAt      time.Time  `json:"createdAt" db:"created_at"`
+	UpdatedAt      time.Time  `json:"updatedAt" db:"updated_at"`
+	DeletedAt      *time.Time `json:"deletedAt" db:"deleted_at"`
+	SeriesID       *uuid.UUID `json:"seriesId" db:"series_id"`
+	BoardRank      string     `json:"boardRank" db:"board_rank"`
+	Version        int        `json:"version" db:"version"`
+}
+
+type SeriesModel struct {
+	ID               uuid.UUID  `db:"id"`
+	UserID           uuid.UUID  `db:"user_id"`
+	Rule             string     `db:"rule"`
+	AnchorAt         time.Time  `db:"anchor_at"`
+	LastOccurrenceAt time.Time  `db:"last_occurrence_at"`
+	StoppedAt        *time.Time `db:"stopped_at"`
+	CreatedAt        time.Time  `db:"created_at"`
+	UpdatedAt        time.Time  `db:"updated_at"`
+}
+
+type SearchResultModel struct {
+	Model
+	Rank                 float64 `db:"rank"`
+	TitleHighlight       string  `db:"title_highlight"`
+	DescriptionHighlight string  `db:"description_highlight"`
+}
+
+type SubtaskRollupModel struct {
+	RootID    uuid.UUID `db:"root_id"`
+	Total     int       `db:"total"`
+	Completed int       `db:"completed"`
+}
+
+type TaskTagModel struct {
+	TaskID    uuid.UUID `db:"task_id"`
+	ID        uuid.UUID `db:"id"`
+	UserID    uuid.UUID `db:"user_id"`
+	Name      string    `db:"name"`
+	Color     string    `db:"color"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+type TaskAssigneeModel struct {
+	TaskID    uuid.UUID `db:"task_id"`
+	UserID    uuid.UUID `db:"id"`
+	Name      string    `db:"name"`
+	Email     string    `db:"email"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type TaskLoggedTimeModel struct {
+	TaskID  uuid.UUID `db:"task_id"`
+	Seconds int64     `db:"seconds"`
+}
+
+type TaskCustomFieldValueModel struct {
+	TaskID  uuid.UUID `db:"task_id"`
+	FieldID uuid.UUID `db:"field_id"`
+	Name    string    `db:"name"`
+	Type    string    `db:"type"`
+	Value   []byte    `db:"value"`
+}
+
+type PurgeResultModel struct {
+	Purged        int64          `db:"purged"`
+	ContentHashes pq.StringArray `db:"content_hashes"`
+}
+
+type StatusCountModel struct {
+	Status string `db:"status"`
+	Count  int    `db:"count"`
 }
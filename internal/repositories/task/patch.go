@@ -0,0 +1,75 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/patchutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+)
+
+// Patch lists the columns a partial update sets. Members that are not present
+// keep their stored value and a null member stores NULL.
+type Patch struct {
+	Title       patchutil.Optional[string]
+	Description patchutil.Optional[string]
+	Priority    patchutil.Optional[enums.TaskPriority]
+	StartAt     patchutil.Optional[time.Time]
+	DueAt       patchutil.Optional[time.Time]
+}
+
+// PatchByID updates only the columns present in the patch and always bumps
+// the version, so tag or custom field changes alone still count as a write
+func (r *repository) PatchByID(ctx context.Context, taskID string, patch *Patch) error {
+	var assignments []string
+	var args []any
+
+	set := func(column string, value any) {
+		args = append(args, value)
+		assignments = append(assignments, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+
+	if patch.Title.Present {
+		set("title", patch.Title.Value)
+	}
+	if patch.Description.Present {
+		set("description", patch.Description.Value)
+	}
+	if patch.Priority.Present {
+		set("priority", patch.Priority.Value)
+	}
+	if patch.StartAt.Present {
+		set("start_at", patch.StartAt.Value)
+	}
+	if patch.DueAt.Present {
+		set("due_at", patch.DueAt.Value)
+	}
+	set("updated_at", timeutil.BangkokNow())
+
+	args = append(args, taskID)
+	query := fmt.Sprintf(`
+		UPDATE tasks 
+		SET %s, version = version + 1
+		WHERE id = $%d AND deleted_at IS NULL
+	`, strings.Join(assignments, ", "), len(args))
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return errors.New("no rows affected")
+	}
+
+	return nil
+}
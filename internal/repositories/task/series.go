@@ -0,0 +1,130 @@
+package task
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+)
+
+func (r *repository) CreateSeries(ctx context.Context, series *entities.TaskSeries) error {
+	seriesModel, err := FromTaskSeriesEntity(series)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO task_series (id, user_id, rule, anchor_at, last_occurrence_at, stopped_at, created_at, updated_at)
+		VALUES (:id, :user_id, :rule, :anchor_at, :last_occurrence_at, :stopped_at, :created_at, :updated_at)
+	`
+	result, err := r.conn(ctx).NamedExecContext(ctx, query, seriesModel)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+func (r *repository) FindSeriesByID(ctx context.Context, seriesID string) (*entities.TaskSeries, error) {
+	query := `
+		SELECT id, user_id, rule, anchor_at, last_occurrence_at, stopped_at, created_at, updated_at
+		FROM task_series
+		WHERE id = $1
+	`
+
+	var seriesModel SeriesModel
+	err := r.conn(ctx).GetContext(ctx, &seriesModel, query, seriesID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return seriesModel.ToTaskSeriesEntity(), nil
+}
+
+func (r *repository) UpdateSeriesIDByID(ctx context.Context, taskID string, seriesID *string) error {
+	query := `
+		UPDATE tasks
+		SET series_id = $1, updated_at = $2, version = version + 1
+		WHERE id = $3 AND deleted_at IS NULL
+	`
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, seriesID, timeutil.BangkokNow(), taskID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// StopSeriesByID ends the series, the occurrences spawned so far are kept
+func (r *repository) StopSeriesByID(ctx context.Context, seriesID string) error {
+	now := timeutil.BangkokNow()
+	query := `
+		UPDATE task_series
+		SET stopped_at = $1, updated_at = $1
+		WHERE id = $2 AND stopped_at IS NULL
+	`
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, now, seriesID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// ClaimSeriesOccurrence moves the series forward to the occurrence due at occurrenceAt.
+// It reports false when the series is stopped or the occurrence was already claimed,
+// so concurrent completions spawn the next occurrence only once.
+func (r *repository) ClaimSeriesOccurrence(ctx context.Context, seriesID string, occurrenceAt time.Time) (bool, error) {
+	query := `
+		UPDATE task_series
+		SET last_occurrence_at = $1, updated_at = $2
+		WHERE id = $3 AND stopped_at IS NULL AND last_occurrence_at < $1
+	`
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, occurrenceAt, timeutil.BangkokNow(), seriesID)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
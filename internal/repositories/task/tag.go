@@ -0,0 +1,56 @@
+package task
+
+import (
+	"context"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/lib/pq"
+)
+
+// ReplaceTags sets the tags of a task to exactly tagIDs in a single statement
+func (r *repository) ReplaceTags(ctx context.Context, taskID string, tagIDs []string) error {
+	query := `
+		WITH removed AS (
+			DELETE FROM task_tags
+			WHERE task_id = $1 AND NOT (tag_id = ANY($2::uuid[]))
+		)
+		INSERT INTO task_tags (task_id, tag_id, created_at)
+		SELECT $1, tag_id, $3
+		FROM unnest($2::uuid[]) AS tag_id
+		ON CONFLICT (task_id, tag_id) DO NOTHING
+	`
+
+	_, err := r.conn(ctx).ExecContext(ctx, query, taskID, pq.Array(tagIDs), timeutil.BangkokNow())
+	return err
+}
+
+// FindTagsByTaskIDs returns the tags of each task keyed by task ID.
+// Tasks without tags are absent from the map.
+func (r *repository) FindTagsByTaskIDs(ctx context.Context, taskIDs []string) (map[string][]entities.Tag, error) {
+	if len(taskIDs) == 0 {
+		return map[string][]entities.Tag{}, nil
+	}
+
+	query := `
+		SELECT tt.task_id, t.id, t.user_id, t.name, t.color, t.created_at, t.updated_at
+		FROM task_tags tt
+		JOIN tags t ON t.id = tt.tag_id
+		WHERE tt.task_id = ANY($1)
+		ORDER BY lower(t.name) ASC, t.id ASC
+	`
+
+	var tagModels []TaskTagModel
+	err := r.conn(ctx).SelectContext(ctx, &tagModels, query, pq.Array(taskIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string][]entities.Tag, len(taskIDs))
+	for _, model := range tagModels {
+		taskID := model.TaskID.String()
+		tags[taskID] = append(tags[taskID], *model.ToTagEntity())
+	}
+
+	return tags, nil
+}
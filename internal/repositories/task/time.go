@@ -0,0 +1,36 @@
+package task
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+)
+
+// SumLoggedSecondsByTaskIDs returns the total seconds of finished time entries keyed by task ID.
+// Tasks without finished entries are absent from the map.
+func (r *repository) SumLoggedSecondsByTaskIDs(ctx context.Context, taskIDs []string) (map[string]int64, error) {
+	if len(taskIDs) == 0 {
+		return map[string]int64{}, nil
+	}
+
+	query := `
+		SELECT task_id,
+			COALESCE(SUM(EXTRACT(EPOCH FROM (ended_at - started_at)))::bigint, 0) AS seconds
+		FROM task_time_entries
+		WHERE task_id = ANY($1) AND ended_at IS NOT NULL
+		GROUP BY task_id
+	`
+
+	var models []TaskLoggedTimeModel
+	err := r.conn(ctx).SelectContext(ctx, &models, query, pq.Array(taskIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	seconds := make(map[string]int64, len(models))
+	for _, model := range models {
+		seconds[model.TaskID.String()] = model.Seconds
+	}
+
+	return seconds, nil
+}
@@ -0,0 +1,117 @@
+package task
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+)
+
+// FindDeletedByID returns the task only while it is in the trash
+func (r *repository) FindDeletedByID(ctx context.Context, taskID string) (*entities.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks
+		WHERE id = $1 AND deleted_at IS NOT NULL
+	`
+
+	var taskModel Model
+	err := r.conn(ctx).GetContext(ctx, &taskModel, query, taskID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return taskModel.ToTaskEntity(), nil
+}
+
+// RestoreByID takes the task out of the trash along with the descendants
+// that were trashed together with it
+func (r *repository) RestoreByID(ctx context.Context, taskID string) error {
+	query := `
+		WITH RECURSIVE subtree AS (
+			SELECT id, deleted_at FROM tasks WHERE id = $1 AND deleted_at IS NOT NULL
+			UNION ALL
+			SELECT t.id, t.deleted_at FROM tasks t JOIN subtree s ON t.parent_id = s.id WHERE t.deleted_at = s.deleted_at
+		) CYCLE id SET is_cycle USING path
+		UPDATE tasks SET deleted_at = NULL, updated_at = $2, version = version + 1 WHERE id IN (SELECT id FROM subtree)
+	`
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, taskID, timeutil.BangkokNow())
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// PurgeByID permanently deletes a trashed task and its descendants, which are
+// always trashed as well. It returns the content hashes of the attachments
+// deleted along with them.
+func (r *repository) PurgeByID(ctx context.Context, taskID string) ([]string, error) {
+	query := `
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM tasks WHERE id = $1 AND deleted_at IS NOT NULL
+			UNION ALL
+			SELECT t.id FROM tasks t JOIN subtree s ON t.parent_id = s.id
+		) CYCLE id SET is_cycle USING path,
+		purged AS (
+			DELETE FROM tasks WHERE id IN (SELECT id FROM subtree) RETURNING id
+		)
+		` + purgeResultColumns
+
+	var result PurgeResultModel
+	if err := r.conn(ctx).GetContext(ctx, &result, query, taskID); err != nil {
+		return nil, err
+	}
+
+	if result.Purged == 0 {
+		return nil, ErrNoRowsAffected
+	}
+
+	return result.ContentHashes, nil
+}
+
+// PurgeDeletedBefore permanently deletes the tasks trashed before the given time.
+// A task is never trashed after its parent, so whole subtrees go at once. It
+// returns how many tasks were deleted and the content hashes of their attachments.
+func (r *repository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, []string, error) {
+	query := `
+		WITH purged AS (
+			DELETE FROM tasks WHERE deleted_at < $1 RETURNING id
+		)
+		` + purgeResultColumns
+
+	var result PurgeResultModel
+	if err := r.conn(ctx).GetContext(ctx, &result, query, before); err != nil {
+		return 0, nil, err
+	}
+
+	return result.Purged, result.ContentHashes, nil
+}
+
+// purgeResultColumns selects a PurgeResultModel from the purged CTE. The
+// attachments are read from the snapshot the statement started with, so those
+// removed by the cascade are still visible.
+const purgeResultColumns = `
+	SELECT
+		(SELECT COUNT(*) FROM purged) AS purged,
+		ARRAY(
+			SELECT DISTINCT content_hash FROM task_attachments
+			WHERE task_id IN (SELECT id FROM purged)
+		) AS content_hashes
+`
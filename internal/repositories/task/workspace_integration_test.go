@@ -0,0 +1,206 @@
+//go:build integration
+
+package task
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/infrastructure/database"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// errRollback undoes everything a test wrote
+var errRollback = errors.New("rollback")
+
+// WorkspaceIntegrationTestSuite runs the workspace move queries against a
+// migrated database given by DATABASE_DRIVER and DATABASE_URI:
+//
+//	go test -tags integration ./internal/repositories/task/...
+//
+// Every test runs in a transaction that is rolled back.
+type WorkspaceIntegrationTestSuite struct {
+	suite.Suite
+	db         *sqlx.DB
+	repo       Repository
+	transactor database.Transactor
+}
+
+func (suite *WorkspaceIntegrationTestSuite) SetupSuite() {
+	uri := os.Getenv("DATABASE_URI")
+	if uri == "" {
+		suite.T().Skip("DATABASE_URI is not set")
+	}
+
+	driver := os.Getenv("DATABASE_DRIVER")
+	if driver == "" {
+		driver = "postgres"
+	}
+
+	db, err := sqlx.Connect(driver, uri)
+	require.NoError(suite.T(), err)
+
+	suite.db = db
+	suite.repo = NewRepository(db)
+	suite.transactor = database.NewTransactor(db)
+}
+
+func (suite *WorkspaceIntegrationTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+// withinRollback runs fn in a transaction that is always rolled back
+func (suite *WorkspaceIntegrationTestSuite) withinRollback(fn func(ctx context.Context)) {
+	err := suite.transactor.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		fn(ctx)
+		return errRollback
+	})
+	require.ErrorIs(suite.T(), err, errRollback)
+}
+
+func (suite *WorkspaceIntegrationTestSuite) createUser(ctx context.Context) string {
+	userID := uuid.NewString()
+	_, err := database.Conn(ctx, suite.db).ExecContext(ctx, `
+		INSERT INTO users (id, name, email, password, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $5)
+	`, userID, "Test User", userID+"@example.com", "password", time.Now())
+	require.NoError(suite.T(), err)
+
+	return userID
+}
+
+func (suite *WorkspaceIntegrationTestSuite) createWorkspace(ctx context.Context, memberIDs ...string) string {
+	workspaceID := uuid.NewString()
+	conn := database.Conn(ctx, suite.db)
+	now := time.Now()
+
+	_, err := conn.ExecContext(ctx, `
+		INSERT INTO workspaces (id, name, created_at, updated_at)
+		VALUES ($1, $2, $3, $3)
+	`, workspaceID, "Test Workspace", now)
+	require.NoError(suite.T(), err)
+
+	for _, memberID := range memberIDs {
+		_, err := conn.ExecContext(ctx, `
+			INSERT INTO workspace_members (workspace_id, user_id, role, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $4)
+		`, workspaceID, memberID, enums.WorkspaceRoleMember.String(), now)
+		require.NoError(suite.T(), err)
+	}
+
+	return workspaceID
+}
+
+func (suite *WorkspaceIntegrationTestSuite) createTask(ctx context.Context, userID string, parentID *string) string {
+	now := time.Now()
+	taskID, err := suite.repo.Create(ctx, &entities.Task{
+		ID:             uuid.NewString(),
+		UserID:         userID,
+		ParentID:       parentID,
+		Title:          "Test Task",
+		Description:    "Test Description",
+		Priority:       enums.TaskPriorityMedium,
+		Status:         enums.TaskStatusTodo,
+		StatusCategory: enums.TaskStatusCategoryTodo,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		BoardRank:      "0|hzzzzz:",
+	})
+	require.NoError(suite.T(), err)
+
+	return taskID
+}
+
+func (suite *WorkspaceIntegrationTestSuite) assigneeIDs(ctx context.Context, taskID string) []string {
+	assigneesByTask, err := suite.repo.FindAssigneesByTaskIDs(ctx, []string{taskID})
+	require.NoError(suite.T(), err)
+
+	ids := []string{}
+	for _, assignee := range assigneesByTask[taskID] {
+		ids = append(ids, assignee.ID)
+	}
+
+	return ids
+}
+
+func (suite *WorkspaceIntegrationTestSuite) TestUpdateWorkspaceByID_MovesSubtreeIntoWorkspace() {
+	suite.withinRollback(func(ctx context.Context) {
+		// Arrange
+		ownerID := suite.createUser(ctx)
+		memberID := suite.createUser(ctx)
+		outsiderID := suite.createUser(ctx)
+		workspaceID := suite.createWorkspace(ctx, ownerID, memberID)
+
+		rootID := suite.createTask(ctx, ownerID, nil)
+		subtaskID := suite.createTask(ctx, ownerID, &rootID)
+		require.NoError(suite.T(), suite.repo.CreateAssignee(ctx, rootID, memberID))
+		require.NoError(suite.T(), suite.repo.CreateAssignee(ctx, rootID, outsiderID))
+		require.NoError(suite.T(), suite.repo.CreateAssignee(ctx, subtaskID, outsiderID))
+
+		// Act
+		err := suite.repo.UpdateWorkspaceByID(ctx, rootID, &workspaceID)
+
+		// Assert
+		require.NoError(suite.T(), err)
+		for _, taskID := range []string{rootID, subtaskID} {
+			movedTask, err := suite.repo.FindByID(ctx, taskID)
+			require.NoError(suite.T(), err)
+			require.NotNil(suite.T(), movedTask)
+			assert.Equal(suite.T(), &workspaceID, movedTask.WorkspaceID)
+			assert.Equal(suite.T(), 2, movedTask.Version)
+		}
+		assert.ElementsMatch(suite.T(), []string{memberID}, suite.assigneeIDs(ctx, rootID))
+		assert.Empty(suite.T(), suite.assigneeIDs(ctx, subtaskID))
+	})
+}
+
+func (suite *WorkspaceIntegrationTestSuite) TestUpdateWorkspaceByID_MovesSubtreeBackToPersonal() {
+	suite.withinRollback(func(ctx context.Context) {
+		// Arrange
+		ownerID := suite.createUser(ctx)
+		memberID := suite.createUser(ctx)
+		workspaceID := suite.createWorkspace(ctx, ownerID, memberID)
+
+		rootID := suite.createTask(ctx, ownerID, nil)
+		require.NoError(suite.T(), suite.repo.UpdateWorkspaceByID(ctx, rootID, &workspaceID))
+		require.NoError(suite.T(), suite.repo.CreateAssignee(ctx, rootID, ownerID))
+		require.NoError(suite.T(), suite.repo.CreateAssignee(ctx, rootID, memberID))
+
+		// Act
+		err := suite.repo.UpdateWorkspaceByID(ctx, rootID, nil)
+
+		// Assert
+		require.NoError(suite.T(), err)
+		movedTask, err := suite.repo.FindByID(ctx, rootID)
+		require.NoError(suite.T(), err)
+		require.NotNil(suite.T(), movedTask)
+		assert.Nil(suite.T(), movedTask.WorkspaceID)
+		assert.ElementsMatch(suite.T(), []string{ownerID}, suite.assigneeIDs(ctx, rootID))
+	})
+}
+
+func (suite *WorkspaceIntegrationTestSuite) TestUpdateWorkspaceByID_MissingTask() {
+	suite.withinRollback(func(ctx context.Context) {
+		// Act
+		err := suite.repo.UpdateWorkspaceByID(ctx, uuid.NewString(), nil)
+
+		// Assert
+		assert.ErrorIs(suite.T(), err, ErrNoRowsAffected)
+	})
+}
+
+func TestWorkspaceIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(WorkspaceIntegrationTestSuite))
+}
@@ -0,0 +1,289 @@
+package timeentry
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/jmoiron/sqlx"
+)
+
+type Repository interface {
+	Create(ctx context.Context, entry *entities.TimeEntry) error
+	FindByID(ctx context.Context, entryID string) (*entities.TimeEntry, error)
+	FindByTaskID(ctx context.Context, taskID string) ([]entities.TimeEntry, error)
+	FindRunningByUserID(ctx context.Context, userID string) (*entities.TimeEntry, error)
+	UpdateByID(ctx context.Context, entryID string, startedAt time.Time, endedAt time.Time, note string) error
+	StopByID(ctx context.Context, entryID string, endedAt time.Time, note *string) error
+	DeleteByID(ctx context.Context, entryID string) error
+	Report(ctx context.Context, userID string, from, to time.Time, groupBy enums.TimeReportGroupBy) ([]entities.TimeReportRow, error)
+	SumSeconds(ctx context.Context, userID string, from, to time.Time) (int64, error)
+}
+
+// entryColumns lists the columns scanned into WithUserModel
+const entryColumns = `
+	e.id, e.task_id, e.user_id, e.started_at, e.ended_at, e.note, e.created_at, e.updated_at,
+	u.name AS user_name, u.email AS user_email`
+
+type repository struct {
+	db *sqlx.DB
+}
+
+// @WireSet("Repository")
+func NewRepository(db *sqlx.DB) Repository {
+	return &repository{
+		db: db,
+	}
+}
+
+// Create stores the entry. Starting a second running timer for a user
+// affects no rows and returns ErrNoRowsAffected.
+func (r *repository) Create(ctx context.Context, entry *entities.TimeEntry) error {
+	entryModel, err := FromTimeEntryEntity(entry)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO task_time_entries (id, task_id, user_id, started_at, ended_at, note, created_at, updated_at)
+		VALUES (:id, :task_id, :user_id, :started_at, :ended_at, :note, :created_at, :updated_at)
+		ON CONFLICT DO NOTHING
+	`
+	result, err := r.db.NamedExecContext(ctx, query, entryModel)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+func (r *repository) FindByID(ctx context.Context, entryID string) (*entities.TimeEntry, error) {
+	query := `
+		SELECT ` + entryColumns + `
+		FROM task_time_entries e
+		JOIN users u ON u.id = e.user_id
+		WHERE e.id = $1
+	`
+
+	var entryModel WithUserModel
+	err := r.db.GetContext(ctx, &entryModel, query, entryID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return entryModel.ToTimeEntryEntity(), nil
+}
+
+func (r *repository) FindByTaskID(ctx context.Context, taskID string) ([]entities.TimeEntry, error) {
+	query := `
+		SELECT ` + entryColumns + `
+		FROM task_time_entries e
+		JOIN users u ON u.id = e.user_id
+		WHERE e.task_id = $1
+		ORDER BY e.started_at DESC, e.id DESC
+	`
+
+	var entryModels []WithUserModel
+	err := r.db.SelectContext(ctx, &entryModels, query, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]entities.TimeEntry, len(entryModels))
+	for i, model := range entryModels {
+		entries[i] = *model.ToTimeEntryEntity()
+	}
+
+	return entries, nil
+}
+
+func (r *repository) FindRunningByUserID(ctx context.Context, userID string) (*entities.TimeEntry, error) {
+	query := `
+		SELECT ` + entryColumns + `
+		FROM task_time_entries e
+		JOIN users u ON u.id = e.user_id
+		WHERE e.user_id = $1 AND e.ended_at IS NULL
+	`
+
+	var entryModel WithUserModel
+	err := r.db.GetContext(ctx, &entryModel, query, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return entryModel.ToTimeEntryEntity(), nil
+}
+
+func (r *repository) UpdateByID(ctx context.Context, entryID string, startedAt time.Time, endedAt time.Time, note string) error {
+	query := `
+		UPDATE task_time_entries
+		SET started_at = $1, ended_at = $2, note = $3, updated_at = $4
+		WHERE id = $5
+	`
+
+	result, err := r.db.ExecContext(ctx, query, startedAt, endedAt, note, timeutil.BangkokNow(), entryID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// StopByID ends a running timer, note replaces the entry's note when given
+func (r *repository) StopByID(ctx context.Context, entryID string, endedAt time.Time, note *string) error {
+	query := `
+		UPDATE task_time_entries
+		SET ended_at = $1, note = COALESCE($2, note), updated_at = $1
+		WHERE id = $3 AND ended_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, endedAt, note, entryID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+func (r *repository) DeleteByID(ctx context.Context, entryID string) error {
+	query := `DELETE FROM task_time_entries WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, entryID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// reportGroups maps each grouping to its key and label expressions and the joins they need.
+// Days are taken in Bangkok time, entries of untagged tasks or tasks without a project
+// fall into a group with an empty key.
+var reportGroups = map[enums.TimeReportGroupBy]struct {
+	key   string
+	label string
+	joins string
+}{
+	enums.TimeReportGroupByDay: {
+		key:   `to_char(e.started_at AT TIME ZONE 'Asia/Bangkok', 'YYYY-MM-DD')`,
+		label: `to_char(e.started_at AT TIME ZONE 'Asia/Bangkok', 'YYYY-MM-DD')`,
+	},
+	enums.TimeReportGroupByProject: {
+		key:   `COALESCE(p.id::text, '')`,
+		label: `COALESCE(p.name, '')`,
+		joins: `LEFT JOIN projects p ON p.id = t.project_id`,
+	},
+	enums.TimeReportGroupByTag: {
+		key:   `COALESCE(g.id::text, '')`,
+		label: `COALESCE(g.name, '')`,
+		joins: `LEFT JOIN task_tags tt ON tt.task_id = t.id LEFT JOIN tags g ON g.id = tt.tag_id`,
+	},
+}
+
+// Report sums the user's finished entries started within [from, to).
+// An entry of a task with several tags counts toward each of them.
+func (r *repository) Report(ctx context.Context, userID string, from, to time.Time, groupBy enums.TimeReportGroupBy) ([]entities.TimeReportRow, error) {
+	group, ok := reportGroups[groupBy]
+	if !ok {
+		return nil, fmt.Errorf("unsupported report grouping %q", groupBy)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			%s AS key,
+			%s AS label,
+			SUM(EXTRACT(EPOCH FROM e.ended_at - e.started_at))::BIGINT AS seconds
+		FROM task_time_entries e
+		JOIN tasks t ON t.id = e.task_id
+		%s
+		WHERE e.user_id = $1
+			AND e.ended_at IS NOT NULL
+			AND e.started_at >= $2
+			AND e.started_at < $3
+			AND t.deleted_at IS NULL
+		GROUP BY 1, 2
+		ORDER BY 1 ASC
+	`, group.key, group.label, group.joins)
+
+	var rowModels []ReportRowModel
+	err := r.db.SelectContext(ctx, &rowModels, query, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]entities.TimeReportRow, len(rowModels))
+	for i, model := range rowModels {
+		rows[i] = *model.ToTimeReportRowEntity()
+	}
+
+	return rows, nil
+}
+
+// SumSeconds totals the user's finished entries started within [from, to)
+func (r *repository) SumSeconds(ctx context.Context, userID string, from, to time.Time) (int64, error) {
+	query := `
+		SELECT COALESCE(SUM(EXTRACT(EPOCH FROM e.ended_at - e.started_at)), 0)::BIGINT
+		FROM task_time_entries e
+		JOIN tasks t ON t.id = e.task_id
+		WHERE e.user_id = $1
+			AND e.ended_at IS NOT NULL
+			AND e.started_at >= $2
+			AND e.started_at < $3
+			AND t.deleted_at IS NULL
+	`
+
+	var seconds int64
+	err := r.db.GetContext(ctx, &seconds, query, userID, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	return seconds, nil
+}
@@ -0,0 +1,8 @@
+package timeentry
+
+import "errors"
+
+var (
+	ErrNullTimeEntry  = errors.New("time entry entity cannot be null")
+	ErrNoRowsAffected = errors.New("no rows affected")
+)
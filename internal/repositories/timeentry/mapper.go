@@ -0,0 +1,82 @@
+package timeentry
+
+import (
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+)
+
+func FromTimeEntryEntity(entity *entities.TimeEntry) (*Model, error) {
+	if entity == nil {
+		return nil, ErrNullTimeEntry
+	}
+
+	entryUUID, err := uuid.Parse(entity.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskUUID, err := uuid.Parse(entity.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	userUUID, err := uuid.Parse(entity.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var endedAt sql.NullTime
+	if entity.EndedAt != nil {
+		endedAt = sql.NullTime{Time: *entity.EndedAt, Valid: true}
+	}
+
+	return &Model{
+		ID:        entryUUID,
+		TaskID:    taskUUID,
+		UserID:    userUUID,
+		StartedAt: entity.StartedAt,
+		EndedAt:   endedAt,
+		Note:      entity.Note,
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+	}, nil
+}
+
+func (m *Model) ToTimeEntryEntity() *entities.TimeEntry {
+	entry := &entities.TimeEntry{
+		ID:        m.ID.String(),
+		TaskID:    m.TaskID.String(),
+		UserID:    m.UserID.String(),
+		StartedAt: m.StartedAt,
+		Note:      m.Note,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+
+	if m.EndedAt.Valid {
+		entry.EndedAt = &m.EndedAt.Time
+	}
+
+	return entry
+}
+
+func (m *WithUserModel) ToTimeEntryEntity() *entities.TimeEntry {
+	entry := m.Model.ToTimeEntryEntity()
+	entry.User = &entities.UserSummary{
+		ID:    entry.UserID,
+		Name:  m.UserName,
+		Email: m.UserEmail,
+	}
+
+	return entry
+}
+
+func (m *ReportRowModel) ToTimeReportRowEntity() *entities.TimeReportRow {
+	return &entities.TimeReportRow{
+		Key:     m.Key,
+		Label:   m.Label,
+		Seconds: m.Seconds,
+	}
+}
@@ -0,0 +1,31 @@
+package timeentry
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Model struct {
+	ID        uuid.UUID    `json:"id" db:"id"`
+	TaskID    uuid.UUID    `json:"taskId" db:"task_id"`
+	UserID    uuid.UUID    `json:"userId" db:"user_id"`
+	StartedAt time.Time    `json:"startedAt" db:"started_at"`
+	EndedAt   sql.NullTime `json:"endedAt" db:"ended_at"`
+	Note      string       `json:"note" db:"note"`
+	CreatedAt time.Time    `json:"createdAt" db:"created_at"`
+	UpdatedAt time.Time    `json:"updatedAt" db:"updated_at"`
+}
+
+type WithUserModel struct {
+	Model
+	UserName  string `db:"user_name"`
+	UserEmail string `db:"user_email"`
+}
+
+type ReportRowModel struct {
+	Key     string `db:"key"`
+	Label   string `db:"label"`
+	Seconds int64  `db:"seconds"`
+}
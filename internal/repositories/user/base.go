@@ -7,11 +7,14 @@ import (
 
 	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type Repository interface {
 	Create(ctx context.Context, user *entities.User) error
 	FindByEmail(ctx context.Context, email string) (*entities.User, error)
+	FindByID(ctx context.Context, userID string) (*entities.User, error)
+	FindByIDs(ctx context.Context, userIDs []string) ([]entities.User, error)
 }
 
 type repository struct {
@@ -72,3 +75,50 @@ func (r *repository) FindByEmail(ctx context.Context, email string) (*entities.U
 
 	return userModel.ToUserEntity(), nil
 }
+
+func (r *repository) FindByID(ctx context.Context, userID string) (*entities.User, error) {
+	query := `
+		SELECT 
+			id, name, email, password, created_at, updated_at
+		FROM users
+		WHERE id = $1
+	`
+
+	var userModel Model
+	err := r.db.GetContext(ctx, &userModel, query, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return userModel.ToUserEntity(), nil
+}
+
+func (r *repository) FindByIDs(ctx context.Context, userIDs []string) ([]entities.User, error) {
+	if len(userIDs) == 0 {
+		return []entities.User{}, nil
+	}
+
+	query := `
+		SELECT 
+			id, name, email, password, created_at, updated_at
+		FROM users
+		WHERE id = ANY($1)
+	`
+
+	var userModels []Model
+	err := r.db.SelectContext(ctx, &userModels, query, pq.Array(userIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]entities.User, len(userModels))
+	for i, model := range userModels {
+		users[i] = *model.ToUserEntity()
+	}
+
+	return users, nil
+}
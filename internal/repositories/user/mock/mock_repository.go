@@ -162,3 +162,139 @@ func (_c *MockRepository_FindByEmail_Call) RunAndReturn(run func(ctx context.Con
 	_c.Call.Return(run)
 	return _c
 }
+
+// FindByID provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindByID(ctx context.Context, userID string) (*entities.User, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *entities.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entities.User, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entities.User); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockRepository_Expecter) FindByID(ctx interface{}, userID interface{}) *MockRepository_FindByID_Call {
+	return &MockRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, userID)}
+}
+
+func (_c *MockRepository_FindByID_Call) Run(run func(ctx context.Context, userID string)) *MockRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindByID_Call) Return(user *entities.User, err error) *MockRepository_FindByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockRepository_FindByID_Call) RunAndReturn(run func(ctx context.Context, userID string) (*entities.User, error)) *MockRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByIDs provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindByIDs(ctx context.Context, userIDs []string) ([]entities.User, error) {
+	ret := _mock.Called(ctx, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIDs")
+	}
+
+	var r0 []entities.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]entities.User, error)); ok {
+		return returnFunc(ctx, userIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []entities.User); ok {
+		r0 = returnFunc(ctx, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIDs'
+type MockRepository_FindByIDs_Call struct {
+	*mock.Call
+}
+
+// FindByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIDs []string
+func (_e *MockRepository_Expecter) FindByIDs(ctx interface{}, userIDs interface{}) *MockRepository_FindByIDs_Call {
+	return &MockRepository_FindByIDs_Call{Call: _e.mock.On("FindByIDs", ctx, userIDs)}
+}
+
+func (_c *MockRepository_FindByIDs_Call) Run(run func(ctx context.Context, userIDs []string)) *MockRepository_FindByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindByIDs_Call) Return(users []entities.User, err error) *MockRepository_FindByIDs_Call {
+	_c.Call.Return(users, err)
+	return _c
+}
+
+func (_c *MockRepository_FindByIDs_Call) RunAndReturn(run func(ctx context.Context, userIDs []string) ([]entities.User, error)) *MockRepository_FindByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,234 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/infrastructure/database"
+	"github.com/jmoiron/sqlx"
+)
+
+type Repository interface {
+	Create(ctx context.Context, webhook *entities.Webhook) error
+	FindByID(ctx context.Context, webhookID string) (*entities.Webhook, error)
+	FindByUserID(ctx context.Context, userID string) ([]entities.Webhook, error)
+	FindByWorkspaceID(ctx context.Context, workspaceID string) ([]entities.Webhook, error)
+	FindSubscribed(ctx context.Context, userID string, workspaceID *string, event enums.WebhookEvent) ([]entities.Webhook, error)
+	UpdateByID(ctx context.Context, webhook *entities.Webhook) error
+	DeleteByID(ctx context.Context, webhookID string) error
+	RecordFailureByID(ctx context.Context, webhookID string, disableAfter int, now time.Time) (bool, error)
+	ResetFailuresByID(ctx context.Context, webhookID string) error
+
+	// Deliveries
+	CreateDelivery(ctx context.Context, delivery *entities.WebhookDelivery) error
+	FindDeliveryByID(ctx context.Context, deliveryID string) (*entities.WebhookDelivery, error)
+	FindDeliveriesByWebhookID(ctx context.Context, webhookID string, opts *DeliveryListOptions) ([]entities.WebhookDelivery, error)
+	ClaimDueDeliveries(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]entities.WebhookDelivery, error)
+	UpdateDeliveryAttemptByID(ctx context.Context, delivery *entities.WebhookDelivery) error
+}
+
+// webhookColumns lists the columns scanned into Model
+const webhookColumns = `
+	id, user_id, workspace_id, url, secret, events, enabled, consecutive_failures,
+	disabled_at, created_at, updated_at`
+
+type repository struct {
+	db *sqlx.DB
+}
+
+// @WireSet("Repository")
+func NewRepository(db *sqlx.DB) Repository {
+	return &repository{
+		db: db,
+	}
+}
+
+// conn joins the transaction bound to ctx when there is one
+func (r *repository) conn(ctx context.Context) database.Executor {
+	return database.Conn(ctx, r.db)
+}
+
+func (r *repository) Create(ctx context.Context, webhook *entities.Webhook) error {
+	webhookModel, err := FromWebhookEntity(webhook)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO webhooks (id, user_id, workspace_id, url, secret, events, enabled, consecutive_failures, disabled_at, created_at, updated_at)
+		VALUES (:id, :user_id, :workspace_id, :url, :secret, :events, :enabled, :consecutive_failures, :disabled_at, :created_at, :updated_at)
+	`
+	result, err := r.conn(ctx).NamedExecContext(ctx, query, webhookModel)
+	if err != nil {
+		return err
+	}
+
+	return ensureRowsAffected(result)
+}
+
+func (r *repository) FindByID(ctx context.Context, webhookID string) (*entities.Webhook, error) {
+	query := `
+		SELECT ` + webhookColumns + `
+		FROM webhooks
+		WHERE id = $1
+	`
+
+	var webhookModel Model
+	err := r.conn(ctx).GetContext(ctx, &webhookModel, query, webhookID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return webhookModel.ToWebhookEntity(), nil
+}
+
+// FindByUserID returns the personal webhooks of a user
+func (r *repository) FindByUserID(ctx context.Context, userID string) ([]entities.Webhook, error) {
+	query := `
+		SELECT ` + webhookColumns + `
+		FROM webhooks
+		WHERE user_id = $1 AND workspace_id IS NULL
+		ORDER BY created_at, id
+	`
+
+	return r.findMany(ctx, query, userID)
+}
+
+func (r *repository) FindByWorkspaceID(ctx context.Context, workspaceID string) ([]entities.Webhook, error) {
+	query := `
+		SELECT ` + webhookColumns + `
+		FROM webhooks
+		WHERE workspace_id = $1
+		ORDER BY created_at, id
+	`
+
+	return r.findMany(ctx, query, workspaceID)
+}
+
+// FindSubscribed returns the enabled webhooks receiving an event about a task,
+// those of its workspace or, for a personal task, the personal ones of its owner
+func (r *repository) FindSubscribed(ctx context.Context, userID string, workspaceID *string, event enums.WebhookEvent) ([]entities.Webhook, error) {
+	if workspaceID != nil {
+		query := `
+			SELECT ` + webhookColumns + `
+			FROM webhooks
+			WHERE workspace_id = $1 AND enabled AND $2 = ANY(events)
+		`
+
+		return r.findMany(ctx, query, *workspaceID, event.String())
+	}
+
+	query := `
+		SELECT ` + webhookColumns + `
+		FROM webhooks
+		WHERE user_id = $1 AND workspace_id IS NULL AND enabled AND $2 = ANY(events)
+	`
+
+	return r.findMany(ctx, query, userID, event.String())
+}
+
+func (r *repository) UpdateByID(ctx context.Context, webhook *entities.Webhook) error {
+	webhookModel, err := FromWebhookEntity(webhook)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE webhooks
+		SET url = :url, secret = :secret, events = :events, enabled = :enabled,
+			consecutive_failures = :consecutive_failures, disabled_at = :disabled_at, updated_at = :updated_at
+		WHERE id = :id
+	`
+	result, err := r.conn(ctx).NamedExecContext(ctx, query, webhookModel)
+	if err != nil {
+		return err
+	}
+
+	return ensureRowsAffected(result)
+}
+
+func (r *repository) DeleteByID(ctx context.Context, webhookID string) error {
+	query := `DELETE FROM webhooks WHERE id = $1`
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, webhookID)
+	if err != nil {
+		return err
+	}
+
+	return ensureRowsAffected(result)
+}
+
+// RecordFailureByID counts a delivery that ran out of attempts and disables the
+// webhook once disableAfter of them failed in a row. Reports whether this
+// failure disabled the webhook.
+func (r *repository) RecordFailureByID(ctx context.Context, webhookID string, disableAfter int, now time.Time) (bool, error) {
+	query := `
+		WITH previous AS (
+			SELECT id, enabled FROM webhooks WHERE id = $1 FOR UPDATE
+		)
+		UPDATE webhooks w
+		SET consecutive_failures = w.consecutive_failures + 1,
+			enabled = w.enabled AND w.consecutive_failures + 1 < $2,
+			disabled_at = CASE
+				WHEN w.enabled AND w.consecutive_failures + 1 >= $2 THEN $3
+				ELSE w.disabled_at
+			END
+		FROM previous
+		WHERE w.id = previous.id
+		RETURNING previous.enabled AND NOT w.enabled
+	`
+
+	var disabled bool
+	err := r.conn(ctx).GetContext(ctx, &disabled, query, webhookID, disableAfter, now)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return disabled, nil
+}
+
+// ResetFailuresByID clears the failure count after a successful delivery
+func (r *repository) ResetFailuresByID(ctx context.Context, webhookID string) error {
+	query := `UPDATE webhooks SET consecutive_failures = 0 WHERE id = $1 AND consecutive_failures > 0`
+
+	_, err := r.conn(ctx).ExecContext(ctx, query, webhookID)
+	return err
+}
+
+func (r *repository) findMany(ctx context.Context, query string, args ...any) ([]entities.Webhook, error) {
+	var webhookModels []Model
+	err := r.conn(ctx).SelectContext(ctx, &webhookModels, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := make([]entities.Webhook, len(webhookModels))
+	for i, model := range webhookModels {
+		webhooks[i] = *model.ToWebhookEntity()
+	}
+
+	return webhooks, nil
+}
+
+func ensureRowsAffected(result sql.Result) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
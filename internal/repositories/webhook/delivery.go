@@ -0,0 +1,147 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+)
+
+// DeliveryKeyset identifies the delivery a page starts after
+type DeliveryKeyset struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// DeliveryListOptions describes a page of a webhook's deliveries, newest first
+type DeliveryListOptions struct {
+	After *DeliveryKeyset
+	Limit int
+}
+
+// deliveryColumns lists the columns scanned into DeliveryModel
+const deliveryColumns = `
+	id, webhook_id, event_id, event, payload, status, attempts, next_attempt_at,
+	last_attempt_at, response_status, last_error, redelivery_of, created_at`
+
+func (r *repository) CreateDelivery(ctx context.Context, delivery *entities.WebhookDelivery) error {
+	deliveryModel, err := FromWebhookDeliveryEntity(delivery)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO webhook_deliveries (id, webhook_id, event_id, event, payload, status, attempts, next_attempt_at, last_attempt_at, response_status, last_error, redelivery_of, created_at)
+		VALUES (:id, :webhook_id, :event_id, :event, :payload, :status, :attempts, :next_attempt_at, :last_attempt_at, :response_status, :last_error, :redelivery_of, :created_at)
+	`
+	result, err := r.conn(ctx).NamedExecContext(ctx, query, deliveryModel)
+	if err != nil {
+		return err
+	}
+
+	return ensureRowsAffected(result)
+}
+
+func (r *repository) FindDeliveryByID(ctx context.Context, deliveryID string) (*entities.WebhookDelivery, error) {
+	query := `
+		SELECT ` + deliveryColumns + `
+		FROM webhook_deliveries
+		WHERE id = $1
+	`
+
+	var deliveryModel DeliveryModel
+	err := r.conn(ctx).GetContext(ctx, &deliveryModel, query, deliveryID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return deliveryModel.ToWebhookDeliveryEntity(), nil
+}
+
+func (r *repository) FindDeliveriesByWebhookID(ctx context.Context, webhookID string, opts *DeliveryListOptions) ([]entities.WebhookDelivery, error) {
+	query := `
+		SELECT ` + deliveryColumns + `
+		FROM webhook_deliveries
+		WHERE webhook_id = $1
+	`
+	args := []any{webhookID}
+
+	if opts.After != nil {
+		query += ` AND (created_at, id) < ($2, $3)`
+		args = append(args, opts.After.CreatedAt, opts.After.ID)
+	}
+
+	query += ` ORDER BY created_at DESC, id DESC`
+
+	if opts.Limit > 0 {
+		args = append(args, opts.Limit)
+		query += ` LIMIT $` + strconv.Itoa(len(args))
+	}
+
+	return r.findDeliveries(ctx, query, args...)
+}
+
+// ClaimDueDeliveries picks up to limit pending deliveries of enabled webhooks
+// whose next attempt is due and pushes that attempt to leaseUntil, so other
+// workers leave them alone while they are sent. A delivery whose worker died
+// is picked up again once the lease runs out.
+func (r *repository) ClaimDueDeliveries(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]entities.WebhookDelivery, error) {
+	query := `
+		UPDATE webhook_deliveries
+		SET next_attempt_at = $3
+		WHERE id IN (
+			SELECT d.id
+			FROM webhook_deliveries d
+			JOIN webhooks w ON w.id = d.webhook_id
+			WHERE d.status = $1 AND d.next_attempt_at <= $2 AND w.enabled
+			ORDER BY d.next_attempt_at
+			LIMIT $4
+			FOR UPDATE OF d SKIP LOCKED
+		)
+		RETURNING ` + deliveryColumns
+
+	return r.findDeliveries(ctx, query, enums.WebhookDeliveryStatusPending.String(), now, leaseUntil, limit)
+}
+
+// UpdateDeliveryAttemptByID stores the outcome of an attempt
+func (r *repository) UpdateDeliveryAttemptByID(ctx context.Context, delivery *entities.WebhookDelivery) error {
+	deliveryModel, err := FromWebhookDeliveryEntity(delivery)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE webhook_deliveries
+		SET status = :status, attempts = :attempts, next_attempt_at = :next_attempt_at,
+			last_attempt_at = :last_attempt_at, response_status = :response_status, last_error = :last_error
+		WHERE id = :id
+	`
+	result, err := r.conn(ctx).NamedExecContext(ctx, query, deliveryModel)
+	if err != nil {
+		return err
+	}
+
+	return ensureRowsAffected(result)
+}
+
+func (r *repository) findDeliveries(ctx context.Context, query string, args ...any) ([]entities.WebhookDelivery, error) {
+	var deliveryModels []DeliveryModel
+	err := r.conn(ctx).SelectContext(ctx, &deliveryModels, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]entities.WebhookDelivery, len(deliveryModels))
+	for i, model := range deliveryModels {
+		deliveries[i] = *model.ToWebhookDeliveryEntity()
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,9 @@
+package webhook
+
+import "errors"
+
+var (
+	ErrNullWebhook         = errors.New("webhook entity cannot be null")
+	ErrNullWebhookDelivery = errors.New("webhook delivery entity cannot be null")
+	ErrNoRowsAffected      = errors.New("no rows affected")
+)
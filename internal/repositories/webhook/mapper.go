@@ -0,0 +1,150 @@
+package webhook
+
+import (
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+)
+
+func FromWebhookEntity(entity *entities.Webhook) (*Model, error) {
+	if entity == nil {
+		return nil, ErrNullWebhook
+	}
+
+	webhookUUID, err := uuid.Parse(entity.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	userUUID, err := uuid.Parse(entity.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceUUID, err := parseOptionalUUID(entity.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]string, len(entity.Events))
+	for i, event := range entity.Events {
+		events[i] = event.String()
+	}
+
+	return &Model{
+		ID:                  webhookUUID,
+		UserID:              userUUID,
+		WorkspaceID:         workspaceUUID,
+		URL:                 entity.URL,
+		Secret:              entity.Secret,
+		Events:              events,
+		Enabled:             entity.Enabled,
+		ConsecutiveFailures: entity.ConsecutiveFailures,
+		DisabledAt:          entity.DisabledAt,
+		CreatedAt:           entity.CreatedAt,
+		UpdatedAt:           entity.UpdatedAt,
+	}, nil
+}
+
+func (m *Model) ToWebhookEntity() *entities.Webhook {
+	events := make([]enums.WebhookEvent, len(m.Events))
+	for i, event := range m.Events {
+		events[i] = enums.WebhookEvent(event)
+	}
+
+	return &entities.Webhook{
+		ID:                  m.ID.String(),
+		UserID:              m.UserID.String(),
+		WorkspaceID:         optionalUUIDString(m.WorkspaceID),
+		URL:                 m.URL,
+		Secret:              m.Secret,
+		Events:              events,
+		Enabled:             m.Enabled,
+		ConsecutiveFailures: m.ConsecutiveFailures,
+		DisabledAt:          m.DisabledAt,
+		CreatedAt:           m.CreatedAt,
+		UpdatedAt:           m.UpdatedAt,
+	}
+}
+
+func FromWebhookDeliveryEntity(entity *entities.WebhookDelivery) (*DeliveryModel, error) {
+	if entity == nil {
+		return nil, ErrNullWebhookDelivery
+	}
+
+	deliveryUUID, err := uuid.Parse(entity.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookUUID, err := uuid.Parse(entity.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	eventUUID, err := uuid.Parse(entity.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	redeliveryOfUUID, err := parseOptionalUUID(entity.RedeliveryOf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeliveryModel{
+		ID:             deliveryUUID,
+		WebhookID:      webhookUUID,
+		EventID:        eventUUID,
+		Event:          entity.Event.String(),
+		Payload:        entity.Payload,
+		Status:         entity.Status.String(),
+		Attempts:       entity.Attempts,
+		NextAttemptAt:  entity.NextAttemptAt,
+		LastAttemptAt:  entity.LastAttemptAt,
+		ResponseStatus: entity.ResponseStatus,
+		LastError:      entity.LastError,
+		RedeliveryOf:   redeliveryOfUUID,
+		CreatedAt:      entity.CreatedAt,
+	}, nil
+}
+
+func (m *DeliveryModel) ToWebhookDeliveryEntity() *entities.WebhookDelivery {
+	return &entities.WebhookDelivery{
+		ID:             m.ID.String(),
+		WebhookID:      m.WebhookID.String(),
+		EventID:        m.EventID.String(),
+		Event:          enums.WebhookEvent(m.Event),
+		Payload:        m.Payload,
+		Status:         enums.WebhookDeliveryStatus(m.Status),
+		Attempts:       m.Attempts,
+		NextAttemptAt:  m.NextAttemptAt,
+		LastAttemptAt:  m.LastAttemptAt,
+		ResponseStatus: m.ResponseStatus,
+		LastError:      m.LastError,
+		RedeliveryOf:   optionalUUIDString(m.RedeliveryOf),
+		CreatedAt:      m.CreatedAt,
+	}
+}
+
+func parseOptionalUUID(id *string) (*uuid.UUID, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	parsed, err := uuid.Parse(*id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsed, nil
+}
+
+func optionalUUIDString(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+
+	s := id.String()
+	return &s
+}
@@ -0,0 +1,38 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type Model struct {
+	ID                  uuid.UUID      `json:"id" db:"id"`
+	UserID              uuid.UUID      `json:"userId" db:"user_id"`
+	WorkspaceID         *uuid.UUID     `json:"workspaceId" db:"workspace_id"`
+	URL                 string         `json:"url" db:"url"`
+	Secret              string         `json:"secret" db:"secret"`
+	Events              pq.StringArray `json:"events" db:"events"`
+	Enabled             bool           `json:"enabled" db:"enabled"`
+	ConsecutiveFailures int            `json:"consecutiveFailures" db:"consecutive_failures"`
+	DisabledAt          *time.Time     `json:"disabledAt" db:"disabled_at"`
+	CreatedAt           time.Time      `json:"createdAt" db:"created_at"`
+	UpdatedAt           time.Time      `json:"updatedAt" db:"updated_at"`
+}
+
+type DeliveryModel struct {
+	ID             uuid.UUID  `json:"id" db:"id"`
+	WebhookID      uuid.UUID  `json:"webhookId" db:"webhook_id"`
+	EventID        uuid.UUID  `json:"eventId" db:"event_id"`
+	Event          string     `json:"event" db:"event"`
+	Payload        string     `json:"payload" db:"payload"`
+	Status         string     `json:"status" db:"status"`
+	Attempts       int        `json:"attempts" db:"attempts"`
+	NextAttemptAt  *time.Time `json:"nextAttemptAt" db:"next_attempt_at"`
+	LastAttemptAt  *time.Time `json:"lastAttemptAt" db:"last_attempt_at"`
+	ResponseStatus *int       `json:"responseStatus" db:"response_status"`
+	LastError      *string    `json:"lastError" db:"last_error"`
+	RedeliveryOf   *uuid.UUID `json:"redeliveryOf" db:"redelivery_of"`
+	CreatedAt      time.Time  `json:"createdAt" db:"created_at"`
+}
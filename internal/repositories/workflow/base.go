@@ -0,0 +1,240 @@
+package workflow
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/infrastructure/database"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type Repository interface {
+	FindByWorkspaceID(ctx context.Context, workspaceID string) (*entities.Workflow, error)
+	FindByProjectID(ctx context.Context, projectID string) (*entities.Workflow, error)
+	Save(ctx context.Context, workflow *entities.Workflow) error
+	DeleteByID(ctx context.Context, workflowID string) error
+
+	// Tasks following a workflow
+	CountTasksByStatus(ctx context.Context, workspaceID, projectID *string) (map[enums.TaskStatus]int, error)
+	SyncTaskStatusCategories(ctx context.Context, workspaceID, projectID *string, statuses []entities.WorkflowStatus) error
+}
+
+type repository struct {
+	db *sqlx.DB
+}
+
+// @WireSet("Repository")
+func NewRepository(db *sqlx.DB) Repository {
+	return &repository{
+		db: db,
+	}
+}
+
+// conn joins the transaction bound to ctx when there is one
+func (r *repository) conn(ctx context.Context) database.Executor {
+	return database.Conn(ctx, r.db)
+}
+
+func (r *repository) FindByWorkspaceID(ctx context.Context, workspaceID string) (*entities.Workflow, error) {
+	query := `
+		SELECT id, workspace_id, project_id, name, created_at, updated_at
+		FROM task_workflows
+		WHERE workspace_id = $1
+	`
+
+	return r.findOne(ctx, query, workspaceID)
+}
+
+func (r *repository) FindByProjectID(ctx context.Context, projectID string) (*entities.Workflow, error) {
+	query := `
+		SELECT id, workspace_id, project_id, name, created_at, updated_at
+		FROM task_workflows
+		WHERE project_id = $1
+	`
+
+	return r.findOne(ctx, query, projectID)
+}
+
+// Save creates the workflow or renames it, then replaces its statuses and transitions.
+// It runs several statements, so callers wrap it in a transaction.
+func (r *repository) Save(ctx context.Context, workflow *entities.Workflow) error {
+	workflowModel, err := FromWorkflowEntity(workflow)
+	if err != nil {
+		return err
+	}
+
+	upsertQuery := `
+		INSERT INTO task_workflows (id, workspace_id, project_id, name, created_at, updated_at)
+		VALUES (:id, :workspace_id, :project_id, :name, :created_at, :updated_at)
+		ON CONFLICT (id) DO UPDATE
+		SET name = EXCLUDED.name, updated_at = EXCLUDED.updated_at
+	`
+	if _, err := r.conn(ctx).NamedExecContext(ctx, upsertQuery, workflowModel); err != nil {
+		return err
+	}
+
+	// Transitions go along with the statuses they reference
+	deleteQuery := `DELETE FROM task_workflow_statuses WHERE workflow_id = $1`
+	if _, err := r.conn(ctx).ExecContext(ctx, deleteQuery, workflowModel.ID); err != nil {
+		return err
+	}
+
+	keys := make([]string, len(workflow.Statuses))
+	names := make([]string, len(workflow.Statuses))
+	categories := make([]string, len(workflow.Statuses))
+	positions := make([]int64, len(workflow.Statuses))
+	for i, status := range workflow.Statuses {
+		keys[i] = status.Key.String()
+		names[i] = status.Name
+		categories[i] = status.Category.String()
+		positions[i] = int64(status.Position)
+	}
+
+	statusQuery := `
+		INSERT INTO task_workflow_statuses (workflow_id, key, name, category, position)
+		SELECT $1, s.key, s.name, s.category, s.position
+		FROM unnest($2::text[], $3::text[], $4::text[], $5::int[]) AS s (key, name, category, position)
+	`
+	_, err = r.conn(ctx).ExecContext(ctx, statusQuery,
+		workflowModel.ID, pq.Array(keys), pq.Array(names), pq.Array(categories), pq.Array(positions),
+	)
+	if err != nil {
+		return err
+	}
+
+	if len(workflow.Transitions) == 0 {
+		return nil
+	}
+
+	froms := make([]string, len(workflow.Transitions))
+	tos := make([]string, len(workflow.Transitions))
+	for i, transition := range workflow.Transitions {
+		froms[i] = transition.From.String()
+		tos[i] = transition.To.String()
+	}
+
+	transitionQuery := `
+		INSERT INTO task_workflow_transitions (workflow_id, from_status, to_status)
+		SELECT $1, t.from_status, t.to_status
+		FROM unnest($2::text[], $3::text[]) AS t (from_status, to_status)
+	`
+	_, err = r.conn(ctx).ExecContext(ctx, transitionQuery, workflowModel.ID, pq.Array(froms), pq.Array(tos))
+	return err
+}
+
+func (r *repository) DeleteByID(ctx context.Context, workflowID string) error {
+	query := `DELETE FROM task_workflows WHERE id = $1`
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, workflowID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// CountTasksByStatus counts the tasks of a workspace, or of a project's personal tasks,
+// per status. Trashed tasks are counted too since they can be restored.
+func (r *repository) CountTasksByStatus(ctx context.Context, workspaceID, projectID *string) (map[enums.TaskStatus]int, error) {
+	condition, scopeID := scopeCondition(workspaceID, projectID)
+	query := `
+		SELECT status, COUNT(*) AS count
+		FROM tasks
+		WHERE ` + condition + `
+		GROUP BY status
+	`
+
+	var usageModels []StatusUsageModel
+	err := r.conn(ctx).SelectContext(ctx, &usageModels, query, scopeID)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[enums.TaskStatus]int, len(usageModels))
+	for _, model := range usageModels {
+		counts[enums.TaskStatus(model.Status)] = model.Count
+	}
+
+	return counts, nil
+}
+
+// SyncTaskStatusCategories updates the status category of the tasks in a workspace or
+// project to the category their status has in the given statuses
+func (r *repository) SyncTaskStatusCategories(ctx context.Context, workspaceID, projectID *string, statuses []entities.WorkflowStatus) error {
+	keys := make([]string, len(statuses))
+	categories := make([]string, len(statuses))
+	for i, status := range statuses {
+		keys[i] = status.Key.String()
+		categories[i] = status.Category.String()
+	}
+
+	condition, scopeID := scopeCondition(workspaceID, projectID)
+	query := `
+		UPDATE tasks
+		SET status_category = s.category
+		FROM unnest($2::text[], $3::text[]) AS s (key, category)
+		WHERE ` + condition + ` AND tasks.status = s.key AND tasks.status_category <> s.category
+	`
+
+	_, err := r.conn(ctx).ExecContext(ctx, query, scopeID, pq.Array(keys), pq.Array(categories))
+	return err
+}
+
+func (r *repository) findOne(ctx context.Context, query string, args ...any) (*entities.Workflow, error) {
+	var workflowModel Model
+	err := r.conn(ctx).GetContext(ctx, &workflowModel, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	statusQuery := `
+		SELECT key, name, category, position
+		FROM task_workflow_statuses
+		WHERE workflow_id = $1
+		ORDER BY position ASC
+	`
+
+	var statusModels []StatusModel
+	if err := r.conn(ctx).SelectContext(ctx, &statusModels, statusQuery, workflowModel.ID); err != nil {
+		return nil, err
+	}
+
+	transitionQuery := `
+		SELECT from_status, to_status
+		FROM task_workflow_transitions
+		WHERE workflow_id = $1
+		ORDER BY from_status ASC, to_status ASC
+	`
+
+	var transitionModels []TransitionModel
+	if err := r.conn(ctx).SelectContext(ctx, &transitionModels, transitionQuery, workflowModel.ID); err != nil {
+		return nil, err
+	}
+
+	return workflowModel.ToWorkflowEntity(statusModels, transitionModels), nil
+}
+
+// scopeCondition selects the tasks following the workflow of a workspace or a project,
+// workspace tasks never belong to a project
+func scopeCondition(workspaceID, projectID *string) (string, string) {
+	if workspaceID != nil {
+		return "tasks.workspace_id = $1", *workspaceID
+	}
+	return "tasks.workspace_id IS NULL AND tasks.project_id = $1", *projectID
+}
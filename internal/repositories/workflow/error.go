@@ -0,0 +1,8 @@
+package workflow
+
+import "errors"
+
+var (
+	ErrNullWorkflow   = errors.New("workflow entity cannot be null")
+	ErrNoRowsAffected = errors.New("no rows affected")
+)
@@ -0,0 +1,90 @@
+package workflow
+
+import (
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+)
+
+func FromWorkflowEntity(entity *entities.Workflow) (*Model, error) {
+	if entity == nil {
+		return nil, ErrNullWorkflow
+	}
+
+	workflowUUID, err := uuid.Parse(entity.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceUUID, err := parseOptionalUUID(entity.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	projectUUID, err := parseOptionalUUID(entity.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Model{
+		ID:          workflowUUID,
+		WorkspaceID: workspaceUUID,
+		ProjectID:   projectUUID,
+		Name:        entity.Name,
+		CreatedAt:   entity.CreatedAt,
+		UpdatedAt:   entity.UpdatedAt,
+	}, nil
+}
+
+func (m *Model) ToWorkflowEntity(statuses []StatusModel, transitions []TransitionModel) *entities.Workflow {
+	workflow := &entities.Workflow{
+		ID:          m.ID.String(),
+		WorkspaceID: optionalUUIDString(m.WorkspaceID),
+		ProjectID:   optionalUUIDString(m.ProjectID),
+		Name:        m.Name,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+		Statuses:    make([]entities.WorkflowStatus, len(statuses)),
+		Transitions: make([]entities.WorkflowTransition, len(transitions)),
+	}
+
+	for i, status := range statuses {
+		workflow.Statuses[i] = entities.WorkflowStatus{
+			Key:      enums.TaskStatus(status.Key),
+			Name:     status.Name,
+			Category: enums.TaskStatusCategory(status.Category),
+			Position: status.Position,
+		}
+	}
+
+	for i, transition := range transitions {
+		workflow.Transitions[i] = entities.WorkflowTransition{
+			From: enums.TaskStatus(transition.FromStatus),
+			To:   enums.TaskStatus(transition.ToStatus),
+		}
+	}
+
+	return workflow
+}
+
+func parseOptionalUUID(id *string) (*uuid.UUID, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	parsed, err := uuid.Parse(*id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsed, nil
+}
+
+func optionalUUIDString(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+
+	s := id.String()
+	return &s
+}
@@ -0,0 +1,33 @@
+package workflow
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Model struct {
+	ID          uuid.UUID  `json:"id" db:"id"`
+	WorkspaceID *uuid.UUID `json:"workspaceId" db:"workspace_id"`
+	ProjectID   *uuid.UUID `json:"projectId" db:"project_id"`
+	Name        string     `json:"name" db:"name"`
+	CreatedAt   time.Time  `json:"createdAt" db:"created_at"`
+	UpdatedAt   time.Time  `json:"updatedAt" db:"updated_at"`
+}
+
+type StatusModel struct {
+	Key      string `db:"key"`
+	Name     string `db:"name"`
+	Category string `db:"category"`
+	Position int    `db:"position"`
+}
+
+type TransitionModel struct {
+	FromStatus string `db:"from_status"`
+	ToStatus   string `db:"to_status"`
+}
+
+type StatusUsageModel struct {
+	Status string `db:"status"`
+	Count  int    `db:"count"`
+}
@@ -0,0 +1,170 @@
+package workspace
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/jmoiron/sqlx"
+)
+
+type Repository interface {
+	Create(ctx context.Context, workspace *entities.Workspace, owner *entities.WorkspaceMember) error
+	FindByID(ctx context.Context, workspaceID string) (*entities.Workspace, error)
+	FindByUserID(ctx context.Context, userID string) ([]entities.Workspace, error)
+	UpdateByID(ctx context.Context, workspaceID string, name string) error
+	DeleteByID(ctx context.Context, workspaceID string) error
+
+	// Members
+	CreateMember(ctx context.Context, member *entities.WorkspaceMember) error
+	FindMember(ctx context.Context, workspaceID string, userID string) (*entities.WorkspaceMember, error)
+	FindMembersByWorkspaceID(ctx context.Context, workspaceID string) ([]entities.WorkspaceMember, error)
+	CountMembersByRole(ctx context.Context, workspaceID string, role enums.WorkspaceRole) (int, error)
+	UpdateMemberRole(ctx context.Context, workspaceID string, userID string, role enums.WorkspaceRole) error
+	DeleteMember(ctx context.Context, workspaceID string, userID string) error
+}
+
+type repository struct {
+	db *sqlx.DB
+}
+
+// @WireSet("Repository")
+func NewRepository(db *sqlx.DB) Repository {
+	return &repository{
+		db: db,
+	}
+}
+
+// Create inserts the workspace together with its first owner in a single statement
+func (r *repository) Create(ctx context.Context, workspace *entities.Workspace, owner *entities.WorkspaceMember) error {
+	workspaceModel, err := FromWorkspaceEntity(workspace)
+	if err != nil {
+		return err
+	}
+
+	ownerModel, err := FromWorkspaceMemberEntity(owner)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		WITH created AS (
+			INSERT INTO workspaces (id, name, created_at, updated_at)
+			VALUES ($1, $2, $3, $4)
+			RETURNING id
+		)
+		INSERT INTO workspace_members (workspace_id, user_id, role, created_at, updated_at)
+		SELECT id, $5, $6, $7, $8 FROM created
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		workspaceModel.ID, workspaceModel.Name, workspaceModel.CreatedAt, workspaceModel.UpdatedAt,
+		ownerModel.UserID, ownerModel.Role, ownerModel.CreatedAt, ownerModel.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+func (r *repository) FindByID(ctx context.Context, workspaceID string) (*entities.Workspace, error) {
+	query := `
+		SELECT 
+			id, name, created_at, updated_at
+		FROM workspaces
+		WHERE id = $1
+	`
+
+	var workspaceModel Model
+	err := r.db.GetContext(ctx, &workspaceModel, query, workspaceID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return workspaceModel.ToWorkspaceEntity(), nil
+}
+
+// FindByUserID returns the workspaces the user is a member of, along with the user's role
+func (r *repository) FindByUserID(ctx context.Context, userID string) ([]entities.Workspace, error) {
+	query := `
+		SELECT 
+			w.id, w.name, w.created_at, w.updated_at, m.role
+		FROM workspaces w
+		JOIN workspace_members m ON m.workspace_id = w.id
+		WHERE m.user_id = $1
+		ORDER BY w.name ASC, w.id ASC
+	`
+
+	var workspaceModels []WithRoleModel
+	err := r.db.SelectContext(ctx, &workspaceModels, query, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces := make([]entities.Workspace, len(workspaceModels))
+	for i, model := range workspaceModels {
+		workspaces[i] = *model.ToWorkspaceEntity()
+	}
+
+	return workspaces, nil
+}
+
+func (r *repository) UpdateByID(ctx context.Context, workspaceID string, name string) error {
+	query := `
+		UPDATE workspaces 
+		SET name = $1, updated_at = $2
+		WHERE id = $3
+	`
+
+	result, err := r.db.ExecContext(ctx, query, name, timeutil.BangkokNow(), workspaceID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+func (r *repository) DeleteByID(ctx context.Context, workspaceID string) error {
+	query := `DELETE FROM workspaces WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, workspaceID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
@@ -0,0 +1,9 @@
+package workspace
+
+import "errors"
+
+var (
+	ErrNullWorkspace       = errors.New("workspace entity cannot be null")
+	ErrNullWorkspaceMember = errors.New("workspace member entity cannot be null")
+	ErrNoRowsAffected      = errors.New("no rows affected")
+)
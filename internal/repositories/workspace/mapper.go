@@ -0,0 +1,83 @@
+package workspace
+
+import (
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+)
+
+func FromWorkspaceEntity(entity *entities.Workspace) (*Model, error) {
+	if entity == nil {
+		return nil, ErrNullWorkspace
+	}
+
+	workspaceUUID, err := uuid.Parse(entity.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Model{
+		ID:        workspaceUUID,
+		Name:      entity.Name,
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+	}, nil
+}
+
+func (m *Model) ToWorkspaceEntity() *entities.Workspace {
+	return &entities.Workspace{
+		ID:        m.ID.String(),
+		Name:      m.Name,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+func (m *WithRoleModel) ToWorkspaceEntity() *entities.Workspace {
+	workspace := m.Model.ToWorkspaceEntity()
+	workspace.Role = enums.WorkspaceRole(m.Role)
+
+	return workspace
+}
+
+func FromWorkspaceMemberEntity(entity *entities.WorkspaceMember) (*MemberModel, error) {
+	if entity == nil {
+		return nil, ErrNullWorkspaceMember
+	}
+
+	workspaceUUID, err := uuid.Parse(entity.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	userUUID, err := uuid.Parse(entity.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MemberModel{
+		WorkspaceID: workspaceUUID,
+		UserID:      userUUID,
+		Role:        entity.Role.String(),
+		CreatedAt:   entity.CreatedAt,
+		UpdatedAt:   entity.UpdatedAt,
+	}, nil
+}
+
+func (m *MemberModel) ToWorkspaceMemberEntity() *entities.WorkspaceMember {
+	return &entities.WorkspaceMember{
+		WorkspaceID: m.WorkspaceID.String(),
+		UserID:      m.UserID.String(),
+		Role:        enums.WorkspaceRole(m.Role),
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func (m *MemberWithUserModel) ToWorkspaceMemberEntity() *entities.WorkspaceMember {
+	member := m.MemberModel.ToWorkspaceMemberEntity()
+	member.Name = m.Name
+	member.Email = m.Email
+
+	return member
+}
@@ -0,0 +1,149 @@
+package workspace
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+)
+
+// CreateMember adds a user to a workspace.
+// Returns ErrNoRowsAffected when the user is already a member.
+func (r *repository) CreateMember(ctx context.Context, member *entities.WorkspaceMember) error {
+	memberModel, err := FromWorkspaceMemberEntity(member)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO workspace_members (workspace_id, user_id, role, created_at, updated_at)
+		VALUES (:workspace_id, :user_id, :role, :created_at, :updated_at)
+		ON CONFLICT (workspace_id, user_id) DO NOTHING
+	`
+	result, err := r.db.NamedExecContext(ctx, query, memberModel)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+func (r *repository) FindMember(ctx context.Context, workspaceID string, userID string) (*entities.WorkspaceMember, error) {
+	query := `
+		SELECT 
+			workspace_id, user_id, role, created_at, updated_at
+		FROM workspace_members
+		WHERE workspace_id = $1 AND user_id = $2
+	`
+
+	var memberModel MemberModel
+	err := r.db.GetContext(ctx, &memberModel, query, workspaceID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return memberModel.ToWorkspaceMemberEntity(), nil
+}
+
+func (r *repository) FindMembersByWorkspaceID(ctx context.Context, workspaceID string) ([]entities.WorkspaceMember, error) {
+	query := `
+		SELECT 
+			m.workspace_id, m.user_id, m.role, m.created_at, m.updated_at, u.name, u.email
+		FROM workspace_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.workspace_id = $1
+		ORDER BY m.created_at ASC, m.user_id ASC
+	`
+
+	var memberModels []MemberWithUserModel
+	err := r.db.SelectContext(ctx, &memberModels, query, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]entities.WorkspaceMember, len(memberModels))
+	for i, model := range memberModels {
+		members[i] = *model.ToWorkspaceMemberEntity()
+	}
+
+	return members, nil
+}
+
+func (r *repository) CountMembersByRole(ctx context.Context, workspaceID string, role enums.WorkspaceRole) (int, error) {
+	query := `SELECT COUNT(*) FROM workspace_members WHERE workspace_id = $1 AND role = $2`
+
+	var count int
+	if err := r.db.GetContext(ctx, &count, query, workspaceID, role.String()); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *repository) UpdateMemberRole(ctx context.Context, workspaceID string, userID string, role enums.WorkspaceRole) error {
+	query := `
+		UPDATE workspace_members 
+		SET role = $1, updated_at = $2
+		WHERE workspace_id = $3 AND user_id = $4
+	`
+
+	result, err := r.db.ExecContext(ctx, query, role.String(), timeutil.BangkokNow(), workspaceID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// DeleteMember removes a user from a workspace along with their assignments
+// to the workspace's tasks
+func (r *repository) DeleteMember(ctx context.Context, workspaceID string, userID string) error {
+	query := `
+		WITH unassigned AS (
+			DELETE FROM task_assignees
+			WHERE user_id = $2
+				AND task_id IN (SELECT id FROM tasks WHERE workspace_id = $1)
+		)
+		DELETE FROM workspace_members WHERE workspace_id = $1 AND user_id = $2
+	`
+
+	result, err := r.db.ExecContext(ctx, query, workspaceID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
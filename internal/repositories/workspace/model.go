@@ -0,0 +1,33 @@
+package workspace
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Model struct {
+	ID        uuid.UUID `json:"id" db:"id"`
+	Name      string    `json:"name" db:"name"`
+	CreatedAt time.Time `json:"createdAt" db:"created_at"`
+	UpdatedAt time.Time `json:"updatedAt" db:"updated_at"`
+}
+
+type WithRoleModel struct {
+	Model
+	Role string `db:"role"`
+}
+
+type MemberModel struct {
+	WorkspaceID uuid.UUID `json:"workspaceId" db:"workspace_id"`
+	UserID      uuid.UUID `json:"userId" db:"user_id"`
+	Role        string    `json:"role" db:"role"`
+	CreatedAt   time.Time `json:"createdAt" db:"created_at"`
+	UpdatedAt   time.Time `json:"updatedAt" db:"updated_at"`
+}
+
+type MemberWithUserModel struct {
+	MemberModel
+	Name  string `db:"name"`
+	Email string `db:"email"`
+}
@@ -2,10 +2,18 @@ package router
 
 import (
 	"net/http"
+	"strconv"
 
+	"github.com/graphzc/sdd-task-management-example/internal/services/task"
 	"github.com/graphzc/sdd-task-management-example/internal/utils/echoutil"
+	"github.com/labstack/echo/v4"
+	echoMiddleware "github.com/labstack/echo/v4/middleware"
 )
 
+// multipartOverheadBytes is the room an upload body gets beyond its file, for
+// the other form fields and the multipart boundaries
+const multipartOverheadBytes = 64 << 10
+
 func (r *Router) RegisterAPIRoutes() {
 	// Health check
 	r.echo.GET("/health", echoutil.WrapWithStatus(r.handlers.Common.HealthCheck, http.StatusOK))
@@ -19,6 +27,12 @@ func (r *Router) RegisterAPIRoutes() {
 		authGroup.POST("/login", echoutil.WrapWithStatus(r.handlers.Auth.Login, http.StatusOK))
 	}
 
+	// Calendar feed routes, authenticated by the token in the URL
+	calendarGroup := v1Public.Group("/calendar/:token", r.feedTokenMiddleware.Middleware)
+	{
+		calendarGroup.GET("/tasks.ics", echoutil.WrapWithStatus(r.handlers.CalendarFeed.GetFeedTasksWrapped, http.StatusOK))
+	}
+
 	// Protected routes
 	v1Protected := v1Public.Group("", r.authMiddleware.Middleware)
 
@@ -27,9 +41,140 @@ func (r *Router) RegisterAPIRoutes() {
 	{
 		taskGroup.POST("", echoutil.WrapWithStatus(r.handlers.Task.CreateTaskWrapped, http.StatusCreated))
 		taskGroup.GET("", echoutil.WrapWithStatus(r.handlers.Task.GetTasksByUserIDWrapped, http.StatusOK))
+		taskGroup.GET("/search", echoutil.WrapWithStatus(r.handlers.Task.SearchTasksWrapped, http.StatusOK))
+		taskGroup.GET("/assigned", echoutil.WrapWithStatus(r.handlers.Task.GetAssignedTasksWrapped, http.StatusOK))
+		taskGroup.GET("/trash", echoutil.WrapWithStatus(r.handlers.Task.GetTrashedTasksWrapped, http.StatusOK))
+		taskGroup.POST("/bulk", echoutil.WrapWithStatus(r.handlers.Task.BulkTasksWrapped, http.StatusOK))
+		taskGroup.POST("/import", echoutil.WrapWithStatus(r.handlers.Task.ImportTasksWrapped, http.StatusOK), uploadLimit(task.MaxImportSizeBytes))
+		taskGroup.GET("/export", echoutil.WrapWithStatus(r.handlers.Task.ExportTasksWrapped, http.StatusOK))
 		taskGroup.GET("/:id", echoutil.WrapWithStatus(r.handlers.Task.GetTaskByIDWrapped, http.StatusOK))
 		taskGroup.PUT("/:id", echoutil.WrapWithStatus(r.handlers.Task.UpdateTaskByIDWrapped, http.StatusOK))
+		taskGroup.PATCH("/:id", echoutil.WrapWithStatus(r.handlers.Task.PatchTaskByIDWrapped, http.StatusOK))
 		taskGroup.PATCH("/:id/status", echoutil.WrapWithStatus(r.handlers.Task.UpdateTaskStatusByIDWrapped, http.StatusOK))
+		taskGroup.POST("/:id/move", echoutil.WrapWithStatus(r.handlers.Task.MoveTaskWrapped, http.StatusOK))
 		taskGroup.DELETE("/:id", echoutil.WrapWithStatus(r.handlers.Task.DeleteTaskByIDWrapped, http.StatusOK))
+		taskGroup.GET("/:id/recurrence", echoutil.WrapWithStatus(r.handlers.Task.GetTaskRecurrenceWrapped, http.StatusOK))
+		taskGroup.PUT("/:id/recurrence", echoutil.WrapWithStatus(r.handlers.Task.SetTaskRecurrenceWrapped, http.StatusOK))
+		taskGroup.DELETE("/:id/recurrence", echoutil.WrapWithStatus(r.handlers.Task.StopTaskRecurrenceWrapped, http.StatusOK))
+		taskGroup.POST("/:id/restore", echoutil.WrapWithStatus(r.handlers.Task.RestoreTaskByIDWrapped, http.StatusOK))
+		taskGroup.DELETE("/:id/permanent", echoutil.WrapWithStatus(r.handlers.Task.PurgeTaskByIDWrapped, http.StatusOK))
+		taskGroup.GET("/:id/subtasks", echoutil.WrapWithStatus(r.handlers.Task.GetSubtasksByIDWrapped, http.StatusOK))
+		taskGroup.GET("/:id/tree", echoutil.WrapWithStatus(r.handlers.Task.GetTaskTreeByIDWrapped, http.StatusOK))
+		taskGroup.PATCH("/:id/parent", echoutil.WrapWithStatus(r.handlers.Task.UpdateTaskParentByIDWrapped, http.StatusOK))
+		taskGroup.GET("/:id/dependencies", echoutil.WrapWithStatus(r.handlers.Task.GetTaskBlockersByIDWrapped, http.StatusOK))
+		taskGroup.POST("/:id/dependencies", echoutil.WrapWithStatus(r.handlers.Task.AddTaskDependencyWrapped, http.StatusCreated))
+		taskGroup.DELETE("/:id/dependencies", echoutil.WrapWithStatus(r.handlers.Task.RemoveTaskDependencyWrapped, http.StatusOK))
+		taskGroup.PATCH("/:id/project", echoutil.WrapWithStatus(r.handlers.Task.UpdateTaskProjectByIDWrapped, http.StatusOK))
+		taskGroup.PATCH("/:id/workspace", echoutil.WrapWithStatus(r.handlers.Task.UpdateTaskWorkspaceByIDWrapped, http.StatusOK))
+		taskGroup.POST("/:id/assignees", echoutil.WrapWithStatus(r.handlers.Task.AssignTaskWrapped, http.StatusCreated))
+		taskGroup.DELETE("/:id/assignees/:userId", echoutil.WrapWithStatus(r.handlers.Task.UnassignTaskWrapped, http.StatusOK))
+		taskGroup.GET("/:id/activity", echoutil.WrapWithStatus(r.handlers.Task.GetTaskActivityByIDWrapped, http.StatusOK))
+		taskGroup.GET("/:id/comments", echoutil.WrapWithStatus(r.handlers.Comment.GetCommentsByTaskIDWrapped, http.StatusOK))
+		taskGroup.POST("/:id/comments", echoutil.WrapWithStatus(r.handlers.Comment.CreateCommentWrapped, http.StatusCreated))
+		taskGroup.PUT("/:id/comments/:commentId", echoutil.WrapWithStatus(r.handlers.Comment.UpdateCommentByIDWrapped, http.StatusOK))
+		taskGroup.DELETE("/:id/comments/:commentId", echoutil.WrapWithStatus(r.handlers.Comment.DeleteCommentByIDWrapped, http.StatusOK))
+		taskGroup.GET("/:id/attachments", echoutil.WrapWithStatus(r.handlers.Attachment.GetAttachmentsByTaskIDWrapped, http.StatusOK))
+		taskGroup.POST("/:id/attachments", echoutil.WrapWithStatus(r.handlers.Attachment.UploadAttachmentWrapped, http.StatusCreated), uploadLimit(r.config.Attachment.MaxSizeBytes))
+		taskGroup.GET("/:id/attachments/:attachmentId", echoutil.WrapWithStatus(r.handlers.Attachment.DownloadAttachmentWrapped, http.StatusOK))
+		taskGroup.DELETE("/:id/attachments/:attachmentId", echoutil.WrapWithStatus(r.handlers.Attachment.DeleteAttachmentByIDWrapped, http.StatusOK))
+		taskGroup.POST("/:id/timer/start", echoutil.WrapWithStatus(r.handlers.TimeEntry.StartTimerWrapped, http.StatusCreated))
+		taskGroup.POST("/:id/timer/stop", echoutil.WrapWithStatus(r.handlers.TimeEntry.StopTimerWrapped, http.StatusOK))
+		taskGroup.GET("/:id/time-entries", echoutil.WrapWithStatus(r.handlers.TimeEntry.GetTimeEntriesByTaskIDWrapped, http.StatusOK))
+		taskGroup.POST("/:id/time-entries", echoutil.WrapWithStatus(r.handlers.TimeEntry.CreateTimeEntryWrapped, http.StatusCreated))
+		taskGroup.PUT("/:id/time-entries/:entryId", echoutil.WrapWithStatus(r.handlers.TimeEntry.UpdateTimeEntryByIDWrapped, http.StatusOK))
+		taskGroup.DELETE("/:id/time-entries/:entryId", echoutil.WrapWithStatus(r.handlers.TimeEntry.DeleteTimeEntryByIDWrapped, http.StatusOK))
+	}
+
+	// Calendar feed management routes
+	calendarFeedGroup := v1Protected.Group("/calendar-feed")
+	{
+		calendarFeedGroup.POST("", echoutil.WrapWithStatus(r.handlers.CalendarFeed.CreateFeedWrapped, http.StatusCreated))
+		calendarFeedGroup.GET("", echoutil.WrapWithStatus(r.handlers.CalendarFeed.GetFeedWrapped, http.StatusOK))
+		calendarFeedGroup.DELETE("", echoutil.WrapWithStatus(r.handlers.CalendarFeed.DeleteFeedWrapped, http.StatusOK))
+	}
+
+	// Board routes
+	v1Protected.GET("/board", echoutil.WrapWithStatus(r.handlers.Task.GetBoardWrapped, http.StatusOK))
+
+	// Time entry routes
+	timeEntryGroup := v1Protected.Group("/time-entries")
+	{
+		timeEntryGroup.GET("/report", echoutil.WrapWithStatus(r.handlers.TimeEntry.GetTimeReportWrapped, http.StatusOK))
+	}
+
+	// Tag routes
+	tagGroup := v1Protected.Group("/tags")
+	{
+		tagGroup.POST("", echoutil.WrapWithStatus(r.handlers.Tag.CreateTagWrapped, http.StatusCreated))
+		tagGroup.GET("", echoutil.WrapWithStatus(r.handlers.Tag.GetTagsByUserIDWrapped, http.StatusOK))
+		tagGroup.GET("/:id", echoutil.WrapWithStatus(r.handlers.Tag.GetTagByIDWrapped, http.StatusOK))
+		tagGroup.PUT("/:id", echoutil.WrapWithStatus(r.handlers.Tag.UpdateTagByIDWrapped, http.StatusOK))
+		tagGroup.DELETE("/:id", echoutil.WrapWithStatus(r.handlers.Tag.DeleteTagByIDWrapped, http.StatusOK))
 	}
+
+	// Project routes
+	projectGroup := v1Protected.Group("/projects")
+	{
+		projectGroup.POST("", echoutil.WrapWithStatus(r.handlers.Project.CreateProjectWrapped, http.StatusCreated))
+		projectGroup.GET("", echoutil.WrapWithStatus(r.handlers.Project.GetProjectsByUserIDWrapped, http.StatusOK))
+		projectGroup.GET("/:id", echoutil.WrapWithStatus(r.handlers.Project.GetProjectByIDWrapped, http.StatusOK))
+		projectGroup.PUT("/:id", echoutil.WrapWithStatus(r.handlers.Project.UpdateProjectByIDWrapped, http.StatusOK))
+		projectGroup.DELETE("/:id", echoutil.WrapWithStatus(r.handlers.Project.DeleteProjectByIDWrapped, http.StatusOK))
+		projectGroup.PATCH("/:id/archive", echoutil.WrapWithStatus(r.handlers.Project.ArchiveProjectByIDWrapped, http.StatusOK))
+		projectGroup.PATCH("/:id/unarchive", echoutil.WrapWithStatus(r.handlers.Project.UnarchiveProjectByIDWrapped, http.StatusOK))
+		projectGroup.GET("/:id/tasks", echoutil.WrapWithStatus(r.handlers.Task.GetTasksByProjectIDWrapped, http.StatusOK))
+		projectGroup.GET("/:id/custom-fields", echoutil.WrapWithStatus(r.handlers.CustomField.GetCustomFieldsByProjectIDWrapped, http.StatusOK))
+		projectGroup.POST("/:id/custom-fields", echoutil.WrapWithStatus(r.handlers.CustomField.CreateProjectCustomFieldWrapped, http.StatusCreated))
+		projectGroup.GET("/:id/workflow", echoutil.WrapWithStatus(r.handlers.Workflow.GetWorkflowByProjectIDWrapped, http.StatusOK))
+		projectGroup.PUT("/:id/workflow", echoutil.WrapWithStatus(r.handlers.Workflow.SaveProjectWorkflowWrapped, http.StatusOK))
+		projectGroup.DELETE("/:id/workflow", echoutil.WrapWithStatus(r.handlers.Workflow.ResetProjectWorkflowWrapped, http.StatusOK))
+	}
+
+	// Workspace routes
+	workspaceGroup := v1Protected.Group("/workspaces")
+	{
+		workspaceGroup.POST("", echoutil.WrapWithStatus(r.handlers.Workspace.CreateWorkspaceWrapped, http.StatusCreated))
+		workspaceGroup.GET("", echoutil.WrapWithStatus(r.handlers.Workspace.GetWorkspacesByUserIDWrapped, http.StatusOK))
+		workspaceGroup.GET("/:id", echoutil.WrapWithStatus(r.handlers.Workspace.GetWorkspaceByIDWrapped, http.StatusOK))
+		workspaceGroup.PUT("/:id", echoutil.WrapWithStatus(r.handlers.Workspace.UpdateWorkspaceByIDWrapped, http.StatusOK))
+		workspaceGroup.DELETE("/:id", echoutil.WrapWithStatus(r.handlers.Workspace.DeleteWorkspaceByIDWrapped, http.StatusOK))
+		workspaceGroup.GET("/:id/members", echoutil.WrapWithStatus(r.handlers.Workspace.GetMembersByWorkspaceIDWrapped, http.StatusOK))
+		workspaceGroup.POST("/:id/members", echoutil.WrapWithStatus(r.handlers.Workspace.AddMemberWrapped, http.StatusCreated))
+		workspaceGroup.PATCH("/:id/members/:userId", echoutil.WrapWithStatus(r.handlers.Workspace.UpdateMemberRoleWrapped, http.StatusOK))
+		workspaceGroup.DELETE("/:id/members/:userId", echoutil.WrapWithStatus(r.handlers.Workspace.RemoveMemberWrapped, http.StatusOK))
+		workspaceGroup.GET("/:id/tasks", echoutil.WrapWithStatus(r.handlers.Task.GetTasksByWorkspaceIDWrapped, http.StatusOK))
+		workspaceGroup.GET("/:id/custom-fields", echoutil.WrapWithStatus(r.handlers.CustomField.GetCustomFieldsByWorkspaceIDWrapped, http.StatusOK))
+		workspaceGroup.POST("/:id/custom-fields", echoutil.WrapWithStatus(r.handlers.CustomField.CreateWorkspaceCustomFieldWrapped, http.StatusCreated))
+		workspaceGroup.GET("/:id/workflow", echoutil.WrapWithStatus(r.handlers.Workflow.GetWorkflowByWorkspaceIDWrapped, http.StatusOK))
+		workspaceGroup.PUT("/:id/workflow", echoutil.WrapWithStatus(r.handlers.Workflow.SaveWorkspaceWorkflowWrapped, http.StatusOK))
+		workspaceGroup.DELETE("/:id/workflow", echoutil.WrapWithStatus(r.handlers.Workflow.ResetWorkspaceWorkflowWrapped, http.StatusOK))
+		workspaceGroup.GET("/:id/webhooks", echoutil.WrapWithStatus(r.handlers.Webhook.GetWebhooksByWorkspaceIDWrapped, http.StatusOK))
+		workspaceGroup.POST("/:id/webhooks", echoutil.WrapWithStatus(r.handlers.Webhook.CreateWorkspaceWebhookWrapped, http.StatusCreated))
+	}
+
+	// Custom field routes
+	customFieldGroup := v1Protected.Group("/custom-fields")
+	{
+		customFieldGroup.GET("/:id", echoutil.WrapWithStatus(r.handlers.CustomField.GetCustomFieldByIDWrapped, http.StatusOK))
+		customFieldGroup.PUT("/:id", echoutil.WrapWithStatus(r.handlers.CustomField.UpdateCustomFieldByIDWrapped, http.StatusOK))
+		customFieldGroup.DELETE("/:id", echoutil.WrapWithStatus(r.handlers.CustomField.DeleteCustomFieldByIDWrapped, http.StatusOK))
+	}
+
+	// Webhook routes
+	webhookGroup := v1Protected.Group("/webhooks")
+	{
+		webhookGroup.POST("", echoutil.WrapWithStatus(r.handlers.Webhook.CreateWebhookWrapped, http.StatusCreated))
+		webhookGroup.GET("", echoutil.WrapWithStatus(r.handlers.Webhook.GetWebhooksWrapped, http.StatusOK))
+		webhookGroup.GET("/:id", echoutil.WrapWithStatus(r.handlers.Webhook.GetWebhookByIDWrapped, http.StatusOK))
+		webhookGroup.PUT("/:id", echoutil.WrapWithStatus(r.handlers.Webhook.UpdateWebhookByIDWrapped, http.StatusOK))
+		webhookGroup.DELETE("/:id", echoutil.WrapWithStatus(r.handlers.Webhook.DeleteWebhookByIDWrapped, http.StatusOK))
+		webhookGroup.GET("/:id/deliveries", echoutil.WrapWithStatus(r.handlers.Webhook.GetDeliveriesByWebhookIDWrapped, http.StatusOK))
+		webhookGroup.POST("/:id/deliveries/:deliveryId/redeliver", echoutil.WrapWithStatus(r.handlers.Webhook.RedeliverByIDWrapped, http.StatusCreated))
+	}
+}
+
+// uploadLimit refuses request bodies too large to carry a file of at most
+// fileSizeBytes before they are read, the handler still checks the file itself
+func uploadLimit(fileSizeBytes int64) echo.MiddlewareFunc {
+	return echoMiddleware.BodyLimit(strconv.FormatInt(fileSizeBytes+multipartOverheadBytes, 10))
 }
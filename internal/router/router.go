@@ -1,21 +1,26 @@
 package router
 
 import (
+	"github.com/graphzc/sdd-task-management-example/internal/config"
 	"github.com/graphzc/sdd-task-management-example/internal/handlers"
 	"github.com/graphzc/sdd-task-management-example/internal/middlewares"
 	"github.com/labstack/echo/v4"
 )
 
 type Router struct {
-	echo           *echo.Echo
-	handlers       *handlers.Handlers
-	authMiddleware middlewares.AuthMiddleware
+	config              *config.Config
+	echo                *echo.Echo
+	handlers            *handlers.Handlers
+	authMiddleware      middlewares.AuthMiddleware
+	feedTokenMiddleware middlewares.FeedTokenMiddleware
 }
 
-func NewRouter(echo *echo.Echo, handlers *handlers.Handlers, authMiddleware middlewares.AuthMiddleware) *Router {
+func NewRouter(config *config.Config, echo *echo.Echo, handlers *handlers.Handlers, authMiddleware middlewares.AuthMiddleware, feedTokenMiddleware middlewares.FeedTokenMiddleware) *Router {
 	return &Router{
-		echo:           echo,
-		handlers:       handlers,
-		authMiddleware: authMiddleware,
+		config:              config,
+		echo:                echo,
+		handlers:            handlers,
+		authMiddleware:      authMiddleware,
+		feedTokenMiddleware: feedTokenMiddleware,
 	}
 }
@@ -0,0 +1,392 @@
+package attachment
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/config"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/infrastructure/storage"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/attachment"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/task"
+	"github.com/graphzc/sdd-task-management-example/internal/services/authz"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/rs/zerolog/log"
+)
+
+// sniffLength is how many leading bytes are inspected to detect the MIME type
+const sniffLength = 512
+
+type Service interface {
+	UploadAttachment(ctx context.Context, taskID string, in *AttachmentUploadInput, userID string) (*entities.Attachment, error)
+	FindAttachmentsByTaskID(ctx context.Context, taskID string, userID string) ([]entities.Attachment, error)
+	DownloadAttachment(ctx context.Context, taskID string, attachmentID string, userID string) (*AttachmentDownloadOutput, error)
+	DeleteAttachmentByID(ctx context.Context, taskID string, attachmentID string, userID string) error
+	DeleteUnusedContent(ctx context.Context, contentHashes []string)
+}
+
+type service struct {
+	config         *config.Config
+	attachmentRepo attachment.Repository
+	taskRepo       task.Repository
+	policy         authz.Policy
+	blobStore      storage.BlobStore
+}
+
+// @WireSet("Service")
+func NewService(
+	config *config.Config,
+	attachmentRepo attachment.Repository,
+	taskRepo task.Repository,
+	policy authz.Policy,
+	blobStore storage.BlobStore,
+) Service {
+	return &service{
+		config:         config,
+		attachmentRepo: attachmentRepo,
+		taskRepo:       taskRepo,
+		policy:         policy,
+		blobStore:      blobStore,
+	}
+}
+
+func (s *service) UploadAttachment(ctx context.Context, taskID string, in *AttachmentUploadInput, userID string) (*entities.Attachment, error) {
+	// Find the task first to ensure it exists and the user may change it
+	if err := s.authorizeTask(ctx, taskID, userID, authz.ActionWrite); err != nil {
+		return nil, err
+	}
+
+	fileName := strings.TrimSpace(filepath.Base(in.FileName))
+	if fileName == "" || fileName == "." || fileName == string(filepath.Separator) {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"File name is required",
+		)
+	}
+
+	// Hash the content while enforcing the size limit, the declared size is not trusted
+	maxSize := s.config.Attachment.MaxSizeBytes
+	hash := sha256.New()
+	size, err := io.Copy(hash, io.LimitReader(in.Content, maxSize+1))
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to read attachment content")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to upload attachment",
+		)
+	}
+
+	if size > maxSize {
+		log.Warn().
+			Str("taskId", taskID).
+			Int64("maxSize", maxSize).
+			Msg("Attachment is too large")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"File is too large",
+		)
+	}
+
+	if size == 0 {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"File is empty",
+		)
+	}
+
+	contentType, err := s.detectContentType(in.Content)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to detect attachment content type")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to upload attachment",
+		)
+	}
+
+	if !s.isAllowedContentType(contentType) {
+		log.Warn().
+			Str("taskId", taskID).
+			Str("contentType", contentType).
+			Msg("Attachment content type is not allowed")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"File type is not allowed",
+		)
+	}
+
+	newAttachment := &entities.Attachment{
+		ID:          uuid.NewString(),
+		TaskID:      taskID,
+		UserID:      userID,
+		FileName:    fileName,
+		ContentType: contentType,
+		Size:        size,
+		ContentHash: hex.EncodeToString(hash.Sum(nil)),
+		CreatedAt:   timeutil.BangkokNow(),
+	}
+
+	// Identical content is stored once and shared by every attachment of it
+	if err := s.storeContent(ctx, newAttachment, in.Content); err != nil {
+		return nil, err
+	}
+
+	if err := s.attachmentRepo.Create(ctx, newAttachment); err != nil {
+		if errors.Is(err, attachment.ErrNoRowsAffected) {
+			log.Warn().
+				Str("taskId", taskID).
+				Str("contentHash", newAttachment.ContentHash).
+				Msg("File is already attached to the task")
+
+			return nil, servererr.NewError(
+				servererr.ErrorCodeConflict,
+				"File is already attached to the task",
+			)
+		}
+
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to create attachment")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to upload attachment",
+		)
+	}
+
+	return newAttachment, nil
+}
+
+func (s *service) FindAttachmentsByTaskID(ctx context.Context, taskID string, userID string) ([]entities.Attachment, error) {
+	// Find the task first to ensure it exists and is visible to the user
+	if err := s.authorizeTask(ctx, taskID, userID, authz.ActionRead); err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.attachmentRepo.FindByTaskID(ctx, taskID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to find attachments by task ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find attachments",
+		)
+	}
+
+	return attachments, nil
+}
+
+func (s *service) DownloadAttachment(ctx context.Context, taskID string, attachmentID string, userID string) (*AttachmentDownloadOutput, error) {
+	foundAttachment, err := s.findAttachment(ctx, taskID, attachmentID, userID, authz.ActionRead)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := s.blobStore.Get(ctx, blobKey(foundAttachment.ContentHash))
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("attachmentId", attachmentID).
+			Msg("Failed to read attachment content")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to download attachment",
+		)
+	}
+
+	return &AttachmentDownloadOutput{
+		Attachment: foundAttachment,
+		Content:    content,
+	}, nil
+}
+
+func (s *service) DeleteAttachmentByID(ctx context.Context, taskID string, attachmentID string, userID string) error {
+	foundAttachment, err := s.findAttachment(ctx, taskID, attachmentID, userID, authz.ActionWrite)
+	if err != nil {
+		return err
+	}
+
+	if err := s.attachmentRepo.DeleteByID(ctx, attachmentID); err != nil {
+		log.Error().
+			Err(err).
+			Str("attachmentId", attachmentID).
+			Msg("Failed to delete attachment")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to delete attachment",
+		)
+	}
+
+	s.DeleteUnusedContent(ctx, []string{foundAttachment.ContentHash})
+
+	return nil
+}
+
+// DeleteUnusedContent removes the stored content of each hash no attachment
+// refers to anymore. A leftover blob is harmless so failures are only logged.
+func (s *service) DeleteUnusedContent(ctx context.Context, contentHashes []string) {
+	for _, contentHash := range contentHashes {
+		remaining, err := s.attachmentRepo.CountByContentHash(ctx, contentHash)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("contentHash", contentHash).
+				Msg("Failed to count attachments by content hash")
+
+			continue
+		}
+
+		if remaining > 0 {
+			continue
+		}
+
+		if err := s.blobStore.Delete(ctx, blobKey(contentHash)); err != nil {
+			log.Error().
+				Err(err).
+				Str("contentHash", contentHash).
+				Msg("Failed to delete attachment content")
+		}
+	}
+}
+
+// authorizeTask ensures the task exists and the user may perform the action on it
+func (s *service) authorizeTask(ctx context.Context, taskID string, userID string, action authz.Action) error {
+	foundTask, err := s.taskRepo.FindByID(ctx, taskID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to find task by ID")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find task",
+		)
+	}
+
+	if foundTask == nil {
+		log.Warn().
+			Str("taskId", taskID).
+			Msg("Task not found")
+
+		return servererr.NewError(
+			servererr.ErrorCodeNotFound,
+			"Task not found",
+		)
+	}
+
+	return s.policy.AuthorizeTask(ctx, foundTask, userID, action)
+}
+
+// findAttachment looks up an attachment of a task the user may perform the action on
+func (s *service) findAttachment(ctx context.Context, taskID string, attachmentID string, userID string, action authz.Action) (*entities.Attachment, error) {
+	if err := s.authorizeTask(ctx, taskID, userID, action); err != nil {
+		return nil, err
+	}
+
+	foundAttachment, err := s.attachmentRepo.FindByID(ctx, attachmentID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("attachmentId", attachmentID).
+			Msg("Failed to find attachment by ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find attachment",
+		)
+	}
+
+	if foundAttachment == nil || foundAttachment.TaskID != taskID {
+		log.Warn().
+			Str("taskId", taskID).
+			Str("attachmentId", attachmentID).
+			Msg("Attachment not found")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeNotFound,
+			"Attachment not found",
+		)
+	}
+
+	return foundAttachment, nil
+}
+
+// storeContent uploads the attachment content unless a blob with the same hash exists
+func (s *service) storeContent(ctx context.Context, newAttachment *entities.Attachment, content io.ReadSeeker) error {
+	key := blobKey(newAttachment.ContentHash)
+
+	exists, err := s.blobStore.Exists(ctx, key)
+	if err == nil && !exists {
+		if _, err = content.Seek(0, io.SeekStart); err == nil {
+			err = s.blobStore.Put(ctx, key, content, newAttachment.ContentType)
+		}
+	}
+
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", newAttachment.TaskID).
+			Msg("Failed to store attachment content")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to upload attachment",
+		)
+	}
+
+	return nil
+}
+
+// detectContentType sniffs the media type from the leading bytes of the content
+func (s *service) detectContentType(content io.ReadSeeker) (string, error) {
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	head := make([]byte, sniffLength)
+	n, err := io.ReadFull(content, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	return http.DetectContentType(head[:n]), nil
+}
+
+func (s *service) isAllowedContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return slices.Contains(s.config.Attachment.AllowedMIMETypes, mediaType)
+}
+
+func blobKey(contentHash string) string {
+	return "attachments/" + contentHash
+}
@@ -0,0 +1,17 @@
+package attachment
+
+import (
+	"io"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+)
+
+type AttachmentUploadInput struct {
+	FileName string
+	Content  io.ReadSeeker
+}
+
+type AttachmentDownloadOutput struct {
+	Attachment *entities.Attachment
+	Content    io.ReadCloser
+}
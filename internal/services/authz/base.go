@@ -0,0 +1,124 @@
+package authz
+
+import (
+	"context"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/workspace"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/rs/zerolog/log"
+)
+
+// Action is something a user wants to do with a resource
+type Action string
+
+const (
+	ActionRead   Action = "read"
+	ActionWrite  Action = "write"
+	ActionManage Action = "manage"
+	ActionOwn    Action = "own"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// requiredRoles maps each action to the least privileged workspace role allowed to perform it
+var requiredRoles = map[Action]enums.WorkspaceRole{
+	ActionRead:   enums.WorkspaceRoleViewer,
+	ActionWrite:  enums.WorkspaceRoleMember,
+	ActionManage: enums.WorkspaceRoleAdmin,
+	ActionOwn:    enums.WorkspaceRoleOwner,
+}
+
+// Policy is the single place deciding who may access tasks and workspaces.
+// Users outside a workspace get NotFound so its existence is not revealed,
+// while members lacking the required role get Forbidden.
+type Policy interface {
+	AuthorizeTask(ctx context.Context, task *entities.Task, userID string, action Action) error
+	AuthorizeWorkspace(ctx context.Context, workspaceID string, userID string, action Action) (*entities.WorkspaceMember, error)
+}
+
+type policy struct {
+	workspaceRepo workspace.Repository
+}
+
+// @WireSet("Service")
+func NewPolicy(workspaceRepo workspace.Repository) Policy {
+	return &policy{
+		workspaceRepo: workspaceRepo,
+	}
+}
+
+func (p *policy) AuthorizeTask(ctx context.Context, task *entities.Task, userID string, action Action) error {
+	// Personal tasks are only accessible by their owner
+	if task.WorkspaceID == nil {
+		if task.UserID != userID {
+			log.Warn().
+				Str("taskId", task.ID).
+				Str("userId", userID).
+				Str("taskUserId", task.UserID).
+				Msg("Task does not belong to user")
+
+			return servererr.NewError(
+				servererr.ErrorCodeNotFound,
+				"Task not found",
+			)
+		}
+
+		return nil
+	}
+
+	_, err := p.authorizeMember(ctx, *task.WorkspaceID, userID, action, "Task not found")
+	return err
+}
+
+func (p *policy) AuthorizeWorkspace(ctx context.Context, workspaceID string, userID string, action Action) (*entities.WorkspaceMember, error) {
+	return p.authorizeMember(ctx, workspaceID, userID, action, "Workspace not found")
+}
+
+func (p *policy) authorizeMember(ctx context.Context, workspaceID string, userID string, action Action, notFoundMessage string) (*entities.WorkspaceMember, error) {
+	member, err := p.workspaceRepo.FindMember(ctx, workspaceID, userID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("workspaceId", workspaceID).
+			Str("userId", userID).
+			Msg("Failed to find workspace member")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to check permissions",
+		)
+	}
+
+	if member == nil {
+		log.Warn().
+			Str("workspaceId", workspaceID).
+			Str("userId", userID).
+			Msg("User is not a workspace member")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeNotFound,
+			notFoundMessage,
+		)
+	}
+
+	requiredRole, ok := requiredRoles[action]
+	if !ok || !member.Role.AtLeast(requiredRole) {
+		log.Warn().
+			Str("workspaceId", workspaceID).
+			Str("userId", userID).
+			Str("role", member.Role.String()).
+			Str("action", action.String()).
+			Msg("Workspace role does not allow action")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeForbidden,
+			"You do not have permission to perform this action",
+		)
+	}
+
+	return member, nil
+}
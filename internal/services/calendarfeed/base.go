@@ -0,0 +1,217 @@
+package calendarfeed
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/graphzc/sdd-task-management-example/internal/config"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/calendarfeed"
+	"github.com/graphzc/sdd-task-management-example/internal/services/task"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/rs/zerolog/log"
+)
+
+// tokenBytes is the amount of randomness in a feed token
+const tokenBytes = 32
+
+type Service interface {
+	CreateFeed(ctx context.Context, userID string) (*CalendarFeedOutput, error)
+	FindFeedByUserID(ctx context.Context, userID string) (*entities.CalendarFeed, error)
+	DeleteFeed(ctx context.Context, userID string) error
+	AuthenticateFeedToken(ctx context.Context, token string) (string, error)
+	ExportFeedTasks(ctx context.Context, userID string) (*task.TaskExportOutput, error)
+}
+
+type service struct {
+	config           *config.Config
+	calendarFeedRepo calendarfeed.Repository
+	taskService      task.Service
+}
+
+// @WireSet("Service")
+func NewService(
+	config *config.Config,
+	calendarFeedRepo calendarfeed.Repository,
+	taskService task.Service,
+) Service {
+	return &service{
+		config:           config,
+		calendarFeedRepo: calendarFeedRepo,
+		taskService:      taskService,
+	}
+}
+
+// CreateFeed gives the user a feed with a fresh token. A feed the user
+// already had is replaced, so its URL stops working.
+func (s *service) CreateFeed(ctx context.Context, userID string) (*CalendarFeedOutput, error) {
+	token, err := generateToken()
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to generate calendar feed token")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to create calendar feed",
+		)
+	}
+
+	newFeed := &entities.CalendarFeed{
+		UserID:    userID,
+		TokenHash: hashToken(token),
+		CreatedAt: timeutil.BangkokNow(),
+	}
+
+	if err := s.calendarFeedRepo.Save(ctx, newFeed); err != nil {
+		log.Error().
+			Err(err).
+			Str("userId", userID).
+			Msg("Failed to save calendar feed")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to create calendar feed",
+		)
+	}
+
+	return &CalendarFeedOutput{
+		URL:       s.feedURL(token),
+		CreatedAt: newFeed.CreatedAt,
+	}, nil
+}
+
+func (s *service) FindFeedByUserID(ctx context.Context, userID string) (*entities.CalendarFeed, error) {
+	foundFeed, err := s.calendarFeedRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("userId", userID).
+			Msg("Failed to find calendar feed by user ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find calendar feed",
+		)
+	}
+
+	if foundFeed == nil {
+		log.Warn().
+			Str("userId", userID).
+			Msg("Calendar feed not found")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeNotFound,
+			"Calendar feed not found",
+		)
+	}
+
+	return foundFeed, nil
+}
+
+// DeleteFeed revokes the feed of the user
+func (s *service) DeleteFeed(ctx context.Context, userID string) error {
+	if err := s.calendarFeedRepo.DeleteByUserID(ctx, userID); err != nil {
+		if errors.Is(err, calendarfeed.ErrNoRowsAffected) {
+			log.Warn().
+				Str("userId", userID).
+				Msg("Calendar feed not found")
+
+			return servererr.NewError(
+				servererr.ErrorCodeNotFound,
+				"Calendar feed not found",
+			)
+		}
+
+		log.Error().
+			Err(err).
+			Str("userId", userID).
+			Msg("Failed to delete calendar feed")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to delete calendar feed",
+		)
+	}
+
+	return nil
+}
+
+// AuthenticateFeedToken returns the user a feed token belongs to. Calendar
+// clients cannot send an Authorization header, the token in the feed URL
+// stands in for it.
+func (s *service) AuthenticateFeedToken(ctx context.Context, token string) (string, error) {
+	if token == "" {
+		return "", errInvalidFeedToken()
+	}
+
+	foundFeed, err := s.calendarFeedRepo.FindByTokenHash(ctx, hashToken(token))
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to find calendar feed by token")
+
+		return "", servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find calendar feed",
+		)
+	}
+
+	if foundFeed == nil {
+		return "", errInvalidFeedToken()
+	}
+
+	return foundFeed.UserID, nil
+}
+
+// ExportFeedTasks streams the open tasks of the user, personal ones and
+// those of every workspace they belong to
+func (s *service) ExportFeedTasks(ctx context.Context, userID string) (*task.TaskExportOutput, error) {
+	return s.taskService.ExportTasks(ctx, &task.TaskListInput{
+		StatusCategories: []string{
+			enums.TaskStatusCategoryTodo.String(),
+			enums.TaskStatusCategoryActive.String(),
+		},
+		IncludeWorkspaces: true,
+		SortBy:            task.SortByCreatedAt,
+		SortOrder:         task.SortOrderAsc,
+	}, userID)
+}
+
+// feedURL is the address calendar clients subscribe to
+func (s *service) feedURL(token string) string {
+	return strings.TrimRight(s.config.CalendarFeed.BaseURL, "/") + "/api/v1/calendar/" + token + "/tasks.ics"
+}
+
+func errInvalidFeedToken() error {
+	log.Warn().
+		Msg("Invalid calendar feed token")
+
+	return servererr.NewError(
+		servererr.ErrorCodeUnauthorized,
+		"Invalid calendar feed token",
+	)
+}
+
+// generateToken returns an unguessable token safe to put in a URL path
+func generateToken() (string, error) {
+	b := make([]byte, tokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashToken is what is stored of a token, a leaked table does not reveal feed URLs
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
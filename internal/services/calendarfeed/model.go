@@ -0,0 +1,9 @@
+package calendarfeed
+
+import "time"
+
+// CalendarFeedOutput is a newly created feed, the only time its URL is known
+type CalendarFeedOutput struct {
+	URL       string
+	CreatedAt time.Time
+}
@@ -0,0 +1,196 @@
+package comment
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/config"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/comment"
+	"github.com/graphzc/sdd-task-management-example/internal/services/task"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/rs/zerolog/log"
+)
+
+type Service interface {
+	CreateComment(ctx context.Context, taskID string, in *CommentCreateInput, userID string) (*entities.Comment, error)
+	FindCommentsByTaskID(ctx context.Context, taskID string, userID string) ([]entities.Comment, error)
+	UpdateCommentByID(ctx context.Context, taskID string, commentID string, in *CommentUpdateInput, userID string) error
+	DeleteCommentByID(ctx context.Context, taskID string, commentID string, userID string) error
+}
+
+type service struct {
+	config      *config.Config
+	commentRepo comment.Repository
+	taskService task.Service
+}
+
+// @WireSet("Service")
+func NewService(
+	config *config.Config,
+	commentRepo comment.Repository,
+	taskService task.Service,
+) Service {
+	return &service{
+		config:      config,
+		commentRepo: commentRepo,
+		taskService: taskService,
+	}
+}
+
+func (s *service) CreateComment(ctx context.Context, taskID string, in *CommentCreateInput, userID string) (*entities.Comment, error) {
+	// Anyone who can see the task can take part in its discussion
+	if _, err := s.taskService.FindTaskByID(ctx, taskID, userID); err != nil {
+		return nil, err
+	}
+
+	newComment := &entities.Comment{
+		ID:        uuid.NewString(),
+		TaskID:    taskID,
+		UserID:    userID,
+		Body:      strings.TrimSpace(in.Body),
+		CreatedAt: timeutil.BangkokNow(),
+		UpdatedAt: timeutil.BangkokNow(),
+	}
+
+	if err := s.commentRepo.Create(ctx, newComment); err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to create comment")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to create comment",
+		)
+	}
+
+	// Read the comment back to fill in its author
+	createdComment, err := s.commentRepo.FindByID(ctx, newComment.ID)
+	if err != nil || createdComment == nil {
+		log.Error().
+			Err(err).
+			Str("commentId", newComment.ID).
+			Msg("Failed to find created comment")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to create comment",
+		)
+	}
+
+	return createdComment, nil
+}
+
+func (s *service) FindCommentsByTaskID(ctx context.Context, taskID string, userID string) ([]entities.Comment, error) {
+	// Find the task first to ensure it exists and is visible to the user
+	if _, err := s.taskService.FindTaskByID(ctx, taskID, userID); err != nil {
+		return nil, err
+	}
+
+	comments, err := s.commentRepo.FindByTaskID(ctx, taskID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to find comments by task ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find comments",
+		)
+	}
+
+	return comments, nil
+}
+
+func (s *service) UpdateCommentByID(ctx context.Context, taskID string, commentID string, in *CommentUpdateInput, userID string) error {
+	// Find the comment first to ensure it exists and the user wrote it
+	if _, err := s.findAuthoredComment(ctx, taskID, commentID, userID); err != nil {
+		return err
+	}
+
+	if err := s.commentRepo.UpdateBodyByID(ctx, commentID, strings.TrimSpace(in.Body), timeutil.BangkokNow()); err != nil {
+		log.Error().
+			Err(err).
+			Str("commentId", commentID).
+			Msg("Failed to update comment")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to update comment",
+		)
+	}
+
+	return nil
+}
+
+func (s *service) DeleteCommentByID(ctx context.Context, taskID string, commentID string, userID string) error {
+	// Find the comment first to ensure it exists and the user wrote it
+	if _, err := s.findAuthoredComment(ctx, taskID, commentID, userID); err != nil {
+		return err
+	}
+
+	if err := s.commentRepo.DeleteByID(ctx, commentID); err != nil {
+		log.Error().
+			Err(err).
+			Str("commentId", commentID).
+			Msg("Failed to delete comment")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to delete comment",
+		)
+	}
+
+	return nil
+}
+
+// findAuthoredComment looks up a comment of a task visible to the user,
+// rejecting users other than its author
+func (s *service) findAuthoredComment(ctx context.Context, taskID string, commentID string, userID string) (*entities.Comment, error) {
+	if _, err := s.taskService.FindTaskByID(ctx, taskID, userID); err != nil {
+		return nil, err
+	}
+
+	foundComment, err := s.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("commentId", commentID).
+			Msg("Failed to find comment by ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find comment",
+		)
+	}
+
+	if foundComment == nil || foundComment.TaskID != taskID {
+		log.Warn().
+			Str("taskId", taskID).
+			Str("commentId", commentID).
+			Msg("Comment not found")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeNotFound,
+			"Comment not found",
+		)
+	}
+
+	if foundComment.UserID != userID {
+		log.Warn().
+			Str("commentId", commentID).
+			Str("userId", userID).
+			Msg("User is not the author of the comment")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeForbidden,
+			"Only the author can change the comment",
+		)
+	}
+
+	return foundComment, nil
+}
@@ -0,0 +1,9 @@
+package comment
+
+type CommentCreateInput struct {
+	Body string
+}
+
+type CommentUpdateInput struct {
+	Body string
+}
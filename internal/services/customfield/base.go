@@ -0,0 +1,418 @@
+package customfield
+
+import (
+	"context"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/config"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/customfield"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/project"
+	"github.com/graphzc/sdd-task-management-example/internal/services/authz"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/rs/zerolog/log"
+)
+
+// MaxOptions is the largest number of options a select field offers
+const MaxOptions = 50
+
+// Service manages custom field definitions. Workspace fields are managed by workspace
+// admins and readable by every member, project fields belong to the project owner.
+type Service interface {
+	CreateWorkspaceCustomField(ctx context.Context, workspaceID string, in *CustomFieldCreateInput, userID string) (*entities.CustomField, error)
+	FindCustomFieldsByWorkspaceID(ctx context.Context, workspaceID string, userID string) ([]entities.CustomField, error)
+	CreateProjectCustomField(ctx context.Context, projectID string, in *CustomFieldCreateInput, userID string) (*entities.CustomField, error)
+	FindCustomFieldsByProjectID(ctx context.Context, projectID string, userID string) ([]entities.CustomField, error)
+	FindCustomFieldByID(ctx context.Context, fieldID string, userID string) (*entities.CustomField, error)
+	UpdateCustomFieldByID(ctx context.Context, fieldID string, in *CustomFieldUpdateInput, userID string) error
+	DeleteCustomFieldByID(ctx context.Context, fieldID string, userID string) error
+}
+
+type service struct {
+	config          *config.Config
+	customFieldRepo customfield.Repository
+	projectRepo     project.Repository
+	policy          authz.Policy
+}
+
+// @WireSet("Service")
+func NewService(
+	config *config.Config,
+	customFieldRepo customfield.Repository,
+	projectRepo project.Repository,
+	policy authz.Policy,
+) Service {
+	return &service{
+		config:          config,
+		customFieldRepo: customFieldRepo,
+		projectRepo:     projectRepo,
+		policy:          policy,
+	}
+}
+
+func (s *service) CreateWorkspaceCustomField(ctx context.Context, workspaceID string, in *CustomFieldCreateInput, userID string) (*entities.CustomField, error) {
+	// Only workspace admins define fields
+	if _, err := s.policy.AuthorizeWorkspace(ctx, workspaceID, userID, authz.ActionManage); err != nil {
+		return nil, err
+	}
+
+	newField := &entities.CustomField{
+		WorkspaceID: &workspaceID,
+	}
+
+	return s.createCustomField(ctx, newField, in)
+}
+
+func (s *service) FindCustomFieldsByWorkspaceID(ctx context.Context, workspaceID string, userID string) ([]entities.CustomField, error) {
+	if _, err := s.policy.AuthorizeWorkspace(ctx, workspaceID, userID, authz.ActionRead); err != nil {
+		return nil, err
+	}
+
+	fields, err := s.customFieldRepo.FindByWorkspaceID(ctx, workspaceID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("workspaceId", workspaceID).
+			Msg("Failed to find custom fields by workspace ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find custom fields",
+		)
+	}
+
+	return fields, nil
+}
+
+func (s *service) CreateProjectCustomField(ctx context.Context, projectID string, in *CustomFieldCreateInput, userID string) (*entities.CustomField, error) {
+	if err := s.authorizeProject(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	newField := &entities.CustomField{
+		ProjectID: &projectID,
+	}
+
+	return s.createCustomField(ctx, newField, in)
+}
+
+func (s *service) FindCustomFieldsByProjectID(ctx context.Context, projectID string, userID string) ([]entities.CustomField, error) {
+	if err := s.authorizeProject(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	fields, err := s.customFieldRepo.FindByProjectID(ctx, projectID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("projectId", projectID).
+			Msg("Failed to find custom fields by project ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find custom fields",
+		)
+	}
+
+	return fields, nil
+}
+
+func (s *service) FindCustomFieldByID(ctx context.Context, fieldID string, userID string) (*entities.CustomField, error) {
+	return s.findAuthorizedCustomField(ctx, fieldID, userID, authz.ActionRead)
+}
+
+func (s *service) UpdateCustomFieldByID(ctx context.Context, fieldID string, in *CustomFieldUpdateInput, userID string) error {
+	foundField, err := s.findAuthorizedCustomField(ctx, fieldID, userID, authz.ActionManage)
+	if err != nil {
+		return err
+	}
+
+	updatedField := *foundField
+	updatedField.Name = strings.TrimSpace(in.Name)
+	updatedField.Required = in.Required
+	updatedField.Options = in.Options
+	updatedField.Min = in.Min
+	updatedField.Max = in.Max
+
+	if err := validateRules(&updatedField); err != nil {
+		return err
+	}
+
+	if err := s.ensureNameAvailable(ctx, &updatedField); err != nil {
+		return err
+	}
+
+	if err := s.customFieldRepo.UpdateByID(ctx, &updatedField); err != nil {
+		log.Error().
+			Err(err).
+			Str("fieldId", fieldID).
+			Msg("Failed to update custom field")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to update custom field",
+		)
+	}
+
+	return nil
+}
+
+func (s *service) DeleteCustomFieldByID(ctx context.Context, fieldID string, userID string) error {
+	if _, err := s.findAuthorizedCustomField(ctx, fieldID, userID, authz.ActionManage); err != nil {
+		return err
+	}
+
+	// Task values of the field are removed along with it
+	if err := s.customFieldRepo.DeleteByID(ctx, fieldID); err != nil {
+		log.Error().
+			Err(err).
+			Str("fieldId", fieldID).
+			Msg("Failed to delete custom field")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to delete custom field",
+		)
+	}
+
+	return nil
+}
+
+// createCustomField fills in and saves a field whose scope is already set
+func (s *service) createCustomField(ctx context.Context, newField *entities.CustomField, in *CustomFieldCreateInput) (*entities.CustomField, error) {
+	newField.ID = uuid.NewString()
+	newField.Name = strings.TrimSpace(in.Name)
+	newField.Type = enums.CustomFieldType(in.Type)
+	newField.Required = in.Required
+	newField.Options = in.Options
+	newField.Min = in.Min
+	newField.Max = in.Max
+	newField.CreatedAt = timeutil.BangkokNow()
+	newField.UpdatedAt = timeutil.BangkokNow()
+
+	if !newField.Type.IsValid() {
+		log.Warn().
+			Str("type", in.Type).
+			Msg("Invalid custom field type")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid type. Type must be text, number, date, single_select, multi_select or checkbox",
+		)
+	}
+
+	if err := validateRules(newField); err != nil {
+		return nil, err
+	}
+
+	// Field names are unique within their workspace or project
+	if err := s.ensureNameAvailable(ctx, newField); err != nil {
+		return nil, err
+	}
+
+	if err := s.customFieldRepo.Create(ctx, newField); err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to create custom field")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to create custom field",
+		)
+	}
+
+	return newField, nil
+}
+
+// findAuthorizedCustomField loads a field and checks the user may perform action on it
+func (s *service) findAuthorizedCustomField(ctx context.Context, fieldID string, userID string, action authz.Action) (*entities.CustomField, error) {
+	field, err := s.customFieldRepo.FindByID(ctx, fieldID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("fieldId", fieldID).
+			Msg("Failed to find custom field by ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find custom field",
+		)
+	}
+
+	if field == nil {
+		log.Warn().
+			Str("fieldId", fieldID).
+			Msg("Custom field not found")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeNotFound,
+			"Custom field not found",
+		)
+	}
+
+	if field.WorkspaceID != nil {
+		if _, err := s.policy.AuthorizeWorkspace(ctx, *field.WorkspaceID, userID, action); err != nil {
+			return nil, err
+		}
+		return field, nil
+	}
+
+	if err := s.authorizeProject(ctx, *field.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	return field, nil
+}
+
+// authorizeProject checks the project exists and belongs to the user
+func (s *service) authorizeProject(ctx context.Context, projectID string, userID string) error {
+	foundProject, err := s.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("projectId", projectID).
+			Msg("Failed to find project by ID")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find project",
+		)
+	}
+
+	if foundProject == nil || foundProject.UserID != userID {
+		log.Warn().
+			Str("projectId", projectID).
+			Str("userId", userID).
+			Msg("Project not found")
+
+		return servererr.NewError(
+			servererr.ErrorCodeNotFound,
+			"Project not found",
+		)
+	}
+
+	return nil
+}
+
+// ensureNameAvailable checks no other field of the same workspace or project already uses the name
+func (s *service) ensureNameAvailable(ctx context.Context, field *entities.CustomField) error {
+	var existingField *entities.CustomField
+	var err error
+	if field.WorkspaceID != nil {
+		existingField, err = s.customFieldRepo.FindByWorkspaceIDAndName(ctx, *field.WorkspaceID, field.Name)
+	} else {
+		existingField, err = s.customFieldRepo.FindByProjectIDAndName(ctx, *field.ProjectID, field.Name)
+	}
+
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to check existing custom field by name")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to check existing custom field",
+		)
+	}
+
+	if existingField != nil && existingField.ID != field.ID {
+		log.Warn().
+			Str("name", field.Name).
+			Msg("Custom field with the same name already exists")
+
+		return servererr.NewError(
+			servererr.ErrorCodeConflict,
+			"Custom field with the same name already exists",
+		)
+	}
+
+	return nil
+}
+
+// validateRules checks the options and bounds of a field suit its type,
+// trimming and deduplicating its options along the way
+func validateRules(field *entities.CustomField) error {
+	options := make([]string, 0, len(field.Options))
+	for _, option := range field.Options {
+		option = strings.TrimSpace(option)
+		if option != "" && !slices.Contains(options, option) {
+			options = append(options, option)
+		}
+	}
+	field.Options = options
+
+	if field.Type.HasOptions() && (len(options) == 0 || len(options) > MaxOptions) {
+		log.Warn().
+			Int("options", len(options)).
+			Msg("Custom select field has an invalid number of options")
+
+		return servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid options. Select fields need between 1 and 50 options",
+		)
+	}
+
+	if !field.Type.HasOptions() && len(options) > 0 {
+		log.Warn().
+			Str("type", field.Type.String()).
+			Msg("Custom field type does not take options")
+
+		return servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid options. Only select fields take options",
+		)
+	}
+
+	if field.Min == nil && field.Max == nil {
+		return nil
+	}
+
+	if !field.Type.HasBounds() {
+		log.Warn().
+			Str("type", field.Type.String()).
+			Msg("Custom field type does not take bounds")
+
+		return servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid bounds. Only text and number fields take min and max",
+		)
+	}
+
+	if field.Min != nil && field.Max != nil && *field.Min > *field.Max {
+		log.Warn().
+			Float64("min", *field.Min).
+			Float64("max", *field.Max).
+			Msg("Custom field min is greater than max")
+
+		return servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid bounds. Min must not be greater than max",
+		)
+	}
+
+	// Text bounds are lengths
+	if field.Type == enums.CustomFieldTypeText && (!isLength(field.Min) || !isLength(field.Max)) {
+		log.Warn().
+			Msg("Text custom field has invalid length bounds")
+
+		return servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid bounds. Text lengths must be whole numbers between 0 and 1000",
+		)
+	}
+
+	return nil
+}
+
+// isLength reports whether a bound is unset or a whole number of characters a text value may have
+func isLength(bound *float64) bool {
+	if bound == nil {
+		return true
+	}
+	return *bound >= 0 && *bound <= entities.CustomFieldMaxTextLength && *bound == math.Trunc(*bound)
+}
@@ -0,0 +1,19 @@
+package customfield
+
+type CustomFieldCreateInput struct {
+	Name     string
+	Type     string
+	Required bool
+	Options  []string
+	Min      *float64
+	Max      *float64
+}
+
+// CustomFieldUpdateInput replaces the rules of a field, its type cannot change
+type CustomFieldUpdateInput struct {
+	Name     string
+	Required bool
+	Options  []string
+	Min      *float64
+	Max      *float64
+}
@@ -0,0 +1,201 @@
+package project
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/config"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/project"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/rs/zerolog/log"
+)
+
+type Service interface {
+	CreateProject(ctx context.Context, in *ProjectCreateInput, userID string) (*entities.Project, error)
+	FindProjectByID(ctx context.Context, projectID string, userID string) (*entities.Project, error)
+	FindProjectsByUserID(ctx context.Context, in *ProjectListInput, userID string) ([]entities.Project, error)
+	UpdateProjectByID(ctx context.Context, projectID string, in *ProjectUpdateInput, userID string) error
+	ArchiveProjectByID(ctx context.Context, projectID string, userID string) error
+	UnarchiveProjectByID(ctx context.Context, projectID string, userID string) error
+	DeleteProjectByID(ctx context.Context, projectID string, userID string) error
+}
+
+type service struct {
+	config      *config.Config
+	projectRepo project.Repository
+}
+
+// @WireSet("Service")
+func NewService(
+	config *config.Config,
+	projectRepo project.Repository,
+) Service {
+	return &service{
+		config:      config,
+		projectRepo: projectRepo,
+	}
+}
+
+func (s *service) CreateProject(ctx context.Context, in *ProjectCreateInput, userID string) (*entities.Project, error) {
+	newProject := &entities.Project{
+		ID:          uuid.NewString(),
+		UserID:      userID,
+		Name:        in.Name,
+		Description: in.Description,
+		CreatedAt:   timeutil.BangkokNow(),
+		UpdatedAt:   timeutil.BangkokNow(),
+	}
+
+	if err := s.projectRepo.Create(ctx, newProject); err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to create project")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to create project",
+		)
+	}
+
+	return newProject, nil
+}
+
+func (s *service) FindProjectByID(ctx context.Context, projectID string, userID string) (*entities.Project, error) {
+	// Find the project
+	project, err := s.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("projectId", projectID).
+			Msg("Failed to find project by ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find project",
+		)
+	}
+
+	// Check if project exists and belongs to the user
+	if project == nil || project.UserID != userID {
+		log.Warn().
+			Str("projectId", projectID).
+			Str("userId", userID).
+			Msg("Project not found")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeNotFound,
+			"Project not found",
+		)
+	}
+
+	return project, nil
+}
+
+func (s *service) FindProjectsByUserID(ctx context.Context, in *ProjectListInput, userID string) ([]entities.Project, error) {
+	projects, err := s.projectRepo.FindByUserID(ctx, userID, in.IncludeArchived)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("userId", userID).
+			Msg("Failed to find projects by user ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find projects",
+		)
+	}
+
+	return projects, nil
+}
+
+func (s *service) UpdateProjectByID(ctx context.Context, projectID string, in *ProjectUpdateInput, userID string) error {
+	// Find the project first to ensure it exists and belongs to user
+	_, err := s.FindProjectByID(ctx, projectID, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.projectRepo.UpdateByID(ctx, projectID, in.Name, in.Description); err != nil {
+		log.Error().
+			Err(err).
+			Str("projectId", projectID).
+			Msg("Failed to update project")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to update project",
+		)
+	}
+
+	return nil
+}
+
+func (s *service) ArchiveProjectByID(ctx context.Context, projectID string, userID string) error {
+	foundProject, err := s.FindProjectByID(ctx, projectID, userID)
+	if err != nil {
+		return err
+	}
+
+	// Archiving twice keeps the original archive date
+	if foundProject.IsArchived() {
+		return nil
+	}
+
+	now := timeutil.BangkokNow()
+	return s.updateArchivedAt(ctx, projectID, &now)
+}
+
+func (s *service) UnarchiveProjectByID(ctx context.Context, projectID string, userID string) error {
+	foundProject, err := s.FindProjectByID(ctx, projectID, userID)
+	if err != nil {
+		return err
+	}
+
+	if !foundProject.IsArchived() {
+		return nil
+	}
+
+	return s.updateArchivedAt(ctx, projectID, nil)
+}
+
+func (s *service) DeleteProjectByID(ctx context.Context, projectID string, userID string) error {
+	// Find the project first to ensure it exists and belongs to user
+	_, err := s.FindProjectByID(ctx, projectID, userID)
+	if err != nil {
+		return err
+	}
+
+	// Tasks of the project are kept and detached from it by the database
+	if err := s.projectRepo.DeleteByID(ctx, projectID); err != nil {
+		log.Error().
+			Err(err).
+			Str("projectId", projectID).
+			Msg("Failed to delete project")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to delete project",
+		)
+	}
+
+	return nil
+}
+
+func (s *service) updateArchivedAt(ctx context.Context, projectID string, archivedAt *time.Time) error {
+	if err := s.projectRepo.UpdateArchivedAtByID(ctx, projectID, archivedAt); err != nil {
+		log.Error().
+			Err(err).
+			Str("projectId", projectID).
+			Msg("Failed to update project archive state")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to update project",
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package project
+
+type ProjectCreateInput struct {
+	Name        string
+	Description string
+}
+
+type ProjectUpdateInput struct {
+	Name        string
+	Description string
+}
+
+type ProjectListInput struct {
+	IncludeArchived bool
+}
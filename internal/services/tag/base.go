@@ -0,0 +1,194 @@
+package tag
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/config"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/tag"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/rs/zerolog/log"
+)
+
+type Service interface {
+	CreateTag(ctx context.Context, in *TagCreateInput, userID string) (*entities.Tag, error)
+	FindTagByID(ctx context.Context, tagID string, userID string) (*entities.Tag, error)
+	FindTagsByUserID(ctx context.Context, userID string) ([]entities.Tag, error)
+	UpdateTagByID(ctx context.Context, tagID string, in *TagUpdateInput, userID string) error
+	DeleteTagByID(ctx context.Context, tagID string, userID string) error
+}
+
+type service struct {
+	config  *config.Config
+	tagRepo tag.Repository
+}
+
+// @WireSet("Service")
+func NewService(
+	config *config.Config,
+	tagRepo tag.Repository,
+) Service {
+	return &service{
+		config:  config,
+		tagRepo: tagRepo,
+	}
+}
+
+func (s *service) CreateTag(ctx context.Context, in *TagCreateInput, userID string) (*entities.Tag, error) {
+	name := strings.TrimSpace(in.Name)
+
+	// Tag names are unique per user
+	if err := s.ensureNameAvailable(ctx, userID, name, ""); err != nil {
+		return nil, err
+	}
+
+	newTag := &entities.Tag{
+		ID:        uuid.NewString(),
+		UserID:    userID,
+		Name:      name,
+		Color:     strings.ToUpper(in.Color),
+		CreatedAt: timeutil.BangkokNow(),
+		UpdatedAt: timeutil.BangkokNow(),
+	}
+
+	if err := s.tagRepo.Create(ctx, newTag); err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to create tag")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to create tag",
+		)
+	}
+
+	return newTag, nil
+}
+
+func (s *service) FindTagByID(ctx context.Context, tagID string, userID string) (*entities.Tag, error) {
+	// Find the tag
+	tag, err := s.tagRepo.FindByID(ctx, tagID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("tagId", tagID).
+			Msg("Failed to find tag by ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find tag",
+		)
+	}
+
+	// Check if tag exists and belongs to the user
+	if tag == nil || tag.UserID != userID {
+		log.Warn().
+			Str("tagId", tagID).
+			Str("userId", userID).
+			Msg("Tag not found")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeNotFound,
+			"Tag not found",
+		)
+	}
+
+	return tag, nil
+}
+
+func (s *service) FindTagsByUserID(ctx context.Context, userID string) ([]entities.Tag, error) {
+	tags, err := s.tagRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("userId", userID).
+			Msg("Failed to find tags by user ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find tags",
+		)
+	}
+
+	return tags, nil
+}
+
+func (s *service) UpdateTagByID(ctx context.Context, tagID string, in *TagUpdateInput, userID string) error {
+	// Find the tag first to ensure it exists and belongs to user
+	_, err := s.FindTagByID(ctx, tagID, userID)
+	if err != nil {
+		return err
+	}
+
+	name := strings.TrimSpace(in.Name)
+	if err := s.ensureNameAvailable(ctx, userID, name, tagID); err != nil {
+		return err
+	}
+
+	if err := s.tagRepo.UpdateByID(ctx, tagID, name, strings.ToUpper(in.Color)); err != nil {
+		log.Error().
+			Err(err).
+			Str("tagId", tagID).
+			Msg("Failed to update tag")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to update tag",
+		)
+	}
+
+	return nil
+}
+
+func (s *service) DeleteTagByID(ctx context.Context, tagID string, userID string) error {
+	// Find the tag first to ensure it exists and belongs to user
+	_, err := s.FindTagByID(ctx, tagID, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.tagRepo.DeleteByID(ctx, tagID); err != nil {
+		log.Error().
+			Err(err).
+			Str("tagId", tagID).
+			Msg("Failed to delete tag")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to delete tag",
+		)
+	}
+
+	return nil
+}
+
+// ensureNameAvailable checks no other tag of the user already uses the name
+func (s *service) ensureNameAvailable(ctx context.Context, userID string, name string, exceptTagID string) error {
+	existingTag, err := s.tagRepo.FindByUserIDAndName(ctx, userID, name)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to check existing tag by name")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to check existing tag",
+		)
+	}
+
+	if existingTag != nil && existingTag.ID != exceptTagID {
+		log.Warn().
+			Str("name", name).
+			Msg("Tag with the same name already exists")
+
+		return servererr.NewError(
+			servererr.ErrorCodeConflict,
+			"Tag with the same name already exists",
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,11 @@
+package tag
+
+type TagCreateInput struct {
+	Name  string
+	Color string
+}
+
+type TagUpdateInput struct {
+	Name  string
+	Color string
+}
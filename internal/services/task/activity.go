@@ -0,0 +1,287 @@
+package task
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/activity"
+	"github.com/graphzc/sdd-task-management-example/internal/services/authz"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/cursorutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/rs/zerolog/log"
+)
+
+// activitySortBy and activitySortOrder tag the cursors of activity pages,
+// which are always newest first
+const (
+	activitySortBy    = "activityCreatedAt"
+	activitySortOrder = SortOrderDesc
+)
+
+func (s *service) FindTaskActivityByID(ctx context.Context, taskID string, in *TaskActivityListInput, userID string) (*TaskActivityListOutput, error) {
+	// Find the task first to ensure it exists and is visible to the user
+	if _, err := s.findAuthorizedTask(ctx, taskID, userID, authz.ActionRead); err != nil {
+		return nil, err
+	}
+
+	limit := in.Limit
+	if limit <= 0 {
+		limit = DefaultListLimit
+	}
+	if limit > MaxListLimit {
+		limit = MaxListLimit
+	}
+
+	opts := activity.ListOptions{
+		Limit: limit + 1, // Fetch one extra row to know if there is another page
+	}
+
+	if in.Cursor != "" {
+		keyset, err := decodeActivityCursor(in.Cursor)
+		if err != nil {
+			log.Warn().
+				Str("cursor", in.Cursor).
+				Msg("Invalid activity cursor")
+
+			return nil, servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				"Invalid cursor",
+			)
+		}
+		opts.After = keyset
+	}
+
+	activities, err := s.activityRepo.FindByTaskID(ctx, taskID, &opts)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to find task activity")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find task activity",
+		)
+	}
+
+	output := &TaskActivityListOutput{
+		Activities: activities,
+	}
+
+	if len(activities) > limit {
+		output.Activities = activities[:limit]
+
+		last := output.Activities[limit-1]
+		next, err := cursorutil.Encode(cursorutil.Cursor{
+			SortBy:    activitySortBy,
+			SortOrder: activitySortOrder,
+			Value:     last.CreatedAt.Format(time.RFC3339Nano),
+			ID:        last.ID,
+		})
+		if err != nil {
+			log.Error().
+				Err(err).
+				Msg("Failed to encode activity cursor")
+
+			return nil, servererr.NewError(
+				servererr.ErrorCodeInternalServerError,
+				"Failed to find task activity",
+			)
+		}
+		output.NextCursor = next
+	}
+
+	return output, nil
+}
+
+// recordActivity appends an event to the task's history and publishes it to
+// subscribed webhooks. Every task mutation goes through it, which makes it the
+// only place task events leave the service. The change it describes is already
+// saved, so a failure is logged rather than reported, and a history that could
+// not be written does not hold the webhooks back.
+func (s *service) recordActivity(ctx context.Context, taskID string, actorID string, action enums.TaskActivityAction, changes []entities.TaskFieldChange) {
+	newActivity := &entities.TaskActivity{
+		ID:        uuid.NewString(),
+		TaskID:    taskID,
+		ActorID:   actorID,
+		Action:    action,
+		Changes:   changes,
+		CreatedAt: timeutil.BangkokNow(),
+	}
+
+	if err := s.activityRepo.Create(ctx, newActivity); err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Str("action", action.String()).
+			Msg("Failed to record task activity")
+	}
+
+	s.webhookService.PublishTaskEvent(ctx, newActivity)
+}
+
+// findTaskTagIDs returns the sorted IDs of the tags currently on a task
+func (s *service) findTaskTagIDs(ctx context.Context, taskID string) ([]string, error) {
+	tagsByTask, err := s.taskRepo.FindTagsByTaskIDs(ctx, []string{taskID})
+	if err != nil {
+		return nil, err
+	}
+
+	tagIDs := make([]string, 0, len(tagsByTask[taskID]))
+	for _, tag := range tagsByTask[taskID] {
+		tagIDs = append(tagIDs, tag.ID)
+	}
+	slices.Sort(tagIDs)
+
+	return tagIDs, nil
+}
+
+// findAssigneeIDsByTaskIDs returns the sorted IDs of the users currently assigned to each task
+func (s *service) findAssigneeIDsByTaskIDs(ctx context.Context, taskIDs []string) (map[string][]string, error) {
+	assigneesByTask, err := s.taskRepo.FindAssigneesByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	assigneeIDs := make(map[string][]string, len(taskIDs))
+	for _, taskID := range taskIDs {
+		ids := make([]string, 0, len(assigneesByTask[taskID]))
+		for _, assignee := range assigneesByTask[taskID] {
+			ids = append(ids, assignee.ID)
+		}
+		slices.Sort(ids)
+		assigneeIDs[taskID] = ids
+	}
+
+	return assigneeIDs, nil
+}
+
+// taskField is a named task value as it appears in the activity history
+type taskField struct {
+	name  string
+	value any
+}
+
+// taskFields lists the tracked fields of a task, tag IDs only when given
+func taskFields(t *entities.Task, tagIDs []string) []taskField {
+	fields := []taskField{
+		{"title", t.Title},
+		{"description", t.Description},
+		{"priority", t.Priority.Int()},
+		{"status", t.Status.String()},
+		{"startAt", timeValue(t.StartAt)},
+		{"dueAt", timeValue(t.DueAt)},
+		{"parentId", stringValue(t.ParentID)},
+		{"projectId", stringValue(t.ProjectID)},
+		{"workspaceId", stringValue(t.WorkspaceID)},
+	}
+
+	if tagIDs != nil {
+		sorted := slices.Clone(tagIDs)
+		slices.Sort(sorted)
+		fields = append(fields, taskField{"tagIds", sorted})
+	}
+
+	return fields
+}
+
+// assigneeFields lists the assignees of a task as a tracked field
+func assigneeFields(assigneeIDs []string) []taskField {
+	sorted := slices.Clone(assigneeIDs)
+	slices.Sort(sorted)
+
+	return []taskField{{"assigneeIds", sorted}}
+}
+
+// diffTaskFields returns the fields whose value differs between two snapshots,
+// either side may be nil for a created or deleted task
+func diffTaskFields(before, after []taskField) []entities.TaskFieldChange {
+	values := make(map[string][2]any)
+	var order []string
+
+	for _, field := range before {
+		order = append(order, field.name)
+		values[field.name] = [2]any{field.value, nil}
+	}
+	for _, field := range after {
+		pair, seen := values[field.name]
+		if !seen {
+			order = append(order, field.name)
+		}
+		pair[1] = field.value
+		values[field.name] = pair
+	}
+
+	changes := []entities.TaskFieldChange{}
+	for _, name := range order {
+		pair := values[name]
+		if isEmptyValue(pair[0]) && isEmptyValue(pair[1]) {
+			continue
+		}
+		if reflect.DeepEqual(pair[0], pair[1]) {
+			continue
+		}
+
+		changes = append(changes, entities.TaskFieldChange{
+			Field:  name,
+			Before: pair[0],
+			After:  pair[1],
+		})
+	}
+
+	return changes
+}
+
+func decodeActivityCursor(encoded string) (*activity.Keyset, error) {
+	cursor, err := cursorutil.Decode(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	if cursor.SortBy != activitySortBy || cursor.SortOrder != activitySortOrder || cursor.Backward {
+		return nil, cursorutil.ErrInvalidCursor
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, cursor.Value)
+	if err != nil {
+		return nil, cursorutil.ErrInvalidCursor
+	}
+
+	if _, err := uuid.Parse(cursor.ID); err != nil {
+		return nil, cursorutil.ErrInvalidCursor
+	}
+
+	return &activity.Keyset{CreatedAt: createdAt, ID: cursor.ID}, nil
+}
+
+func timeValue(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func stringValue(s *string) any {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
+
+func isEmptyValue(v any) bool {
+	switch value := v.(type) {
+	case nil:
+		return true
+	case string:
+		return value == ""
+	case []string:
+		return len(value) == 0
+	}
+	return false
+}
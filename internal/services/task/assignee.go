@@ -0,0 +1,249 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/task"
+	"github.com/graphzc/sdd-task-management-example/internal/services/authz"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/rs/zerolog/log"
+)
+
+// AssigneeMe is the assignee filter value standing for the requesting user
+const AssigneeMe = "me"
+
+func (s *service) AssignTask(ctx context.Context, taskID string, in *TaskAssigneeInput, userID string) error {
+	// Find the task first to ensure it exists and the user may change it
+	foundTask, err := s.findAuthorizedTask(ctx, taskID, userID, authz.ActionWrite)
+	if err != nil {
+		return err
+	}
+
+	assignee, err := s.userRepo.FindByID(ctx, in.UserID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("assigneeId", in.UserID).
+			Msg("Failed to find assignee")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to assign task",
+		)
+	}
+
+	if assignee == nil {
+		log.Warn().
+			Str("assigneeId", in.UserID).
+			Msg("Assignee not found")
+
+		return servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Assignee not found",
+		)
+	}
+
+	// Assignees must be able to see the task
+	if err := s.policy.AuthorizeTask(ctx, foundTask, assignee.ID, authz.ActionRead); err != nil {
+		if serverErr, ok := err.(*servererr.ServerError); ok && serverErr.Code == servererr.ErrorCodeNotFound {
+			return servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				"Assignee cannot access the task",
+			)
+		}
+
+		return err
+	}
+
+	// Keep the current assignees to record what changed
+	previousAssigneeIDs, err := s.findPreviousAssigneeIDs(ctx, taskID, "Failed to assign task")
+	if err != nil {
+		return err
+	}
+
+	if err := s.taskRepo.CreateAssignee(ctx, taskID, assignee.ID); err != nil {
+		if errors.Is(err, task.ErrNoRowsAffected) {
+			return servererr.NewError(
+				servererr.ErrorCodeConflict,
+				"User is already assigned to the task",
+			)
+		}
+
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Str("assigneeId", assignee.ID).
+			Msg("Failed to create task assignee")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to assign task",
+		)
+	}
+
+	assigneeIDs := append(slices.Clone(previousAssigneeIDs), assignee.ID)
+	s.recordActivity(ctx, taskID, userID, enums.TaskActivityActionUpdated, diffTaskFields(assigneeFields(previousAssigneeIDs), assigneeFields(assigneeIDs)))
+
+	return nil
+}
+
+func (s *service) UnassignTask(ctx context.Context, taskID string, in *TaskAssigneeInput, userID string) error {
+	// Find the task first to ensure it exists and the user may change it
+	_, err := s.findAuthorizedTask(ctx, taskID, userID, authz.ActionWrite)
+	if err != nil {
+		return err
+	}
+
+	// Keep the current assignees to record what changed
+	previousAssigneeIDs, err := s.findPreviousAssigneeIDs(ctx, taskID, "Failed to unassign task")
+	if err != nil {
+		return err
+	}
+
+	if err := s.taskRepo.DeleteAssignee(ctx, taskID, in.UserID); err != nil {
+		if errors.Is(err, task.ErrNoRowsAffected) {
+			return servererr.NewError(
+				servererr.ErrorCodeNotFound,
+				"Task assignee not found",
+			)
+		}
+
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Str("assigneeId", in.UserID).
+			Msg("Failed to delete task assignee")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to unassign task",
+		)
+	}
+
+	assigneeIDs := slices.DeleteFunc(slices.Clone(previousAssigneeIDs), func(id string) bool {
+		return id == in.UserID
+	})
+	s.recordActivity(ctx, taskID, userID, enums.TaskActivityActionUpdated, diffTaskFields(assigneeFields(previousAssigneeIDs), assigneeFields(assigneeIDs)))
+
+	return nil
+}
+
+// findPreviousAssigneeIDs returns the sorted IDs of the users assigned to a
+// task before a change, failing with failureMessage
+func (s *service) findPreviousAssigneeIDs(ctx context.Context, taskID string, failureMessage string) ([]string, error) {
+	assigneeIDs, err := s.findAssigneeIDsByTaskIDs(ctx, []string{taskID})
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to find task assignees")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			failureMessage,
+		)
+	}
+
+	return assigneeIDs[taskID], nil
+}
+
+func (s *service) FindAssignedTasks(ctx context.Context, in *TaskListInput, userID string) (*TaskListOutput, error) {
+	// Tasks assigned to the user, wherever they can see them
+	assignedInput := *in
+	assignedInput.AssigneeIDs = []string{userID}
+	assignedInput.IncludeWorkspaces = true
+	assignedInput.WorkspaceID = nil
+	assignedInput.ProjectID = nil
+
+	return s.FindTaskByUserID(ctx, &assignedInput, userID)
+}
+
+// resolveAssigneeIDs replaces "me" with the user's ID and rejects malformed IDs
+func resolveAssigneeIDs(assigneeIDs []string, userID string) ([]string, error) {
+	resolved := make([]string, 0, len(assigneeIDs))
+	for _, assigneeID := range assigneeIDs {
+		if assigneeID == AssigneeMe {
+			assigneeID = userID
+		}
+
+		if _, err := uuid.Parse(assigneeID); err != nil {
+			log.Warn().
+				Str("assignee", assigneeID).
+				Msg("Invalid assignee filter")
+
+			return nil, servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				"Invalid assignee. Assignee must be me or a user ID",
+			)
+		}
+
+		resolved = append(resolved, assigneeID)
+	}
+
+	return uniqueStrings(resolved), nil
+}
+
+// attachPeople fills in the creator and the assignees of each task
+func (s *service) attachPeople(ctx context.Context, tasks []entities.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	taskIDs := make([]string, len(tasks))
+	creatorIDs := make([]string, len(tasks))
+	for i, t := range tasks {
+		taskIDs[i] = t.ID
+		creatorIDs[i] = t.UserID
+	}
+
+	assignees, err := s.taskRepo.FindAssigneesByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to find task assignees")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find task assignees",
+		)
+	}
+
+	creators, err := s.userRepo.FindByIDs(ctx, uniqueStrings(creatorIDs))
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to find task creators")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find task creators",
+		)
+	}
+
+	creatorsByID := make(map[string]entities.UserSummary, len(creators))
+	for _, creator := range creators {
+		creatorsByID[creator.ID] = entities.UserSummary{
+			ID:    creator.ID,
+			Name:  creator.Name,
+			Email: creator.Email,
+		}
+	}
+
+	for i := range tasks {
+		if creator, ok := creatorsByID[tasks[i].UserID]; ok {
+			tasks[i].Creator = &creator
+		}
+
+		tasks[i].Assignees = assignees[tasks[i].ID]
+		if tasks[i].Assignees == nil {
+			tasks[i].Assignees = []entities.TaskAssignee{}
+		}
+	}
+
+	return nil
+}
@@ -2,12 +2,28 @@ package task
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/graphzc/sdd-task-management-example/internal/config"
 	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
 	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/infrastructure/database"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/activity"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/customfield"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/project"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/tag"
 	"github.com/graphzc/sdd-task-management-example/internal/repositories/task"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/user"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/workflow"
+	"github.com/graphzc/sdd-task-management-example/internal/services/attachment"
+	"github.com/graphzc/sdd-task-management-example/internal/services/authz"
+	"github.com/graphzc/sdd-task-management-example/internal/services/webhook"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/cursorutil"
 	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
 	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
 	"github.com/rs/zerolog/log"
@@ -16,58 +32,268 @@ import (
 type Service interface {
 	CreateTask(ctx context.Context, in *TaskCreateInput, userID string) error
 	FindTaskByID(ctx context.Context, taskID string, userID string) (*entities.Task, error)
-	FindTaskByUserID(ctx context.Context, userID string) ([]entities.Task, error)
-	DeleteTaskByID(ctx context.Context, taskID string, userID string) error
+	FindTaskByUserID(ctx context.Context, in *TaskListInput, userID string) (*TaskListOutput, error)
+	SearchTasks(ctx context.Context, in *TaskSearchInput, userID string) ([]entities.TaskSearchResult, error)
+	ExportTasks(ctx context.Context, in *TaskListInput, userID string) (*TaskExportOutput, error)
+	DeleteTaskByID(ctx context.Context, taskID string, in *TaskDeleteInput, userID string) error
 	UpdateTaskByID(ctx context.Context, taskID string, in *TaskUpdateInput, userID string) error
+	PatchTaskByID(ctx context.Context, taskID string, in *TaskPatchInput, userID string) error
 	UpdateTaskStatusByID(ctx context.Context, taskID string, in *TaskUpdateStatusInput, userID string) error
+
+	// Hierarchy
+	FindSubtasksByID(ctx context.Context, taskID string, userID string) ([]entities.Task, error)
+	FindTaskTreeByID(ctx context.Context, taskID string, userID string) (*entities.TaskTree, error)
+	UpdateTaskParentByID(ctx context.Context, taskID string, in *TaskUpdateParentInput, userID string) error
+
+	// Dependencies
+	AddTaskDependency(ctx context.Context, taskID string, in *TaskDependencyInput, userID string) error
+	RemoveTaskDependency(ctx context.Context, taskID string, in *TaskDependencyInput, userID string) error
+	FindTaskBlockersByID(ctx context.Context, taskID string, userID string) ([]entities.Task, error)
+
+	// Projects
+	FindTasksByProjectID(ctx context.Context, projectID string, in *TaskListInput, userID string) (*TaskListOutput, error)
+	UpdateTaskProjectByID(ctx context.Context, taskID string, in *TaskUpdateProjectInput, userID string) error
+
+	// Workspaces
+	FindTasksByWorkspaceID(ctx context.Context, workspaceID string, in *TaskListInput, userID string) (*TaskListOutput, error)
+	UpdateTaskWorkspaceByID(ctx context.Context, taskID string, in *TaskUpdateWorkspaceInput, userID string) error
+
+	// Assignees
+	AssignTask(ctx context.Context, taskID string, in *TaskAssigneeInput, userID string) error
+	UnassignTask(ctx context.Context, taskID string, in *TaskAssigneeInput, userID string) error
+	FindAssignedTasks(ctx context.Context, in *TaskListInput, userID string) (*TaskListOutput, error)
+
+	// Activity
+	FindTaskActivityByID(ctx context.Context, taskID string, in *TaskActivityListInput, userID string) (*TaskActivityListOutput, error)
+
+	// Trash
+	FindTrashedTasks(ctx context.Context, in *TaskListInput, userID string) (*TaskListOutput, error)
+	RestoreTaskByID(ctx context.Context, taskID string, userID string) error
+	PurgeTaskByID(ctx context.Context, taskID string, userID string) error
+	PurgeExpiredTrash(ctx context.Context, now time.Time) (int64, error)
+
+	// Bulk
+	BulkTasks(ctx context.Context, in *TaskBulkInput, userID string) (*TaskBulkOutput, error)
+
+	// Import
+	ImportTasks(ctx context.Context, in *TaskImportInput, userID string) (*TaskImportOutput, error)
+
+	// Recurrence
+	SetTaskRecurrence(ctx context.Context, taskID string, in *TaskRecurrenceInput, userID string) error
+	FindTaskRecurrence(ctx context.Context, taskID string, in *TaskRecurrencePreviewInput, userID string) (*TaskRecurrenceOutput, error)
+	StopTaskRecurrence(ctx context.Context, taskID string, userID string) error
+
+	// Board
+	MoveTask(ctx context.Context, taskID string, in *TaskMoveInput, userID string) error
+	FindBoard(ctx context.Context, in *TaskBoardInput, userID string) (*TaskBoardOutput, error)
 }
 
 type service struct {
-	config   *config.Config
-	taskRepo task.Repository
+	config            *config.Config
+	taskRepo          task.Repository
+	tagRepo           tag.Repository
+	projectRepo       project.Repository
+	customFieldRepo   customfield.Repository
+	workflowRepo      workflow.Repository
+	userRepo          user.Repository
+	activityRepo      activity.Repository
+	webhookService    webhook.Service
+	attachmentService attachment.Service
+	policy            authz.Policy
+	transactor        database.Transactor
 }
 
 // @WireSet("Service")
 func NewService(
 	config *config.Config,
 	taskRepo task.Repository,
+	tagRepo tag.Repository,
+	projectRepo project.Repository,
+	customFieldRepo customfield.Repository,
+	workflowRepo workflow.Repository,
+	userRepo user.Repository,
+	activityRepo activity.Repository,
+	webhookService webhook.Service,
+	attachmentService attachment.Service,
+	policy authz.Policy,
+	transactor database.Transactor,
 ) Service {
 	return &service{
-		config:   config,
-		taskRepo: taskRepo,
+		config:            config,
+		taskRepo:          taskRepo,
+		tagRepo:           tagRepo,
+		projectRepo:       projectRepo,
+		customFieldRepo:   customFieldRepo,
+		workflowRepo:      workflowRepo,
+		userRepo:          userRepo,
+		activityRepo:      activityRepo,
+		webhookService:    webhookService,
+		attachmentService: attachmentService,
+		policy:            policy,
+		transactor:        transactor,
 	}
 }
 
 func (s *service) CreateTask(ctx context.Context, in *TaskCreateInput, userID string) error {
+	_, err := s.createTask(ctx, in, userID)
+	return err
+}
+
+// createTask creates the task and returns its ID
+func (s *service) createTask(ctx context.Context, in *TaskCreateInput, userID string) (string, error) {
+	// Validate schedule
+	if err := validateSchedule(in.StartAt, in.DueAt); err != nil {
+		return "", err
+	}
+
+	// Ensure parent task exists and the user may add to it
+	workspaceID := in.WorkspaceID
+	projectID := in.ProjectID
+	if in.ParentID != nil {
+		parent, err := s.findParentTask(ctx, *in.ParentID, userID)
+		if err != nil {
+			return "", err
+		}
+
+		// Subtasks live in the workspace of their parent
+		if workspaceID == nil {
+			workspaceID = parent.WorkspaceID
+		}
+
+		if !sameWorkspace(workspaceID, parent.WorkspaceID) {
+			log.Warn().
+				Str("parentId", *in.ParentID).
+				Msg("Parent task is in another workspace")
+
+			return "", servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				"Invalid parent. Parent task must be in the same workspace",
+			)
+		}
+
+		// Subtasks default to the project of their parent
+		if projectID == nil {
+			projectID = parent.ProjectID
+		}
+	}
+
+	// Ensure the user may create tasks in the workspace
+	if workspaceID != nil {
+		if _, err := s.policy.AuthorizeWorkspace(ctx, *workspaceID, userID, authz.ActionWrite); err != nil {
+			return "", err
+		}
+
+		if in.ProjectID != nil {
+			return "", errWorkspaceTaskProject()
+		}
+		projectID = nil
+	}
+
+	// Ensure project exists, belongs to user and is not archived
+	if projectID != nil {
+		if _, err := s.findTargetProject(ctx, *projectID, userID); err != nil {
+			return "", err
+		}
+	}
+
+	// Ensure tags exist and belong to user
+	tagIDs, err := s.resolveTagIDs(ctx, in.TagIDs, userID)
+	if err != nil {
+		return "", err
+	}
+
+	// Validate custom field values against the fields of the workspace or project
+	customFieldValues, err := s.resolveCustomFieldValues(ctx, workspaceID, projectID, in.CustomFields, true)
+	if err != nil {
+		return "", err
+	}
+
+	// New tasks start in the initial status of the workflow of the workspace or project
+	taskWorkflow, err := s.findWorkflow(ctx, workspaceID, projectID)
+	if err != nil {
+		return "", err
+	}
+	initialStatus := taskWorkflow.InitialStatus()
+
 	// Create new task entity
 	newTask := &entities.Task{
-		ID:          uuid.NewString(),
-		UserID:      userID,
-		Title:       in.Title,
-		Description: in.Description,
-		Priority:    enums.TaskPriority(in.Priority),
-		Status:      enums.TaskStatusTodo, // Default status
-		CreatedAt:   timeutil.BangkokNow(),
-		UpdatedAt:   timeutil.BangkokNow(),
+		ID:             uuid.NewString(),
+		UserID:         userID,
+		WorkspaceID:    workspaceID,
+		ProjectID:      projectID,
+		ParentID:       in.ParentID,
+		Title:          in.Title,
+		Description:    in.Description,
+		Priority:       enums.TaskPriority(in.Priority),
+		Status:         initialStatus.Key,
+		StatusCategory: initialStatus.Category,
+		StartAt:        in.StartAt,
+		DueAt:          in.DueAt,
+		CreatedAt:      timeutil.BangkokNow(),
+		UpdatedAt:      timeutil.BangkokNow(),
 	}
 
-	// Create task in repository
-	_, err := s.taskRepo.Create(ctx, newTask)
-	if err != nil {
-		log.Error().
-			Err(err).
-			Msg("Failed to create task")
+	// Create task in repository at the top of its board column, together with
+	// its tags and custom field values so a failure leaves no partial task
+	err = s.withinTransaction(ctx, "Failed to create task", func(ctx context.Context) error {
+		rank, err := s.rankAtTop(ctx, boardColumnOf(newTask, newTask.Status), newTask.ID)
+		if err != nil {
+			return err
+		}
+		newTask.BoardRank = rank
 
-		return servererr.NewError(
-			servererr.ErrorCodeInternalServerError,
-			"Failed to create task",
-		)
+		if _, err := s.taskRepo.Create(ctx, newTask); err != nil {
+			log.Error().
+				Err(err).
+				Msg("Failed to create task")
+
+			return servererr.NewError(
+				servererr.ErrorCodeInternalServerError,
+				"Failed to create task",
+			)
+		}
+
+		// Assign tags
+		if len(tagIDs) > 0 {
+			if err := s.replaceTaskTags(ctx, newTask.ID, tagIDs); err != nil {
+				return err
+			}
+		}
+
+		// Set custom field values
+		if err := s.setCustomFieldValues(ctx, newTask.ID, customFieldValues); err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", err
 	}
 
-	return nil
+	createdFields := append(taskFields(newTask, tagIDs), customFieldActivityFields(customFieldValues)...)
+	s.recordActivity(ctx, newTask.ID, userID, enums.TaskActivityActionCreated, diffTaskFields(nil, createdFields))
+
+	return newTask.ID, nil
 }
 
 func (s *service) FindTaskByID(ctx context.Context, taskID string, userID string) (*entities.Task, error) {
+	task, err := s.findAuthorizedTask(ctx, taskID, userID, authz.ActionRead)
+	if err != nil {
+		return nil, err
+	}
+
+	// Attach subtask rollup and tags
+	tasks := []entities.Task{*task}
+	if err := s.enrichTasks(ctx, tasks); err != nil {
+		return nil, err
+	}
+
+	return &tasks[0], nil
+}
+
+// findAuthorizedTask loads a task and checks the user may perform action on it
+func (s *service) findAuthorizedTask(ctx context.Context, taskID string, userID string, action authz.Action) (*entities.Task, error) {
 	// Find the task
 	task, err := s.taskRepo.FindByID(ctx, taskID)
 	if err != nil {
@@ -94,61 +320,421 @@ func (s *service) FindTaskByID(ctx context.Context, taskID string, userID string
 		)
 	}
 
-	// Check if task belongs to the user
-	if task.UserID != userID {
-		log.Warn().
-			Str("taskId", taskID).
+	// Check if the user may access the task
+	if err := s.policy.AuthorizeTask(ctx, task, userID, action); err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+func (s *service) FindTaskByUserID(ctx context.Context, in *TaskListInput, userID string) (*TaskListOutput, error) {
+	// Apply defaults
+	limit := in.Limit
+	if limit <= 0 {
+		limit = DefaultListLimit
+	}
+	if limit > MaxListLimit {
+		limit = MaxListLimit
+	}
+
+	sortBy, sortOrder, sortField, err := resolveListSort(in)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := s.resolveListFilter(ctx, in, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := task.ListOptions{
+		Filter:     *filter,
+		SortField:  sortField,
+		Descending: sortOrder == SortOrderDesc,
+		Limit:      limit + 1, // Fetch one extra row to know if there is another page
+	}
+
+	// Resume from cursor if provided
+	var cursor *cursorutil.Cursor
+	if in.Cursor != "" {
+		decoded, keyset, err := decodeCursor(in.Cursor, sortBy, sortOrder)
+		if err != nil {
+			log.Warn().
+				Str("cursor", in.Cursor).
+				Msg("Invalid list cursor")
+
+			return nil, servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				"Invalid cursor",
+			)
+		}
+
+		cursor = decoded
+		opts.After = keyset
+
+		// Walk the other way to reach the previous page
+		if cursor.Backward {
+			opts.Descending = !opts.Descending
+		}
+	}
+
+	tasks, err := s.taskRepo.FindAll(ctx, &opts)
+	if err != nil {
+		log.Error().
+			Err(err).
 			Str("userId", userID).
-			Str("taskUserId", task.UserID).
-			Msg("Task does not belong to user")
+			Msg("Failed to find tasks by user ID")
 
 		return nil, servererr.NewError(
-			servererr.ErrorCodeNotFound,
-			"Task not found",
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find tasks",
 		)
 	}
 
-	return task, nil
+	totalCount, err := s.taskRepo.Count(ctx, filter)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("userId", userID).
+			Msg("Failed to count tasks by user ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find tasks",
+		)
+	}
+
+	output, err := buildPage(tasks, limit, cursor, sortBy, sortOrder)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to build task page")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find tasks",
+		)
+	}
+
+	output.TotalCount = totalCount
+
+	if err := s.enrichTasks(ctx, output.Tasks); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// resolveListSort applies the default sort of a listing and checks the requested one
+func resolveListSort(in *TaskListInput) (string, string, task.SortField, error) {
+	sortBy := in.SortBy
+	if sortBy == "" {
+		sortBy = SortByCreatedAt
+	}
+
+	sortField, ok := sortFields[sortBy]
+	if !ok {
+		log.Warn().
+			Str("sortBy", in.SortBy).
+			Msg("Invalid sort field")
+
+		return "", "", "", servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid sortBy. Sort must be priority, title, createdAt or updatedAt",
+		)
+	}
+
+	sortOrder := in.SortOrder
+	if sortOrder == "" {
+		sortOrder = SortOrderDesc
+	}
+
+	if sortOrder != SortOrderAsc && sortOrder != SortOrderDesc {
+		log.Warn().
+			Str("sortOrder", in.SortOrder).
+			Msg("Invalid sort order")
+
+		return "", "", "", servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid sortOrder. Order must be asc or desc",
+		)
+	}
+
+	return sortBy, sortOrder, sortField, nil
 }
 
-func (s *service) FindTaskByUserID(ctx context.Context, userID string) ([]entities.Task, error) {
-	tasks, err := s.taskRepo.FindByUserID(ctx, userID)
+// resolveListFilter builds the repository filter of a listing from its input
+func (s *service) resolveListFilter(ctx context.Context, in *TaskListInput, userID string) (*task.ListFilter, error) {
+	assigneeIDs, err := resolveAssigneeIDs(in.AssigneeIDs, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &task.ListFilter{
+		OwnerID:           userID,
+		WorkspaceID:       in.WorkspaceID,
+		IncludeWorkspaces: in.IncludeWorkspaces,
+		Deleted:           in.Deleted,
+		AssigneeIDs:       assigneeIDs,
+		CreatedFrom:       in.CreatedFrom,
+		CreatedTo:         in.CreatedTo,
+		UpdatedFrom:       in.UpdatedFrom,
+		UpdatedTo:         in.UpdatedTo,
+	}
+
+	if in.Overdue {
+		now := timeutil.BangkokNow()
+		filter.OverdueAt = &now
+	}
+
+	for _, status := range in.Statuses {
+		filter.Statuses = append(filter.Statuses, enums.TaskStatus(status))
+	}
+
+	for _, category := range in.StatusCategories {
+		categoryEnum := enums.TaskStatusCategory(category)
+		if !categoryEnum.IsValid() {
+			log.Warn().
+				Str("statusCategory", category).
+				Msg("Invalid task status category filter")
+
+			return nil, servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				"Invalid status category. Status category must be todo, active, or done",
+			)
+		}
+		filter.StatusCategories = append(filter.StatusCategories, categoryEnum)
+	}
+
+	for _, priority := range in.Priorities {
+		if priority < 1 || priority > 3 {
+			log.Warn().
+				Int("priority", priority).
+				Msg("Invalid task priority filter")
+
+			return nil, servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				"Invalid priority. Priority must be between 1 and 3",
+			)
+		}
+		filter.Priorities = append(filter.Priorities, enums.TaskPriority(priority))
+	}
+
+	tagMode := in.TagMode
+	if tagMode == "" {
+		tagMode = TagModeOr
+	}
+
+	if tagMode != TagModeAnd && tagMode != TagModeOr {
+		log.Warn().
+			Str("tagMode", in.TagMode).
+			Msg("Invalid tag mode")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid tagMode. Mode must be and or or",
+		)
+	}
+
+	filter.TagIDs = uniqueStrings(in.TagIDs)
+	filter.MatchAllTags = tagMode == TagModeAnd
+
+	filter.CustomFields, err = s.resolveCustomFieldFilters(ctx, in.CustomFields, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tasks of archived projects are hidden unless asked for
+	filter.ProjectID = in.ProjectID
+	filter.ExcludeArchivedProjects = in.ProjectID == nil && !in.IncludeArchived
+
+	if (in.CreatedFrom != nil && in.CreatedTo != nil && in.CreatedFrom.After(*in.CreatedTo)) ||
+		(in.UpdatedFrom != nil && in.UpdatedTo != nil && in.UpdatedFrom.After(*in.UpdatedTo)) {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid date range. From must not be after to",
+		)
+	}
+
+	return filter, nil
+}
+
+func (s *service) SearchTasks(ctx context.Context, in *TaskSearchInput, userID string) ([]entities.TaskSearchResult, error) {
+	query := strings.TrimSpace(in.Query)
+	if query == "" {
+		log.Warn().
+			Msg("Empty search query")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Search query must not be empty",
+		)
+	}
+
+	limit := in.Limit
+	if limit <= 0 {
+		limit = DefaultListLimit
+	}
+	if limit > MaxListLimit {
+		limit = MaxListLimit
+	}
+
+	offset := max(in.Offset, 0)
+
+	// Results are scoped to the user's personal tasks and the workspaces they belong to
+	results, err := s.taskRepo.Search(ctx, userID, query, limit, offset)
 	if err != nil {
 		log.Error().
 			Err(err).
 			Str("userId", userID).
-			Msg("Failed to find tasks by user ID")
+			Str("query", query).
+			Msg("Failed to search tasks")
 
 		return nil, servererr.NewError(
 			servererr.ErrorCodeInternalServerError,
-			"Failed to find tasks",
+			"Failed to search tasks",
 		)
 	}
 
-	return tasks, nil
+	return results, nil
 }
 
-func (s *service) DeleteTaskByID(ctx context.Context, taskID string, userID string) error {
+func (s *service) DeleteTaskByID(ctx context.Context, taskID string, in *TaskDeleteInput, userID string) error {
+	// Validate strategy
+	strategy := enums.TaskDeleteStrategy(in.Strategy)
+	if strategy == "" {
+		strategy = enums.TaskDeleteStrategyReject
+	}
+
+	if strategy != enums.TaskDeleteStrategyReject && strategy != enums.TaskDeleteStrategyCascade && strategy != enums.TaskDeleteStrategyReparent {
+		log.Warn().
+			Str("strategy", in.Strategy).
+			Msg("Invalid task delete strategy")
+
+		return servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid strategy. Strategy must be reject, cascade, or reparent",
+		)
+	}
+
 	// Find the task first
-	_, err := s.FindTaskByID(ctx, taskID, userID)
+	foundTask, err := s.findAuthorizedTask(ctx, taskID, userID, authz.ActionWrite)
+	if err != nil {
+		return err
+	}
+
+	tasks := []entities.Task{*foundTask}
+	if err := s.attachSubtaskRollups(ctx, tasks); err != nil {
+		return err
+	}
+	foundTask = &tasks[0]
+
+	hasSubtasks := foundTask.Subtasks != nil && foundTask.Subtasks.Total > 0
+
+	if hasSubtasks && strategy == enums.TaskDeleteStrategyReject {
+		log.Warn().
+			Str("taskId", taskID).
+			Int("subtasks", foundTask.Subtasks.Total).
+			Msg("Task still has subtasks")
+
+		return servererr.NewError(
+			servererr.ErrorCodeConflict,
+			"Task has subtasks. Delete with strategy cascade or reparent",
+		)
+	}
+
+	// Subtasks are trashed or moved along, so record what happened to each of them
+	var affectedTasks []entities.Task
+	err = s.withinTransaction(ctx, "Failed to delete task", func(ctx context.Context) error {
+		// Ensure nobody changed the task since the client read it
+		if err := s.ensureVersion(ctx, taskID, in.Version); err != nil {
+			return err
+		}
+
+		if hasSubtasks {
+			var err error
+			affectedTasks, err = s.findDeleteAffectedTasks(ctx, taskID, strategy)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Delete the task
+		var err error
+		switch {
+		case !hasSubtasks:
+			err = s.taskRepo.DeleteByID(ctx, taskID)
+		case strategy == enums.TaskDeleteStrategyCascade:
+			err = s.taskRepo.DeleteTreeByID(ctx, taskID)
+		default:
+			err = s.taskRepo.DeleteByIDAndReparentChildren(ctx, taskID, foundTask.ParentID)
+		}
+
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("taskId", taskID).
+				Str("strategy", strategy.String()).
+				Msg("Failed to delete task")
+
+			return servererr.NewError(
+				servererr.ErrorCodeInternalServerError,
+				"Failed to delete task",
+			)
+		}
+
+		return nil
+	})
 	if err != nil {
 		return err
 	}
 
-	// Delete the task
-	if err := s.taskRepo.DeleteByID(ctx, taskID); err != nil {
+	s.recordActivity(ctx, taskID, userID, enums.TaskActivityActionDeleted, diffTaskFields(taskFields(foundTask, nil), nil))
+
+	for i := range affectedTasks {
+		affectedTask := &affectedTasks[i]
+		if strategy == enums.TaskDeleteStrategyCascade {
+			s.recordActivity(ctx, affectedTask.ID, userID, enums.TaskActivityActionDeleted, diffTaskFields(taskFields(affectedTask, nil), nil))
+			continue
+		}
+
+		reparentedTask := *affectedTask
+		reparentedTask.ParentID = foundTask.ParentID
+		s.recordActivity(ctx, affectedTask.ID, userID, enums.TaskActivityActionUpdated, diffTaskFields(taskFields(affectedTask, nil), taskFields(&reparentedTask, nil)))
+	}
+
+	return nil
+}
+
+// findDeleteAffectedTasks returns the subtasks a delete changes besides the
+// task itself: all descendants for a cascade, the children for a reparent
+func (s *service) findDeleteAffectedTasks(ctx context.Context, taskID string, strategy enums.TaskDeleteStrategy) ([]entities.Task, error) {
+	var affectedTasks []entities.Task
+	var err error
+	if strategy == enums.TaskDeleteStrategyCascade {
+		affectedTasks, err = s.taskRepo.FindSubtreeByID(ctx, taskID)
+		if len(affectedTasks) > 0 {
+			// The subtree starts with the task itself
+			affectedTasks = affectedTasks[1:]
+		}
+	} else {
+		affectedTasks, err = s.taskRepo.FindByParentID(ctx, taskID)
+	}
+
+	if err != nil {
 		log.Error().
 			Err(err).
 			Str("taskId", taskID).
-			Msg("Failed to delete task")
+			Msg("Failed to find subtasks")
 
-		return servererr.NewError(
+		return nil, servererr.NewError(
 			servererr.ErrorCodeInternalServerError,
 			"Failed to delete task",
 		)
 	}
 
-	return nil
+	return affectedTasks, nil
 }
 
 func (s *service) UpdateTaskByID(ctx context.Context, taskID string, in *TaskUpdateInput, userID string) error {
@@ -164,53 +750,208 @@ func (s *service) UpdateTaskByID(ctx context.Context, taskID string, in *TaskUpd
 		)
 	}
 
-	// Find the task first to ensure it exists and belongs to user
-	_, err := s.FindTaskByID(ctx, taskID, userID)
+	// Validate schedule
+	if err := validateSchedule(in.StartAt, in.DueAt); err != nil {
+		return err
+	}
+
+	// Find the task first to ensure it exists and the user may change it
+	foundTask, err := s.findAuthorizedTask(ctx, taskID, userID, authz.ActionWrite)
+	if err != nil {
+		return err
+	}
+
+	// Ensure tags exist and belong to user
+	var tagIDs, previousTagIDs []string
+	if in.TagIDs != nil {
+		tagIDs, err = s.resolveTagIDs(ctx, in.TagIDs, userID)
+		if err != nil {
+			return err
+		}
+
+		// Keep the current tags to record what changed
+		previousTagIDs, err = s.findPreviousTagIDs(ctx, taskID)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Validate custom field values and keep the current ones to record what changed
+	customFieldValues, err := s.resolveCustomFieldValues(ctx, foundTask.WorkspaceID, foundTask.ProjectID, in.CustomFields, false)
+	if err != nil {
+		return err
+	}
+
+	previousCustomFieldValues, err := s.findPreviousCustomFieldValues(ctx, taskID, customFieldValues)
 	if err != nil {
 		return err
 	}
 
-	// Update task in repository
-	if err := s.taskRepo.UpdateByID(ctx, taskID, in.Title, in.Description, enums.TaskPriority(in.Priority)); err != nil {
+	err = s.withinTransaction(ctx, "Failed to update task", func(ctx context.Context) error {
+		// Ensure nobody changed the task since the client read it
+		if err := s.ensureVersion(ctx, taskID, in.Version); err != nil {
+			return err
+		}
+
+		// Update task in repository
+		if err := s.taskRepo.UpdateByID(ctx, taskID, in.Title, in.Description, enums.TaskPriority(in.Priority), in.StartAt, in.DueAt); err != nil {
+			log.Error().
+				Err(err).
+				Str("taskId", taskID).
+				Msg("Failed to update task")
+
+			return servererr.NewError(
+				servererr.ErrorCodeInternalServerError,
+				"Failed to update task",
+			)
+		}
+
+		// Replace tags when provided
+		if in.TagIDs != nil {
+			if err := s.replaceTaskTags(ctx, taskID, tagIDs); err != nil {
+				return err
+			}
+		}
+
+		// Set custom field values when provided
+		if err := s.setCustomFieldValues(ctx, taskID, customFieldValues); err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	updatedTask := *foundTask
+	updatedTask.Title = in.Title
+	updatedTask.Description = in.Description
+	updatedTask.Priority = enums.TaskPriority(in.Priority)
+	updatedTask.StartAt = in.StartAt
+	updatedTask.DueAt = in.DueAt
+
+	previousFields := append(taskFields(foundTask, previousTagIDs), customFieldActivityFields(previousCustomFieldValues)...)
+	updatedFields := append(taskFields(&updatedTask, tagIDs), customFieldActivityFields(customFieldValues)...)
+	if changes := diffTaskFields(previousFields, updatedFields); len(changes) > 0 {
+		s.recordActivity(ctx, taskID, userID, enums.TaskActivityActionUpdated, changes)
+	}
+
+	return nil
+}
+
+// findPreviousTagIDs returns the current tags of a task before an update replaces them
+func (s *service) findPreviousTagIDs(ctx context.Context, taskID string) ([]string, error) {
+	tagIDs, err := s.findTaskTagIDs(ctx, taskID)
+	if err != nil {
 		log.Error().
 			Err(err).
 			Str("taskId", taskID).
-			Msg("Failed to update task")
+			Msg("Failed to find task tags")
 
-		return servererr.NewError(
+		return nil, servererr.NewError(
 			servererr.ErrorCodeInternalServerError,
 			"Failed to update task",
 		)
 	}
 
-	return nil
+	return tagIDs, nil
+}
+
+// findPreviousCustomFieldValues returns the current values of the fields an update sets
+func (s *service) findPreviousCustomFieldValues(ctx context.Context, taskID string, values map[string]json.RawMessage) (map[string]json.RawMessage, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	currentValues, err := s.findTaskCustomFieldValues(ctx, taskID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to find task custom field values")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to update task",
+		)
+	}
+
+	previousValues := make(map[string]json.RawMessage, len(values))
+	for fieldID := range values {
+		previousValues[fieldID] = currentValues[fieldID]
+	}
+
+	return previousValues, nil
 }
 
 func (s *service) UpdateTaskStatusByID(ctx context.Context, taskID string, in *TaskUpdateStatusInput, userID string) error {
-	// Validate status
 	statusEnum := enums.TaskStatus(in.Status)
-	if statusEnum != enums.TaskStatusTodo && statusEnum != enums.TaskStatusInProgress && statusEnum != enums.TaskStatusCompleted {
-		log.Warn().
-			Str("status", in.Status).
-			Msg("Invalid task status")
 
-		return servererr.NewError(
-			servererr.ErrorCodeBadRequest,
-			"Invalid status. Status must be TODO, IN_PROGRESS, or COMPLETED",
-		)
+	// Find the task first to ensure it exists and the user may change it
+	foundTask, err := s.findAuthorizedTask(ctx, taskID, userID, authz.ActionWrite)
+	if err != nil {
+		return err
+	}
+
+	// Validate status against the workflow the task follows
+	taskWorkflow, err := s.findWorkflow(ctx, foundTask.WorkspaceID, foundTask.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	target, err := resolveStatusTransition(taskWorkflow, foundTask, statusEnum)
+	if err != nil {
+		return err
+	}
+
+	// Ensure no open task is blocking this one
+	if err := s.ensureNotBlocked(ctx, taskID, target); err != nil {
+		return err
 	}
 
-	// Find the task first to ensure it exists and belongs to user
-	_, err := s.FindTaskByID(ctx, taskID, userID)
+	err = s.withinTransaction(ctx, "Failed to update task status", func(ctx context.Context) error {
+		// Ensure nobody changed the task since the client read it
+		if err := s.ensureVersion(ctx, taskID, in.Version); err != nil {
+			return err
+		}
+
+		if foundTask.Status == target.Key {
+			return s.applyStatus(ctx, foundTask, target, userID)
+		}
+
+		// A task changing column goes to the top of its new column
+		rank, err := s.rankAtTop(ctx, boardColumnOf(foundTask, target.Key), taskID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.applyStatus(ctx, foundTask, target, userID); err != nil {
+			return err
+		}
+
+		return s.updateBoardRank(ctx, taskID, rank)
+	})
 	if err != nil {
 		return err
 	}
 
-	// Update task status in repository
-	if err := s.taskRepo.UpdateStatusByID(ctx, taskID, statusEnum); err != nil {
+	if foundTask.Status != statusEnum {
+		s.recordActivity(ctx, taskID, userID, enums.TaskActivityActionStatusChanged, []entities.TaskFieldChange{
+			{Field: "status", Before: foundTask.Status.String(), After: statusEnum.String()},
+		})
+	}
+
+	return nil
+}
+
+// applyStatus updates the status of a task. Completing an occurrence of a series
+// spawns the next one, so callers run it inside a transaction.
+func (s *service) applyStatus(ctx context.Context, foundTask *entities.Task, target *entities.WorkflowStatus, userID string) error {
+	if err := s.taskRepo.UpdateStatusByID(ctx, foundTask.ID, target.Key, target.Category); err != nil {
 		log.Error().
 			Err(err).
-			Str("taskId", taskID).
+			Str("taskId", foundTask.ID).
 			Msg("Failed to update task status")
 
 		return servererr.NewError(
@@ -219,5 +960,98 @@ func (s *service) UpdateTaskStatusByID(ctx context.Context, taskID string, in *T
 		)
 	}
 
+	completed := target.Category == enums.TaskStatusCategoryDone && foundTask.StatusCategory != enums.TaskStatusCategoryDone
+	if completed && foundTask.SeriesID != nil {
+		return s.spawnNextOccurrence(ctx, foundTask, userID)
+	}
+
+	return nil
+}
+
+// ensureVersion locks the task and checks it is still at the version the client last
+// saw, so concurrent edits cannot overwrite each other. A nil version skips the check.
+// Callers run it inside a transaction so the lock lasts until their update is done.
+func (s *service) ensureVersion(ctx context.Context, taskID string, version *int) error {
+	if version == nil {
+		return nil
+	}
+
+	current, err := s.taskRepo.LockVersionByID(ctx, taskID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to lock task version")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to check task version",
+		)
+	}
+
+	if current == 0 {
+		log.Warn().
+			Str("taskId", taskID).
+			Msg("Task not found")
+
+		return servererr.NewError(
+			servererr.ErrorCodeNotFound,
+			"Task not found",
+		)
+	}
+
+	if current != *version {
+		log.Warn().
+			Str("taskId", taskID).
+			Int("version", *version).
+			Int("currentVersion", current).
+			Msg("Task version does not match")
+
+		return servererr.NewError(
+			servererr.ErrorCodePreconditionFailed,
+			fmt.Sprintf("Task was modified by someone else. Current version is %d", current),
+		)
+	}
+
+	return nil
+}
+
+// withinTransaction runs fn in a database transaction. Errors other than
+// server errors are logged and surfaced as an internal error with message.
+func (s *service) withinTransaction(ctx context.Context, message string, fn func(ctx context.Context) error) error {
+	err := s.transactor.WithinTransaction(ctx, fn)
+	if err == nil {
+		return nil
+	}
+
+	var serverErr *servererr.ServerError
+	if errors.As(err, &serverErr) {
+		return serverErr
+	}
+
+	log.Error().
+		Err(err).
+		Msg("Failed to run transaction")
+
+	return servererr.NewError(
+		servererr.ErrorCodeInternalServerError,
+		message,
+	)
+}
+
+// validateSchedule checks that a task starts before it is due
+func validateSchedule(startAt, dueAt *time.Time) error {
+	if startAt != nil && dueAt != nil && !startAt.Before(*dueAt) {
+		log.Warn().
+			Time("startAt", *startAt).
+			Time("dueAt", *dueAt).
+			Msg("Task start date is not before due date")
+
+		return servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid schedule. Start date must be before due date",
+		)
+	}
+
 	return nil
 }
@@ -0,0 +1,445 @@
+package task
+
+import (
+	"context"
+	"slices"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/task"
+	"github.com/graphzc/sdd-task-management-example/internal/services/authz"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/rankutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/rs/zerolog/log"
+)
+
+// maxRankLength bounds how long ranks may grow before their column is rebalanced
+const maxRankLength = 64
+
+func (s *service) MoveTask(ctx context.Context, taskID string, in *TaskMoveInput, userID string) error {
+	// Find the task first to ensure it exists and the user may change it
+	foundTask, err := s.findAuthorizedTask(ctx, taskID, userID, authz.ActionWrite)
+	if err != nil {
+		return err
+	}
+
+	status := foundTask.Status
+	if in.Status != nil {
+		status = enums.TaskStatus(*in.Status)
+	}
+
+	// Moving to another column changes the status, which the workflow must allow
+	var target *entities.WorkflowStatus
+	if status != foundTask.Status {
+		taskWorkflow, err := s.findWorkflow(ctx, foundTask.WorkspaceID, foundTask.ProjectID)
+		if err != nil {
+			return err
+		}
+
+		target, err = resolveStatusTransition(taskWorkflow, foundTask, status)
+		if err != nil {
+			return err
+		}
+
+		if err := s.ensureNotBlocked(ctx, taskID, target); err != nil {
+			return err
+		}
+	}
+
+	column := boardColumnOf(foundTask, status)
+	err = s.withinTransaction(ctx, "Failed to move task", func(ctx context.Context) error {
+		// Moves into the same column wait for each other so no two get the same rank
+		if err := s.lockBoardColumn(ctx, column); err != nil {
+			return err
+		}
+
+		rank, err := s.rankBetween(ctx, column, func(ctx context.Context) (string, string, error) {
+			return s.findMoveBounds(ctx, column, foundTask, in)
+		})
+		if err != nil {
+			return err
+		}
+
+		if target != nil {
+			if err := s.applyStatus(ctx, foundTask, target, userID); err != nil {
+				return err
+			}
+		}
+
+		return s.updateBoardRank(ctx, taskID, rank)
+	})
+	if err != nil {
+		return err
+	}
+
+	if target != nil {
+		s.recordActivity(ctx, taskID, userID, enums.TaskActivityActionStatusChanged, []entities.TaskFieldChange{
+			{Field: "status", Before: foundTask.Status.String(), After: status.String()},
+		})
+	}
+
+	return nil
+}
+
+func (s *service) FindBoard(ctx context.Context, in *TaskBoardInput, userID string) (*TaskBoardOutput, error) {
+	filter := task.ListFilter{
+		OwnerID:     userID,
+		WorkspaceID: in.WorkspaceID,
+		ProjectID:   in.ProjectID,
+		// Archived projects only show up on their own board
+		ExcludeArchivedProjects: in.ProjectID == nil,
+	}
+
+	if in.WorkspaceID != nil {
+		// Any member may view the workspace's board
+		if _, err := s.policy.AuthorizeWorkspace(ctx, *in.WorkspaceID, userID, authz.ActionRead); err != nil {
+			return nil, err
+		}
+
+		if in.ProjectID != nil {
+			return nil, errWorkspaceTaskProject()
+		}
+	} else if in.ProjectID != nil {
+		// Ensure project exists and belongs to user
+		if _, err := s.findOwnedProject(ctx, *in.ProjectID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Columns follow the workflow, statuses outside of it are appended after
+	taskWorkflow, err := s.findWorkflow(ctx, in.WorkspaceID, in.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.taskRepo.CountByStatus(ctx, &filter)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to count tasks by status")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find board",
+		)
+	}
+
+	columns := make([]TaskBoardColumn, 0, len(taskWorkflow.Statuses))
+	for _, status := range taskWorkflow.Statuses {
+		columns = append(columns, TaskBoardColumn{
+			Status:   status.Key,
+			Name:     status.Name,
+			Category: status.Category,
+		})
+	}
+
+	var extraStatuses []enums.TaskStatus
+	for status := range counts {
+		if taskWorkflow.FindStatus(status) == nil {
+			extraStatuses = append(extraStatuses, status)
+		}
+	}
+	slices.Sort(extraStatuses)
+
+	for _, status := range extraStatuses {
+		columns = append(columns, TaskBoardColumn{
+			Status: status,
+			Name:   status.String(),
+		})
+	}
+
+	// Load every column, then attach tags, rollups and the like in one pass
+	var tasks []entities.Task
+	sizes := make([]int, len(columns))
+	for i := range columns {
+		column := &columns[i]
+		column.TotalCount = counts[column.Status]
+		if column.TotalCount == 0 {
+			continue
+		}
+
+		columnFilter := filter
+		columnFilter.Statuses = []enums.TaskStatus{column.Status}
+
+		columnTasks, err := s.taskRepo.FindAll(ctx, &task.ListOptions{
+			Filter:    columnFilter,
+			SortField: task.SortFieldBoardRank,
+			Limit:     s.config.Task.BoardColumnLimit,
+		})
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("status", column.Status.String()).
+				Msg("Failed to find board tasks")
+
+			return nil, servererr.NewError(
+				servererr.ErrorCodeInternalServerError,
+				"Failed to find board",
+			)
+		}
+
+		column.Truncated = len(columnTasks) < column.TotalCount
+		if column.Category == "" && len(columnTasks) > 0 {
+			column.Category = columnTasks[0].StatusCategory
+		}
+
+		sizes[i] = len(columnTasks)
+		tasks = append(tasks, columnTasks...)
+	}
+
+	if err := s.enrichTasks(ctx, tasks); err != nil {
+		return nil, err
+	}
+
+	offset := 0
+	for i := range columns {
+		columns[i].Tasks = tasks[offset : offset+sizes[i]]
+		offset += sizes[i]
+	}
+
+	return &TaskBoardOutput{Columns: columns}, nil
+}
+
+// findMoveBounds returns the ranks a moved task must fall between, checking the
+// requested neighbours are in the target column
+func (s *service) findMoveBounds(ctx context.Context, column *task.BoardColumn, movedTask *entities.Task, in *TaskMoveInput) (string, string, error) {
+	var prev, next string
+
+	if in.AfterID != nil {
+		after, err := s.findNeighbour(ctx, column, movedTask, *in.AfterID)
+		if err != nil {
+			return "", "", err
+		}
+		prev = after.BoardRank
+	}
+
+	if in.BeforeID != nil {
+		before, err := s.findNeighbour(ctx, column, movedTask, *in.BeforeID)
+		if err != nil {
+			return "", "", err
+		}
+		next = before.BoardRank
+	}
+
+	var err error
+	switch {
+	case in.AfterID != nil && in.BeforeID != nil:
+		if prev > next {
+			log.Warn().
+				Str("taskId", movedTask.ID).
+				Msg("Move neighbours are out of order")
+
+			return "", "", servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				"Invalid neighbours. The after task must come before the before task",
+			)
+		}
+
+		// Equal ranks are spread out by the caller before checking again
+		if prev == next {
+			return prev, next, nil
+		}
+
+		following, err := s.findBoardRankAfter(ctx, column, prev, movedTask.ID)
+		if err != nil {
+			return "", "", err
+		}
+
+		if following != next {
+			log.Warn().
+				Str("taskId", movedTask.ID).
+				Msg("Move neighbours are not adjacent")
+
+			return "", "", servererr.NewError(
+				servererr.ErrorCodeConflict,
+				"Neighbour tasks are no longer next to each other",
+			)
+		}
+	case in.AfterID != nil:
+		next, err = s.findBoardRankAfter(ctx, column, prev, movedTask.ID)
+	case in.BeforeID != nil:
+		prev, err = s.findBoardRankBefore(ctx, column, next, movedTask.ID)
+	default:
+		next, err = s.findBoardRankAfter(ctx, column, "", movedTask.ID)
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	return prev, next, nil
+}
+
+// findNeighbour looks up a task the moved task is placed next to. It must be another
+// task of the target column, anything else is a bad request.
+func (s *service) findNeighbour(ctx context.Context, column *task.BoardColumn, movedTask *entities.Task, neighbourID string) (*entities.Task, error) {
+	if neighbourID == movedTask.ID {
+		log.Warn().
+			Str("taskId", movedTask.ID).
+			Msg("Task cannot be moved next to itself")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid neighbour. A task cannot be moved next to itself",
+		)
+	}
+
+	neighbour, err := s.taskRepo.FindByID(ctx, neighbourID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", neighbourID).
+			Msg("Failed to find neighbour task")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to move task",
+		)
+	}
+
+	inColumn := neighbour != nil &&
+		neighbour.Status == column.Status &&
+		sameWorkspace(neighbour.WorkspaceID, column.WorkspaceID) &&
+		(column.WorkspaceID != nil || neighbour.UserID == column.UserID)
+	if !inColumn {
+		log.Warn().
+			Str("taskId", movedTask.ID).
+			Str("neighbourId", neighbourID).
+			Msg("Neighbour task is not in the target column")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid neighbour. Neighbour task must be in the target column",
+		)
+	}
+
+	return neighbour, nil
+}
+
+// rankAtTop returns a rank placing a task first in its column. The column stays
+// locked until the surrounding transaction ends.
+func (s *service) rankAtTop(ctx context.Context, column *task.BoardColumn, taskID string) (string, error) {
+	if err := s.lockBoardColumn(ctx, column); err != nil {
+		return "", err
+	}
+
+	return s.rankBetween(ctx, column, func(ctx context.Context) (string, string, error) {
+		first, err := s.findBoardRankAfter(ctx, column, "", taskID)
+		return "", first, err
+	})
+}
+
+// rankBetween returns a rank between the bounds found by findBounds. Neighbours sharing
+// a rank, or ranks grown too long, have the column rebalanced before trying once more.
+func (s *service) rankBetween(ctx context.Context, column *task.BoardColumn, findBounds func(ctx context.Context) (string, string, error)) (string, error) {
+	for attempt := 0; ; attempt++ {
+		prev, next, err := findBounds(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		rank, err := rankutil.Between(prev, next)
+		if err == nil && len(rank) <= maxRankLength {
+			return rank, nil
+		}
+
+		if attempt > 0 {
+			log.Error().
+				Err(err).
+				Str("prev", prev).
+				Str("next", next).
+				Msg("Failed to rank task")
+
+			return "", servererr.NewError(
+				servererr.ErrorCodeInternalServerError,
+				"Failed to rank task",
+			)
+		}
+
+		if err := s.taskRepo.RebalanceBoardColumn(ctx, column); err != nil {
+			log.Error().
+				Err(err).
+				Str("status", column.Status.String()).
+				Msg("Failed to rebalance board column")
+
+			return "", servererr.NewError(
+				servererr.ErrorCodeInternalServerError,
+				"Failed to rank task",
+			)
+		}
+	}
+}
+
+func (s *service) lockBoardColumn(ctx context.Context, column *task.BoardColumn) error {
+	if err := s.taskRepo.LockBoardColumn(ctx, column); err != nil {
+		log.Error().
+			Err(err).
+			Str("status", column.Status.String()).
+			Msg("Failed to lock board column")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to rank task",
+		)
+	}
+
+	return nil
+}
+
+func (s *service) findBoardRankAfter(ctx context.Context, column *task.BoardColumn, rank string, excludeTaskID string) (string, error) {
+	following, err := s.taskRepo.FindBoardRankAfter(ctx, column, rank, excludeTaskID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("status", column.Status.String()).
+			Msg("Failed to find following board rank")
+
+		return "", servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to rank task",
+		)
+	}
+
+	return following, nil
+}
+
+func (s *service) findBoardRankBefore(ctx context.Context, column *task.BoardColumn, rank string, excludeTaskID string) (string, error) {
+	preceding, err := s.taskRepo.FindBoardRankBefore(ctx, column, rank, excludeTaskID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("status", column.Status.String()).
+			Msg("Failed to find preceding board rank")
+
+		return "", servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to rank task",
+		)
+	}
+
+	return preceding, nil
+}
+
+func (s *service) updateBoardRank(ctx context.Context, taskID string, rank string) error {
+	if err := s.taskRepo.UpdateBoardRankByID(ctx, taskID, rank); err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to update task board rank")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to rank task",
+		)
+	}
+
+	return nil
+}
+
+// boardColumnOf returns the column a task sits in with the given status
+func boardColumnOf(t *entities.Task, status enums.TaskStatus) *task.BoardColumn {
+	return &task.BoardColumn{
+		UserID:      t.UserID,
+		WorkspaceID: t.WorkspaceID,
+		Status:      status,
+	}
+}
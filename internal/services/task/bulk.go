@@ -0,0 +1,186 @@
+package task
+
+import (
+	"context"
+	"errors"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/rs/zerolog/log"
+)
+
+const MaxBulkOperations = 100
+
+// errBulkRollback aborts the transaction of an all-or-nothing batch with a failed operation
+var errBulkRollback = errors.New("bulk operation failed")
+
+func (s *service) BulkTasks(ctx context.Context, in *TaskBulkInput, userID string) (*TaskBulkOutput, error) {
+	// Validate mode
+	mode := enums.TaskBulkMode(in.Mode)
+	if mode == "" {
+		mode = enums.TaskBulkModeAllOrNothing
+	}
+
+	if mode != enums.TaskBulkModeAllOrNothing && mode != enums.TaskBulkModeBestEffort {
+		log.Warn().
+			Str("mode", in.Mode).
+			Msg("Invalid bulk mode")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid mode. Mode must be all_or_nothing or best_effort",
+		)
+	}
+
+	if len(in.Operations) == 0 || len(in.Operations) > MaxBulkOperations {
+		log.Warn().
+			Int("operations", len(in.Operations)).
+			Msg("Invalid number of bulk operations")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid operations. A batch must have between 1 and 100 operations",
+		)
+	}
+
+	output := &TaskBulkOutput{
+		Mode:    mode,
+		Results: make([]TaskBulkResult, len(in.Operations)),
+	}
+
+	// Every operation runs in its own savepoint so a failed one leaves the
+	// others intact, the batch is then committed or rolled back as a whole
+	failed := false
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		for i := range in.Operations {
+			output.Results[i] = s.runBulkOperation(ctx, &in.Operations[i], userID)
+			if output.Results[i].Err != nil {
+				failed = true
+			}
+		}
+
+		if failed && mode == enums.TaskBulkModeAllOrNothing {
+			return errBulkRollback
+		}
+
+		return nil
+	})
+
+	if err != nil && !errors.Is(err, errBulkRollback) {
+		log.Error().
+			Err(err).
+			Str("userId", userID).
+			Msg("Failed to run bulk task operations")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to run bulk operations",
+		)
+	}
+
+	output.Committed = err == nil
+	if !output.Committed {
+		for i := range output.Results {
+			output.Results[i].RolledBack = output.Results[i].Err == nil
+		}
+	}
+
+	return output, nil
+}
+
+func (s *service) runBulkOperation(ctx context.Context, op *TaskBulkOperationInput, userID string) TaskBulkResult {
+	result := TaskBulkResult{
+		Action: enums.TaskBulkAction(op.Action),
+		TaskID: op.TaskID,
+	}
+
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		switch result.Action {
+		case enums.TaskBulkActionCreate:
+			if op.Create == nil {
+				return errBulkMissingInput(result.Action)
+			}
+
+			taskID, err := s.createTask(ctx, op.Create, userID)
+			result.TaskID = taskID
+			return err
+		case enums.TaskBulkActionUpdate:
+			if op.Update == nil {
+				return errBulkMissingInput(result.Action)
+			}
+			if op.Update.Version == nil {
+				return errBulkMissingVersion(result.Action)
+			}
+
+			return s.UpdateTaskByID(ctx, op.TaskID, op.Update, userID)
+		case enums.TaskBulkActionUpdateStatus:
+			if op.Status == nil {
+				return errBulkMissingInput(result.Action)
+			}
+			if op.Status.Version == nil {
+				return errBulkMissingVersion(result.Action)
+			}
+
+			return s.UpdateTaskStatusByID(ctx, op.TaskID, op.Status, userID)
+		case enums.TaskBulkActionDelete:
+			if op.Delete == nil || op.Delete.Version == nil {
+				return errBulkMissingVersion(result.Action)
+			}
+
+			return s.DeleteTaskByID(ctx, op.TaskID, op.Delete, userID)
+		default:
+			log.Warn().
+				Str("action", op.Action).
+				Msg("Invalid bulk action")
+
+			return servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				"Invalid action. Action must be create, update, update_status, or delete",
+			)
+		}
+	})
+
+	if err != nil {
+		var serverErr *servererr.ServerError
+		if !errors.As(err, &serverErr) {
+			log.Error().
+				Err(err).
+				Str("action", op.Action).
+				Str("taskId", result.TaskID).
+				Msg("Failed to run bulk operation")
+
+			serverErr = servererr.NewError(
+				servererr.ErrorCodeInternalServerError,
+				"Failed to run bulk operation",
+			)
+		}
+
+		result.Err = serverErr
+	}
+
+	return result
+}
+
+func errBulkMissingInput(action enums.TaskBulkAction) error {
+	log.Warn().
+		Str("action", action.String()).
+		Msg("Bulk operation is missing its input")
+
+	return servererr.NewError(
+		servererr.ErrorCodeBadRequest,
+		"Invalid operation. Input for "+action.String()+" is required",
+	)
+}
+
+// errBulkMissingVersion refuses an operation changing a task without its version,
+// like the single task endpoints a batch must not overwrite changes it has not seen
+func errBulkMissingVersion(action enums.TaskBulkAction) error {
+	log.Warn().
+		Str("action", action.String()).
+		Msg("Bulk operation is missing the task version")
+
+	return servererr.NewError(
+		servererr.ErrorCodePreconditionRequired,
+		"Version is required for "+action.String()+". Fetch the task to get its current version",
+	)
+}
@@ -0,0 +1,485 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/task"
+	"github.com/graphzc/sdd-task-management-example/internal/services/authz"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/rs/zerolog/log"
+)
+
+// customFieldActivityPrefix prefixes the field ID of custom field changes in the activity history
+const customFieldActivityPrefix = "customFields."
+
+// findApplicableCustomFields returns the fields defined where a task lives: its workspace,
+// or its project for personal tasks. Personal tasks outside a project have no custom fields.
+func (s *service) findApplicableCustomFields(ctx context.Context, workspaceID, projectID *string) ([]entities.CustomField, error) {
+	var fields []entities.CustomField
+	var err error
+
+	switch {
+	case workspaceID != nil:
+		fields, err = s.customFieldRepo.FindByWorkspaceID(ctx, *workspaceID)
+	case projectID != nil:
+		fields, err = s.customFieldRepo.FindByProjectID(ctx, *projectID)
+	default:
+		return nil, nil
+	}
+
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to find custom fields")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find custom fields",
+		)
+	}
+
+	return fields, nil
+}
+
+// resolveCustomFieldValues validates values keyed by field ID against the fields that apply
+// to a task and returns them normalized, a nil value clearing the field. On create every
+// required field must be given a value, on update required fields cannot be cleared.
+func (s *service) resolveCustomFieldValues(ctx context.Context, workspaceID, projectID *string, values map[string]json.RawMessage, isCreate bool) (map[string]json.RawMessage, error) {
+	if len(values) == 0 && !isCreate {
+		return nil, nil
+	}
+
+	fields, err := s.findApplicableCustomFields(ctx, workspaceID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldIDs := make([]string, 0, len(values))
+	for fieldID := range values {
+		fieldIDs = append(fieldIDs, fieldID)
+	}
+	slices.Sort(fieldIDs)
+
+	for _, fieldID := range fieldIDs {
+		if !slices.ContainsFunc(fields, func(f entities.CustomField) bool { return f.ID == fieldID }) {
+			log.Warn().
+				Str("fieldId", fieldID).
+				Msg("Custom field does not apply to task")
+
+			return nil, servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				fmt.Sprintf("Invalid customFields. Field %s does not apply to the task", fieldID),
+			)
+		}
+	}
+
+	resolved := make(map[string]json.RawMessage, len(values))
+	for i := range fields {
+		field := &fields[i]
+
+		raw, given := values[field.ID]
+		if !given {
+			if isCreate && field.Required {
+				return nil, errCustomFieldRequired(field)
+			}
+			continue
+		}
+
+		value, err := normalizeCustomFieldValue(field, raw)
+		if err != nil {
+			return nil, err
+		}
+
+		if value == nil && field.Required {
+			return nil, errCustomFieldRequired(field)
+		}
+
+		// Nothing to clear on a new task
+		if value == nil && isCreate {
+			continue
+		}
+
+		resolved[field.ID] = value
+	}
+
+	return resolved, nil
+}
+
+func (s *service) setCustomFieldValues(ctx context.Context, taskID string, values map[string]json.RawMessage) error {
+	if err := s.taskRepo.SetCustomFieldValues(ctx, taskID, values); err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to set task custom field values")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to update task custom fields",
+		)
+	}
+
+	return nil
+}
+
+// attachCustomFields fills in the custom field values of each task
+func (s *service) attachCustomFields(ctx context.Context, tasks []entities.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	taskIDs := make([]string, len(tasks))
+	for i, t := range tasks {
+		taskIDs[i] = t.ID
+	}
+
+	values, err := s.taskRepo.FindCustomFieldValuesByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to find task custom field values")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find task custom fields",
+		)
+	}
+
+	for i := range tasks {
+		tasks[i].CustomFields = values[tasks[i].ID]
+		if tasks[i].CustomFields == nil {
+			tasks[i].CustomFields = []entities.TaskCustomFieldValue{}
+		}
+	}
+
+	return nil
+}
+
+// findTaskCustomFieldValues returns the current values of a task keyed by field ID
+func (s *service) findTaskCustomFieldValues(ctx context.Context, taskID string) (map[string]json.RawMessage, error) {
+	valuesByTask, err := s.findCustomFieldValuesByTaskIDs(ctx, []string{taskID})
+	if err != nil {
+		return nil, err
+	}
+
+	return valuesByTask[taskID], nil
+}
+
+// findCustomFieldValuesByTaskIDs returns the custom field values of each task keyed by field ID
+func (s *service) findCustomFieldValuesByTaskIDs(ctx context.Context, taskIDs []string) (map[string]map[string]json.RawMessage, error) {
+	valuesByTask, err := s.taskRepo.FindCustomFieldValuesByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]map[string]json.RawMessage, len(taskIDs))
+	for _, taskID := range taskIDs {
+		values[taskID] = make(map[string]json.RawMessage, len(valuesByTask[taskID]))
+		for _, value := range valuesByTask[taskID] {
+			values[taskID][value.FieldID] = value.Value
+		}
+	}
+
+	return values, nil
+}
+
+// removeStaleCustomFieldValues deletes the values moved tasks keep for fields that do
+// not apply at their new place, and returns the values the tasks have afterwards
+func (s *service) removeStaleCustomFieldValues(ctx context.Context, taskIDs []string, message string) (map[string]map[string]json.RawMessage, error) {
+	if err := s.taskRepo.DeleteStaleCustomFieldValues(ctx, taskIDs); err != nil {
+		log.Error().
+			Err(err).
+			Strs("taskIds", taskIDs).
+			Msg("Failed to delete stale task custom field values")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			message,
+		)
+	}
+
+	values, err := s.findCustomFieldValuesByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Strs("taskIds", taskIDs).
+			Msg("Failed to find task custom field values")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			message,
+		)
+	}
+
+	return values, nil
+}
+
+// resolveCustomFieldFilters parses filters of the form "<fieldId>:<value>" into
+// repository filters, checking each field exists and is visible to the user
+func (s *service) resolveCustomFieldFilters(ctx context.Context, filters []string, userID string) ([]task.CustomFieldFilter, error) {
+	resolved := make([]task.CustomFieldFilter, 0, len(filters))
+	for _, filter := range filters {
+		fieldID, rawValue, ok := strings.Cut(filter, ":")
+		if _, err := uuid.Parse(fieldID); !ok || err != nil {
+			log.Warn().
+				Str("customField", filter).
+				Msg("Invalid custom field filter")
+
+			return nil, servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				"Invalid customField. Filter must be fieldId:value",
+			)
+		}
+
+		field, err := s.findVisibleCustomField(ctx, fieldID, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := customFieldFilterValue(field, rawValue)
+		if err != nil {
+			return nil, err
+		}
+
+		resolved = append(resolved, task.CustomFieldFilter{
+			FieldID: fieldID,
+			Value:   value,
+		})
+	}
+
+	return resolved, nil
+}
+
+// findVisibleCustomField loads a field defined in a workspace the user belongs to or in one of their projects
+func (s *service) findVisibleCustomField(ctx context.Context, fieldID string, userID string) (*entities.CustomField, error) {
+	field, err := s.customFieldRepo.FindByID(ctx, fieldID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("fieldId", fieldID).
+			Msg("Failed to find custom field by ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find custom field",
+		)
+	}
+
+	visible := field != nil
+	if visible && field.WorkspaceID != nil {
+		if _, err := s.policy.AuthorizeWorkspace(ctx, *field.WorkspaceID, userID, authz.ActionRead); err != nil {
+			visible = false
+		}
+	}
+
+	if visible && field.ProjectID != nil {
+		foundProject, err := s.projectRepo.FindByID(ctx, *field.ProjectID)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("projectId", *field.ProjectID).
+				Msg("Failed to find project by ID")
+
+			return nil, servererr.NewError(
+				servererr.ErrorCodeInternalServerError,
+				"Failed to find custom field",
+			)
+		}
+		visible = foundProject != nil && foundProject.UserID == userID
+	}
+
+	if !visible {
+		log.Warn().
+			Str("fieldId", fieldID).
+			Str("userId", userID).
+			Msg("Custom field not found")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid customField. Custom field not found",
+		)
+	}
+
+	return field, nil
+}
+
+// normalizeCustomFieldValue checks a JSON value against the type and rules of a field and
+// returns its canonical encoding. Null, empty text and no selected options clear the field,
+// which is reported as a nil value.
+func normalizeCustomFieldValue(field *entities.CustomField, raw json.RawMessage) (json.RawMessage, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	var value any
+	switch field.Type {
+	case enums.CustomFieldTypeText:
+		var text string
+		if err := json.Unmarshal(raw, &text); err != nil {
+			return nil, errCustomFieldValue(field, "must be text")
+		}
+		if text == "" {
+			return nil, nil
+		}
+
+		length := float64(utf8.RuneCountInString(text))
+		if (field.Min != nil && length < *field.Min) || (field.Max != nil && length > *field.Max) || length > entities.CustomFieldMaxTextLength {
+			return nil, errCustomFieldValue(field, "has an invalid length")
+		}
+		value = text
+
+	case enums.CustomFieldTypeNumber:
+		var number float64
+		if err := json.Unmarshal(raw, &number); err != nil {
+			return nil, errCustomFieldValue(field, "must be a number")
+		}
+		if (field.Min != nil && number < *field.Min) || (field.Max != nil && number > *field.Max) {
+			return nil, errCustomFieldValue(field, "is out of range")
+		}
+		value = number
+
+	case enums.CustomFieldTypeDate:
+		var date string
+		if err := json.Unmarshal(raw, &date); err != nil {
+			return nil, errCustomFieldValue(field, "must be a date formatted as YYYY-MM-DD")
+		}
+		if _, err := time.Parse(time.DateOnly, date); err != nil {
+			return nil, errCustomFieldValue(field, "must be a date formatted as YYYY-MM-DD")
+		}
+		value = date
+
+	case enums.CustomFieldTypeSingleSelect:
+		var option string
+		if err := json.Unmarshal(raw, &option); err != nil || !slices.Contains(field.Options, option) {
+			return nil, errCustomFieldValue(field, "must be one of its options")
+		}
+		value = option
+
+	case enums.CustomFieldTypeMultiSelect:
+		var options []string
+		if err := json.Unmarshal(raw, &options); err != nil {
+			return nil, errCustomFieldValue(field, "must be a list of its options")
+		}
+		options = uniqueStrings(options)
+		if len(options) == 0 {
+			return nil, nil
+		}
+		for _, option := range options {
+			if !slices.Contains(field.Options, option) {
+				return nil, errCustomFieldValue(field, "must be a list of its options")
+			}
+		}
+		value = options
+
+	case enums.CustomFieldTypeCheckbox:
+		var checked bool
+		if err := json.Unmarshal(raw, &checked); err != nil {
+			return nil, errCustomFieldValue(field, "must be true or false")
+		}
+		value = checked
+
+	default:
+		return nil, errCustomFieldValue(field, "has an unsupported type")
+	}
+
+	return json.Marshal(value)
+}
+
+// customFieldFilterValue converts a filter value from a query string into the JSON
+// a stored value must contain to match, a single option for multi-select fields
+func customFieldFilterValue(field *entities.CustomField, rawValue string) (json.RawMessage, error) {
+	var value any
+	switch field.Type {
+	case enums.CustomFieldTypeNumber:
+		number, err := strconv.ParseFloat(rawValue, 64)
+		if err != nil {
+			return nil, errCustomFieldFilter(field, "must be a number")
+		}
+		value = number
+
+	case enums.CustomFieldTypeCheckbox:
+		checked, err := strconv.ParseBool(rawValue)
+		if err != nil {
+			return nil, errCustomFieldFilter(field, "must be true or false")
+		}
+		value = checked
+
+	case enums.CustomFieldTypeDate:
+		if _, err := time.Parse(time.DateOnly, rawValue); err != nil {
+			return nil, errCustomFieldFilter(field, "must be a date formatted as YYYY-MM-DD")
+		}
+		value = rawValue
+
+	case enums.CustomFieldTypeMultiSelect:
+		value = []string{rawValue}
+
+	default:
+		value = rawValue
+	}
+
+	return json.Marshal(value)
+}
+
+// customFieldActivityFields lists custom field values as they appear in the activity history
+func customFieldActivityFields(values map[string]json.RawMessage) []taskField {
+	fieldIDs := make([]string, 0, len(values))
+	for fieldID := range values {
+		fieldIDs = append(fieldIDs, fieldID)
+	}
+	slices.Sort(fieldIDs)
+
+	fields := make([]taskField, 0, len(fieldIDs))
+	for _, fieldID := range fieldIDs {
+		var value any
+		if values[fieldID] != nil {
+			_ = json.Unmarshal(values[fieldID], &value)
+		}
+		fields = append(fields, taskField{customFieldActivityPrefix + fieldID, value})
+	}
+
+	return fields
+}
+
+func errCustomFieldRequired(field *entities.CustomField) error {
+	log.Warn().
+		Str("fieldId", field.ID).
+		Msg("Required custom field has no value")
+
+	return servererr.NewError(
+		servererr.ErrorCodeBadRequest,
+		fmt.Sprintf("Invalid customFields. %q is required", field.Name),
+	)
+}
+
+func errCustomFieldValue(field *entities.CustomField, reason string) error {
+	log.Warn().
+		Str("fieldId", field.ID).
+		Str("type", field.Type.String()).
+		Msg("Invalid custom field value")
+
+	return servererr.NewError(
+		servererr.ErrorCodeBadRequest,
+		fmt.Sprintf("Invalid customFields. %q %s", field.Name, reason),
+	)
+}
+
+func errCustomFieldFilter(field *entities.CustomField, reason string) error {
+	log.Warn().
+		Str("fieldId", field.ID).
+		Str("type", field.Type.String()).
+		Msg("Invalid custom field filter value")
+
+	return servererr.NewError(
+		servererr.ErrorCodeBadRequest,
+		fmt.Sprintf("Invalid customField. Filter value of %q %s", field.Name, reason),
+	)
+}
@@ -0,0 +1,229 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/task"
+	"github.com/graphzc/sdd-task-management-example/internal/services/authz"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/rs/zerolog/log"
+)
+
+func (s *service) AddTaskDependency(ctx context.Context, taskID string, in *TaskDependencyInput, userID string) error {
+	if in.BlockedByID == taskID {
+		log.Warn().
+			Str("taskId", taskID).
+			Msg("Task cannot block itself")
+
+		return servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Task cannot block itself",
+		)
+	}
+
+	// The user must be able to change the task and see its blocker
+	foundTask, err := s.findAuthorizedTask(ctx, taskID, userID, authz.ActionWrite)
+	if err != nil {
+		return err
+	}
+
+	blocker, err := s.findBlockingTask(ctx, in.BlockedByID, userID)
+	if err != nil {
+		return err
+	}
+
+	if !sameWorkspace(foundTask.WorkspaceID, blocker.WorkspaceID) {
+		log.Warn().
+			Str("taskId", taskID).
+			Str("blockedById", in.BlockedByID).
+			Msg("Blocking task is in another workspace")
+
+		return servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid dependency. Blocking task must be in the same workspace",
+		)
+	}
+
+	// Checking for a cycle and adding the edge run under the scope's lock, so two
+	// opposite edges added at the same time cannot both pass the check
+	return s.withinTransaction(ctx, "Failed to add task dependency", func(ctx context.Context) error {
+		if err := s.taskRepo.LockDependencies(ctx, foundTask.WorkspaceID); err != nil {
+			log.Error().
+				Err(err).
+				Str("taskId", taskID).
+				Msg("Failed to lock task dependencies")
+
+			return servererr.NewError(
+				servererr.ErrorCodeInternalServerError,
+				"Failed to add task dependency",
+			)
+		}
+
+		// The new edge would close a cycle if the blocker already depends on this task
+		blockerIDs, err := s.taskRepo.FindTransitiveBlockerIDs(ctx, in.BlockedByID)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("taskId", in.BlockedByID).
+				Msg("Failed to find transitive blockers")
+
+			return servererr.NewError(
+				servererr.ErrorCodeInternalServerError,
+				"Failed to add task dependency",
+			)
+		}
+
+		if slices.Contains(blockerIDs, taskID) {
+			log.Warn().
+				Str("taskId", taskID).
+				Str("blockedById", in.BlockedByID).
+				Msg("Task dependency would create a cycle")
+
+			return servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				"Invalid dependency. It would create a dependency cycle",
+			)
+		}
+
+		if err := s.taskRepo.CreateDependency(ctx, taskID, in.BlockedByID); err != nil {
+			if errors.Is(err, task.ErrNoRowsAffected) {
+				return servererr.NewError(
+					servererr.ErrorCodeConflict,
+					"Task dependency already exists",
+				)
+			}
+
+			log.Error().
+				Err(err).
+				Str("taskId", taskID).
+				Str("blockedById", in.BlockedByID).
+				Msg("Failed to create task dependency")
+
+			return servererr.NewError(
+				servererr.ErrorCodeInternalServerError,
+				"Failed to add task dependency",
+			)
+		}
+
+		return nil
+	})
+}
+
+func (s *service) RemoveTaskDependency(ctx context.Context, taskID string, in *TaskDependencyInput, userID string) error {
+	// Find the task first to ensure it exists and the user may change it
+	_, err := s.findAuthorizedTask(ctx, taskID, userID, authz.ActionWrite)
+	if err != nil {
+		return err
+	}
+
+	if err := s.taskRepo.DeleteDependency(ctx, taskID, in.BlockedByID); err != nil {
+		if errors.Is(err, task.ErrNoRowsAffected) {
+			return servererr.NewError(
+				servererr.ErrorCodeNotFound,
+				"Task dependency not found",
+			)
+		}
+
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Str("blockedById", in.BlockedByID).
+			Msg("Failed to delete task dependency")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to remove task dependency",
+		)
+	}
+
+	return nil
+}
+
+func (s *service) FindTaskBlockersByID(ctx context.Context, taskID string, userID string) ([]entities.Task, error) {
+	// Find the task first to ensure it exists and belongs to user
+	_, err := s.FindTaskByID(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	blockers, err := s.taskRepo.FindBlockersByID(ctx, taskID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to find task blockers")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find task dependencies",
+		)
+	}
+
+	return blockers, nil
+}
+
+// ensureNotBlocked rejects moving a task into a status its open blockers forbid
+func (s *service) ensureNotBlocked(ctx context.Context, taskID string, status *entities.WorkflowStatus) error {
+	guarded := status.Category == enums.TaskStatusCategoryDone ||
+		(status.Category == enums.TaskStatusCategoryActive && s.config.Task.BlockInProgress)
+	if !guarded {
+		return nil
+	}
+
+	blockers, err := s.taskRepo.FindBlockersByID(ctx, taskID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to find task blockers")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to update task status",
+		)
+	}
+
+	var openBlockers []string
+	for _, blocker := range blockers {
+		if blocker.StatusCategory != enums.TaskStatusCategoryDone {
+			openBlockers = append(openBlockers, fmt.Sprintf("%q (%s)", blocker.Title, blocker.ID))
+		}
+	}
+
+	if len(openBlockers) > 0 {
+		log.Warn().
+			Str("taskId", taskID).
+			Strs("blockers", openBlockers).
+			Msg("Task is blocked by open tasks")
+
+		return servererr.NewError(
+			servererr.ErrorCodeTaskBlocked,
+			fmt.Sprintf("Task cannot be moved to %s while blocked by: %s", status.Key, strings.Join(openBlockers, ", ")),
+		)
+	}
+
+	return nil
+}
+
+// findBlockingTask looks up a prospective blocker, reporting a missing one as a bad request
+func (s *service) findBlockingTask(ctx context.Context, blockedByID string, userID string) (*entities.Task, error) {
+	blocker, err := s.findAuthorizedTask(ctx, blockedByID, userID, authz.ActionRead)
+	if err != nil {
+		if serverErr, ok := err.(*servererr.ServerError); ok && serverErr.Code == servererr.ErrorCodeNotFound {
+			return nil, servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				"Blocking task not found",
+			)
+		}
+
+		return nil, err
+	}
+
+	return blocker, nil
+}
@@ -0,0 +1,48 @@
+package task
+
+import (
+	"context"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/task"
+	"github.com/rs/zerolog/log"
+)
+
+// ExportTasks checks the filters of an export up front, the tasks themselves are
+// only read once the caller is ready to write them out through ForEach
+func (s *service) ExportTasks(ctx context.Context, in *TaskListInput, userID string) (*TaskExportOutput, error) {
+	_, sortOrder, sortField, err := resolveListSort(in)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := s.resolveListFilter(ctx, in, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := task.ListOptions{
+		Filter:     *filter,
+		SortField:  sortField,
+		Descending: sortOrder == SortOrderDesc,
+	}
+
+	return &TaskExportOutput{
+		ForEach: func(ctx context.Context, fn func(task *entities.Task) error) error {
+			var writeErr error
+			err := s.taskRepo.StreamAll(ctx, &opts, func(task *entities.Task) error {
+				writeErr = fn(task)
+				return writeErr
+			})
+
+			if err != nil && writeErr == nil {
+				log.Error().
+					Err(err).
+					Str("userId", userID).
+					Msg("Failed to export tasks")
+			}
+
+			return err
+		},
+	}, nil
+}
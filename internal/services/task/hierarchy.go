@@ -0,0 +1,287 @@
+package task
+
+import (
+	"context"
+	"slices"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/services/authz"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/rs/zerolog/log"
+)
+
+func (s *service) FindSubtasksByID(ctx context.Context, taskID string, userID string) ([]entities.Task, error) {
+	// Find the task first to ensure it exists and belongs to user
+	_, err := s.FindTaskByID(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	subtasks, err := s.taskRepo.FindByParentID(ctx, taskID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to find subtasks")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find subtasks",
+		)
+	}
+
+	if err := s.enrichTasks(ctx, subtasks); err != nil {
+		return nil, err
+	}
+
+	return subtasks, nil
+}
+
+func (s *service) FindTaskTreeByID(ctx context.Context, taskID string, userID string) (*entities.TaskTree, error) {
+	// Find the task first to ensure it exists and belongs to user
+	_, err := s.FindTaskByID(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	subtree, err := s.taskRepo.FindSubtreeByID(ctx, taskID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to find task subtree")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find task tree",
+		)
+	}
+
+	if len(subtree) == 0 {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeNotFound,
+			"Task not found",
+		)
+	}
+
+	if err := s.enrichTasks(ctx, subtree); err != nil {
+		return nil, err
+	}
+
+	// Group tasks by parent, the subtree is ordered root first
+	childrenOf := make(map[string][]entities.Task)
+	for _, t := range subtree[1:] {
+		childrenOf[*t.ParentID] = append(childrenOf[*t.ParentID], t)
+	}
+
+	tree := buildTaskTree(subtree[0], childrenOf)
+
+	return &tree, nil
+}
+
+func (s *service) UpdateTaskParentByID(ctx context.Context, taskID string, in *TaskUpdateParentInput, userID string) error {
+	// Find the task first to ensure it exists and the user may change it
+	foundTask, err := s.findAuthorizedTask(ctx, taskID, userID, authz.ActionWrite)
+	if err != nil {
+		return err
+	}
+
+	if in.ParentID != nil {
+		if *in.ParentID == taskID {
+			log.Warn().
+				Str("taskId", taskID).
+				Msg("Task cannot be its own parent")
+
+			return servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				"Task cannot be its own parent",
+			)
+		}
+
+		parent, err := s.findParentTask(ctx, *in.ParentID, userID)
+		if err != nil {
+			return err
+		}
+
+		if !sameWorkspace(foundTask.WorkspaceID, parent.WorkspaceID) {
+			log.Warn().
+				Str("taskId", taskID).
+				Str("parentId", *in.ParentID).
+				Msg("Parent task is in another workspace")
+
+			return servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				"Invalid parent. Parent task must be in the same workspace",
+			)
+		}
+
+		// Moving a task under one of its own descendants would create a cycle
+		ancestorIDs, err := s.taskRepo.FindAncestorIDs(ctx, *in.ParentID)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("parentId", *in.ParentID).
+				Msg("Failed to find task ancestors")
+
+			return servererr.NewError(
+				servererr.ErrorCodeInternalServerError,
+				"Failed to update task parent",
+			)
+		}
+
+		if slices.Contains(ancestorIDs, taskID) {
+			return errParentCycle(taskID, *in.ParentID)
+		}
+	}
+
+	err = s.withinTransaction(ctx, "Failed to update task parent", func(ctx context.Context) error {
+		// Ensure nobody changed the task since the client read it
+		if err := s.ensureVersion(ctx, taskID, in.Version); err != nil {
+			return err
+		}
+
+		if in.ParentID != nil {
+			if err := s.ensureNoParentCycle(ctx, taskID, *in.ParentID); err != nil {
+				return err
+			}
+		}
+
+		if err := s.taskRepo.UpdateParentByID(ctx, taskID, in.ParentID); err != nil {
+			log.Error().
+				Err(err).
+				Str("taskId", taskID).
+				Msg("Failed to update task parent")
+
+			return servererr.NewError(
+				servererr.ErrorCodeInternalServerError,
+				"Failed to update task parent",
+			)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	movedTask := *foundTask
+	movedTask.ParentID = in.ParentID
+	if changes := diffTaskFields(taskFields(foundTask, nil), taskFields(&movedTask, nil)); len(changes) > 0 {
+		s.recordActivity(ctx, taskID, userID, enums.TaskActivityActionUpdated, changes)
+	}
+
+	return nil
+}
+
+// ensureNoParentCycle checks again, with the task and the new parent's ancestors
+// locked, that the task is not among them. A concurrent move the first check
+// could not see, such as the parent being moved under the task, then either
+// shows up here or has to wait for this transaction.
+func (s *service) ensureNoParentCycle(ctx context.Context, taskID string, parentID string) error {
+	if _, err := s.taskRepo.LockVersionByID(ctx, taskID); err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to lock task")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to update task parent",
+		)
+	}
+
+	ancestorIDs, err := s.taskRepo.LockAncestorIDs(ctx, parentID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("parentId", parentID).
+			Msg("Failed to lock task ancestors")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to update task parent",
+		)
+	}
+
+	if slices.Contains(ancestorIDs, taskID) {
+		return errParentCycle(taskID, parentID)
+	}
+
+	return nil
+}
+
+func errParentCycle(taskID string, parentID string) error {
+	log.Warn().
+		Str("taskId", taskID).
+		Str("parentId", parentID).
+		Msg("Task parent would create a cycle")
+
+	return servererr.NewError(
+		servererr.ErrorCodeBadRequest,
+		"Invalid parent. A task cannot be moved under one of its subtasks",
+	)
+}
+
+// findParentTask looks up a prospective parent task the user may add subtasks to,
+// reporting a missing one as a bad request
+func (s *service) findParentTask(ctx context.Context, parentID string, userID string) (*entities.Task, error) {
+	parent, err := s.findAuthorizedTask(ctx, parentID, userID, authz.ActionWrite)
+	if err != nil {
+		if serverErr, ok := err.(*servererr.ServerError); ok && serverErr.Code == servererr.ErrorCodeNotFound {
+			return nil, servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				"Parent task not found",
+			)
+		}
+
+		return nil, err
+	}
+
+	return parent, nil
+}
+
+// attachSubtaskRollups fills in the completed/total subtask counts of each task
+func (s *service) attachSubtaskRollups(ctx context.Context, tasks []entities.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	taskIDs := make([]string, len(tasks))
+	for i, t := range tasks {
+		taskIDs[i] = t.ID
+	}
+
+	rollups, err := s.taskRepo.CountSubtasksByIDs(ctx, taskIDs)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to count subtasks")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to count subtasks",
+		)
+	}
+
+	for i := range tasks {
+		rollup := rollups[tasks[i].ID]
+		tasks[i].Subtasks = &rollup
+	}
+
+	return nil
+}
+
+func buildTaskTree(root entities.Task, childrenOf map[string][]entities.Task) entities.TaskTree {
+	children := childrenOf[root.ID]
+	tree := entities.TaskTree{
+		Task:     root,
+		Children: make([]entities.TaskTree, len(children)),
+	}
+
+	for i, child := range children {
+		tree.Children[i] = buildTaskTree(child, childrenOf)
+	}
+
+	return tree
+}
@@ -0,0 +1,94 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/rs/zerolog/log"
+)
+
+const MaxImportRows = 1000
+
+// MaxImportSizeBytes caps the uploaded import file, far more than MaxImportRows rows need
+const MaxImportSizeBytes = 5 << 20
+
+// errImportRollback aborts the transaction of a dry run once every row was tried
+var errImportRollback = errors.New("import dry run")
+
+func (s *service) ImportTasks(ctx context.Context, in *TaskImportInput, userID string) (*TaskImportOutput, error) {
+	if len(in.Rows) > MaxImportRows {
+		log.Warn().
+			Int("rows", len(in.Rows)).
+			Msg("Too many rows to import")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			fmt.Sprintf("Invalid file. An import can have at most %d rows", MaxImportRows),
+		)
+	}
+
+	output := &TaskImportOutput{
+		Results: make([]TaskImportResult, len(in.Rows)),
+	}
+
+	// Every row is created in its own savepoint so a failed one leaves the others
+	// intact, the valid rows are then committed together unless this is a dry run
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		for i := range in.Rows {
+			output.Results[i] = s.importRow(ctx, &in.Rows[i], userID)
+		}
+
+		if in.DryRun {
+			return errImportRollback
+		}
+
+		return nil
+	})
+
+	if err != nil && !errors.Is(err, errImportRollback) {
+		log.Error().
+			Err(err).
+			Str("userId", userID).
+			Msg("Failed to import tasks")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to import tasks",
+		)
+	}
+
+	output.Committed = err == nil
+
+	return output, nil
+}
+
+func (s *service) importRow(ctx context.Context, in *TaskCreateInput, userID string) TaskImportResult {
+	var result TaskImportResult
+
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		taskID, err := s.createTask(ctx, in, userID)
+		result.TaskID = taskID
+		return err
+	})
+
+	if err != nil {
+		var serverErr *servererr.ServerError
+		if !errors.As(err, &serverErr) {
+			log.Error().
+				Err(err).
+				Msg("Failed to import task")
+
+			serverErr = servererr.NewError(
+				servererr.ErrorCodeInternalServerError,
+				"Failed to create task",
+			)
+		}
+
+		result.TaskID = ""
+		result.Err = serverErr
+	}
+
+	return result
+}
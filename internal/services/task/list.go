@@ -0,0 +1,134 @@
+package task
+
+import (
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/task"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/cursorutil"
+)
+
+const (
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+
+	SortByPriority  = "priority"
+	SortByTitle     = "title"
+	SortByCreatedAt = "createdAt"
+	SortByUpdatedAt = "updatedAt"
+	SortByBoardRank = "boardRank"
+
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
+var sortFields = map[string]task.SortField{
+	SortByPriority:  task.SortFieldPriority,
+	SortByTitle:     task.SortFieldTitle,
+	SortByCreatedAt: task.SortFieldCreatedAt,
+	SortByUpdatedAt: task.SortFieldUpdatedAt,
+	SortByBoardRank: task.SortFieldBoardRank,
+}
+
+// sortValue returns the string form of the value a task is sorted by,
+// as stored inside a cursor
+func sortValue(t *entities.Task, sortBy string) string {
+	switch sortBy {
+	case SortByPriority:
+		return strconv.Itoa(t.Priority.Int())
+	case SortByTitle:
+		return t.Title
+	case SortByBoardRank:
+		return t.BoardRank
+	case SortByUpdatedAt:
+		return t.UpdatedAt.Format(time.RFC3339Nano)
+	default:
+		return t.CreatedAt.Format(time.RFC3339Nano)
+	}
+}
+
+// parseSortValue converts a cursor value back into the type of its column
+func parseSortValue(value string, sortBy string) (any, error) {
+	switch sortBy {
+	case SortByPriority:
+		return strconv.Atoi(value)
+	case SortByTitle, SortByBoardRank:
+		return value, nil
+	default:
+		return time.Parse(time.RFC3339Nano, value)
+	}
+}
+
+func encodeCursor(t *entities.Task, sortBy, sortOrder string, backward bool) (string, error) {
+	return cursorutil.Encode(cursorutil.Cursor{
+		SortBy:    sortBy,
+		SortOrder: sortOrder,
+		Value:     sortValue(t, sortBy),
+		ID:        t.ID,
+		Backward:  backward,
+	})
+}
+
+// decodeCursor parses an opaque cursor and checks it was issued for the same sort
+func decodeCursor(encoded, sortBy, sortOrder string) (*cursorutil.Cursor, *task.Keyset, error) {
+	cursor, err := cursorutil.Decode(encoded)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if cursor.SortBy != sortBy || cursor.SortOrder != sortOrder {
+		return nil, nil, cursorutil.ErrInvalidCursor
+	}
+
+	value, err := parseSortValue(cursor.Value, sortBy)
+	if err != nil {
+		return nil, nil, cursorutil.ErrInvalidCursor
+	}
+
+	return cursor, &task.Keyset{Value: value, ID: cursor.ID}, nil
+}
+
+// buildPage trims the extra look-ahead row and produces the cursors around the page
+func buildPage(tasks []entities.Task, limit int, cursor *cursorutil.Cursor, sortBy, sortOrder string) (*TaskListOutput, error) {
+	backward := cursor != nil && cursor.Backward
+	hasMore := len(tasks) > limit
+	if hasMore {
+		tasks = tasks[:limit]
+	}
+
+	// Backward pages are queried in reverse order
+	if backward {
+		slices.Reverse(tasks)
+	}
+
+	output := &TaskListOutput{
+		Tasks: tasks,
+	}
+
+	if len(tasks) == 0 {
+		return output, nil
+	}
+
+	hasNext := (!backward && hasMore) || backward
+	hasPrev := (backward && hasMore) || (!backward && cursor != nil)
+
+	if hasNext {
+		next, err := encodeCursor(&tasks[len(tasks)-1], sortBy, sortOrder, false)
+		if err != nil {
+			return nil, err
+		}
+		output.NextCursor = next
+	}
+
+	if hasPrev {
+		prev, err := encodeCursor(&tasks[0], sortBy, sortOrder, true)
+		if err != nil {
+			return nil, err
+		}
+		output.PrevCursor = prev
+	}
+
+	return output, nil
+}
@@ -6,6 +6,7 @@ package mock_task
 
 import (
 	"context"
+	"time"
 
 	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
 	"github.com/graphzc/sdd-task-management-example/internal/services/task"
@@ -39,6 +40,218 @@ func (_m *MockService) EXPECT() *MockService_Expecter {
 	return &MockService_Expecter{mock: &_m.Mock}
 }
 
+// AddTaskDependency provides a mock function for the type MockService
+func (_mock *MockService) AddTaskDependency(ctx context.Context, taskID string, in *task.TaskDependencyInput, userID string) error {
+	ret := _mock.Called(ctx, taskID, in, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTaskDependency")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *task.TaskDependencyInput, string) error); ok {
+		r0 = returnFunc(ctx, taskID, in, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockService_AddTaskDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTaskDependency'
+type MockService_AddTaskDependency_Call struct {
+	*mock.Call
+}
+
+// AddTaskDependency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - in *task.TaskDependencyInput
+//   - userID string
+func (_e *MockService_Expecter) AddTaskDependency(ctx interface{}, taskID interface{}, in interface{}, userID interface{}) *MockService_AddTaskDependency_Call {
+	return &MockService_AddTaskDependency_Call{Call: _e.mock.On("AddTaskDependency", ctx, taskID, in, userID)}
+}
+
+func (_c *MockService_AddTaskDependency_Call) Run(run func(ctx context.Context, taskID string, in *task.TaskDependencyInput, userID string)) *MockService_AddTaskDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *task.TaskDependencyInput
+		if args[2] != nil {
+			arg2 = args[2].(*task.TaskDependencyInput)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_AddTaskDependency_Call) Return(err error) *MockService_AddTaskDependency_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockService_AddTaskDependency_Call) RunAndReturn(run func(ctx context.Context, taskID string, in *task.TaskDependencyInput, userID string) error) *MockService_AddTaskDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssignTask provides a mock function for the type MockService
+func (_mock *MockService) AssignTask(ctx context.Context, taskID string, in *task.TaskAssigneeInput, userID string) error {
+	ret := _mock.Called(ctx, taskID, in, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignTask")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *task.TaskAssigneeInput, string) error); ok {
+		r0 = returnFunc(ctx, taskID, in, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockService_AssignTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignTask'
+type MockService_AssignTask_Call struct {
+	*mock.Call
+}
+
+// AssignTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - in *task.TaskAssigneeInput
+//   - userID string
+func (_e *MockService_Expecter) AssignTask(ctx interface{}, taskID interface{}, in interface{}, userID interface{}) *MockService_AssignTask_Call {
+	return &MockService_AssignTask_Call{Call: _e.mock.On("AssignTask", ctx, taskID, in, userID)}
+}
+
+func (_c *MockService_AssignTask_Call) Run(run func(ctx context.Context, taskID string, in *task.TaskAssigneeInput, userID string)) *MockService_AssignTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *task.TaskAssigneeInput
+		if args[2] != nil {
+			arg2 = args[2].(*task.TaskAssigneeInput)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_AssignTask_Call) Return(err error) *MockService_AssignTask_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockService_AssignTask_Call) RunAndReturn(run func(ctx context.Context, taskID string, in *task.TaskAssigneeInput, userID string) error) *MockService_AssignTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkTasks provides a mock function for the type MockService
+func (_mock *MockService) BulkTasks(ctx context.Context, in *task.TaskBulkInput, userID string) (*task.TaskBulkOutput, error) {
+	ret := _mock.Called(ctx, in, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkTasks")
+	}
+
+	var r0 *task.TaskBulkOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.TaskBulkInput, string) (*task.TaskBulkOutput, error)); ok {
+		return returnFunc(ctx, in, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.TaskBulkInput, string) *task.TaskBulkOutput); ok {
+		r0 = returnFunc(ctx, in, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*task.TaskBulkOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *task.TaskBulkInput, string) error); ok {
+		r1 = returnFunc(ctx, in, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_BulkTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkTasks'
+type MockService_BulkTasks_Call struct {
+	*mock.Call
+}
+
+// BulkTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *task.TaskBulkInput
+//   - userID string
+func (_e *MockService_Expecter) BulkTasks(ctx interface{}, in interface{}, userID interface{}) *MockService_BulkTasks_Call {
+	return &MockService_BulkTasks_Call{Call: _e.mock.On("BulkTasks", ctx, in, userID)}
+}
+
+func (_c *MockService_BulkTasks_Call) Run(run func(ctx context.Context, in *task.TaskBulkInput, userID string)) *MockService_BulkTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *task.TaskBulkInput
+		if args[1] != nil {
+			arg1 = args[1].(*task.TaskBulkInput)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_BulkTasks_Call) Return(taskBulkOutput *task.TaskBulkOutput, err error) *MockService_BulkTasks_Call {
+	_c.Call.Return(taskBulkOutput, err)
+	return _c
+}
+
+func (_c *MockService_BulkTasks_Call) RunAndReturn(run func(ctx context.Context, in *task.TaskBulkInput, userID string) (*task.TaskBulkOutput, error)) *MockService_BulkTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateTask provides a mock function for the type MockService
 func (_mock *MockService) CreateTask(ctx context.Context, in *task.TaskCreateInput, userID string) error {
 	ret := _mock.Called(ctx, in, userID)
@@ -56,28 +269,1630 @@ func (_mock *MockService) CreateTask(ctx context.Context, in *task.TaskCreateInp
 	return r0
 }
 
-// MockService_CreateTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTask'
-type MockService_CreateTask_Call struct {
+// MockService_CreateTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTask'
+type MockService_CreateTask_Call struct {
+	*mock.Call
+}
+
+// CreateTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *task.TaskCreateInput
+//   - userID string
+func (_e *MockService_Expecter) CreateTask(ctx interface{}, in interface{}, userID interface{}) *MockService_CreateTask_Call {
+	return &MockService_CreateTask_Call{Call: _e.mock.On("CreateTask", ctx, in, userID)}
+}
+
+func (_c *MockService_CreateTask_Call) Run(run func(ctx context.Context, in *task.TaskCreateInput, userID string)) *MockService_CreateTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *task.TaskCreateInput
+		if args[1] != nil {
+			arg1 = args[1].(*task.TaskCreateInput)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_CreateTask_Call) Return(err error) *MockService_CreateTask_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockService_CreateTask_Call) RunAndReturn(run func(ctx context.Context, in *task.TaskCreateInput, userID string) error) *MockService_CreateTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTaskByID provides a mock function for the type MockService
+func (_mock *MockService) DeleteTaskByID(ctx context.Context, taskID string, in *task.TaskDeleteInput, userID string) error {
+	ret := _mock.Called(ctx, taskID, in, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTaskByID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *task.TaskDeleteInput, string) error); ok {
+		r0 = returnFunc(ctx, taskID, in, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockService_DeleteTaskByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTaskByID'
+type MockService_DeleteTaskByID_Call struct {
+	*mock.Call
+}
+
+// DeleteTaskByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - in *task.TaskDeleteInput
+//   - userID string
+func (_e *MockService_Expecter) DeleteTaskByID(ctx interface{}, taskID interface{}, in interface{}, userID interface{}) *MockService_DeleteTaskByID_Call {
+	return &MockService_DeleteTaskByID_Call{Call: _e.mock.On("DeleteTaskByID", ctx, taskID, in, userID)}
+}
+
+func (_c *MockService_DeleteTaskByID_Call) Run(run func(ctx context.Context, taskID string, in *task.TaskDeleteInput, userID string)) *MockService_DeleteTaskByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *task.TaskDeleteInput
+		if args[2] != nil {
+			arg2 = args[2].(*task.TaskDeleteInput)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_DeleteTaskByID_Call) Return(err error) *MockService_DeleteTaskByID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockService_DeleteTaskByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, in *task.TaskDeleteInput, userID string) error) *MockService_DeleteTaskByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportTasks provides a mock function for the type MockService
+func (_mock *MockService) ExportTasks(ctx context.Context, in *task.TaskListInput, userID string) (*task.TaskExportOutput, error) {
+	ret := _mock.Called(ctx, in, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportTasks")
+	}
+
+	var r0 *task.TaskExportOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.TaskListInput, string) (*task.TaskExportOutput, error)); ok {
+		return returnFunc(ctx, in, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.TaskListInput, string) *task.TaskExportOutput); ok {
+		r0 = returnFunc(ctx, in, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*task.TaskExportOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *task.TaskListInput, string) error); ok {
+		r1 = returnFunc(ctx, in, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_ExportTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportTasks'
+type MockService_ExportTasks_Call struct {
+	*mock.Call
+}
+
+// ExportTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *task.TaskListInput
+//   - userID string
+func (_e *MockService_Expecter) ExportTasks(ctx interface{}, in interface{}, userID interface{}) *MockService_ExportTasks_Call {
+	return &MockService_ExportTasks_Call{Call: _e.mock.On("ExportTasks", ctx, in, userID)}
+}
+
+func (_c *MockService_ExportTasks_Call) Run(run func(ctx context.Context, in *task.TaskListInput, userID string)) *MockService_ExportTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *task.TaskListInput
+		if args[1] != nil {
+			arg1 = args[1].(*task.TaskListInput)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_ExportTasks_Call) Return(taskExportOutput *task.TaskExportOutput, err error) *MockService_ExportTasks_Call {
+	_c.Call.Return(taskExportOutput, err)
+	return _c
+}
+
+func (_c *MockService_ExportTasks_Call) RunAndReturn(run func(ctx context.Context, in *task.TaskListInput, userID string) (*task.TaskExportOutput, error)) *MockService_ExportTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAssignedTasks provides a mock function for the type MockService
+func (_mock *MockService) FindAssignedTasks(ctx context.Context, in *task.TaskListInput, userID string) (*task.TaskListOutput, error) {
+	ret := _mock.Called(ctx, in, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAssignedTasks")
+	}
+
+	var r0 *task.TaskListOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.TaskListInput, string) (*task.TaskListOutput, error)); ok {
+		return returnFunc(ctx, in, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.TaskListInput, string) *task.TaskListOutput); ok {
+		r0 = returnFunc(ctx, in, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*task.TaskListOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *task.TaskListInput, string) error); ok {
+		r1 = returnFunc(ctx, in, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_FindAssignedTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAssignedTasks'
+type MockService_FindAssignedTasks_Call struct {
+	*mock.Call
+}
+
+// FindAssignedTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *task.TaskListInput
+//   - userID string
+func (_e *MockService_Expecter) FindAssignedTasks(ctx interface{}, in interface{}, userID interface{}) *MockService_FindAssignedTasks_Call {
+	return &MockService_FindAssignedTasks_Call{Call: _e.mock.On("FindAssignedTasks", ctx, in, userID)}
+}
+
+func (_c *MockService_FindAssignedTasks_Call) Run(run func(ctx context.Context, in *task.TaskListInput, userID string)) *MockService_FindAssignedTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *task.TaskListInput
+		if args[1] != nil {
+			arg1 = args[1].(*task.TaskListInput)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_FindAssignedTasks_Call) Return(taskListOutput *task.TaskListOutput, err error) *MockService_FindAssignedTasks_Call {
+	_c.Call.Return(taskListOutput, err)
+	return _c
+}
+
+func (_c *MockService_FindAssignedTasks_Call) RunAndReturn(run func(ctx context.Context, in *task.TaskListInput, userID string) (*task.TaskListOutput, error)) *MockService_FindAssignedTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBoard provides a mock function for the type MockService
+func (_mock *MockService) FindBoard(ctx context.Context, in *task.TaskBoardInput, userID string) (*task.TaskBoardOutput, error) {
+	ret := _mock.Called(ctx, in, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBoard")
+	}
+
+	var r0 *task.TaskBoardOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.TaskBoardInput, string) (*task.TaskBoardOutput, error)); ok {
+		return returnFunc(ctx, in, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.TaskBoardInput, string) *task.TaskBoardOutput); ok {
+		r0 = returnFunc(ctx, in, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*task.TaskBoardOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *task.TaskBoardInput, string) error); ok {
+		r1 = returnFunc(ctx, in, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_FindBoard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBoard'
+type MockService_FindBoard_Call struct {
+	*mock.Call
+}
+
+// FindBoard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *task.TaskBoardInput
+//   - userID string
+func (_e *MockService_Expecter) FindBoard(ctx interface{}, in interface{}, userID interface{}) *MockService_FindBoard_Call {
+	return &MockService_FindBoard_Call{Call: _e.mock.On("FindBoard", ctx, in, userID)}
+}
+
+func (_c *MockService_FindBoard_Call) Run(run func(ctx context.Context, in *task.TaskBoardInput, userID string)) *MockService_FindBoard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *task.TaskBoardInput
+		if args[1] != nil {
+			arg1 = args[1].(*task.TaskBoardInput)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_FindBoard_Call) Return(taskBoardOutput *task.TaskBoardOutput, err error) *MockService_FindBoard_Call {
+	_c.Call.Return(taskBoardOutput, err)
+	return _c
+}
+
+func (_c *MockService_FindBoard_Call) RunAndReturn(run func(ctx context.Context, in *task.TaskBoardInput, userID string) (*task.TaskBoardOutput, error)) *MockService_FindBoard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSubtasksByID provides a mock function for the type MockService
+func (_mock *MockService) FindSubtasksByID(ctx context.Context, taskID string, userID string) ([]entities.Task, error) {
+	ret := _mock.Called(ctx, taskID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSubtasksByID")
+	}
+
+	var r0 []entities.Task
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]entities.Task, error)); ok {
+		return returnFunc(ctx, taskID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []entities.Task); ok {
+		r0 = returnFunc(ctx, taskID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Task)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, taskID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_FindSubtasksByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSubtasksByID'
+type MockService_FindSubtasksByID_Call struct {
+	*mock.Call
+}
+
+// FindSubtasksByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - userID string
+func (_e *MockService_Expecter) FindSubtasksByID(ctx interface{}, taskID interface{}, userID interface{}) *MockService_FindSubtasksByID_Call {
+	return &MockService_FindSubtasksByID_Call{Call: _e.mock.On("FindSubtasksByID", ctx, taskID, userID)}
+}
+
+func (_c *MockService_FindSubtasksByID_Call) Run(run func(ctx context.Context, taskID string, userID string)) *MockService_FindSubtasksByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_FindSubtasksByID_Call) Return(tasks []entities.Task, err error) *MockService_FindSubtasksByID_Call {
+	_c.Call.Return(tasks, err)
+	return _c
+}
+
+func (_c *MockService_FindSubtasksByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, userID string) ([]entities.Task, error)) *MockService_FindSubtasksByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTaskActivityByID provides a mock function for the type MockService
+func (_mock *MockService) FindTaskActivityByID(ctx context.Context, taskID string, in *task.TaskActivityListInput, userID string) (*task.TaskActivityListOutput, error) {
+	ret := _mock.Called(ctx, taskID, in, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTaskActivityByID")
+	}
+
+	var r0 *task.TaskActivityListOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *task.TaskActivityListInput, string) (*task.TaskActivityListOutput, error)); ok {
+		return returnFunc(ctx, taskID, in, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *task.TaskActivityListInput, string) *task.TaskActivityListOutput); ok {
+		r0 = returnFunc(ctx, taskID, in, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*task.TaskActivityListOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *task.TaskActivityListInput, string) error); ok {
+		r1 = returnFunc(ctx, taskID, in, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_FindTaskActivityByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTaskActivityByID'
+type MockService_FindTaskActivityByID_Call struct {
+	*mock.Call
+}
+
+// FindTaskActivityByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - in *task.TaskActivityListInput
+//   - userID string
+func (_e *MockService_Expecter) FindTaskActivityByID(ctx interface{}, taskID interface{}, in interface{}, userID interface{}) *MockService_FindTaskActivityByID_Call {
+	return &MockService_FindTaskActivityByID_Call{Call: _e.mock.On("FindTaskActivityByID", ctx, taskID, in, userID)}
+}
+
+func (_c *MockService_FindTaskActivityByID_Call) Run(run func(ctx context.Context, taskID string, in *task.TaskActivityListInput, userID string)) *MockService_FindTaskActivityByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *task.TaskActivityListInput
+		if args[2] != nil {
+			arg2 = args[2].(*task.TaskActivityListInput)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_FindTaskActivityByID_Call) Return(taskActivityListOutput *task.TaskActivityListOutput, err error) *MockService_FindTaskActivityByID_Call {
+	_c.Call.Return(taskActivityListOutput, err)
+	return _c
+}
+
+func (_c *MockService_FindTaskActivityByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, in *task.TaskActivityListInput, userID string) (*task.TaskActivityListOutput, error)) *MockService_FindTaskActivityByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTaskBlockersByID provides a mock function for the type MockService
+func (_mock *MockService) FindTaskBlockersByID(ctx context.Context, taskID string, userID string) ([]entities.Task, error) {
+	ret := _mock.Called(ctx, taskID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTaskBlockersByID")
+	}
+
+	var r0 []entities.Task
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]entities.Task, error)); ok {
+		return returnFunc(ctx, taskID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []entities.Task); ok {
+		r0 = returnFunc(ctx, taskID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Task)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, taskID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_FindTaskBlockersByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTaskBlockersByID'
+type MockService_FindTaskBlockersByID_Call struct {
+	*mock.Call
+}
+
+// FindTaskBlockersByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - userID string
+func (_e *MockService_Expecter) FindTaskBlockersByID(ctx interface{}, taskID interface{}, userID interface{}) *MockService_FindTaskBlockersByID_Call {
+	return &MockService_FindTaskBlockersByID_Call{Call: _e.mock.On("FindTaskBlockersByID", ctx, taskID, userID)}
+}
+
+func (_c *MockService_FindTaskBlockersByID_Call) Run(run func(ctx context.Context, taskID string, userID string)) *MockService_FindTaskBlockersByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_FindTaskBlockersByID_Call) Return(tasks []entities.Task, err error) *MockService_FindTaskBlockersByID_Call {
+	_c.Call.Return(tasks, err)
+	return _c
+}
+
+func (_c *MockService_FindTaskBlockersByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, userID string) ([]entities.Task, error)) *MockService_FindTaskBlockersByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTaskByID provides a mock function for the type MockService
+func (_mock *MockService) FindTaskByID(ctx context.Context, taskID string, userID string) (*entities.Task, error) {
+	ret := _mock.Called(ctx, taskID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTaskByID")
+	}
+
+	var r0 *entities.Task
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*entities.Task, error)); ok {
+		return returnFunc(ctx, taskID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *entities.Task); ok {
+		r0 = returnFunc(ctx, taskID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Task)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, taskID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_FindTaskByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTaskByID'
+type MockService_FindTaskByID_Call struct {
+	*mock.Call
+}
+
+// FindTaskByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - userID string
+func (_e *MockService_Expecter) FindTaskByID(ctx interface{}, taskID interface{}, userID interface{}) *MockService_FindTaskByID_Call {
+	return &MockService_FindTaskByID_Call{Call: _e.mock.On("FindTaskByID", ctx, taskID, userID)}
+}
+
+func (_c *MockService_FindTaskByID_Call) Run(run func(ctx context.Context, taskID string, userID string)) *MockService_FindTaskByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_FindTaskByID_Call) Return(task1 *entities.Task, err error) *MockService_FindTaskByID_Call {
+	_c.Call.Return(task1, err)
+	return _c
+}
+
+func (_c *MockService_FindTaskByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, userID string) (*entities.Task, error)) *MockService_FindTaskByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTaskByUserID provides a mock function for the type MockService
+func (_mock *MockService) FindTaskByUserID(ctx context.Context, in *task.TaskListInput, userID string) (*task.TaskListOutput, error) {
+	ret := _mock.Called(ctx, in, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTaskByUserID")
+	}
+
+	var r0 *task.TaskListOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.TaskListInput, string) (*task.TaskListOutput, error)); ok {
+		return returnFunc(ctx, in, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.TaskListInput, string) *task.TaskListOutput); ok {
+		r0 = returnFunc(ctx, in, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*task.TaskListOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *task.TaskListInput, string) error); ok {
+		r1 = returnFunc(ctx, in, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_FindTaskByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTaskByUserID'
+type MockService_FindTaskByUserID_Call struct {
+	*mock.Call
+}
+
+// FindTaskByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *task.TaskListInput
+//   - userID string
+func (_e *MockService_Expecter) FindTaskByUserID(ctx interface{}, in interface{}, userID interface{}) *MockService_FindTaskByUserID_Call {
+	return &MockService_FindTaskByUserID_Call{Call: _e.mock.On("FindTaskByUserID", ctx, in, userID)}
+}
+
+func (_c *MockService_FindTaskByUserID_Call) Run(run func(ctx context.Context, in *task.TaskListInput, userID string)) *MockService_FindTaskByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *task.TaskListInput
+		if args[1] != nil {
+			arg1 = args[1].(*task.TaskListInput)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_FindTaskByUserID_Call) Return(taskListOutput *task.TaskListOutput, err error) *MockService_FindTaskByUserID_Call {
+	_c.Call.Return(taskListOutput, err)
+	return _c
+}
+
+func (_c *MockService_FindTaskByUserID_Call) RunAndReturn(run func(ctx context.Context, in *task.TaskListInput, userID string) (*task.TaskListOutput, error)) *MockService_FindTaskByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTaskRecurrence provides a mock function for the type MockService
+func (_mock *MockService) FindTaskRecurrence(ctx context.Context, taskID string, in *task.TaskRecurrencePreviewInput, userID string) (*task.TaskRecurrenceOutput, error) {
+	ret := _mock.Called(ctx, taskID, in, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTaskRecurrence")
+	}
+
+	var r0 *task.TaskRecurrenceOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *task.TaskRecurrencePreviewInput, string) (*task.TaskRecurrenceOutput, error)); ok {
+		return returnFunc(ctx, taskID, in, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *task.TaskRecurrencePreviewInput, string) *task.TaskRecurrenceOutput); ok {
+		r0 = returnFunc(ctx, taskID, in, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*task.TaskRecurrenceOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *task.TaskRecurrencePreviewInput, string) error); ok {
+		r1 = returnFunc(ctx, taskID, in, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_FindTaskRecurrence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTaskRecurrence'
+type MockService_FindTaskRecurrence_Call struct {
+	*mock.Call
+}
+
+// FindTaskRecurrence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - in *task.TaskRecurrencePreviewInput
+//   - userID string
+func (_e *MockService_Expecter) FindTaskRecurrence(ctx interface{}, taskID interface{}, in interface{}, userID interface{}) *MockService_FindTaskRecurrence_Call {
+	return &MockService_FindTaskRecurrence_Call{Call: _e.mock.On("FindTaskRecurrence", ctx, taskID, in, userID)}
+}
+
+func (_c *MockService_FindTaskRecurrence_Call) Run(run func(ctx context.Context, taskID string, in *task.TaskRecurrencePreviewInput, userID string)) *MockService_FindTaskRecurrence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *task.TaskRecurrencePreviewInput
+		if args[2] != nil {
+			arg2 = args[2].(*task.TaskRecurrencePreviewInput)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_FindTaskRecurrence_Call) Return(taskRecurrenceOutput *task.TaskRecurrenceOutput, err error) *MockService_FindTaskRecurrence_Call {
+	_c.Call.Return(taskRecurrenceOutput, err)
+	return _c
+}
+
+func (_c *MockService_FindTaskRecurrence_Call) RunAndReturn(run func(ctx context.Context, taskID string, in *task.TaskRecurrencePreviewInput, userID string) (*task.TaskRecurrenceOutput, error)) *MockService_FindTaskRecurrence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTaskTreeByID provides a mock function for the type MockService
+func (_mock *MockService) FindTaskTreeByID(ctx context.Context, taskID string, userID string) (*entities.TaskTree, error) {
+	ret := _mock.Called(ctx, taskID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTaskTreeByID")
+	}
+
+	var r0 *entities.TaskTree
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*entities.TaskTree, error)); ok {
+		return returnFunc(ctx, taskID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *entities.TaskTree); ok {
+		r0 = returnFunc(ctx, taskID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.TaskTree)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, taskID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_FindTaskTreeByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTaskTreeByID'
+type MockService_FindTaskTreeByID_Call struct {
+	*mock.Call
+}
+
+// FindTaskTreeByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - userID string
+func (_e *MockService_Expecter) FindTaskTreeByID(ctx interface{}, taskID interface{}, userID interface{}) *MockService_FindTaskTreeByID_Call {
+	return &MockService_FindTaskTreeByID_Call{Call: _e.mock.On("FindTaskTreeByID", ctx, taskID, userID)}
+}
+
+func (_c *MockService_FindTaskTreeByID_Call) Run(run func(ctx context.Context, taskID string, userID string)) *MockService_FindTaskTreeByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_FindTaskTreeByID_Call) Return(taskTree *entities.TaskTree, err error) *MockService_FindTaskTreeByID_Call {
+	_c.Call.Return(taskTree, err)
+	return _c
+}
+
+func (_c *MockService_FindTaskTreeByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, userID string) (*entities.TaskTree, error)) *MockService_FindTaskTreeByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTasksByProjectID provides a mock function for the type MockService
+func (_mock *MockService) FindTasksByProjectID(ctx context.Context, projectID string, in *task.TaskListInput, userID string) (*task.TaskListOutput, error) {
+	ret := _mock.Called(ctx, projectID, in, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTasksByProjectID")
+	}
+
+	var r0 *task.TaskListOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *task.TaskListInput, string) (*task.TaskListOutput, error)); ok {
+		return returnFunc(ctx, projectID, in, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *task.TaskListInput, string) *task.TaskListOutput); ok {
+		r0 = returnFunc(ctx, projectID, in, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*task.TaskListOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *task.TaskListInput, string) error); ok {
+		r1 = returnFunc(ctx, projectID, in, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_FindTasksByProjectID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTasksByProjectID'
+type MockService_FindTasksByProjectID_Call struct {
+	*mock.Call
+}
+
+// FindTasksByProjectID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - in *task.TaskListInput
+//   - userID string
+func (_e *MockService_Expecter) FindTasksByProjectID(ctx interface{}, projectID interface{}, in interface{}, userID interface{}) *MockService_FindTasksByProjectID_Call {
+	return &MockService_FindTasksByProjectID_Call{Call: _e.mock.On("FindTasksByProjectID", ctx, projectID, in, userID)}
+}
+
+func (_c *MockService_FindTasksByProjectID_Call) Run(run func(ctx context.Context, projectID string, in *task.TaskListInput, userID string)) *MockService_FindTasksByProjectID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *task.TaskListInput
+		if args[2] != nil {
+			arg2 = args[2].(*task.TaskListInput)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_FindTasksByProjectID_Call) Return(taskListOutput *task.TaskListOutput, err error) *MockService_FindTasksByProjectID_Call {
+	_c.Call.Return(taskListOutput, err)
+	return _c
+}
+
+func (_c *MockService_FindTasksByProjectID_Call) RunAndReturn(run func(ctx context.Context, projectID string, in *task.TaskListInput, userID string) (*task.TaskListOutput, error)) *MockService_FindTasksByProjectID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTasksByWorkspaceID provides a mock function for the type MockService
+func (_mock *MockService) FindTasksByWorkspaceID(ctx context.Context, workspaceID string, in *task.TaskListInput, userID string) (*task.TaskListOutput, error) {
+	ret := _mock.Called(ctx, workspaceID, in, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTasksByWorkspaceID")
+	}
+
+	var r0 *task.TaskListOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *task.TaskListInput, string) (*task.TaskListOutput, error)); ok {
+		return returnFunc(ctx, workspaceID, in, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *task.TaskListInput, string) *task.TaskListOutput); ok {
+		r0 = returnFunc(ctx, workspaceID, in, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*task.TaskListOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *task.TaskListInput, string) error); ok {
+		r1 = returnFunc(ctx, workspaceID, in, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_FindTasksByWorkspaceID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTasksByWorkspaceID'
+type MockService_FindTasksByWorkspaceID_Call struct {
+	*mock.Call
+}
+
+// FindTasksByWorkspaceID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workspaceID string
+//   - in *task.TaskListInput
+//   - userID string
+func (_e *MockService_Expecter) FindTasksByWorkspaceID(ctx interface{}, workspaceID interface{}, in interface{}, userID interface{}) *MockService_FindTasksByWorkspaceID_Call {
+	return &MockService_FindTasksByWorkspaceID_Call{Call: _e.mock.On("FindTasksByWorkspaceID", ctx, workspaceID, in, userID)}
+}
+
+func (_c *MockService_FindTasksByWorkspaceID_Call) Run(run func(ctx context.Context, workspaceID string, in *task.TaskListInput, userID string)) *MockService_FindTasksByWorkspaceID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *task.TaskListInput
+		if args[2] != nil {
+			arg2 = args[2].(*task.TaskListInput)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_FindTasksByWorkspaceID_Call) Return(taskListOutput *task.TaskListOutput, err error) *MockService_FindTasksByWorkspaceID_Call {
+	_c.Call.Return(taskListOutput, err)
+	return _c
+}
+
+func (_c *MockService_FindTasksByWorkspaceID_Call) RunAndReturn(run func(ctx context.Context, workspaceID string, in *task.TaskListInput, userID string) (*task.TaskListOutput, error)) *MockService_FindTasksByWorkspaceID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTrashedTasks provides a mock function for the type MockService
+func (_mock *MockService) FindTrashedTasks(ctx context.Context, in *task.TaskListInput, userID string) (*task.TaskListOutput, error) {
+	ret := _mock.Called(ctx, in, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTrashedTasks")
+	}
+
+	var r0 *task.TaskListOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.TaskListInput, string) (*task.TaskListOutput, error)); ok {
+		return returnFunc(ctx, in, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.TaskListInput, string) *task.TaskListOutput); ok {
+		r0 = returnFunc(ctx, in, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*task.TaskListOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *task.TaskListInput, string) error); ok {
+		r1 = returnFunc(ctx, in, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_FindTrashedTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTrashedTasks'
+type MockService_FindTrashedTasks_Call struct {
+	*mock.Call
+}
+
+// FindTrashedTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *task.TaskListInput
+//   - userID string
+func (_e *MockService_Expecter) FindTrashedTasks(ctx interface{}, in interface{}, userID interface{}) *MockService_FindTrashedTasks_Call {
+	return &MockService_FindTrashedTasks_Call{Call: _e.mock.On("FindTrashedTasks", ctx, in, userID)}
+}
+
+func (_c *MockService_FindTrashedTasks_Call) Run(run func(ctx context.Context, in *task.TaskListInput, userID string)) *MockService_FindTrashedTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *task.TaskListInput
+		if args[1] != nil {
+			arg1 = args[1].(*task.TaskListInput)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_FindTrashedTasks_Call) Return(taskListOutput *task.TaskListOutput, err error) *MockService_FindTrashedTasks_Call {
+	_c.Call.Return(taskListOutput, err)
+	return _c
+}
+
+func (_c *MockService_FindTrashedTasks_Call) RunAndReturn(run func(ctx context.Context, in *task.TaskListInput, userID string) (*task.TaskListOutput, error)) *MockService_FindTrashedTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportTasks provides a mock function for the type MockService
+func (_mock *MockService) ImportTasks(ctx context.Context, in *task.TaskImportInput, userID string) (*task.TaskImportOutput, error) {
+	ret := _mock.Called(ctx, in, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportTasks")
+	}
+
+	var r0 *task.TaskImportOutput
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.TaskImportInput, string) (*task.TaskImportOutput, error)); ok {
+		return returnFunc(ctx, in, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.TaskImportInput, string) *task.TaskImportOutput); ok {
+		r0 = returnFunc(ctx, in, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*task.TaskImportOutput)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *task.TaskImportInput, string) error); ok {
+		r1 = returnFunc(ctx, in, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_ImportTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportTasks'
+type MockService_ImportTasks_Call struct {
+	*mock.Call
+}
+
+// ImportTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *task.TaskImportInput
+//   - userID string
+func (_e *MockService_Expecter) ImportTasks(ctx interface{}, in interface{}, userID interface{}) *MockService_ImportTasks_Call {
+	return &MockService_ImportTasks_Call{Call: _e.mock.On("ImportTasks", ctx, in, userID)}
+}
+
+func (_c *MockService_ImportTasks_Call) Run(run func(ctx context.Context, in *task.TaskImportInput, userID string)) *MockService_ImportTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *task.TaskImportInput
+		if args[1] != nil {
+			arg1 = args[1].(*task.TaskImportInput)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_ImportTasks_Call) Return(taskImportOutput *task.TaskImportOutput, err error) *MockService_ImportTasks_Call {
+	_c.Call.Return(taskImportOutput, err)
+	return _c
+}
+
+func (_c *MockService_ImportTasks_Call) RunAndReturn(run func(ctx context.Context, in *task.TaskImportInput, userID string) (*task.TaskImportOutput, error)) *MockService_ImportTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveTask provides a mock function for the type MockService
+func (_mock *MockService) MoveTask(ctx context.Context, taskID string, in *task.TaskMoveInput, userID string) error {
+	ret := _mock.Called(ctx, taskID, in, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveTask")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *task.TaskMoveInput, string) error); ok {
+		r0 = returnFunc(ctx, taskID, in, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockService_MoveTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveTask'
+type MockService_MoveTask_Call struct {
+	*mock.Call
+}
+
+// MoveTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - in *task.TaskMoveInput
+//   - userID string
+func (_e *MockService_Expecter) MoveTask(ctx interface{}, taskID interface{}, in interface{}, userID interface{}) *MockService_MoveTask_Call {
+	return &MockService_MoveTask_Call{Call: _e.mock.On("MoveTask", ctx, taskID, in, userID)}
+}
+
+func (_c *MockService_MoveTask_Call) Run(run func(ctx context.Context, taskID string, in *task.TaskMoveInput, userID string)) *MockService_MoveTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *task.TaskMoveInput
+		if args[2] != nil {
+			arg2 = args[2].(*task.TaskMoveInput)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_MoveTask_Call) Return(err error) *MockService_MoveTask_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockService_MoveTask_Call) RunAndReturn(run func(ctx context.Context, taskID string, in *task.TaskMoveInput, userID string) error) *MockService_MoveTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchTaskByID provides a mock function for the type MockService
+func (_mock *MockService) PatchTaskByID(ctx context.Context, taskID string, in *task.TaskPatchInput, userID string) error {
+	ret := _mock.Called(ctx, taskID, in, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchTaskByID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *task.TaskPatchInput, string) error); ok {
+		r0 = returnFunc(ctx, taskID, in, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockService_PatchTaskByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchTaskByID'
+type MockService_PatchTaskByID_Call struct {
+	*mock.Call
+}
+
+// PatchTaskByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - in *task.TaskPatchInput
+//   - userID string
+func (_e *MockService_Expecter) PatchTaskByID(ctx interface{}, taskID interface{}, in interface{}, userID interface{}) *MockService_PatchTaskByID_Call {
+	return &MockService_PatchTaskByID_Call{Call: _e.mock.On("PatchTaskByID", ctx, taskID, in, userID)}
+}
+
+func (_c *MockService_PatchTaskByID_Call) Run(run func(ctx context.Context, taskID string, in *task.TaskPatchInput, userID string)) *MockService_PatchTaskByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *task.TaskPatchInput
+		if args[2] != nil {
+			arg2 = args[2].(*task.TaskPatchInput)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_PatchTaskByID_Call) Return(err error) *MockService_PatchTaskByID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockService_PatchTaskByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, in *task.TaskPatchInput, userID string) error) *MockService_PatchTaskByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeExpiredTrash provides a mock function for the type MockService
+func (_mock *MockService) PurgeExpiredTrash(ctx context.Context, now time.Time) (int64, error) {
+	ret := _mock.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeExpiredTrash")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return returnFunc(ctx, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = returnFunc(ctx, now)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_PurgeExpiredTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeExpiredTrash'
+type MockService_PurgeExpiredTrash_Call struct {
+	*mock.Call
+}
+
+// PurgeExpiredTrash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockService_Expecter) PurgeExpiredTrash(ctx interface{}, now interface{}) *MockService_PurgeExpiredTrash_Call {
+	return &MockService_PurgeExpiredTrash_Call{Call: _e.mock.On("PurgeExpiredTrash", ctx, now)}
+}
+
+func (_c *MockService_PurgeExpiredTrash_Call) Run(run func(ctx context.Context, now time.Time)) *MockService_PurgeExpiredTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_PurgeExpiredTrash_Call) Return(n int64, err error) *MockService_PurgeExpiredTrash_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockService_PurgeExpiredTrash_Call) RunAndReturn(run func(ctx context.Context, now time.Time) (int64, error)) *MockService_PurgeExpiredTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeTaskByID provides a mock function for the type MockService
+func (_mock *MockService) PurgeTaskByID(ctx context.Context, taskID string, userID string) error {
+	ret := _mock.Called(ctx, taskID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeTaskByID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, taskID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockService_PurgeTaskByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeTaskByID'
+type MockService_PurgeTaskByID_Call struct {
+	*mock.Call
+}
+
+// PurgeTaskByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - userID string
+func (_e *MockService_Expecter) PurgeTaskByID(ctx interface{}, taskID interface{}, userID interface{}) *MockService_PurgeTaskByID_Call {
+	return &MockService_PurgeTaskByID_Call{Call: _e.mock.On("PurgeTaskByID", ctx, taskID, userID)}
+}
+
+func (_c *MockService_PurgeTaskByID_Call) Run(run func(ctx context.Context, taskID string, userID string)) *MockService_PurgeTaskByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_PurgeTaskByID_Call) Return(err error) *MockService_PurgeTaskByID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockService_PurgeTaskByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, userID string) error) *MockService_PurgeTaskByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTaskDependency provides a mock function for the type MockService
+func (_mock *MockService) RemoveTaskDependency(ctx context.Context, taskID string, in *task.TaskDependencyInput, userID string) error {
+	ret := _mock.Called(ctx, taskID, in, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTaskDependency")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *task.TaskDependencyInput, string) error); ok {
+		r0 = returnFunc(ctx, taskID, in, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockService_RemoveTaskDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTaskDependency'
+type MockService_RemoveTaskDependency_Call struct {
+	*mock.Call
+}
+
+// RemoveTaskDependency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - in *task.TaskDependencyInput
+//   - userID string
+func (_e *MockService_Expecter) RemoveTaskDependency(ctx interface{}, taskID interface{}, in interface{}, userID interface{}) *MockService_RemoveTaskDependency_Call {
+	return &MockService_RemoveTaskDependency_Call{Call: _e.mock.On("RemoveTaskDependency", ctx, taskID, in, userID)}
+}
+
+func (_c *MockService_RemoveTaskDependency_Call) Run(run func(ctx context.Context, taskID string, in *task.TaskDependencyInput, userID string)) *MockService_RemoveTaskDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *task.TaskDependencyInput
+		if args[2] != nil {
+			arg2 = args[2].(*task.TaskDependencyInput)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_RemoveTaskDependency_Call) Return(err error) *MockService_RemoveTaskDependency_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockService_RemoveTaskDependency_Call) RunAndReturn(run func(ctx context.Context, taskID string, in *task.TaskDependencyInput, userID string) error) *MockService_RemoveTaskDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreTaskByID provides a mock function for the type MockService
+func (_mock *MockService) RestoreTaskByID(ctx context.Context, taskID string, userID string) error {
+	ret := _mock.Called(ctx, taskID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreTaskByID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, taskID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockService_RestoreTaskByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreTaskByID'
+type MockService_RestoreTaskByID_Call struct {
+	*mock.Call
+}
+
+// RestoreTaskByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - userID string
+func (_e *MockService_Expecter) RestoreTaskByID(ctx interface{}, taskID interface{}, userID interface{}) *MockService_RestoreTaskByID_Call {
+	return &MockService_RestoreTaskByID_Call{Call: _e.mock.On("RestoreTaskByID", ctx, taskID, userID)}
+}
+
+func (_c *MockService_RestoreTaskByID_Call) Run(run func(ctx context.Context, taskID string, userID string)) *MockService_RestoreTaskByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_RestoreTaskByID_Call) Return(err error) *MockService_RestoreTaskByID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockService_RestoreTaskByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, userID string) error) *MockService_RestoreTaskByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchTasks provides a mock function for the type MockService
+func (_mock *MockService) SearchTasks(ctx context.Context, in *task.TaskSearchInput, userID string) ([]entities.TaskSearchResult, error) {
+	ret := _mock.Called(ctx, in, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchTasks")
+	}
+
+	var r0 []entities.TaskSearchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.TaskSearchInput, string) ([]entities.TaskSearchResult, error)); ok {
+		return returnFunc(ctx, in, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *task.TaskSearchInput, string) []entities.TaskSearchResult); ok {
+		r0 = returnFunc(ctx, in, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.TaskSearchResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *task.TaskSearchInput, string) error); ok {
+		r1 = returnFunc(ctx, in, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_SearchTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchTasks'
+type MockService_SearchTasks_Call struct {
 	*mock.Call
 }
 
-// CreateTask is a helper method to define mock.On call
+// SearchTasks is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *task.TaskCreateInput
+//   - in *task.TaskSearchInput
 //   - userID string
-func (_e *MockService_Expecter) CreateTask(ctx interface{}, in interface{}, userID interface{}) *MockService_CreateTask_Call {
-	return &MockService_CreateTask_Call{Call: _e.mock.On("CreateTask", ctx, in, userID)}
+func (_e *MockService_Expecter) SearchTasks(ctx interface{}, in interface{}, userID interface{}) *MockService_SearchTasks_Call {
+	return &MockService_SearchTasks_Call{Call: _e.mock.On("SearchTasks", ctx, in, userID)}
 }
 
-func (_c *MockService_CreateTask_Call) Run(run func(ctx context.Context, in *task.TaskCreateInput, userID string)) *MockService_CreateTask_Call {
+func (_c *MockService_SearchTasks_Call) Run(run func(ctx context.Context, in *task.TaskSearchInput, userID string)) *MockService_SearchTasks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *task.TaskCreateInput
+		var arg1 *task.TaskSearchInput
 		if args[1] != nil {
-			arg1 = args[1].(*task.TaskCreateInput)
+			arg1 = args[1].(*task.TaskSearchInput)
 		}
 		var arg2 string
 		if args[2] != nil {
@@ -92,47 +1907,48 @@ func (_c *MockService_CreateTask_Call) Run(run func(ctx context.Context, in *tas
 	return _c
 }
 
-func (_c *MockService_CreateTask_Call) Return(err error) *MockService_CreateTask_Call {
-	_c.Call.Return(err)
+func (_c *MockService_SearchTasks_Call) Return(taskSearchResults []entities.TaskSearchResult, err error) *MockService_SearchTasks_Call {
+	_c.Call.Return(taskSearchResults, err)
 	return _c
 }
 
-func (_c *MockService_CreateTask_Call) RunAndReturn(run func(ctx context.Context, in *task.TaskCreateInput, userID string) error) *MockService_CreateTask_Call {
+func (_c *MockService_SearchTasks_Call) RunAndReturn(run func(ctx context.Context, in *task.TaskSearchInput, userID string) ([]entities.TaskSearchResult, error)) *MockService_SearchTasks_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteTaskByID provides a mock function for the type MockService
-func (_mock *MockService) DeleteTaskByID(ctx context.Context, taskID string, userID string) error {
-	ret := _mock.Called(ctx, taskID, userID)
+// SetTaskRecurrence provides a mock function for the type MockService
+func (_mock *MockService) SetTaskRecurrence(ctx context.Context, taskID string, in *task.TaskRecurrenceInput, userID string) error {
+	ret := _mock.Called(ctx, taskID, in, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteTaskByID")
+		panic("no return value specified for SetTaskRecurrence")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = returnFunc(ctx, taskID, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *task.TaskRecurrenceInput, string) error); ok {
+		r0 = returnFunc(ctx, taskID, in, userID)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockService_DeleteTaskByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTaskByID'
-type MockService_DeleteTaskByID_Call struct {
+// MockService_SetTaskRecurrence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTaskRecurrence'
+type MockService_SetTaskRecurrence_Call struct {
 	*mock.Call
 }
 
-// DeleteTaskByID is a helper method to define mock.On call
+// SetTaskRecurrence is a helper method to define mock.On call
 //   - ctx context.Context
 //   - taskID string
+//   - in *task.TaskRecurrenceInput
 //   - userID string
-func (_e *MockService_Expecter) DeleteTaskByID(ctx interface{}, taskID interface{}, userID interface{}) *MockService_DeleteTaskByID_Call {
-	return &MockService_DeleteTaskByID_Call{Call: _e.mock.On("DeleteTaskByID", ctx, taskID, userID)}
+func (_e *MockService_Expecter) SetTaskRecurrence(ctx interface{}, taskID interface{}, in interface{}, userID interface{}) *MockService_SetTaskRecurrence_Call {
+	return &MockService_SetTaskRecurrence_Call{Call: _e.mock.On("SetTaskRecurrence", ctx, taskID, in, userID)}
 }
 
-func (_c *MockService_DeleteTaskByID_Call) Run(run func(ctx context.Context, taskID string, userID string)) *MockService_DeleteTaskByID_Call {
+func (_c *MockService_SetTaskRecurrence_Call) Run(run func(ctx context.Context, taskID string, in *task.TaskRecurrenceInput, userID string)) *MockService_SetTaskRecurrence_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -142,71 +1958,65 @@ func (_c *MockService_DeleteTaskByID_Call) Run(run func(ctx context.Context, tas
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 string
+		var arg2 *task.TaskRecurrenceInput
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(*task.TaskRecurrenceInput)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockService_DeleteTaskByID_Call) Return(err error) *MockService_DeleteTaskByID_Call {
+func (_c *MockService_SetTaskRecurrence_Call) Return(err error) *MockService_SetTaskRecurrence_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockService_DeleteTaskByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, userID string) error) *MockService_DeleteTaskByID_Call {
+func (_c *MockService_SetTaskRecurrence_Call) RunAndReturn(run func(ctx context.Context, taskID string, in *task.TaskRecurrenceInput, userID string) error) *MockService_SetTaskRecurrence_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindTaskByID provides a mock function for the type MockService
-func (_mock *MockService) FindTaskByID(ctx context.Context, taskID string, userID string) (*entities.Task, error) {
+// StopTaskRecurrence provides a mock function for the type MockService
+func (_mock *MockService) StopTaskRecurrence(ctx context.Context, taskID string, userID string) error {
 	ret := _mock.Called(ctx, taskID, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindTaskByID")
+		panic("no return value specified for StopTaskRecurrence")
 	}
 
-	var r0 *entities.Task
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*entities.Task, error)); ok {
-		return returnFunc(ctx, taskID, userID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *entities.Task); ok {
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
 		r0 = returnFunc(ctx, taskID, userID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.Task)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = returnFunc(ctx, taskID, userID)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockService_FindTaskByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTaskByID'
-type MockService_FindTaskByID_Call struct {
+// MockService_StopTaskRecurrence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopTaskRecurrence'
+type MockService_StopTaskRecurrence_Call struct {
 	*mock.Call
 }
 
-// FindTaskByID is a helper method to define mock.On call
+// StopTaskRecurrence is a helper method to define mock.On call
 //   - ctx context.Context
 //   - taskID string
 //   - userID string
-func (_e *MockService_Expecter) FindTaskByID(ctx interface{}, taskID interface{}, userID interface{}) *MockService_FindTaskByID_Call {
-	return &MockService_FindTaskByID_Call{Call: _e.mock.On("FindTaskByID", ctx, taskID, userID)}
+func (_e *MockService_Expecter) StopTaskRecurrence(ctx interface{}, taskID interface{}, userID interface{}) *MockService_StopTaskRecurrence_Call {
+	return &MockService_StopTaskRecurrence_Call{Call: _e.mock.On("StopTaskRecurrence", ctx, taskID, userID)}
 }
 
-func (_c *MockService_FindTaskByID_Call) Run(run func(ctx context.Context, taskID string, userID string)) *MockService_FindTaskByID_Call {
+func (_c *MockService_StopTaskRecurrence_Call) Run(run func(ctx context.Context, taskID string, userID string)) *MockService_StopTaskRecurrence_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -229,57 +2039,48 @@ func (_c *MockService_FindTaskByID_Call) Run(run func(ctx context.Context, taskI
 	return _c
 }
 
-func (_c *MockService_FindTaskByID_Call) Return(task1 *entities.Task, err error) *MockService_FindTaskByID_Call {
-	_c.Call.Return(task1, err)
+func (_c *MockService_StopTaskRecurrence_Call) Return(err error) *MockService_StopTaskRecurrence_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockService_FindTaskByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, userID string) (*entities.Task, error)) *MockService_FindTaskByID_Call {
+func (_c *MockService_StopTaskRecurrence_Call) RunAndReturn(run func(ctx context.Context, taskID string, userID string) error) *MockService_StopTaskRecurrence_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindTaskByUserID provides a mock function for the type MockService
-func (_mock *MockService) FindTaskByUserID(ctx context.Context, userID string) ([]entities.Task, error) {
-	ret := _mock.Called(ctx, userID)
+// UnassignTask provides a mock function for the type MockService
+func (_mock *MockService) UnassignTask(ctx context.Context, taskID string, in *task.TaskAssigneeInput, userID string) error {
+	ret := _mock.Called(ctx, taskID, in, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindTaskByUserID")
+		panic("no return value specified for UnassignTask")
 	}
 
-	var r0 []entities.Task
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]entities.Task, error)); ok {
-		return returnFunc(ctx, userID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []entities.Task); ok {
-		r0 = returnFunc(ctx, userID)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]entities.Task)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, userID)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *task.TaskAssigneeInput, string) error); ok {
+		r0 = returnFunc(ctx, taskID, in, userID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockService_FindTaskByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTaskByUserID'
-type MockService_FindTaskByUserID_Call struct {
+// MockService_UnassignTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnassignTask'
+type MockService_UnassignTask_Call struct {
 	*mock.Call
 }
 
-// FindTaskByUserID is a helper method to define mock.On call
+// UnassignTask is a helper method to define mock.On call
 //   - ctx context.Context
+//   - taskID string
+//   - in *task.TaskAssigneeInput
 //   - userID string
-func (_e *MockService_Expecter) FindTaskByUserID(ctx interface{}, userID interface{}) *MockService_FindTaskByUserID_Call {
-	return &MockService_FindTaskByUserID_Call{Call: _e.mock.On("FindTaskByUserID", ctx, userID)}
+func (_e *MockService_Expecter) UnassignTask(ctx interface{}, taskID interface{}, in interface{}, userID interface{}) *MockService_UnassignTask_Call {
+	return &MockService_UnassignTask_Call{Call: _e.mock.On("UnassignTask", ctx, taskID, in, userID)}
 }
 
-func (_c *MockService_FindTaskByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockService_FindTaskByUserID_Call {
+func (_c *MockService_UnassignTask_Call) Run(run func(ctx context.Context, taskID string, in *task.TaskAssigneeInput, userID string)) *MockService_UnassignTask_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -289,20 +2090,30 @@ func (_c *MockService_FindTaskByUserID_Call) Run(run func(ctx context.Context, u
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 *task.TaskAssigneeInput
+		if args[2] != nil {
+			arg2 = args[2].(*task.TaskAssigneeInput)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockService_FindTaskByUserID_Call) Return(tasks []entities.Task, err error) *MockService_FindTaskByUserID_Call {
-	_c.Call.Return(tasks, err)
+func (_c *MockService_UnassignTask_Call) Return(err error) *MockService_UnassignTask_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockService_FindTaskByUserID_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]entities.Task, error)) *MockService_FindTaskByUserID_Call {
+func (_c *MockService_UnassignTask_Call) RunAndReturn(run func(ctx context.Context, taskID string, in *task.TaskAssigneeInput, userID string) error) *MockService_UnassignTask_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -376,6 +2187,144 @@ func (_c *MockService_UpdateTaskByID_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// UpdateTaskParentByID provides a mock function for the type MockService
+func (_mock *MockService) UpdateTaskParentByID(ctx context.Context, taskID string, in *task.TaskUpdateParentInput, userID string) error {
+	ret := _mock.Called(ctx, taskID, in, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTaskParentByID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *task.TaskUpdateParentInput, string) error); ok {
+		r0 = returnFunc(ctx, taskID, in, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockService_UpdateTaskParentByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTaskParentByID'
+type MockService_UpdateTaskParentByID_Call struct {
+	*mock.Call
+}
+
+// UpdateTaskParentByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - in *task.TaskUpdateParentInput
+//   - userID string
+func (_e *MockService_Expecter) UpdateTaskParentByID(ctx interface{}, taskID interface{}, in interface{}, userID interface{}) *MockService_UpdateTaskParentByID_Call {
+	return &MockService_UpdateTaskParentByID_Call{Call: _e.mock.On("UpdateTaskParentByID", ctx, taskID, in, userID)}
+}
+
+func (_c *MockService_UpdateTaskParentByID_Call) Run(run func(ctx context.Context, taskID string, in *task.TaskUpdateParentInput, userID string)) *MockService_UpdateTaskParentByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *task.TaskUpdateParentInput
+		if args[2] != nil {
+			arg2 = args[2].(*task.TaskUpdateParentInput)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_UpdateTaskParentByID_Call) Return(err error) *MockService_UpdateTaskParentByID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockService_UpdateTaskParentByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, in *task.TaskUpdateParentInput, userID string) error) *MockService_UpdateTaskParentByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTaskProjectByID provides a mock function for the type MockService
+func (_mock *MockService) UpdateTaskProjectByID(ctx context.Context, taskID string, in *task.TaskUpdateProjectInput, userID string) error {
+	ret := _mock.Called(ctx, taskID, in, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTaskProjectByID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *task.TaskUpdateProjectInput, string) error); ok {
+		r0 = returnFunc(ctx, taskID, in, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockService_UpdateTaskProjectByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTaskProjectByID'
+type MockService_UpdateTaskProjectByID_Call struct {
+	*mock.Call
+}
+
+// UpdateTaskProjectByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - in *task.TaskUpdateProjectInput
+//   - userID string
+func (_e *MockService_Expecter) UpdateTaskProjectByID(ctx interface{}, taskID interface{}, in interface{}, userID interface{}) *MockService_UpdateTaskProjectByID_Call {
+	return &MockService_UpdateTaskProjectByID_Call{Call: _e.mock.On("UpdateTaskProjectByID", ctx, taskID, in, userID)}
+}
+
+func (_c *MockService_UpdateTaskProjectByID_Call) Run(run func(ctx context.Context, taskID string, in *task.TaskUpdateProjectInput, userID string)) *MockService_UpdateTaskProjectByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *task.TaskUpdateProjectInput
+		if args[2] != nil {
+			arg2 = args[2].(*task.TaskUpdateProjectInput)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_UpdateTaskProjectByID_Call) Return(err error) *MockService_UpdateTaskProjectByID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockService_UpdateTaskProjectByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, in *task.TaskUpdateProjectInput, userID string) error) *MockService_UpdateTaskProjectByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateTaskStatusByID provides a mock function for the type MockService
 func (_mock *MockService) UpdateTaskStatusByID(ctx context.Context, taskID string, in *task.TaskUpdateStatusInput, userID string) error {
 	ret := _mock.Called(ctx, taskID, in, userID)
@@ -444,3 +2393,72 @@ func (_c *MockService_UpdateTaskStatusByID_Call) RunAndReturn(run func(ctx conte
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateTaskWorkspaceByID provides a mock function for the type MockService
+func (_mock *MockService) UpdateTaskWorkspaceByID(ctx context.Context, taskID string, in *task.TaskUpdateWorkspaceInput, userID string) error {
+	ret := _mock.Called(ctx, taskID, in, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTaskWorkspaceByID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *task.TaskUpdateWorkspaceInput, string) error); ok {
+		r0 = returnFunc(ctx, taskID, in, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockService_UpdateTaskWorkspaceByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTaskWorkspaceByID'
+type MockService_UpdateTaskWorkspaceByID_Call struct {
+	*mock.Call
+}
+
+// UpdateTaskWorkspaceByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - in *task.TaskUpdateWorkspaceInput
+//   - userID string
+func (_e *MockService_Expecter) UpdateTaskWorkspaceByID(ctx interface{}, taskID interface{}, in interface{}, userID interface{}) *MockService_UpdateTaskWorkspaceByID_Call {
+	return &MockService_UpdateTaskWorkspaceByID_Call{Call: _e.mock.On("UpdateTaskWorkspaceByID", ctx, taskID, in, userID)}
+}
+
+func (_c *MockService_UpdateTaskWorkspaceByID_Call) Run(run func(ctx context.Context, taskID string, in *task.TaskUpdateWorkspaceInput, userID string)) *MockService_UpdateTaskWorkspaceByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *task.TaskUpdateWorkspaceInput
+		if args[2] != nil {
+			arg2 = args[2].(*task.TaskUpdateWorkspaceInput)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_UpdateTaskWorkspaceByID_Call) Return(err error) *MockService_UpdateTaskWorkspaceByID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockService_UpdateTaskWorkspaceByID_Call) RunAndReturn(run func(ctx context.Context, taskID string, in *task.TaskUpdateWorkspaceInput, userID string) error) *MockService_UpdateTaskWorkspaceByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -1,17 +1,246 @@
 package task
 
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/patchutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+)
+
 type TaskCreateInput struct {
+	WorkspaceID *string
+	ProjectID   *string
+	ParentID    *string
 	Title       string
 	Description string
 	Priority    int
+	StartAt     *time.Time
+	DueAt       *time.Time
+	TagIDs      []string
+	// CustomFields holds values keyed by field ID
+	CustomFields map[string]json.RawMessage
 }
 
 type TaskUpdateInput struct {
 	Title       string
 	Description string
 	Priority    int
+	StartAt     *time.Time
+	DueAt       *time.Time
+	// TagIDs replaces the task's tags; nil keeps them unchanged
+	TagIDs []string
+	// CustomFields sets the given values keyed by field ID, a null value clears
+	// the field and fields left out are unchanged
+	CustomFields map[string]json.RawMessage
+	// Version is the version the client last saw, nil skips the check
+	Version *int
+}
+
+// TaskPatchInput is a JSON Merge Patch of a task. Members left out keep their
+// value and a null member removes it where the field is optional.
+type TaskPatchInput struct {
+	Title       patchutil.Optional[string]
+	Description patchutil.Optional[string]
+	Priority    patchutil.Optional[int]
+	StartAt     patchutil.Optional[time.Time]
+	DueAt       patchutil.Optional[time.Time]
+	// TagIDs replaces the task's tags, null removes them all
+	TagIDs patchutil.Optional[[]string]
+	// CustomFields sets the given values keyed by field ID, a null value clears
+	// the field and fields left out are unchanged. Null clears every field.
+	CustomFields patchutil.Optional[map[string]json.RawMessage]
+	// Version is the version the client last saw, nil skips the check
+	Version *int
 }
 
 type TaskUpdateStatusInput struct {
 	Status string
+	// Version is the version the client last saw, nil skips the check
+	Version *int
+}
+
+type TaskUpdateProjectInput struct {
+	ProjectID *string
+	// Version is the version the client last saw, nil skips the check
+	Version *int
+}
+
+type TaskUpdateWorkspaceInput struct {
+	WorkspaceID *string
+	// Version is the version the client last saw, nil skips the check
+	Version *int
+}
+
+type TaskUpdateParentInput struct {
+	ParentID *string
+	// Version is the version the client last saw, nil skips the check
+	Version *int
+}
+
+type TaskDependencyInput struct {
+	BlockedByID string
+}
+
+type TaskAssigneeInput struct {
+	UserID string
+}
+
+type TaskDeleteInput struct {
+	Strategy string
+	// Version is the version the client last saw, nil skips the check
+	Version *int
+}
+
+type TaskListInput struct {
+	Cursor           string
+	Limit            int
+	Statuses         []string
+	StatusCategories []string
+	Priorities       []int
+	CreatedFrom      *time.Time
+	CreatedTo        *time.Time
+	UpdatedFrom      *time.Time
+	UpdatedTo        *time.Time
+	Overdue          bool
+	TagIDs           []string
+	TagMode          string
+	// AssigneeIDs keeps tasks assigned to any of the users, "me" is the requesting user
+	AssigneeIDs []string
+	// CustomFields keeps tasks matching every "<fieldId>:<value>" filter
+	CustomFields []string
+	// WorkspaceID lists a workspace's tasks instead of the user's personal tasks
+	WorkspaceID *string
+	// IncludeWorkspaces widens a personal listing to every workspace of the user
+	IncludeWorkspaces bool
+	// Deleted lists the tasks in the trash instead of the live ones
+	Deleted bool
+	// ProjectID scopes the listing to one project, archived or not
+	ProjectID       *string
+	IncludeArchived bool
+	SortBy          string
+	SortOrder       string
+}
+
+type TaskListOutput struct {
+	Tasks      []entities.Task
+	NextCursor string
+	PrevCursor string
+	TotalCount int
+}
+
+// TaskExportOutput streams the tasks matching an export
+type TaskExportOutput struct {
+	// ForEach runs the query and hands every task to fn as its row is read
+	ForEach func(ctx context.Context, fn func(task *entities.Task) error) error
+}
+
+type TaskActivityListInput struct {
+	Cursor string
+	Limit  int
+}
+
+type TaskActivityListOutput struct {
+	Activities []entities.TaskActivity
+	NextCursor string
+}
+
+type TaskSearchInput struct {
+	Query  string
+	Limit  int
+	Offset int
+}
+
+type TaskBulkInput struct {
+	Mode       string
+	Operations []TaskBulkOperationInput
+}
+
+// TaskBulkOperationInput holds the input matching its action, TaskID is unused by create
+type TaskBulkOperationInput struct {
+	Action string
+	TaskID string
+	Create *TaskCreateInput
+	Update *TaskUpdateInput
+	Status *TaskUpdateStatusInput
+	Delete *TaskDeleteInput
+}
+
+type TaskBulkOutput struct {
+	Mode      enums.TaskBulkMode
+	Committed bool
+	Results   []TaskBulkResult
+}
+
+// TaskBulkResult is the outcome of one operation, Err is nil when it succeeded
+type TaskBulkResult struct {
+	Action enums.TaskBulkAction
+	TaskID string
+	Err    *servererr.ServerError
+	// RolledBack is set on a successful operation undone by another one failing
+	RolledBack bool
+}
+
+type TaskImportInput struct {
+	// DryRun checks every row against the database and then rolls all of them back
+	DryRun bool
+	Rows   []TaskCreateInput
+}
+
+type TaskImportOutput struct {
+	Committed bool
+	Results   []TaskImportResult
+}
+
+// TaskImportResult is the outcome of one row, Err is nil when its task was created
+type TaskImportResult struct {
+	TaskID string
+	Err    *servererr.ServerError
+}
+
+type TaskRecurrenceInput struct {
+	Rule string
+}
+
+type TaskRecurrencePreviewInput struct {
+	Count int
+}
+
+type TaskRecurrenceOutput struct {
+	Series   *entities.TaskSeries
+	Upcoming []entities.TaskOccurrence
+}
+
+// TaskMoveInput places a task on the board. The task goes after AfterID and
+// before BeforeID, either may be left out, and to the top of the column
+// when both are. Status defaults to the current status of the task.
+type TaskMoveInput struct {
+	Status   *string
+	BeforeID *string
+	AfterID  *string
+}
+
+// TaskBoardInput selects the board of a workspace, or the personal board of
+// the user, optionally narrowed to one project
+type TaskBoardInput struct {
+	WorkspaceID *string
+	ProjectID   *string
+}
+
+type TaskBoardOutput struct {
+	Columns []TaskBoardColumn
+}
+
+// TaskBoardColumn holds the tasks of a status in rank order. Truncated is set
+// when the column has more than the tasks returned.
+type TaskBoardColumn struct {
+	Status     enums.TaskStatus
+	Name       string
+	Category   enums.TaskStatusCategory
+	Tasks      []entities.Task
+	TotalCount int
+	Truncated  bool
 }
@@ -0,0 +1,197 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/task"
+	"github.com/graphzc/sdd-task-management-example/internal/services/authz"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/patchutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/rs/zerolog/log"
+)
+
+func (s *service) PatchTaskByID(ctx context.Context, taskID string, in *TaskPatchInput, userID string) error {
+	// Required fields may be changed but not removed
+	if err := validatePatch(in); err != nil {
+		return err
+	}
+
+	// Find the task first to ensure it exists and the user may change it
+	foundTask, err := s.findAuthorizedTask(ctx, taskID, userID, authz.ActionWrite)
+	if err != nil {
+		return err
+	}
+
+	// Validate the schedule the task ends up with
+	startAt := in.StartAt.Apply(foundTask.StartAt)
+	dueAt := in.DueAt.Apply(foundTask.DueAt)
+	if err := validateSchedule(startAt, dueAt); err != nil {
+		return err
+	}
+
+	// Ensure tags exist and belong to user, null removes them all
+	var tagIDs, previousTagIDs []string
+	if in.TagIDs.Present {
+		requestedTagIDs := []string{}
+		if in.TagIDs.Value != nil {
+			requestedTagIDs = *in.TagIDs.Value
+		}
+
+		tagIDs, err = s.resolveTagIDs(ctx, requestedTagIDs, userID)
+		if err != nil {
+			return err
+		}
+
+		// Keep the current tags to record what changed
+		previousTagIDs, err = s.findPreviousTagIDs(ctx, taskID)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Validate custom field values and keep the current ones to record what changed
+	requestedCustomFields, err := s.requestedCustomFieldValues(ctx, taskID, in.CustomFields)
+	if err != nil {
+		return err
+	}
+
+	customFieldValues, err := s.resolveCustomFieldValues(ctx, foundTask.WorkspaceID, foundTask.ProjectID, requestedCustomFields, false)
+	if err != nil {
+		return err
+	}
+
+	previousCustomFieldValues, err := s.findPreviousCustomFieldValues(ctx, taskID, customFieldValues)
+	if err != nil {
+		return err
+	}
+
+	patch := task.Patch{
+		Title:       in.Title,
+		Description: in.Description,
+		StartAt:     in.StartAt,
+		DueAt:       in.DueAt,
+	}
+	if in.Priority.Value != nil {
+		patch.Priority = patchutil.Set(enums.TaskPriority(*in.Priority.Value))
+	}
+
+	err = s.withinTransaction(ctx, "Failed to update task", func(ctx context.Context) error {
+		// Ensure nobody changed the task since the client read it
+		if err := s.ensureVersion(ctx, taskID, in.Version); err != nil {
+			return err
+		}
+
+		// Update only the columns the patch names
+		if err := s.taskRepo.PatchByID(ctx, taskID, &patch); err != nil {
+			log.Error().
+				Err(err).
+				Str("taskId", taskID).
+				Msg("Failed to patch task")
+
+			return servererr.NewError(
+				servererr.ErrorCodeInternalServerError,
+				"Failed to update task",
+			)
+		}
+
+		if in.TagIDs.Present {
+			if err := s.replaceTaskTags(ctx, taskID, tagIDs); err != nil {
+				return err
+			}
+		}
+
+		if err := s.setCustomFieldValues(ctx, taskID, customFieldValues); err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	patchedTask := *foundTask
+	patchedTask.Title = *in.Title.Apply(&foundTask.Title)
+	patchedTask.Description = *in.Description.Apply(&foundTask.Description)
+	patchedTask.Priority = *patch.Priority.Apply(&foundTask.Priority)
+	patchedTask.StartAt = startAt
+	patchedTask.DueAt = dueAt
+
+	previousFields := append(taskFields(foundTask, previousTagIDs), customFieldActivityFields(previousCustomFieldValues)...)
+	patchedFields := append(taskFields(&patchedTask, tagIDs), customFieldActivityFields(customFieldValues)...)
+	if changes := diffTaskFields(previousFields, patchedFields); len(changes) > 0 {
+		s.recordActivity(ctx, taskID, userID, enums.TaskActivityActionUpdated, changes)
+	}
+
+	return nil
+}
+
+// requestedCustomFieldValues returns the custom field values a patch sets,
+// turning a null customFields member into a null value for every set field
+func (s *service) requestedCustomFieldValues(ctx context.Context, taskID string, values patchutil.Optional[map[string]json.RawMessage]) (map[string]json.RawMessage, error) {
+	if !values.Present {
+		return nil, nil
+	}
+
+	if values.Value != nil {
+		return *values.Value, nil
+	}
+
+	currentValues, err := s.findTaskCustomFieldValues(ctx, taskID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to find task custom field values")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to update task",
+		)
+	}
+
+	cleared := make(map[string]json.RawMessage, len(currentValues))
+	for fieldID := range currentValues {
+		cleared[fieldID] = json.RawMessage("null")
+	}
+
+	return cleared, nil
+}
+
+// validatePatch rejects a patch removing or blanking a field every task must have
+func validatePatch(in *TaskPatchInput) error {
+	if in.Title.IsNull() || (in.Title.Value != nil && strings.TrimSpace(*in.Title.Value) == "") {
+		log.Warn().
+			Msg("Task patch removes the title")
+
+		return servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid title. Title cannot be empty",
+		)
+	}
+
+	if in.Description.IsNull() || (in.Description.Value != nil && *in.Description.Value == "") {
+		log.Warn().
+			Msg("Task patch removes the description")
+
+		return servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid description. Description cannot be empty",
+		)
+	}
+
+	if in.Priority.IsNull() || (in.Priority.Value != nil && (*in.Priority.Value < 1 || *in.Priority.Value > 3)) {
+		log.Warn().
+			Msg("Invalid task priority")
+
+		return servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid priority. Priority must be between 1 and 3",
+		)
+	}
+
+	return nil
+}
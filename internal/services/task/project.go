@@ -0,0 +1,176 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/services/authz"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/rs/zerolog/log"
+)
+
+func (s *service) FindTasksByProjectID(ctx context.Context, projectID string, in *TaskListInput, userID string) (*TaskListOutput, error) {
+	// Ensure project exists and belongs to user
+	if _, err := s.findOwnedProject(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	projectInput := *in
+	projectInput.ProjectID = &projectID
+
+	return s.FindTaskByUserID(ctx, &projectInput, userID)
+}
+
+func (s *service) UpdateTaskProjectByID(ctx context.Context, taskID string, in *TaskUpdateProjectInput, userID string) error {
+	// Find the task first to ensure it exists and the user may change it
+	foundTask, err := s.findAuthorizedTask(ctx, taskID, userID, authz.ActionWrite)
+	if err != nil {
+		return err
+	}
+
+	// Ensure the target project can receive tasks
+	if in.ProjectID != nil {
+		if foundTask.WorkspaceID != nil {
+			return errWorkspaceTaskProject()
+		}
+
+		if _, err := s.findTargetProject(ctx, *in.ProjectID, userID); err != nil {
+			return err
+		}
+	}
+
+	// Values of the old project's custom fields do not follow the task
+	var previousCustomFieldValues, customFieldValues map[string]map[string]json.RawMessage
+	err = s.withinTransaction(ctx, "Failed to update task project", func(ctx context.Context) error {
+		// Ensure nobody changed the task since the client read it
+		if err := s.ensureVersion(ctx, taskID, in.Version); err != nil {
+			return err
+		}
+
+		previousCustomFieldValues, err = s.findCustomFieldValuesByTaskIDs(ctx, []string{taskID})
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("taskId", taskID).
+				Msg("Failed to find task custom field values")
+
+			return servererr.NewError(
+				servererr.ErrorCodeInternalServerError,
+				"Failed to update task project",
+			)
+		}
+
+		if err := s.taskRepo.UpdateProjectByID(ctx, taskID, in.ProjectID); err != nil {
+			log.Error().
+				Err(err).
+				Str("taskId", taskID).
+				Msg("Failed to update task project")
+
+			return servererr.NewError(
+				servererr.ErrorCodeInternalServerError,
+				"Failed to update task project",
+			)
+		}
+
+		customFieldValues, err = s.removeStaleCustomFieldValues(ctx, []string{taskID}, "Failed to update task project")
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	movedTask := *foundTask
+	movedTask.ProjectID = in.ProjectID
+	previousFields := append(taskFields(foundTask, nil), customFieldActivityFields(previousCustomFieldValues[taskID])...)
+	movedFields := append(taskFields(&movedTask, nil), customFieldActivityFields(customFieldValues[taskID])...)
+	if changes := diffTaskFields(previousFields, movedFields); len(changes) > 0 {
+		s.recordActivity(ctx, taskID, userID, enums.TaskActivityActionUpdated, changes)
+	}
+
+	return nil
+}
+
+// findOwnedProject looks up a project of the user, reporting a missing one as not found
+func (s *service) findOwnedProject(ctx context.Context, projectID string, userID string) (*entities.Project, error) {
+	foundProject, err := s.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("projectId", projectID).
+			Msg("Failed to find project by ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find project",
+		)
+	}
+
+	if foundProject == nil || foundProject.UserID != userID {
+		log.Warn().
+			Str("projectId", projectID).
+			Str("userId", userID).
+			Msg("Project not found")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeNotFound,
+			"Project not found",
+		)
+	}
+
+	return foundProject, nil
+}
+
+// errWorkspaceTaskProject reports an attempt to put a workspace task in a personal project
+func errWorkspaceTaskProject() error {
+	log.Warn().
+		Msg("Workspace task cannot belong to a project")
+
+	return servererr.NewError(
+		servererr.ErrorCodeBadRequest,
+		"Invalid project. Workspace tasks cannot belong to a project",
+	)
+}
+
+// findTargetProject looks up a project a task is being placed in.
+// A missing project is a bad request and an archived one is a conflict.
+func (s *service) findTargetProject(ctx context.Context, projectID string, userID string) (*entities.Project, error) {
+	foundProject, err := s.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("projectId", projectID).
+			Msg("Failed to find project by ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find project",
+		)
+	}
+
+	if foundProject == nil || foundProject.UserID != userID {
+		log.Warn().
+			Str("projectId", projectID).
+			Str("userId", userID).
+			Msg("Project not found")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Project not found",
+		)
+	}
+
+	if foundProject.IsArchived() {
+		log.Warn().
+			Str("projectId", projectID).
+			Msg("Project is archived")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeConflict,
+			"Project is archived",
+		)
+	}
+
+	return foundProject, nil
+}
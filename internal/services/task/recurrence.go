@@ -0,0 +1,412 @@
+package task
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/services/authz"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/rs/zerolog/log"
+	"github.com/teambition/rrule-go"
+)
+
+const (
+	DefaultOccurrencePreview = 5
+	MaxOccurrencePreview     = 50
+)
+
+// recurrenceFrequencies are the RRULE frequencies a task may repeat with
+var recurrenceFrequencies = map[rrule.Frequency]bool{
+	rrule.DAILY:   true,
+	rrule.WEEKLY:  true,
+	rrule.MONTHLY: true,
+	rrule.YEARLY:  true,
+}
+
+func (s *service) SetTaskRecurrence(ctx context.Context, taskID string, in *TaskRecurrenceInput, userID string) error {
+	foundTask, err := s.findAuthorizedTask(ctx, taskID, userID, authz.ActionWrite)
+	if err != nil {
+		return err
+	}
+
+	// The due date is the first occurrence the rule counts from
+	if foundTask.DueAt == nil {
+		log.Warn().
+			Str("taskId", taskID).
+			Msg("Recurring task has no due date")
+
+		return servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Task must have a due date to recur",
+		)
+	}
+
+	rule, err := parseRecurrenceRule(in.Rule, *foundTask.DueAt)
+	if err != nil {
+		return err
+	}
+
+	previousSeries, err := s.findTaskSeries(ctx, foundTask)
+	if err != nil {
+		return err
+	}
+
+	now := timeutil.BangkokNow()
+	newSeries := &entities.TaskSeries{
+		ID:               uuid.NewString(),
+		UserID:           userID,
+		Rule:             rule.OrigOptions.RRuleString(),
+		AnchorAt:         *foundTask.DueAt,
+		LastOccurrenceAt: *foundTask.DueAt,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+
+	// Replacing the rule ends the current series and starts a new one at this task
+	err = s.withinTransaction(ctx, "Failed to update task recurrence", func(ctx context.Context) error {
+		if previousSeries != nil && !previousSeries.IsStopped() {
+			if err := s.taskRepo.StopSeriesByID(ctx, previousSeries.ID); err != nil {
+				return err
+			}
+		}
+
+		if err := s.taskRepo.CreateSeries(ctx, newSeries); err != nil {
+			return err
+		}
+
+		return s.taskRepo.UpdateSeriesIDByID(ctx, taskID, &newSeries.ID)
+	})
+	if err != nil {
+		return err
+	}
+
+	var previousRule any
+	if previousSeries != nil && !previousSeries.IsStopped() {
+		previousRule = previousSeries.Rule
+	}
+
+	s.recordActivity(ctx, taskID, userID, enums.TaskActivityActionUpdated, []entities.TaskFieldChange{
+		{Field: "recurrence", Before: previousRule, After: newSeries.Rule},
+	})
+
+	return nil
+}
+
+func (s *service) FindTaskRecurrence(ctx context.Context, taskID string, in *TaskRecurrencePreviewInput, userID string) (*TaskRecurrenceOutput, error) {
+	count := in.Count
+	if count <= 0 {
+		count = DefaultOccurrencePreview
+	}
+	if count > MaxOccurrencePreview {
+		count = MaxOccurrencePreview
+	}
+
+	foundTask, err := s.findAuthorizedTask(ctx, taskID, userID, authz.ActionRead)
+	if err != nil {
+		return nil, err
+	}
+
+	series, err := s.findTaskSeries(ctx, foundTask)
+	if err != nil {
+		return nil, err
+	}
+
+	if series == nil {
+		log.Warn().
+			Str("taskId", taskID).
+			Msg("Task is not recurring")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeNotFound,
+			"Task is not recurring",
+		)
+	}
+
+	output := &TaskRecurrenceOutput{
+		Series:   series,
+		Upcoming: []entities.TaskOccurrence{},
+	}
+
+	// A stopped series has nothing coming up
+	if series.IsStopped() || foundTask.DueAt == nil {
+		return output, nil
+	}
+
+	rule, err := parseRecurrenceRule(series.Rule, series.AnchorAt)
+	if err != nil {
+		return nil, err
+	}
+
+	dueAt := *foundTask.DueAt
+	for range count {
+		dueAt = rule.After(dueAt, false)
+		if dueAt.IsZero() {
+			break
+		}
+
+		output.Upcoming = append(output.Upcoming, entities.TaskOccurrence{
+			StartAt: shiftStartAt(foundTask, dueAt),
+			DueAt:   dueAt,
+		})
+	}
+
+	return output, nil
+}
+
+func (s *service) StopTaskRecurrence(ctx context.Context, taskID string, userID string) error {
+	foundTask, err := s.findAuthorizedTask(ctx, taskID, userID, authz.ActionWrite)
+	if err != nil {
+		return err
+	}
+
+	series, err := s.findTaskSeries(ctx, foundTask)
+	if err != nil {
+		return err
+	}
+
+	if series == nil || series.IsStopped() {
+		log.Warn().
+			Str("taskId", taskID).
+			Msg("Task has no active recurrence")
+
+		return servererr.NewError(
+			servererr.ErrorCodeConflict,
+			"Task has no active recurrence",
+		)
+	}
+
+	// Occurrences keep their link to the series so its history stays intact
+	if err := s.taskRepo.StopSeriesByID(ctx, series.ID); err != nil {
+		log.Error().
+			Err(err).
+			Str("seriesId", series.ID).
+			Msg("Failed to stop task series")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to stop task recurrence",
+		)
+	}
+
+	s.recordActivity(ctx, taskID, userID, enums.TaskActivityActionUpdated, []entities.TaskFieldChange{
+		{Field: "recurrence", Before: series.Rule, After: nil},
+	})
+
+	return nil
+}
+
+// findTaskSeries returns the series of the task, or nil when it does not recur
+func (s *service) findTaskSeries(ctx context.Context, t *entities.Task) (*entities.TaskSeries, error) {
+	if t.SeriesID == nil {
+		return nil, nil
+	}
+
+	series, err := s.taskRepo.FindSeriesByID(ctx, *t.SeriesID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("seriesId", *t.SeriesID).
+			Msg("Failed to find task series")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find task recurrence",
+		)
+	}
+
+	return series, nil
+}
+
+// spawnNextOccurrence creates the occurrence that follows a completed task of a series
+func (s *service) spawnNextOccurrence(ctx context.Context, completed *entities.Task, userID string) error {
+	series, err := s.findTaskSeries(ctx, completed)
+	if err != nil {
+		return err
+	}
+
+	if series == nil || series.IsStopped() || completed.DueAt == nil {
+		return nil
+	}
+
+	rule, err := parseRecurrenceRule(series.Rule, series.AnchorAt)
+	if err != nil {
+		return err
+	}
+
+	// The rule may have run out through COUNT or UNTIL
+	nextDueAt := rule.After(*completed.DueAt, false)
+	if nextDueAt.IsZero() {
+		return nil
+	}
+
+	// Completing an older occurrence, or completing this one again, must not spawn twice
+	claimed, err := s.taskRepo.ClaimSeriesOccurrence(ctx, series.ID, nextDueAt)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("seriesId", series.ID).
+			Msg("Failed to claim next task occurrence")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to create next occurrence",
+		)
+	}
+
+	if !claimed {
+		return nil
+	}
+
+	// The next occurrence starts over in the initial status of the workflow
+	taskWorkflow, err := s.findWorkflow(ctx, completed.WorkspaceID, completed.ProjectID)
+	if err != nil {
+		return err
+	}
+	initialStatus := taskWorkflow.InitialStatus()
+
+	now := timeutil.BangkokNow()
+	nextTask := &entities.Task{
+		ID:             uuid.NewString(),
+		UserID:         completed.UserID,
+		WorkspaceID:    completed.WorkspaceID,
+		ProjectID:      completed.ProjectID,
+		ParentID:       completed.ParentID,
+		Title:          completed.Title,
+		Description:    completed.Description,
+		Priority:       completed.Priority,
+		Status:         initialStatus.Key,
+		StatusCategory: initialStatus.Category,
+		StartAt:        shiftStartAt(completed, nextDueAt),
+		DueAt:          &nextDueAt,
+		SeriesID:       &series.ID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	nextTask.BoardRank, err = s.rankAtTop(ctx, boardColumnOf(nextTask, nextTask.Status), nextTask.ID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.taskRepo.Create(ctx, nextTask); err != nil {
+		log.Error().
+			Err(err).
+			Str("seriesId", series.ID).
+			Msg("Failed to create next task occurrence")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to create next occurrence",
+		)
+	}
+
+	// The occurrence keeps the tags and assignees of the one before it
+	tagIDs, err := s.findTaskTagIDs(ctx, completed.ID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", completed.ID).
+			Msg("Failed to find task tags")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to create next occurrence",
+		)
+	}
+
+	if len(tagIDs) > 0 {
+		if err := s.replaceTaskTags(ctx, nextTask.ID, tagIDs); err != nil {
+			return err
+		}
+	}
+
+	assigneesByTask, err := s.taskRepo.FindAssigneesByTaskIDs(ctx, []string{completed.ID})
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", completed.ID).
+			Msg("Failed to find task assignees")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to create next occurrence",
+		)
+	}
+
+	for _, assignee := range assigneesByTask[completed.ID] {
+		if err := s.taskRepo.CreateAssignee(ctx, nextTask.ID, assignee.ID); err != nil {
+			log.Error().
+				Err(err).
+				Str("taskId", nextTask.ID).
+				Str("assigneeId", assignee.ID).
+				Msg("Failed to assign next task occurrence")
+
+			return servererr.NewError(
+				servererr.ErrorCodeInternalServerError,
+				"Failed to create next occurrence",
+			)
+		}
+	}
+
+	s.recordActivity(ctx, nextTask.ID, userID, enums.TaskActivityActionCreated, diffTaskFields(nil, taskFields(nextTask, tagIDs)))
+
+	return nil
+}
+
+// parseRecurrenceRule parses a single RRULE line with its occurrences counted from anchorAt
+func parseRecurrenceRule(value string, anchorAt time.Time) (*rrule.RRule, error) {
+	invalidRule := servererr.NewError(
+		servererr.ErrorCodeBadRequest,
+		"Invalid rule. Rule must be an RRULE repeating daily, weekly, monthly or yearly",
+	)
+
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	if value == "" || strings.ContainsAny(value, "\r\n") {
+		log.Warn().
+			Str("rule", value).
+			Msg("Invalid recurrence rule")
+
+		return nil, invalidRule
+	}
+
+	location, _ := timeutil.GetBangkokLocation()
+
+	option, err := rrule.StrToROptionInLocation(value, location)
+	if err != nil || !recurrenceFrequencies[option.Freq] {
+		log.Warn().
+			Err(err).
+			Str("rule", value).
+			Msg("Invalid recurrence rule")
+
+		return nil, invalidRule
+	}
+
+	option.Dtstart = anchorAt.In(location)
+
+	rule, err := rrule.NewRRule(*option)
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("rule", value).
+			Msg("Invalid recurrence rule")
+
+		return nil, invalidRule
+	}
+
+	return rule, nil
+}
+
+// shiftStartAt keeps the gap between a task's start and due dates for an occurrence due at dueAt
+func shiftStartAt(t *entities.Task, dueAt time.Time) *time.Time {
+	if t.StartAt == nil || t.DueAt == nil {
+		return nil
+	}
+
+	startAt := dueAt.Add(t.StartAt.Sub(*t.DueAt))
+	return &startAt
+}
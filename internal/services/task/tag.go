@@ -0,0 +1,139 @@
+package task
+
+import (
+	"context"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	TagModeAnd = "and"
+	TagModeOr  = "or"
+)
+
+// resolveTagIDs removes duplicates and ensures every tag exists and belongs to the user
+func (s *service) resolveTagIDs(ctx context.Context, tagIDs []string, userID string) ([]string, error) {
+	uniqueIDs := uniqueStrings(tagIDs)
+	if len(uniqueIDs) == 0 {
+		return uniqueIDs, nil
+	}
+
+	tags, err := s.tagRepo.FindByIDs(ctx, uniqueIDs)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to find tags by IDs")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find tags",
+		)
+	}
+
+	owned := 0
+	for _, tag := range tags {
+		if tag.UserID == userID {
+			owned++
+		}
+	}
+
+	if owned != len(uniqueIDs) {
+		log.Warn().
+			Strs("tagIds", uniqueIDs).
+			Str("userId", userID).
+			Msg("Tag not found")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid tagIds. Tags must exist and belong to the user",
+		)
+	}
+
+	return uniqueIDs, nil
+}
+
+func (s *service) replaceTaskTags(ctx context.Context, taskID string, tagIDs []string) error {
+	if err := s.taskRepo.ReplaceTags(ctx, taskID, tagIDs); err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to replace task tags")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to update task tags",
+		)
+	}
+
+	return nil
+}
+
+// attachTags fills in the tags of each task
+func (s *service) attachTags(ctx context.Context, tasks []entities.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	taskIDs := make([]string, len(tasks))
+	for i, t := range tasks {
+		taskIDs[i] = t.ID
+	}
+
+	tags, err := s.taskRepo.FindTagsByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to find task tags")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find task tags",
+		)
+	}
+
+	for i := range tasks {
+		tasks[i].Tags = tags[tasks[i].ID]
+		if tasks[i].Tags == nil {
+			tasks[i].Tags = []entities.Tag{}
+		}
+	}
+
+	return nil
+}
+
+// enrichTasks attaches the computed fields returned alongside tasks
+func (s *service) enrichTasks(ctx context.Context, tasks []entities.Task) error {
+	if err := s.attachSubtaskRollups(ctx, tasks); err != nil {
+		return err
+	}
+
+	if err := s.attachTags(ctx, tasks); err != nil {
+		return err
+	}
+
+	if err := s.attachLoggedTime(ctx, tasks); err != nil {
+		return err
+	}
+
+	if err := s.attachCustomFields(ctx, tasks); err != nil {
+		return err
+	}
+
+	return s.attachPeople(ctx, tasks)
+}
+
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	unique := make([]string, 0, len(values))
+	for _, value := range values {
+		if _, ok := seen[value]; ok {
+			continue
+		}
+		seen[value] = struct{}{}
+		unique = append(unique, value)
+	}
+
+	return unique
+}
@@ -0,0 +1,39 @@
+package task
+
+import (
+	"context"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/rs/zerolog/log"
+)
+
+// attachLoggedTime fills in the total logged time of each task
+func (s *service) attachLoggedTime(ctx context.Context, tasks []entities.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	taskIDs := make([]string, len(tasks))
+	for i, t := range tasks {
+		taskIDs[i] = t.ID
+	}
+
+	seconds, err := s.taskRepo.SumLoggedSecondsByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to sum task logged time")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find task logged time",
+		)
+	}
+
+	for i := range tasks {
+		tasks[i].LoggedSeconds = seconds[tasks[i].ID]
+	}
+
+	return nil
+}
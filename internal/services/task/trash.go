@@ -0,0 +1,155 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/services/authz"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/rs/zerolog/log"
+)
+
+func (s *service) FindTrashedTasks(ctx context.Context, in *TaskListInput, userID string) (*TaskListOutput, error) {
+	// The trash holds the user's personal tasks and those of their workspaces
+	trashInput := *in
+	trashInput.WorkspaceID = nil
+	trashInput.IncludeWorkspaces = true
+	trashInput.Deleted = true
+
+	return s.FindTaskByUserID(ctx, &trashInput, userID)
+}
+
+func (s *service) RestoreTaskByID(ctx context.Context, taskID string, userID string) error {
+	// Find the trashed task first to ensure it exists and the user may change it
+	trashedTask, err := s.findTrashedTask(ctx, taskID, userID)
+	if err != nil {
+		return err
+	}
+
+	// A subtask can only come back under a live parent
+	if trashedTask.ParentID != nil {
+		parent, err := s.taskRepo.FindByID(ctx, *trashedTask.ParentID)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("parentId", *trashedTask.ParentID).
+				Msg("Failed to find parent task")
+
+			return servererr.NewError(
+				servererr.ErrorCodeInternalServerError,
+				"Failed to restore task",
+			)
+		}
+
+		if parent == nil {
+			log.Warn().
+				Str("taskId", taskID).
+				Str("parentId", *trashedTask.ParentID).
+				Msg("Parent task is in the trash")
+
+			return servererr.NewError(
+				servererr.ErrorCodeConflict,
+				"Parent task is in the trash. Restore the parent task first",
+			)
+		}
+	}
+
+	if err := s.taskRepo.RestoreByID(ctx, taskID); err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to restore task")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to restore task",
+		)
+	}
+
+	s.recordActivity(ctx, taskID, userID, enums.TaskActivityActionRestored, nil)
+
+	return nil
+}
+
+func (s *service) PurgeTaskByID(ctx context.Context, taskID string, userID string) error {
+	// Only tasks already in the trash can be deleted permanently
+	if _, err := s.findTrashedTask(ctx, taskID, userID); err != nil {
+		return err
+	}
+
+	contentHashes, err := s.taskRepo.PurgeByID(ctx, taskID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to purge task")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to delete task permanently",
+		)
+	}
+
+	// The attachments went with the tasks, their content is left to clean up
+	s.attachmentService.DeleteUnusedContent(ctx, contentHashes)
+
+	s.recordActivity(ctx, taskID, userID, enums.TaskActivityActionPurged, nil)
+
+	return nil
+}
+
+func (s *service) PurgeExpiredTrash(ctx context.Context, now time.Time) (int64, error) {
+	before := now.Add(-s.config.Task.TrashRetention)
+
+	purged, contentHashes, err := s.taskRepo.PurgeDeletedBefore(ctx, before)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Time("before", before).
+			Msg("Failed to purge expired trash")
+
+		return 0, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to purge expired trash",
+		)
+	}
+
+	s.attachmentService.DeleteUnusedContent(ctx, contentHashes)
+
+	return purged, nil
+}
+
+// findTrashedTask looks up a task in the trash the user may change
+func (s *service) findTrashedTask(ctx context.Context, taskID string, userID string) (*entities.Task, error) {
+	trashedTask, err := s.taskRepo.FindDeletedByID(ctx, taskID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to find trashed task by ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find task",
+		)
+	}
+
+	if trashedTask == nil {
+		log.Warn().
+			Str("taskId", taskID).
+			Msg("Task not found in trash")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeNotFound,
+			"Task not found in trash",
+		)
+	}
+
+	if err := s.policy.AuthorizeTask(ctx, trashedTask, userID, authz.ActionWrite); err != nil {
+		return nil, err
+	}
+
+	return trashedTask, nil
+}
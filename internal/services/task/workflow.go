@@ -0,0 +1,96 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/rs/zerolog/log"
+)
+
+// findWorkflow returns the workflow configured where a task lives: its workspace, or its
+// project for personal tasks. The default workflow applies when none is configured.
+func (s *service) findWorkflow(ctx context.Context, workspaceID, projectID *string) (*entities.Workflow, error) {
+	var workflow *entities.Workflow
+	var err error
+
+	switch {
+	case workspaceID != nil:
+		workflow, err = s.workflowRepo.FindByWorkspaceID(ctx, *workspaceID)
+	case projectID != nil:
+		workflow, err = s.workflowRepo.FindByProjectID(ctx, *projectID)
+	}
+
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to find workflow")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find workflow",
+		)
+	}
+
+	if workflow == nil {
+		return entities.DefaultWorkflow(), nil
+	}
+
+	return workflow, nil
+}
+
+// resolveStatusTransition finds the target status in the workflow of a task and ensures
+// the workflow allows moving the task there from its current status
+func resolveStatusTransition(workflow *entities.Workflow, foundTask *entities.Task, status enums.TaskStatus) (*entities.WorkflowStatus, error) {
+	target := workflow.FindStatus(status)
+	if target == nil {
+		log.Warn().
+			Str("taskId", foundTask.ID).
+			Str("status", status.String()).
+			Msg("Status is not part of the task workflow")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			fmt.Sprintf("Invalid status. Status must be one of: %s", joinStatuses(workflowStatusKeys(workflow))),
+		)
+	}
+
+	if foundTask.Status == status || workflow.CanTransition(foundTask.Status, status) {
+		return target, nil
+	}
+
+	log.Warn().
+		Str("taskId", foundTask.ID).
+		Str("from", foundTask.Status.String()).
+		Str("to", status.String()).
+		Msg("Status transition is not allowed")
+
+	allowed := "none"
+	if next := workflow.NextStatuses(foundTask.Status); len(next) > 0 {
+		allowed = joinStatuses(next)
+	}
+
+	return nil, servererr.NewError(
+		servererr.ErrorCodeInvalidTransition,
+		fmt.Sprintf("Task cannot move from %s to %s. Allowed next statuses: %s", foundTask.Status, status, allowed),
+	)
+}
+
+func workflowStatusKeys(workflow *entities.Workflow) []enums.TaskStatus {
+	keys := make([]enums.TaskStatus, len(workflow.Statuses))
+	for i, status := range workflow.Statuses {
+		keys[i] = status.Key
+	}
+	return keys
+}
+
+func joinStatuses(statuses []enums.TaskStatus) string {
+	names := make([]string, len(statuses))
+	for i, status := range statuses {
+		names[i] = status.String()
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,192 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/services/authz"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/rs/zerolog/log"
+)
+
+func (s *service) FindTasksByWorkspaceID(ctx context.Context, workspaceID string, in *TaskListInput, userID string) (*TaskListOutput, error) {
+	// Any member may list the workspace's tasks
+	if _, err := s.policy.AuthorizeWorkspace(ctx, workspaceID, userID, authz.ActionRead); err != nil {
+		return nil, err
+	}
+
+	workspaceInput := *in
+	workspaceInput.WorkspaceID = &workspaceID
+	workspaceInput.ProjectID = nil
+
+	return s.FindTaskByUserID(ctx, &workspaceInput, userID)
+}
+
+func (s *service) UpdateTaskWorkspaceByID(ctx context.Context, taskID string, in *TaskUpdateWorkspaceInput, userID string) error {
+	// Find the task first to ensure it exists and the user may change it
+	foundTask, err := s.findAuthorizedTask(ctx, taskID, userID, authz.ActionWrite)
+	if err != nil {
+		return err
+	}
+
+	if sameWorkspace(foundTask.WorkspaceID, in.WorkspaceID) {
+		return nil
+	}
+
+	// Subtasks always follow their parent, so only whole trees are moved
+	if foundTask.ParentID != nil {
+		log.Warn().
+			Str("taskId", taskID).
+			Msg("Subtask cannot be moved to another workspace")
+
+		return servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Only top-level tasks can be moved to another workspace",
+		)
+	}
+
+	if in.WorkspaceID != nil {
+		// Ensure the user may create tasks in the target workspace
+		if _, err := s.policy.AuthorizeWorkspace(ctx, *in.WorkspaceID, userID, authz.ActionWrite); err != nil {
+			return err
+		}
+	} else if foundTask.UserID != userID {
+		// Tasks leaving a workspace become personal tasks of their creator
+		log.Warn().
+			Str("taskId", taskID).
+			Str("userId", userID).
+			Msg("Only the task creator can move it out of a workspace")
+
+		return servererr.NewError(
+			servererr.ErrorCodeForbidden,
+			"Only the task creator can move it out of a workspace",
+		)
+	}
+
+	// The subtasks move along, so record what changed for each of them.
+	// Values of custom fields defined at the old place do not follow the tasks.
+	var subtree []entities.Task
+	var previousAssigneeIDs, assigneeIDs map[string][]string
+	var previousCustomFieldValues, customFieldValues map[string]map[string]json.RawMessage
+	err = s.withinTransaction(ctx, "Failed to update task workspace", func(ctx context.Context) error {
+		// Ensure nobody changed the task since the client read it
+		if err := s.ensureVersion(ctx, taskID, in.Version); err != nil {
+			return err
+		}
+
+		subtree, previousAssigneeIDs, err = s.findSubtreeAssigneeIDs(ctx, taskID)
+		if err != nil {
+			return err
+		}
+
+		subtreeIDs := make([]string, len(subtree))
+		for i := range subtree {
+			subtreeIDs[i] = subtree[i].ID
+		}
+
+		previousCustomFieldValues, err = s.findCustomFieldValuesByTaskIDs(ctx, subtreeIDs)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("taskId", taskID).
+				Msg("Failed to find task custom field values")
+
+			return servererr.NewError(
+				servererr.ErrorCodeInternalServerError,
+				"Failed to update task workspace",
+			)
+		}
+
+		if err := s.taskRepo.UpdateWorkspaceByID(ctx, taskID, in.WorkspaceID); err != nil {
+			log.Error().
+				Err(err).
+				Str("taskId", taskID).
+				Msg("Failed to update task workspace")
+
+			return servererr.NewError(
+				servererr.ErrorCodeInternalServerError,
+				"Failed to update task workspace",
+			)
+		}
+
+		customFieldValues, err = s.removeStaleCustomFieldValues(ctx, subtreeIDs, "Failed to update task workspace")
+		if err != nil {
+			return err
+		}
+
+		// Assignees who cannot see the tasks in their new place were removed
+		_, assigneeIDs, err = s.findSubtreeAssigneeIDs(ctx, taskID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for i := range subtree {
+		previousTask := &subtree[i]
+		movedTask := *previousTask
+		movedTask.WorkspaceID = in.WorkspaceID
+		if in.WorkspaceID != nil {
+			movedTask.ProjectID = nil
+		}
+
+		previousFields := append(taskFields(previousTask, nil), assigneeFields(previousAssigneeIDs[previousTask.ID])...)
+		previousFields = append(previousFields, customFieldActivityFields(previousCustomFieldValues[previousTask.ID])...)
+		movedFields := append(taskFields(&movedTask, nil), assigneeFields(assigneeIDs[previousTask.ID])...)
+		movedFields = append(movedFields, customFieldActivityFields(customFieldValues[previousTask.ID])...)
+		if changes := diffTaskFields(previousFields, movedFields); len(changes) > 0 {
+			s.recordActivity(ctx, previousTask.ID, userID, enums.TaskActivityActionUpdated, changes)
+		}
+	}
+
+	return nil
+}
+
+// findSubtreeAssigneeIDs returns the task with its descendants and the
+// sorted assignee IDs of each of them
+func (s *service) findSubtreeAssigneeIDs(ctx context.Context, taskID string) ([]entities.Task, map[string][]string, error) {
+	subtree, err := s.taskRepo.FindSubtreeByID(ctx, taskID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to find task subtree")
+
+		return nil, nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to update task workspace",
+		)
+	}
+
+	taskIDs := make([]string, len(subtree))
+	for i := range subtree {
+		taskIDs[i] = subtree[i].ID
+	}
+
+	assigneeIDs, err := s.findAssigneeIDsByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to find task assignees")
+
+		return nil, nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to update task workspace",
+		)
+	}
+
+	return subtree, assigneeIDs, nil
+}
+
+// sameWorkspace reports whether two optional workspace IDs refer to the same
+// workspace, where nil stands for the personal space
+func sameWorkspace(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return *a == *b
+}
@@ -0,0 +1,421 @@
+package timeentry
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/config"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/timeentry"
+	"github.com/graphzc/sdd-task-management-example/internal/services/task"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/rs/zerolog/log"
+)
+
+// MaxReportRange is the longest period a time report covers
+const MaxReportRange = 366 * 24 * time.Hour
+
+type Service interface {
+	StartTimer(ctx context.Context, taskID string, in *TimerInput, userID string) (*entities.TimeEntry, error)
+	StopTimer(ctx context.Context, taskID string, in *TimerInput, userID string) (*entities.TimeEntry, error)
+	CreateTimeEntry(ctx context.Context, taskID string, in *TimeEntryInput, userID string) (*entities.TimeEntry, error)
+	FindTimeEntriesByTaskID(ctx context.Context, taskID string, userID string) ([]entities.TimeEntry, error)
+	UpdateTimeEntryByID(ctx context.Context, taskID string, entryID string, in *TimeEntryInput, userID string) error
+	DeleteTimeEntryByID(ctx context.Context, taskID string, entryID string, userID string) error
+	GetTimeReport(ctx context.Context, in *TimeReportInput, userID string) (*TimeReportOutput, error)
+}
+
+type service struct {
+	config        *config.Config
+	timeEntryRepo timeentry.Repository
+	taskService   task.Service
+}
+
+// @WireSet("Service")
+func NewService(
+	config *config.Config,
+	timeEntryRepo timeentry.Repository,
+	taskService task.Service,
+) Service {
+	return &service{
+		config:        config,
+		timeEntryRepo: timeEntryRepo,
+		taskService:   taskService,
+	}
+}
+
+func (s *service) StartTimer(ctx context.Context, taskID string, in *TimerInput, userID string) (*entities.TimeEntry, error) {
+	// Anyone who can see the task can log time on it
+	if _, err := s.taskService.FindTaskByID(ctx, taskID, userID); err != nil {
+		return nil, err
+	}
+
+	now := timeutil.BangkokNow()
+	newEntry := &entities.TimeEntry{
+		ID:        uuid.NewString(),
+		TaskID:    taskID,
+		UserID:    userID,
+		StartedAt: now,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if in.Note != nil {
+		newEntry.Note = strings.TrimSpace(*in.Note)
+	}
+
+	if err := s.timeEntryRepo.Create(ctx, newEntry); err != nil {
+		if errors.Is(err, timeentry.ErrNoRowsAffected) {
+			log.Warn().
+				Str("userId", userID).
+				Msg("User already has a running timer")
+
+			return nil, servererr.NewError(
+				servererr.ErrorCodeConflict,
+				"A timer is already running. Stop it before starting another one",
+			)
+		}
+
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to start timer")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to start timer",
+		)
+	}
+
+	return s.findCreatedEntry(ctx, newEntry.ID, "Failed to start timer")
+}
+
+func (s *service) StopTimer(ctx context.Context, taskID string, in *TimerInput, userID string) (*entities.TimeEntry, error) {
+	if _, err := s.taskService.FindTaskByID(ctx, taskID, userID); err != nil {
+		return nil, err
+	}
+
+	runningEntry, err := s.timeEntryRepo.FindRunningByUserID(ctx, userID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("userId", userID).
+			Msg("Failed to find running timer")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to stop timer",
+		)
+	}
+
+	if runningEntry == nil || runningEntry.TaskID != taskID {
+		log.Warn().
+			Str("taskId", taskID).
+			Str("userId", userID).
+			Msg("No timer is running on the task")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeConflict,
+			"No timer is running on the task",
+		)
+	}
+
+	var note *string
+	if in.Note != nil {
+		trimmed := strings.TrimSpace(*in.Note)
+		note = &trimmed
+	}
+
+	if err := s.timeEntryRepo.StopByID(ctx, runningEntry.ID, timeutil.BangkokNow(), note); err != nil {
+		log.Error().
+			Err(err).
+			Str("entryId", runningEntry.ID).
+			Msg("Failed to stop timer")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to stop timer",
+		)
+	}
+
+	return s.findCreatedEntry(ctx, runningEntry.ID, "Failed to stop timer")
+}
+
+func (s *service) CreateTimeEntry(ctx context.Context, taskID string, in *TimeEntryInput, userID string) (*entities.TimeEntry, error) {
+	if err := validateTimeRange(in.StartedAt, in.EndedAt); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.taskService.FindTaskByID(ctx, taskID, userID); err != nil {
+		return nil, err
+	}
+
+	now := timeutil.BangkokNow()
+	endedAt := in.EndedAt
+	newEntry := &entities.TimeEntry{
+		ID:        uuid.NewString(),
+		TaskID:    taskID,
+		UserID:    userID,
+		StartedAt: in.StartedAt,
+		EndedAt:   &endedAt,
+		Note:      strings.TrimSpace(in.Note),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := s.timeEntryRepo.Create(ctx, newEntry); err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to create time entry")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to create time entry",
+		)
+	}
+
+	return s.findCreatedEntry(ctx, newEntry.ID, "Failed to create time entry")
+}
+
+func (s *service) FindTimeEntriesByTaskID(ctx context.Context, taskID string, userID string) ([]entities.TimeEntry, error) {
+	// Find the task first to ensure it exists and is visible to the user
+	if _, err := s.taskService.FindTaskByID(ctx, taskID, userID); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.timeEntryRepo.FindByTaskID(ctx, taskID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", taskID).
+			Msg("Failed to find time entries by task ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find time entries",
+		)
+	}
+
+	return entries, nil
+}
+
+func (s *service) UpdateTimeEntryByID(ctx context.Context, taskID string, entryID string, in *TimeEntryInput, userID string) error {
+	if err := validateTimeRange(in.StartedAt, in.EndedAt); err != nil {
+		return err
+	}
+
+	foundEntry, err := s.findOwnEntry(ctx, taskID, entryID, userID)
+	if err != nil {
+		return err
+	}
+
+	if foundEntry.IsRunning() {
+		log.Warn().
+			Str("entryId", entryID).
+			Msg("Time entry is a running timer")
+
+		return servererr.NewError(
+			servererr.ErrorCodeConflict,
+			"Stop the timer before editing the time entry",
+		)
+	}
+
+	if err := s.timeEntryRepo.UpdateByID(ctx, entryID, in.StartedAt, in.EndedAt, strings.TrimSpace(in.Note)); err != nil {
+		log.Error().
+			Err(err).
+			Str("entryId", entryID).
+			Msg("Failed to update time entry")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to update time entry",
+		)
+	}
+
+	return nil
+}
+
+func (s *service) DeleteTimeEntryByID(ctx context.Context, taskID string, entryID string, userID string) error {
+	if _, err := s.findOwnEntry(ctx, taskID, entryID, userID); err != nil {
+		return err
+	}
+
+	if err := s.timeEntryRepo.DeleteByID(ctx, entryID); err != nil {
+		log.Error().
+			Err(err).
+			Str("entryId", entryID).
+			Msg("Failed to delete time entry")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to delete time entry",
+		)
+	}
+
+	return nil
+}
+
+func (s *service) GetTimeReport(ctx context.Context, in *TimeReportInput, userID string) (*TimeReportOutput, error) {
+	groupBy := enums.TimeReportGroupBy(in.GroupBy)
+	if groupBy == "" {
+		groupBy = enums.TimeReportGroupByDay
+	}
+
+	if groupBy != enums.TimeReportGroupByDay && groupBy != enums.TimeReportGroupByProject && groupBy != enums.TimeReportGroupByTag {
+		log.Warn().
+			Str("groupBy", in.GroupBy).
+			Msg("Invalid time report grouping")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid groupBy. Group must be day, project, or tag",
+		)
+	}
+
+	if !in.From.Before(in.To) || in.To.Sub(in.From) > MaxReportRange {
+		log.Warn().
+			Time("from", in.From).
+			Time("to", in.To).
+			Msg("Invalid time report range")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid date range. From must be before to and the range at most 366 days",
+		)
+	}
+
+	rows, err := s.timeEntryRepo.Report(ctx, userID, in.From, in.To, groupBy)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("userId", userID).
+			Str("groupBy", groupBy.String()).
+			Msg("Failed to build time report")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to build time report",
+		)
+	}
+
+	// Summed separately since an entry counts toward every tag of its task
+	totalSeconds, err := s.timeEntryRepo.SumSeconds(ctx, userID, in.From, in.To)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("userId", userID).
+			Msg("Failed to sum logged time")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to build time report",
+		)
+	}
+
+	return &TimeReportOutput{
+		From:         in.From,
+		To:           in.To,
+		GroupBy:      groupBy,
+		Rows:         rows,
+		TotalSeconds: totalSeconds,
+	}, nil
+}
+
+// findCreatedEntry reads an entry back to fill in its user
+func (s *service) findCreatedEntry(ctx context.Context, entryID string, message string) (*entities.TimeEntry, error) {
+	entry, err := s.timeEntryRepo.FindByID(ctx, entryID)
+	if err != nil || entry == nil {
+		log.Error().
+			Err(err).
+			Str("entryId", entryID).
+			Msg("Failed to find time entry")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			message,
+		)
+	}
+
+	return entry, nil
+}
+
+// findOwnEntry looks up a time entry of a task visible to the user,
+// rejecting users other than the one who logged it
+func (s *service) findOwnEntry(ctx context.Context, taskID string, entryID string, userID string) (*entities.TimeEntry, error) {
+	if _, err := s.taskService.FindTaskByID(ctx, taskID, userID); err != nil {
+		return nil, err
+	}
+
+	foundEntry, err := s.timeEntryRepo.FindByID(ctx, entryID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("entryId", entryID).
+			Msg("Failed to find time entry by ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find time entry",
+		)
+	}
+
+	if foundEntry == nil || foundEntry.TaskID != taskID {
+		log.Warn().
+			Str("taskId", taskID).
+			Str("entryId", entryID).
+			Msg("Time entry not found")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeNotFound,
+			"Time entry not found",
+		)
+	}
+
+	if foundEntry.UserID != userID {
+		log.Warn().
+			Str("entryId", entryID).
+			Str("userId", userID).
+			Msg("User did not log the time entry")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeForbidden,
+			"Only the user who logged the time can change it",
+		)
+	}
+
+	return foundEntry, nil
+}
+
+// validateTimeRange checks that a manual entry ends after it starts and not in the future
+func validateTimeRange(startedAt, endedAt time.Time) error {
+	if !startedAt.Before(endedAt) {
+		log.Warn().
+			Time("startedAt", startedAt).
+			Time("endedAt", endedAt).
+			Msg("Time entry does not end after it starts")
+
+		return servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid time range. End time must be after start time",
+		)
+	}
+
+	if endedAt.After(timeutil.BangkokNow()) {
+		log.Warn().
+			Time("endedAt", endedAt).
+			Msg("Time entry ends in the future")
+
+		return servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid time range. End time must not be in the future",
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package timeentry
+
+import (
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+)
+
+type TimerInput struct {
+	// Note replaces the note of the timer when given
+	Note *string
+}
+
+type TimeEntryInput struct {
+	StartedAt time.Time
+	EndedAt   time.Time
+	Note      string
+}
+
+type TimeReportInput struct {
+	From    time.Time
+	To      time.Time
+	GroupBy string
+}
+
+type TimeReportOutput struct {
+	From         time.Time
+	To           time.Time
+	GroupBy      enums.TimeReportGroupBy
+	Rows         []entities.TimeReportRow
+	TotalSeconds int64
+}
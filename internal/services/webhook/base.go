@@ -0,0 +1,373 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/config"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/task"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/webhook"
+	"github.com/graphzc/sdd-task-management-example/internal/services/authz"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/webhookutil"
+	"github.com/rs/zerolog/log"
+)
+
+// secretBytes is the amount of randomness in a generated secret
+const secretBytes = 32
+
+// Service manages webhooks and delivers task events to them. Personal webhooks
+// belong to their owner, workspace webhooks are managed by workspace admins.
+type Service interface {
+	CreateWebhook(ctx context.Context, in *WebhookCreateInput, userID string) (*entities.Webhook, error)
+	FindWebhooksByUserID(ctx context.Context, userID string) ([]entities.Webhook, error)
+	CreateWorkspaceWebhook(ctx context.Context, workspaceID string, in *WebhookCreateInput, userID string) (*entities.Webhook, error)
+	FindWebhooksByWorkspaceID(ctx context.Context, workspaceID string, userID string) ([]entities.Webhook, error)
+	FindWebhookByID(ctx context.Context, webhookID string, userID string) (*entities.Webhook, error)
+	UpdateWebhookByID(ctx context.Context, webhookID string, in *WebhookUpdateInput, userID string) error
+	DeleteWebhookByID(ctx context.Context, webhookID string, userID string) error
+
+	// Deliveries
+	FindDeliveriesByWebhookID(ctx context.Context, webhookID string, in *WebhookDeliveryListInput, userID string) (*WebhookDeliveryListOutput, error)
+	RedeliverByID(ctx context.Context, webhookID string, deliveryID string, userID string) (*entities.WebhookDelivery, error)
+	PublishTaskEvent(ctx context.Context, activity *entities.TaskActivity)
+	DeliverDue(ctx context.Context, now time.Time) (int, error)
+}
+
+type service struct {
+	config      *config.Config
+	webhookRepo webhook.Repository
+	taskRepo    task.Repository
+	policy      authz.Policy
+	client      *http.Client
+}
+
+// @WireSet("Service")
+func NewService(
+	config *config.Config,
+	webhookRepo webhook.Repository,
+	taskRepo task.Repository,
+	policy authz.Policy,
+) Service {
+	return &service{
+		config:      config,
+		webhookRepo: webhookRepo,
+		taskRepo:    taskRepo,
+		policy:      policy,
+		client:      webhookutil.NewClient(config.Webhook.Timeout),
+	}
+}
+
+func (s *service) CreateWebhook(ctx context.Context, in *WebhookCreateInput, userID string) (*entities.Webhook, error) {
+	return s.createWebhook(ctx, &entities.Webhook{UserID: userID}, in)
+}
+
+func (s *service) FindWebhooksByUserID(ctx context.Context, userID string) ([]entities.Webhook, error) {
+	webhooks, err := s.webhookRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("userId", userID).
+			Msg("Failed to find webhooks by user ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find webhooks",
+		)
+	}
+
+	return webhooks, nil
+}
+
+func (s *service) CreateWorkspaceWebhook(ctx context.Context, workspaceID string, in *WebhookCreateInput, userID string) (*entities.Webhook, error) {
+	// Only workspace admins send workspace events out
+	if _, err := s.policy.AuthorizeWorkspace(ctx, workspaceID, userID, authz.ActionManage); err != nil {
+		return nil, err
+	}
+
+	return s.createWebhook(ctx, &entities.Webhook{UserID: userID, WorkspaceID: &workspaceID}, in)
+}
+
+func (s *service) FindWebhooksByWorkspaceID(ctx context.Context, workspaceID string, userID string) ([]entities.Webhook, error) {
+	if _, err := s.policy.AuthorizeWorkspace(ctx, workspaceID, userID, authz.ActionManage); err != nil {
+		return nil, err
+	}
+
+	webhooks, err := s.webhookRepo.FindByWorkspaceID(ctx, workspaceID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("workspaceId", workspaceID).
+			Msg("Failed to find webhooks by workspace ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find webhooks",
+		)
+	}
+
+	return webhooks, nil
+}
+
+func (s *service) FindWebhookByID(ctx context.Context, webhookID string, userID string) (*entities.Webhook, error) {
+	return s.findAuthorizedWebhook(ctx, webhookID, userID)
+}
+
+func (s *service) UpdateWebhookByID(ctx context.Context, webhookID string, in *WebhookUpdateInput, userID string) error {
+	foundWebhook, err := s.findAuthorizedWebhook(ctx, webhookID, userID)
+	if err != nil {
+		return err
+	}
+
+	webhookURL, err := validateURL(ctx, in.URL)
+	if err != nil {
+		return err
+	}
+
+	events, err := validateEvents(in.Events)
+	if err != nil {
+		return err
+	}
+
+	updatedWebhook := *foundWebhook
+	updatedWebhook.URL = webhookURL
+	updatedWebhook.Events = events
+	updatedWebhook.UpdatedAt = timeutil.BangkokNow()
+	if in.Secret != nil {
+		updatedWebhook.Secret = *in.Secret
+	}
+
+	// Turning a webhook back on gives it a clean slate
+	if in.Enabled && !foundWebhook.Enabled {
+		updatedWebhook.ConsecutiveFailures = 0
+		updatedWebhook.DisabledAt = nil
+	}
+	updatedWebhook.Enabled = in.Enabled
+
+	if err := s.webhookRepo.UpdateByID(ctx, &updatedWebhook); err != nil {
+		log.Error().
+			Err(err).
+			Str("webhookId", webhookID).
+			Msg("Failed to update webhook")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to update webhook",
+		)
+	}
+
+	return nil
+}
+
+func (s *service) DeleteWebhookByID(ctx context.Context, webhookID string, userID string) error {
+	if _, err := s.findAuthorizedWebhook(ctx, webhookID, userID); err != nil {
+		return err
+	}
+
+	// Deliveries of the webhook are removed along with it
+	if err := s.webhookRepo.DeleteByID(ctx, webhookID); err != nil {
+		log.Error().
+			Err(err).
+			Str("webhookId", webhookID).
+			Msg("Failed to delete webhook")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to delete webhook",
+		)
+	}
+
+	return nil
+}
+
+// createWebhook fills in and saves a webhook whose owner and scope are already set
+func (s *service) createWebhook(ctx context.Context, newWebhook *entities.Webhook, in *WebhookCreateInput) (*entities.Webhook, error) {
+	webhookURL, err := validateURL(ctx, in.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := validateEvents(in.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := ""
+	if in.Secret != nil {
+		secret = *in.Secret
+	} else if secret, err = generateSecret(); err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to generate webhook secret")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to create webhook",
+		)
+	}
+
+	newWebhook.ID = uuid.NewString()
+	newWebhook.URL = webhookURL
+	newWebhook.Secret = secret
+	newWebhook.Events = events
+	newWebhook.Enabled = true
+	newWebhook.CreatedAt = timeutil.BangkokNow()
+	newWebhook.UpdatedAt = timeutil.BangkokNow()
+
+	if err := s.webhookRepo.Create(ctx, newWebhook); err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to create webhook")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to create webhook",
+		)
+	}
+
+	return newWebhook, nil
+}
+
+// findAuthorizedWebhook loads a webhook the user may manage
+func (s *service) findAuthorizedWebhook(ctx context.Context, webhookID string, userID string) (*entities.Webhook, error) {
+	foundWebhook, err := s.webhookRepo.FindByID(ctx, webhookID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("webhookId", webhookID).
+			Msg("Failed to find webhook by ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find webhook",
+		)
+	}
+
+	if foundWebhook == nil {
+		log.Warn().
+			Str("webhookId", webhookID).
+			Msg("Webhook not found")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeNotFound,
+			"Webhook not found",
+		)
+	}
+
+	if foundWebhook.WorkspaceID != nil {
+		if _, err := s.policy.AuthorizeWorkspace(ctx, *foundWebhook.WorkspaceID, userID, authz.ActionManage); err != nil {
+			return nil, err
+		}
+		return foundWebhook, nil
+	}
+
+	// Personal webhooks are only visible to their owner
+	if foundWebhook.UserID != userID {
+		log.Warn().
+			Str("webhookId", webhookID).
+			Str("userId", userID).
+			Msg("Webhook does not belong to user")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeNotFound,
+			"Webhook not found",
+		)
+	}
+
+	return foundWebhook, nil
+}
+
+// validateURL checks deliveries can be posted to the URL and that its host
+// is on the public internet rather than the server's own network
+func validateURL(ctx context.Context, rawURL string) (string, error) {
+	rawURL = strings.TrimSpace(rawURL)
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Hostname() == "" {
+		log.Warn().
+			Str("url", rawURL).
+			Msg("Invalid webhook URL")
+
+		return "", servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid URL. URL must be an absolute http or https URL",
+		)
+	}
+
+	if err := webhookutil.CheckHost(ctx, net.DefaultResolver, parsed.Hostname()); err != nil {
+		log.Warn().
+			Err(err).
+			Str("url", rawURL).
+			Msg("Webhook URL host is not allowed")
+
+		if errors.Is(err, webhookutil.ErrForbiddenAddress) {
+			return "", servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				"Invalid URL. URL must not point to a local or private network address",
+			)
+		}
+
+		return "", servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid URL. URL host cannot be resolved",
+		)
+	}
+
+	return rawURL, nil
+}
+
+// validateEvents checks every event is known, dropping duplicates
+func validateEvents(rawEvents []string) ([]enums.WebhookEvent, error) {
+	events := make([]enums.WebhookEvent, 0, len(rawEvents))
+	for _, rawEvent := range rawEvents {
+		event := enums.WebhookEvent(rawEvent)
+		if !event.IsValid() {
+			log.Warn().
+				Str("event", rawEvent).
+				Msg("Invalid webhook event")
+
+			return nil, servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				"Invalid events. Events must be task.created, task.updated, task.status_changed, task.deleted or task.restored",
+			)
+		}
+
+		if !slices.Contains(events, event) {
+			events = append(events, event)
+		}
+	}
+
+	if len(events) == 0 {
+		log.Warn().
+			Msg("Webhook subscribes to no events")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid events. Subscribe to at least one event",
+		)
+	}
+
+	return events, nil
+}
+
+// generateSecret returns a random secret for signing deliveries
+func generateSecret() (string, error) {
+	b := make([]byte, secretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return "whsec_" + base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,478 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/webhook"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/cursorutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/webhookutil"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	DefaultDeliveryListLimit = 20
+	MaxDeliveryListLimit     = 100
+
+	// maxErrorLength caps the error kept from a failed attempt
+	maxErrorLength = 1000
+	// minDeliveryLease is the least time a claimed delivery is left to its worker
+	minDeliveryLease = time.Minute
+)
+
+// deliverySortBy and deliverySortOrder tag the cursors of delivery pages,
+// which are always newest first
+const (
+	deliverySortBy    = "deliveryCreatedAt"
+	deliverySortOrder = "desc"
+)
+
+// taskActivityEvents maps the task activity a webhook event is raised for
+var taskActivityEvents = map[enums.TaskActivityAction]enums.WebhookEvent{
+	enums.TaskActivityActionCreated:       enums.WebhookEventTaskCreated,
+	enums.TaskActivityActionUpdated:       enums.WebhookEventTaskUpdated,
+	enums.TaskActivityActionStatusChanged: enums.WebhookEventTaskStatusChanged,
+	enums.TaskActivityActionDeleted:       enums.WebhookEventTaskDeleted,
+	enums.TaskActivityActionRestored:      enums.WebhookEventTaskRestored,
+}
+
+func (s *service) FindDeliveriesByWebhookID(ctx context.Context, webhookID string, in *WebhookDeliveryListInput, userID string) (*WebhookDeliveryListOutput, error) {
+	if _, err := s.findAuthorizedWebhook(ctx, webhookID, userID); err != nil {
+		return nil, err
+	}
+
+	limit := in.Limit
+	if limit <= 0 {
+		limit = DefaultDeliveryListLimit
+	}
+	if limit > MaxDeliveryListLimit {
+		limit = MaxDeliveryListLimit
+	}
+
+	opts := webhook.DeliveryListOptions{
+		Limit: limit + 1, // Fetch one extra row to know if there is another page
+	}
+
+	if in.Cursor != "" {
+		keyset, err := decodeDeliveryCursor(in.Cursor)
+		if err != nil {
+			log.Warn().
+				Str("cursor", in.Cursor).
+				Msg("Invalid webhook delivery cursor")
+
+			return nil, servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				"Invalid cursor",
+			)
+		}
+		opts.After = keyset
+	}
+
+	deliveries, err := s.webhookRepo.FindDeliveriesByWebhookID(ctx, webhookID, &opts)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("webhookId", webhookID).
+			Msg("Failed to find webhook deliveries")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find webhook deliveries",
+		)
+	}
+
+	output := &WebhookDeliveryListOutput{
+		Deliveries: deliveries,
+	}
+
+	if len(deliveries) > limit {
+		output.Deliveries = deliveries[:limit]
+
+		last := output.Deliveries[limit-1]
+		next, err := cursorutil.Encode(cursorutil.Cursor{
+			SortBy:    deliverySortBy,
+			SortOrder: deliverySortOrder,
+			Value:     last.CreatedAt.Format(time.RFC3339Nano),
+			ID:        last.ID,
+		})
+		if err != nil {
+			log.Error().
+				Err(err).
+				Msg("Failed to encode webhook delivery cursor")
+
+			return nil, servererr.NewError(
+				servererr.ErrorCodeInternalServerError,
+				"Failed to find webhook deliveries",
+			)
+		}
+		output.NextCursor = next
+	}
+
+	return output, nil
+}
+
+// RedeliverByID sends the event of a delivery again as a new delivery,
+// whatever the outcome of the original one
+func (s *service) RedeliverByID(ctx context.Context, webhookID string, deliveryID string, userID string) (*entities.WebhookDelivery, error) {
+	foundWebhook, err := s.findAuthorizedWebhook(ctx, webhookID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	foundDelivery, err := s.webhookRepo.FindDeliveryByID(ctx, deliveryID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("deliveryId", deliveryID).
+			Msg("Failed to find webhook delivery by ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find webhook delivery",
+		)
+	}
+
+	if foundDelivery == nil || foundDelivery.WebhookID != webhookID {
+		log.Warn().
+			Str("webhookId", webhookID).
+			Str("deliveryId", deliveryID).
+			Msg("Webhook delivery not found")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeNotFound,
+			"Webhook delivery not found",
+		)
+	}
+
+	if !foundWebhook.Enabled {
+		log.Warn().
+			Str("webhookId", webhookID).
+			Msg("Webhook is disabled")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeConflict,
+			"Webhook is disabled. Enable it before redelivering",
+		)
+	}
+
+	now := timeutil.BangkokNow()
+	redelivery := &entities.WebhookDelivery{
+		ID:            uuid.NewString(),
+		WebhookID:     webhookID,
+		EventID:       foundDelivery.EventID,
+		Event:         foundDelivery.Event,
+		Payload:       foundDelivery.Payload,
+		Status:        enums.WebhookDeliveryStatusPending,
+		NextAttemptAt: &now,
+		RedeliveryOf:  &foundDelivery.ID,
+		CreatedAt:     now,
+	}
+
+	if err := s.webhookRepo.CreateDelivery(ctx, redelivery); err != nil {
+		log.Error().
+			Err(err).
+			Str("deliveryId", deliveryID).
+			Msg("Failed to create webhook redelivery")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to redeliver webhook",
+		)
+	}
+
+	return redelivery, nil
+}
+
+// PublishTaskEvent queues a delivery of the event behind a task activity for
+// every webhook subscribed to it. The deliveries join the transaction of ctx,
+// so an event is only sent once the change it describes is committed. Like
+// the activity itself, a failure is logged rather than reported.
+func (s *service) PublishTaskEvent(ctx context.Context, activity *entities.TaskActivity) {
+	event, ok := taskActivityEvents[activity.Action]
+	if !ok {
+		return
+	}
+
+	eventTask, err := s.findEventTask(ctx, activity.TaskID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", activity.TaskID).
+			Msg("Failed to find task of webhook event")
+		return
+	}
+
+	if eventTask == nil {
+		log.Warn().
+			Str("taskId", activity.TaskID).
+			Str("event", event.String()).
+			Msg("Task of webhook event not found")
+		return
+	}
+
+	webhooks, err := s.webhookRepo.FindSubscribed(ctx, eventTask.UserID, eventTask.WorkspaceID, event)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", activity.TaskID).
+			Msg("Failed to find subscribed webhooks")
+		return
+	}
+
+	if len(webhooks) == 0 {
+		return
+	}
+
+	payload, err := json.Marshal(toTaskEventPayload(event, activity, eventTask))
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("taskId", activity.TaskID).
+			Msg("Failed to encode webhook payload")
+		return
+	}
+
+	now := timeutil.BangkokNow()
+	for _, subscribed := range webhooks {
+		delivery := &entities.WebhookDelivery{
+			ID:            uuid.NewString(),
+			WebhookID:     subscribed.ID,
+			EventID:       activity.ID,
+			Event:         event,
+			Payload:       string(payload),
+			Status:        enums.WebhookDeliveryStatusPending,
+			NextAttemptAt: &now,
+			CreatedAt:     now,
+		}
+
+		if err := s.webhookRepo.CreateDelivery(ctx, delivery); err != nil {
+			log.Error().
+				Err(err).
+				Str("webhookId", subscribed.ID).
+				Str("event", event.String()).
+				Msg("Failed to queue webhook delivery")
+		}
+	}
+}
+
+// DeliverDue sends the deliveries whose next attempt is due, all at once, and
+// returns how many were attempted
+func (s *service) DeliverDue(ctx context.Context, now time.Time) (int, error) {
+	lease := max(2*s.config.Webhook.Timeout, minDeliveryLease)
+
+	deliveries, err := s.webhookRepo.ClaimDueDeliveries(ctx, now, now.Add(lease), s.config.Webhook.DeliveryBatchSize)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to claim due webhook deliveries")
+
+		return 0, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to deliver webhooks",
+		)
+	}
+
+	webhooks := make(map[string]*entities.Webhook)
+	var wg sync.WaitGroup
+	for i := range deliveries {
+		delivery := &deliveries[i]
+
+		target, ok := webhooks[delivery.WebhookID]
+		if !ok {
+			target, err = s.webhookRepo.FindByID(ctx, delivery.WebhookID)
+			if err != nil {
+				// The lease runs out and the delivery is picked up again
+				log.Error().
+					Err(err).
+					Str("webhookId", delivery.WebhookID).
+					Msg("Failed to find webhook of delivery")
+				continue
+			}
+			webhooks[delivery.WebhookID] = target
+		}
+
+		// Deleted since the delivery was claimed, its deliveries went with it
+		if target == nil {
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.attemptDelivery(ctx, target, delivery)
+		}()
+	}
+	wg.Wait()
+
+	return len(deliveries), nil
+}
+
+// attemptDelivery sends a delivery once and stores the outcome. A failed
+// attempt is retried with exponential backoff until the attempts run out,
+// which counts against the webhook and may disable it.
+func (s *service) attemptDelivery(ctx context.Context, target *entities.Webhook, delivery *entities.WebhookDelivery) {
+	cfg := s.config.Webhook
+	attemptedAt := timeutil.BangkokNow()
+
+	status, sendErr := webhookutil.Send(ctx, s.client, &webhookutil.Request{
+		URL:        target.URL,
+		Secret:     target.Secret,
+		Event:      delivery.Event.String(),
+		DeliveryID: delivery.ID,
+		Body:       []byte(delivery.Payload),
+		Timestamp:  attemptedAt,
+	})
+
+	delivery.Attempts++
+	delivery.LastAttemptAt = &attemptedAt
+	delivery.ResponseStatus = nil
+	if status != 0 {
+		delivery.ResponseStatus = &status
+	}
+
+	switch {
+	case sendErr == nil:
+		delivery.Status = enums.WebhookDeliveryStatusSucceeded
+		delivery.NextAttemptAt = nil
+		delivery.LastError = nil
+	case delivery.Attempts >= cfg.MaxAttempts:
+		delivery.Status = enums.WebhookDeliveryStatusFailed
+		delivery.NextAttemptAt = nil
+		delivery.LastError = errorMessage(sendErr)
+	default:
+		nextAttemptAt := attemptedAt.Add(webhookutil.Backoff(delivery.Attempts, cfg.RetryBaseDelay, cfg.RetryMaxDelay))
+		delivery.Status = enums.WebhookDeliveryStatusPending
+		delivery.NextAttemptAt = &nextAttemptAt
+		delivery.LastError = errorMessage(sendErr)
+	}
+
+	if err := s.webhookRepo.UpdateDeliveryAttemptByID(ctx, delivery); err != nil {
+		log.Error().
+			Err(err).
+			Str("deliveryId", delivery.ID).
+			Msg("Failed to record webhook delivery attempt")
+	}
+
+	switch delivery.Status {
+	case enums.WebhookDeliveryStatusSucceeded:
+		if err := s.webhookRepo.ResetFailuresByID(ctx, target.ID); err != nil {
+			log.Error().
+				Err(err).
+				Str("webhookId", target.ID).
+				Msg("Failed to reset webhook failures")
+		}
+	case enums.WebhookDeliveryStatusFailed:
+		s.recordFailure(ctx, target, delivery)
+	}
+}
+
+// recordFailure counts a delivery that ran out of attempts against its webhook
+func (s *service) recordFailure(ctx context.Context, target *entities.Webhook, delivery *entities.WebhookDelivery) {
+	log.Warn().
+		Str("webhookId", target.ID).
+		Str("deliveryId", delivery.ID).
+		Int("attempts", delivery.Attempts).
+		Msg("Webhook delivery failed")
+
+	disableAfter := s.config.Webhook.DisableAfterFailures
+	if disableAfter <= 0 {
+		return
+	}
+
+	disabled, err := s.webhookRepo.RecordFailureByID(ctx, target.ID, disableAfter, timeutil.BangkokNow())
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("webhookId", target.ID).
+			Msg("Failed to record webhook failure")
+		return
+	}
+
+	if disabled {
+		log.Warn().
+			Str("webhookId", target.ID).
+			Int("failures", disableAfter).
+			Msg("Webhook disabled after failing repeatedly")
+	}
+}
+
+// findEventTask loads the task an event is about, trashed or not
+func (s *service) findEventTask(ctx context.Context, taskID string) (*entities.Task, error) {
+	foundTask, err := s.taskRepo.FindByID(ctx, taskID)
+	if err != nil || foundTask != nil {
+		return foundTask, err
+	}
+
+	return s.taskRepo.FindDeletedByID(ctx, taskID)
+}
+
+func toTaskEventPayload(event enums.WebhookEvent, activity *entities.TaskActivity, task *entities.Task) TaskEventPayload {
+	changes := activity.Changes
+	if changes == nil {
+		changes = []entities.TaskFieldChange{}
+	}
+
+	return TaskEventPayload{
+		ID:        activity.ID,
+		Event:     event,
+		CreatedAt: activity.CreatedAt,
+		ActorID:   activity.ActorID,
+		Task: TaskPayload{
+			ID:             task.ID,
+			UserID:         task.UserID,
+			WorkspaceID:    task.WorkspaceID,
+			ProjectID:      task.ProjectID,
+			ParentID:       task.ParentID,
+			Title:          task.Title,
+			Description:    task.Description,
+			Priority:       task.Priority.Int(),
+			Status:         task.Status,
+			StatusCategory: task.StatusCategory,
+			StartAt:        task.StartAt,
+			DueAt:          task.DueAt,
+			Version:        task.Version,
+			CreatedAt:      task.CreatedAt,
+			UpdatedAt:      task.UpdatedAt,
+			DeletedAt:      task.DeletedAt,
+		},
+		Changes: changes,
+	}
+}
+
+func errorMessage(err error) *string {
+	message := err.Error()
+	if len(message) > maxErrorLength {
+		message = message[:maxErrorLength]
+	}
+
+	return &message
+}
+
+func decodeDeliveryCursor(encoded string) (*webhook.DeliveryKeyset, error) {
+	cursor, err := cursorutil.Decode(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	if cursor.SortBy != deliverySortBy || cursor.SortOrder != deliverySortOrder || cursor.Backward {
+		return nil, cursorutil.ErrInvalidCursor
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, cursor.Value)
+	if err != nil {
+		return nil, cursorutil.ErrInvalidCursor
+	}
+
+	if _, err := uuid.Parse(cursor.ID); err != nil {
+		return nil, cursorutil.ErrInvalidCursor
+	}
+
+	return &webhook.DeliveryKeyset{CreatedAt: createdAt, ID: cursor.ID}, nil
+}
@@ -0,0 +1,65 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+)
+
+type WebhookCreateInput struct {
+	URL string
+	// Secret signs the deliveries, one is generated when it is nil
+	Secret *string
+	Events []string
+}
+
+// WebhookUpdateInput replaces the settings of a webhook. Enabling a webhook
+// disabled for failing clears its failure count, a nil Secret keeps the current one.
+type WebhookUpdateInput struct {
+	URL     string
+	Secret  *string
+	Events  []string
+	Enabled bool
+}
+
+type WebhookDeliveryListInput struct {
+	Cursor string
+	Limit  int
+}
+
+type WebhookDeliveryListOutput struct {
+	Deliveries []entities.WebhookDelivery
+	NextCursor string
+}
+
+// TaskEventPayload is the JSON body delivered for a task event. ID is the
+// same for every delivery of the event, redeliveries included.
+type TaskEventPayload struct {
+	ID        string                     `json:"id"`
+	Event     enums.WebhookEvent         `json:"event"`
+	CreatedAt time.Time                  `json:"createdAt"`
+	ActorID   string                     `json:"actorId"`
+	Task      TaskPayload                `json:"task"`
+	Changes   []entities.TaskFieldChange `json:"changes"`
+}
+
+// TaskPayload is the state of the task right after the event
+type TaskPayload struct {
+	ID             string                   `json:"id"`
+	UserID         string                   `json:"userId"`
+	WorkspaceID    *string                  `json:"workspaceId"`
+	ProjectID      *string                  `json:"projectId"`
+	ParentID       *string                  `json:"parentId"`
+	Title          string                   `json:"title"`
+	Description    string                   `json:"description"`
+	Priority       int                      `json:"priority"`
+	Status         enums.TaskStatus         `json:"status"`
+	StatusCategory enums.TaskStatusCategory `json:"statusCategory"`
+	StartAt        *time.Time               `json:"startAt"`
+	DueAt          *time.Time               `json:"dueAt"`
+	Version        int                      `json:"version"`
+	CreatedAt      time.Time                `json:"createdAt"`
+	UpdatedAt      time.Time                `json:"updatedAt"`
+	DeletedAt      *time.Time               `json:"deletedAt"`
+}
@@ -0,0 +1,480 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/config"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/infrastructure/database"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/project"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/workflow"
+	"github.com/graphzc/sdd-task-management-example/internal/services/authz"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// MaxStatuses is the largest number of statuses a workflow has
+	MaxStatuses = 20
+	// MaxStatusKeyLength is the longest a status key may be
+	MaxStatusKeyLength = 30
+)
+
+// Service manages the workflows tasks follow. A workspace workflow is managed by
+// workspace admins and readable by every member, a project workflow belongs to the
+// project owner and applies to the personal tasks of the project.
+type Service interface {
+	FindWorkflowByWorkspaceID(ctx context.Context, workspaceID string, userID string) (*entities.Workflow, error)
+	SaveWorkspaceWorkflow(ctx context.Context, workspaceID string, in *WorkflowSaveInput, userID string) (*entities.Workflow, error)
+	ResetWorkspaceWorkflow(ctx context.Context, workspaceID string, userID string) error
+	FindWorkflowByProjectID(ctx context.Context, projectID string, userID string) (*entities.Workflow, error)
+	SaveProjectWorkflow(ctx context.Context, projectID string, in *WorkflowSaveInput, userID string) (*entities.Workflow, error)
+	ResetProjectWorkflow(ctx context.Context, projectID string, userID string) error
+}
+
+type service struct {
+	config       *config.Config
+	workflowRepo workflow.Repository
+	projectRepo  project.Repository
+	policy       authz.Policy
+	transactor   database.Transactor
+}
+
+// @WireSet("Service")
+func NewService(
+	config *config.Config,
+	workflowRepo workflow.Repository,
+	projectRepo project.Repository,
+	policy authz.Policy,
+	transactor database.Transactor,
+) Service {
+	return &service{
+		config:       config,
+		workflowRepo: workflowRepo,
+		projectRepo:  projectRepo,
+		policy:       policy,
+		transactor:   transactor,
+	}
+}
+
+func (s *service) FindWorkflowByWorkspaceID(ctx context.Context, workspaceID string, userID string) (*entities.Workflow, error) {
+	if _, err := s.policy.AuthorizeWorkspace(ctx, workspaceID, userID, authz.ActionRead); err != nil {
+		return nil, err
+	}
+
+	return s.findWorkflow(ctx, &workspaceID, nil)
+}
+
+func (s *service) SaveWorkspaceWorkflow(ctx context.Context, workspaceID string, in *WorkflowSaveInput, userID string) (*entities.Workflow, error) {
+	// Only workspace admins change how tasks move
+	if _, err := s.policy.AuthorizeWorkspace(ctx, workspaceID, userID, authz.ActionManage); err != nil {
+		return nil, err
+	}
+
+	return s.saveWorkflow(ctx, &workspaceID, nil, in)
+}
+
+func (s *service) ResetWorkspaceWorkflow(ctx context.Context, workspaceID string, userID string) error {
+	if _, err := s.policy.AuthorizeWorkspace(ctx, workspaceID, userID, authz.ActionManage); err != nil {
+		return err
+	}
+
+	return s.resetWorkflow(ctx, &workspaceID, nil)
+}
+
+func (s *service) FindWorkflowByProjectID(ctx context.Context, projectID string, userID string) (*entities.Workflow, error) {
+	if err := s.authorizeProject(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.findWorkflow(ctx, nil, &projectID)
+}
+
+func (s *service) SaveProjectWorkflow(ctx context.Context, projectID string, in *WorkflowSaveInput, userID string) (*entities.Workflow, error) {
+	if err := s.authorizeProject(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.saveWorkflow(ctx, nil, &projectID, in)
+}
+
+func (s *service) ResetProjectWorkflow(ctx context.Context, projectID string, userID string) error {
+	if err := s.authorizeProject(ctx, projectID, userID); err != nil {
+		return err
+	}
+
+	return s.resetWorkflow(ctx, nil, &projectID)
+}
+
+// findConfiguredWorkflow returns the workflow configured for a workspace or project, or nil
+func (s *service) findConfiguredWorkflow(ctx context.Context, workspaceID, projectID *string) (*entities.Workflow, error) {
+	var foundWorkflow *entities.Workflow
+	var err error
+	if workspaceID != nil {
+		foundWorkflow, err = s.workflowRepo.FindByWorkspaceID(ctx, *workspaceID)
+	} else {
+		foundWorkflow, err = s.workflowRepo.FindByProjectID(ctx, *projectID)
+	}
+
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to find workflow")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find workflow",
+		)
+	}
+
+	return foundWorkflow, nil
+}
+
+// findWorkflow returns the configured workflow, falling back to the default one
+func (s *service) findWorkflow(ctx context.Context, workspaceID, projectID *string) (*entities.Workflow, error) {
+	foundWorkflow, err := s.findConfiguredWorkflow(ctx, workspaceID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if foundWorkflow == nil {
+		return entities.DefaultWorkflow(), nil
+	}
+
+	return foundWorkflow, nil
+}
+
+// saveWorkflow validates and stores the workflow of a workspace or project, then moves
+// the category of its tasks along with the categories of their statuses
+func (s *service) saveWorkflow(ctx context.Context, workspaceID, projectID *string, in *WorkflowSaveInput) (*entities.Workflow, error) {
+	newWorkflow, err := buildWorkflow(in)
+	if err != nil {
+		return nil, err
+	}
+
+	existingWorkflow, err := s.findConfiguredWorkflow(ctx, workspaceID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := timeutil.BangkokNow()
+	newWorkflow.ID = uuid.NewString()
+	newWorkflow.WorkspaceID = workspaceID
+	newWorkflow.ProjectID = projectID
+	newWorkflow.CreatedAt = now
+	newWorkflow.UpdatedAt = now
+	if existingWorkflow != nil {
+		newWorkflow.ID = existingWorkflow.ID
+		newWorkflow.CreatedAt = existingWorkflow.CreatedAt
+	}
+
+	err = s.withinTransaction(ctx, "Failed to save workflow", func(ctx context.Context) error {
+		if err := s.ensureStatusesKept(ctx, workspaceID, projectID, newWorkflow); err != nil {
+			return err
+		}
+
+		if err := s.workflowRepo.Save(ctx, newWorkflow); err != nil {
+			return err
+		}
+
+		return s.workflowRepo.SyncTaskStatusCategories(ctx, workspaceID, projectID, newWorkflow.Statuses)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newWorkflow, nil
+}
+
+// resetWorkflow removes the configured workflow so the default one applies again
+func (s *service) resetWorkflow(ctx context.Context, workspaceID, projectID *string) error {
+	existingWorkflow, err := s.findConfiguredWorkflow(ctx, workspaceID, projectID)
+	if err != nil {
+		return err
+	}
+
+	if existingWorkflow == nil {
+		log.Warn().
+			Msg("Workflow not found")
+
+		return servererr.NewError(
+			servererr.ErrorCodeNotFound,
+			"Workflow not found",
+		)
+	}
+
+	defaultWorkflow := entities.DefaultWorkflow()
+
+	return s.withinTransaction(ctx, "Failed to reset workflow", func(ctx context.Context) error {
+		if err := s.ensureStatusesKept(ctx, workspaceID, projectID, defaultWorkflow); err != nil {
+			return err
+		}
+
+		if err := s.workflowRepo.DeleteByID(ctx, existingWorkflow.ID); err != nil {
+			return err
+		}
+
+		return s.workflowRepo.SyncTaskStatusCategories(ctx, workspaceID, projectID, defaultWorkflow.Statuses)
+	})
+}
+
+// ensureStatusesKept rejects a workflow that drops statuses tasks are still in
+func (s *service) ensureStatusesKept(ctx context.Context, workspaceID, projectID *string, newWorkflow *entities.Workflow) error {
+	counts, err := s.workflowRepo.CountTasksByStatus(ctx, workspaceID, projectID)
+	if err != nil {
+		return err
+	}
+
+	var removed []string
+	for status, count := range counts {
+		if count > 0 && newWorkflow.FindStatus(status) == nil {
+			removed = append(removed, fmt.Sprintf("%s (%d tasks)", status, count))
+		}
+	}
+
+	if len(removed) > 0 {
+		slices.Sort(removed)
+
+		log.Warn().
+			Strs("statuses", removed).
+			Msg("Workflow removes statuses tasks are in")
+
+		return servererr.NewError(
+			servererr.ErrorCodeConflict,
+			fmt.Sprintf("Workflow must keep the statuses tasks are in: %s", strings.Join(removed, ", ")),
+		)
+	}
+
+	return nil
+}
+
+// authorizeProject checks the project exists and belongs to the user
+func (s *service) authorizeProject(ctx context.Context, projectID string, userID string) error {
+	foundProject, err := s.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("projectId", projectID).
+			Msg("Failed to find project by ID")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find project",
+		)
+	}
+
+	if foundProject == nil || foundProject.UserID != userID {
+		log.Warn().
+			Str("projectId", projectID).
+			Str("userId", userID).
+			Msg("Project not found")
+
+		return servererr.NewError(
+			servererr.ErrorCodeNotFound,
+			"Project not found",
+		)
+	}
+
+	return nil
+}
+
+// withinTransaction runs fn in a transaction, passing server errors through and
+// reporting any other failure with message
+func (s *service) withinTransaction(ctx context.Context, message string, fn func(ctx context.Context) error) error {
+	err := s.transactor.WithinTransaction(ctx, fn)
+	if err == nil {
+		return nil
+	}
+
+	var serverErr *servererr.ServerError
+	if errors.As(err, &serverErr) {
+		return serverErr
+	}
+
+	log.Error().
+		Err(err).
+		Msg("Failed to run transaction")
+
+	return servererr.NewError(
+		servererr.ErrorCodeInternalServerError,
+		message,
+	)
+}
+
+// buildWorkflow turns the input into a workflow after checking its statuses and
+// transitions form a usable graph
+func buildWorkflow(in *WorkflowSaveInput) (*entities.Workflow, error) {
+	if len(in.Statuses) == 0 || len(in.Statuses) > MaxStatuses {
+		log.Warn().
+			Int("statuses", len(in.Statuses)).
+			Msg("Workflow has an invalid number of statuses")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			fmt.Sprintf("Invalid statuses. A workflow needs between 1 and %d statuses", MaxStatuses),
+		)
+	}
+
+	newWorkflow := &entities.Workflow{
+		Name:     strings.TrimSpace(in.Name),
+		Statuses: make([]entities.WorkflowStatus, 0, len(in.Statuses)),
+	}
+
+	hasDone := false
+	for i, statusIn := range in.Statuses {
+		key := enums.TaskStatus(strings.TrimSpace(statusIn.Key))
+		if !isStatusKey(key.String()) {
+			log.Warn().
+				Str("key", statusIn.Key).
+				Msg("Invalid workflow status key")
+
+			return nil, servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				fmt.Sprintf("Invalid status key %q. Keys are up to %d uppercase letters, digits or underscores", statusIn.Key, MaxStatusKeyLength),
+			)
+		}
+
+		if newWorkflow.FindStatus(key) != nil {
+			log.Warn().
+				Str("key", key.String()).
+				Msg("Duplicate workflow status key")
+
+			return nil, servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				fmt.Sprintf("Invalid statuses. Status %s is listed more than once", key),
+			)
+		}
+
+		category := enums.TaskStatusCategory(statusIn.Category)
+		if !category.IsValid() {
+			log.Warn().
+				Str("category", statusIn.Category).
+				Msg("Invalid workflow status category")
+
+			return nil, servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				"Invalid status category. Status category must be todo, active, or done",
+			)
+		}
+		hasDone = hasDone || category == enums.TaskStatusCategoryDone
+
+		name := strings.TrimSpace(statusIn.Name)
+		if name == "" {
+			name = key.String()
+		}
+
+		newWorkflow.Statuses = append(newWorkflow.Statuses, entities.WorkflowStatus{
+			Key:      key,
+			Name:     name,
+			Category: category,
+			Position: i,
+		})
+	}
+
+	// Without a done status tasks could never be completed
+	if !hasDone {
+		log.Warn().
+			Msg("Workflow has no done status")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid statuses. A workflow needs at least one status in the done category",
+		)
+	}
+
+	for _, transitionIn := range in.Transitions {
+		transition := entities.WorkflowTransition{
+			From: enums.TaskStatus(strings.TrimSpace(transitionIn.From)),
+			To:   enums.TaskStatus(strings.TrimSpace(transitionIn.To)),
+		}
+
+		for _, status := range []enums.TaskStatus{transition.From, transition.To} {
+			if newWorkflow.FindStatus(status) == nil {
+				log.Warn().
+					Str("status", status.String()).
+					Msg("Workflow transition references an unknown status")
+
+				return nil, servererr.NewError(
+					servererr.ErrorCodeBadRequest,
+					fmt.Sprintf("Invalid transition %s -> %s. Status %s is not part of the workflow", transition.From, transition.To, status),
+				)
+			}
+		}
+
+		if transition.From == transition.To {
+			log.Warn().
+				Str("status", transition.From.String()).
+				Msg("Workflow transition loops on a status")
+
+			return nil, servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				fmt.Sprintf("Invalid transition %s -> %s. A transition must change the status", transition.From, transition.To),
+			)
+		}
+
+		if !slices.Contains(newWorkflow.Transitions, transition) {
+			newWorkflow.Transitions = append(newWorkflow.Transitions, transition)
+		}
+	}
+
+	if unreachable := unreachableStatuses(newWorkflow); len(unreachable) > 0 {
+		log.Warn().
+			Strs("statuses", unreachable).
+			Msg("Workflow has unreachable statuses")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			fmt.Sprintf("Invalid transitions. Statuses cannot be reached from %s: %s", newWorkflow.InitialStatus().Key, strings.Join(unreachable, ", ")),
+		)
+	}
+
+	return newWorkflow, nil
+}
+
+// unreachableStatuses lists the statuses no path of transitions leads to from the initial status
+func unreachableStatuses(w *entities.Workflow) []string {
+	reached := map[enums.TaskStatus]bool{w.InitialStatus().Key: true}
+	queue := []enums.TaskStatus{w.InitialStatus().Key}
+	for len(queue) > 0 {
+		from := queue[0]
+		queue = queue[1:]
+		for _, to := range w.NextStatuses(from) {
+			if !reached[to] {
+				reached[to] = true
+				queue = append(queue, to)
+			}
+		}
+	}
+
+	var unreachable []string
+	for _, status := range w.Statuses {
+		if !reached[status.Key] {
+			unreachable = append(unreachable, status.Key.String())
+		}
+	}
+
+	return unreachable
+}
+
+// isStatusKey reports whether key is a non-empty run of uppercase letters, digits and
+// underscores starting with a letter
+func isStatusKey(key string) bool {
+	if key == "" || len(key) > MaxStatusKeyLength || key[0] < 'A' || key[0] > 'Z' {
+		return false
+	}
+
+	for _, r := range key {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '_' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,20 @@
+package workflow
+
+// WorkflowSaveInput replaces the statuses and transitions of a workflow. The first
+// status is the one new tasks start in.
+type WorkflowSaveInput struct {
+	Name        string
+	Statuses    []WorkflowStatusInput
+	Transitions []WorkflowTransitionInput
+}
+
+type WorkflowStatusInput struct {
+	Key      string
+	Name     string
+	Category string
+}
+
+type WorkflowTransitionInput struct {
+	From string
+	To   string
+}
@@ -0,0 +1,179 @@
+package workspace
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/graphzc/sdd-task-management-example/internal/config"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/user"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/workspace"
+	"github.com/graphzc/sdd-task-management-example/internal/services/authz"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/rs/zerolog/log"
+)
+
+type Service interface {
+	CreateWorkspace(ctx context.Context, in *WorkspaceCreateInput, userID string) (*entities.Workspace, error)
+	FindWorkspaceByID(ctx context.Context, workspaceID string, userID string) (*entities.Workspace, error)
+	FindWorkspacesByUserID(ctx context.Context, userID string) ([]entities.Workspace, error)
+	UpdateWorkspaceByID(ctx context.Context, workspaceID string, in *WorkspaceUpdateInput, userID string) error
+	DeleteWorkspaceByID(ctx context.Context, workspaceID string, userID string) error
+
+	// Members
+	FindMembersByWorkspaceID(ctx context.Context, workspaceID string, userID string) ([]entities.WorkspaceMember, error)
+	AddMember(ctx context.Context, workspaceID string, in *MemberAddInput, userID string) error
+	UpdateMemberRole(ctx context.Context, workspaceID string, memberUserID string, in *MemberUpdateRoleInput, userID string) error
+	RemoveMember(ctx context.Context, workspaceID string, memberUserID string, userID string) error
+}
+
+type service struct {
+	config        *config.Config
+	workspaceRepo workspace.Repository
+	userRepo      user.Repository
+	policy        authz.Policy
+}
+
+// @WireSet("Service")
+func NewService(
+	config *config.Config,
+	workspaceRepo workspace.Repository,
+	userRepo user.Repository,
+	policy authz.Policy,
+) Service {
+	return &service{
+		config:        config,
+		workspaceRepo: workspaceRepo,
+		userRepo:      userRepo,
+		policy:        policy,
+	}
+}
+
+func (s *service) CreateWorkspace(ctx context.Context, in *WorkspaceCreateInput, userID string) (*entities.Workspace, error) {
+	now := timeutil.BangkokNow()
+
+	newWorkspace := &entities.Workspace{
+		ID:        uuid.NewString(),
+		Name:      in.Name,
+		CreatedAt: now,
+		UpdatedAt: now,
+		Role:      enums.WorkspaceRoleOwner,
+	}
+
+	// The creator becomes the first owner
+	owner := &entities.WorkspaceMember{
+		WorkspaceID: newWorkspace.ID,
+		UserID:      userID,
+		Role:        enums.WorkspaceRoleOwner,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := s.workspaceRepo.Create(ctx, newWorkspace, owner); err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to create workspace")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to create workspace",
+		)
+	}
+
+	return newWorkspace, nil
+}
+
+func (s *service) FindWorkspaceByID(ctx context.Context, workspaceID string, userID string) (*entities.Workspace, error) {
+	member, err := s.policy.AuthorizeWorkspace(ctx, workspaceID, userID, authz.ActionRead)
+	if err != nil {
+		return nil, err
+	}
+
+	workspace, err := s.workspaceRepo.FindByID(ctx, workspaceID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("workspaceId", workspaceID).
+			Msg("Failed to find workspace by ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find workspace",
+		)
+	}
+
+	if workspace == nil {
+		log.Warn().
+			Str("workspaceId", workspaceID).
+			Msg("Workspace not found")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeNotFound,
+			"Workspace not found",
+		)
+	}
+
+	workspace.Role = member.Role
+
+	return workspace, nil
+}
+
+func (s *service) FindWorkspacesByUserID(ctx context.Context, userID string) ([]entities.Workspace, error) {
+	workspaces, err := s.workspaceRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("userId", userID).
+			Msg("Failed to find workspaces by user ID")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find workspaces",
+		)
+	}
+
+	return workspaces, nil
+}
+
+func (s *service) UpdateWorkspaceByID(ctx context.Context, workspaceID string, in *WorkspaceUpdateInput, userID string) error {
+	if _, err := s.policy.AuthorizeWorkspace(ctx, workspaceID, userID, authz.ActionManage); err != nil {
+		return err
+	}
+
+	if err := s.workspaceRepo.UpdateByID(ctx, workspaceID, in.Name); err != nil {
+		log.Error().
+			Err(err).
+			Str("workspaceId", workspaceID).
+			Msg("Failed to update workspace")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to update workspace",
+		)
+	}
+
+	return nil
+}
+
+func (s *service) DeleteWorkspaceByID(ctx context.Context, workspaceID string, userID string) error {
+	if _, err := s.policy.AuthorizeWorkspace(ctx, workspaceID, userID, authz.ActionOwn); err != nil {
+		return err
+	}
+
+	// Tasks and memberships of the workspace are removed by the database
+	if err := s.workspaceRepo.DeleteByID(ctx, workspaceID); err != nil {
+		log.Error().
+			Err(err).
+			Str("workspaceId", workspaceID).
+			Msg("Failed to delete workspace")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to delete workspace",
+		)
+	}
+
+	return nil
+}
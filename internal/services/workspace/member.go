@@ -0,0 +1,268 @@
+package workspace
+
+import (
+	"context"
+	"errors"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/graphzc/sdd-task-management-example/internal/repositories/workspace"
+	"github.com/graphzc/sdd-task-management-example/internal/services/authz"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/rs/zerolog/log"
+)
+
+func (s *service) FindMembersByWorkspaceID(ctx context.Context, workspaceID string, userID string) ([]entities.WorkspaceMember, error) {
+	if _, err := s.policy.AuthorizeWorkspace(ctx, workspaceID, userID, authz.ActionRead); err != nil {
+		return nil, err
+	}
+
+	members, err := s.workspaceRepo.FindMembersByWorkspaceID(ctx, workspaceID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("workspaceId", workspaceID).
+			Msg("Failed to find workspace members")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find workspace members",
+		)
+	}
+
+	return members, nil
+}
+
+func (s *service) AddMember(ctx context.Context, workspaceID string, in *MemberAddInput, userID string) error {
+	role, err := parseRole(in.Role)
+	if err != nil {
+		return err
+	}
+
+	// Admins manage members, only owners may appoint other owners
+	if _, err := s.policy.AuthorizeWorkspace(ctx, workspaceID, userID, requiredActionFor(role)); err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.FindByEmail(ctx, in.Email)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to find user by email")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to add workspace member",
+		)
+	}
+
+	if user == nil {
+		log.Warn().
+			Str("workspaceId", workspaceID).
+			Msg("User to add was not found")
+
+		return servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"User not found",
+		)
+	}
+
+	member := &entities.WorkspaceMember{
+		WorkspaceID: workspaceID,
+		UserID:      user.ID,
+		Role:        role,
+		CreatedAt:   timeutil.BangkokNow(),
+		UpdatedAt:   timeutil.BangkokNow(),
+	}
+
+	if err := s.workspaceRepo.CreateMember(ctx, member); err != nil {
+		if errors.Is(err, workspace.ErrNoRowsAffected) {
+			return servererr.NewError(
+				servererr.ErrorCodeConflict,
+				"User is already a workspace member",
+			)
+		}
+
+		log.Error().
+			Err(err).
+			Str("workspaceId", workspaceID).
+			Msg("Failed to add workspace member")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to add workspace member",
+		)
+	}
+
+	return nil
+}
+
+func (s *service) UpdateMemberRole(ctx context.Context, workspaceID string, memberUserID string, in *MemberUpdateRoleInput, userID string) error {
+	role, err := parseRole(in.Role)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.policy.AuthorizeWorkspace(ctx, workspaceID, userID, authz.ActionManage); err != nil {
+		return err
+	}
+
+	member, err := s.findMember(ctx, workspaceID, memberUserID)
+	if err != nil {
+		return err
+	}
+
+	if member.Role == role {
+		return nil
+	}
+
+	// Granting or revoking ownership is reserved to owners
+	if member.Role == enums.WorkspaceRoleOwner || role == enums.WorkspaceRoleOwner {
+		if _, err := s.policy.AuthorizeWorkspace(ctx, workspaceID, userID, authz.ActionOwn); err != nil {
+			return err
+		}
+	}
+
+	if member.Role == enums.WorkspaceRoleOwner {
+		if err := s.ensureAnotherOwner(ctx, workspaceID); err != nil {
+			return err
+		}
+	}
+
+	if err := s.workspaceRepo.UpdateMemberRole(ctx, workspaceID, memberUserID, role); err != nil {
+		log.Error().
+			Err(err).
+			Str("workspaceId", workspaceID).
+			Str("memberUserId", memberUserID).
+			Msg("Failed to update workspace member role")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to update workspace member",
+		)
+	}
+
+	return nil
+}
+
+func (s *service) RemoveMember(ctx context.Context, workspaceID string, memberUserID string, userID string) error {
+	// Members may always leave, removing someone else needs management rights
+	action := authz.ActionRead
+	if memberUserID != userID {
+		action = authz.ActionManage
+	}
+
+	if _, err := s.policy.AuthorizeWorkspace(ctx, workspaceID, userID, action); err != nil {
+		return err
+	}
+
+	member, err := s.findMember(ctx, workspaceID, memberUserID)
+	if err != nil {
+		return err
+	}
+
+	if member.Role == enums.WorkspaceRoleOwner {
+		if _, err := s.policy.AuthorizeWorkspace(ctx, workspaceID, userID, authz.ActionOwn); err != nil {
+			return err
+		}
+
+		if err := s.ensureAnotherOwner(ctx, workspaceID); err != nil {
+			return err
+		}
+	}
+
+	if err := s.workspaceRepo.DeleteMember(ctx, workspaceID, memberUserID); err != nil {
+		log.Error().
+			Err(err).
+			Str("workspaceId", workspaceID).
+			Str("memberUserId", memberUserID).
+			Msg("Failed to remove workspace member")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to remove workspace member",
+		)
+	}
+
+	return nil
+}
+
+func (s *service) findMember(ctx context.Context, workspaceID string, memberUserID string) (*entities.WorkspaceMember, error) {
+	member, err := s.workspaceRepo.FindMember(ctx, workspaceID, memberUserID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("workspaceId", workspaceID).
+			Str("memberUserId", memberUserID).
+			Msg("Failed to find workspace member")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to find workspace member",
+		)
+	}
+
+	if member == nil {
+		return nil, servererr.NewError(
+			servererr.ErrorCodeNotFound,
+			"Workspace member not found",
+		)
+	}
+
+	return member, nil
+}
+
+// ensureAnotherOwner prevents a workspace from losing its last owner
+func (s *service) ensureAnotherOwner(ctx context.Context, workspaceID string) error {
+	owners, err := s.workspaceRepo.CountMembersByRole(ctx, workspaceID, enums.WorkspaceRoleOwner)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("workspaceId", workspaceID).
+			Msg("Failed to count workspace owners")
+
+		return servererr.NewError(
+			servererr.ErrorCodeInternalServerError,
+			"Failed to update workspace member",
+		)
+	}
+
+	if owners <= 1 {
+		log.Warn().
+			Str("workspaceId", workspaceID).
+			Msg("Workspace must keep at least one owner")
+
+		return servererr.NewError(
+			servererr.ErrorCodeConflict,
+			"Workspace must keep at least one owner",
+		)
+	}
+
+	return nil
+}
+
+func parseRole(role string) (enums.WorkspaceRole, error) {
+	roleEnum := enums.WorkspaceRole(role)
+	if !roleEnum.IsValid() {
+		log.Warn().
+			Str("role", role).
+			Msg("Invalid workspace role")
+
+		return "", servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid role. Role must be owner, admin, member, or viewer",
+		)
+	}
+
+	return roleEnum, nil
+}
+
+// requiredActionFor returns the action needed to hand out a role
+func requiredActionFor(role enums.WorkspaceRole) authz.Action {
+	if role == enums.WorkspaceRoleOwner {
+		return authz.ActionOwn
+	}
+
+	return authz.ActionManage
+}
@@ -0,0 +1,18 @@
+package workspace
+
+type WorkspaceCreateInput struct {
+	Name string
+}
+
+type WorkspaceUpdateInput struct {
+	Name string
+}
+
+type MemberAddInput struct {
+	Email string
+	Role  string
+}
+
+type MemberUpdateRoleInput struct {
+	Role string
+}
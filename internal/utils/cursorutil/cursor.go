@@ -0,0 +1,51 @@
+package cursorutil
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+var (
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+// Cursor is the decoded form of an opaque pagination cursor. It records the
+// sort the cursor was produced for and the keyset (sort value + ID) of the
+// boundary row, so the next query can continue right after it.
+type Cursor struct {
+	SortBy    string `json:"s"`
+	SortOrder string `json:"o"`
+	Value     string `json:"v"`
+	ID        string `json:"i"`
+	Backward  bool   `json:"b,omitempty"`
+}
+
+// Encode serializes a cursor into a URL-safe opaque string
+func Encode(cursor Cursor) (string, error) {
+	raw, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// Decode parses an opaque string produced by Encode
+func Decode(encoded string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(raw, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	if cursor.ID == "" || cursor.SortBy == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	return &cursor, nil
+}
@@ -0,0 +1,107 @@
+package cursorutil
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CursorUtilTestSuite struct {
+	suite.Suite
+}
+
+func (suite *CursorUtilTestSuite) TestEncodeDecode_RoundTrip() {
+	// Arrange
+	cursor := Cursor{
+		SortBy:    "createdAt",
+		SortOrder: "desc",
+		Value:     "2025-01-02T15:04:05.123456+07:00",
+		ID:        "0f8fad5b-d9cb-469f-a165-70867728950e",
+	}
+
+	// Act
+	encoded, err := Encode(cursor)
+	assert.NoError(suite.T(), err)
+	decoded, err := Decode(encoded)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), cursor, *decoded)
+}
+
+func (suite *CursorUtilTestSuite) TestEncodeDecode_Backward() {
+	// Arrange
+	cursor := Cursor{
+		SortBy:    "title",
+		SortOrder: "asc",
+		Value:     "Write report",
+		ID:        "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		Backward:  true,
+	}
+
+	// Act
+	encoded, err := Encode(cursor)
+	assert.NoError(suite.T(), err)
+	decoded, err := Decode(encoded)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), decoded.Backward)
+}
+
+func (suite *CursorUtilTestSuite) TestEncode_IsURLSafe() {
+	// Arrange
+	cursor := Cursor{
+		SortBy: "title",
+		Value:  "??>>??//++",
+		ID:     "id",
+	}
+
+	// Act
+	encoded, err := Encode(cursor)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.NotContains(suite.T(), encoded, "+")
+	assert.NotContains(suite.T(), encoded, "/")
+	assert.NotContains(suite.T(), encoded, "=")
+}
+
+func (suite *CursorUtilTestSuite) TestDecode_NotBase64() {
+	// Act
+	cursor, err := Decode("not base64!!")
+
+	// Assert
+	assert.Nil(suite.T(), cursor)
+	assert.Equal(suite.T(), ErrInvalidCursor, err)
+}
+
+func (suite *CursorUtilTestSuite) TestDecode_NotJSON() {
+	// Arrange
+	encoded := base64.RawURLEncoding.EncodeToString([]byte("plain text"))
+
+	// Act
+	cursor, err := Decode(encoded)
+
+	// Assert
+	assert.Nil(suite.T(), cursor)
+	assert.Equal(suite.T(), ErrInvalidCursor, err)
+}
+
+func (suite *CursorUtilTestSuite) TestDecode_MissingFields() {
+	// Arrange
+	encoded := base64.RawURLEncoding.EncodeToString([]byte(`{"v":"x"}`))
+
+	// Act
+	cursor, err := Decode(encoded)
+
+	// Assert
+	assert.Nil(suite.T(), cursor)
+	assert.Equal(suite.T(), ErrInvalidCursor, err)
+}
+
+func TestCursorUtilTestSuite(t *testing.T) {
+	suite.Run(t, new(CursorUtilTestSuite))
+}
@@ -0,0 +1,44 @@
+package echoutil
+
+import (
+	"mime"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Binder binds requests like echo's DefaultBinder and also decodes bodies sent with
+// a JSON based media type, such as application/merge-patch+json, as JSON
+type Binder struct {
+	echo.DefaultBinder
+}
+
+func NewBinder() *Binder {
+	return &Binder{}
+}
+
+func (b *Binder) Bind(i any, c echo.Context) error {
+	if !isJSONSuffixMediaType(c.Request().Header.Get(echo.HeaderContentType)) {
+		return b.DefaultBinder.Bind(i, c)
+	}
+
+	if err := b.BindPathParams(c, i); err != nil {
+		return err
+	}
+
+	if c.Request().ContentLength == 0 {
+		return nil
+	}
+
+	return c.Echo().JSONSerializer.Deserialize(c, i)
+}
+
+// isJSONSuffixMediaType reports whether a content type uses the +json structured syntax suffix
+func isJSONSuffixMediaType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return strings.HasSuffix(mediaType, "+json")
+}
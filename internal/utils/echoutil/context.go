@@ -3,6 +3,7 @@ package echoutil
 import (
 	"context"
 	"errors"
+	"net/http"
 
 	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
 	"github.com/labstack/echo/v4"
@@ -42,3 +43,13 @@ func GetUserIDFromContext(ctx context.Context) (string, error) {
 
 	return userIDStr, nil
 }
+
+type responseHeaderContextKey struct{}
+
+// SetResponseHeader sets a header on the response to the request ctx belongs to.
+// It does nothing when ctx does not come from WrapWithStatus.
+func SetResponseHeader(ctx context.Context, key, value string) {
+	if header, ok := ctx.Value(responseHeaderContextKey{}).(http.Header); ok {
+		header.Set(key, value)
+	}
+}
@@ -0,0 +1,42 @@
+package echoutil
+
+import (
+	"bufio"
+	"context"
+	"io"
+
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/rs/zerolog/log"
+)
+
+// StreamFile serves a file whose content write produces while the response is
+// sent. An error of write past this point can only cut the download short, it
+// is logged and the response ends early.
+func StreamFile(ctx context.Context, name, contentType string, write func(w io.Writer) error) *dto.FileResponse {
+	reader, writer := io.Pipe()
+	go func() {
+		buffered := bufio.NewWriter(writer)
+		err := write(buffered)
+		if err == nil {
+			err = buffered.Flush()
+		}
+
+		if err != nil {
+			userID, _ := GetUserIDFromContext(ctx)
+			log.Error().
+				Err(err).
+				Str("userId", userID).
+				Str("fileName", name).
+				Msg("Failed to stream file")
+		}
+
+		writer.CloseWithError(err)
+	}()
+
+	return &dto.FileResponse{
+		Name:        name,
+		ContentType: contentType,
+		Size:        -1,
+		Content:     reader,
+	}
+}
@@ -0,0 +1,49 @@
+package echoutil
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type EchoUtilTestSuite struct {
+	suite.Suite
+}
+
+func (suite *EchoUtilTestSuite) TestStreamFile() {
+	// Act
+	file := StreamFile(context.Background(), "tasks.csv", "text/csv", func(w io.Writer) error {
+		_, err := io.WriteString(w, "id,title\n")
+		return err
+	})
+	content, err := io.ReadAll(file.Content)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "tasks.csv", file.Name)
+	assert.Equal(suite.T(), "text/csv", file.ContentType)
+	assert.Equal(suite.T(), int64(-1), file.Size)
+	assert.Equal(suite.T(), "id,title\n", string(content))
+}
+
+func (suite *EchoUtilTestSuite) TestStreamFile_WriteError() {
+	// Arrange
+	writeErr := errors.New("read failed")
+
+	// Act
+	file := StreamFile(context.Background(), "tasks.csv", "text/csv", func(w io.Writer) error {
+		return writeErr
+	})
+	_, err := io.ReadAll(file.Content)
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, writeErr)
+}
+
+func TestEchoUtilTestSuite(t *testing.T) {
+	suite.Run(t, new(EchoUtilTestSuite))
+}
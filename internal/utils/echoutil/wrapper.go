@@ -2,8 +2,10 @@ package echoutil
 
 import (
 	"context"
+	"mime"
 	"net/http"
 	"reflect"
+	"strconv"
 	"time"
 
 	"github.com/graphzc/sdd-task-management-example/internal/dto"
@@ -20,10 +22,11 @@ func WrapWithStatus[Req any, Res any](fn func(context.Context, Req) (Res, error)
 	return func(c echo.Context) error {
 		start := time.Now()
 
-		// Log incoming request
+		// Log incoming request. Paths are logged as their route, the actual
+		// path may carry secrets such as a calendar feed token.
 		log.Info().
 			Str("method", c.Request().Method).
-			Str("path", c.Request().URL.Path).
+			Str("path", c.Path()).
 			Str("remote_addr", c.RealIP()).
 			Str("user_agent", c.Request().UserAgent()).
 			Msg("Incoming request")
@@ -31,15 +34,23 @@ func WrapWithStatus[Req any, Res any](fn func(context.Context, Req) (Res, error)
 		var req Req
 		reqType := reflect.TypeOf(req)
 
+		// Allocate the underlying struct when the request DTO is a pointer,
+		// otherwise binding and validation would operate on a nil pointer
+		var bindTarget any = &req
+		if reqType != nil && reqType.Kind() == reflect.Ptr {
+			req = reflect.New(reqType.Elem()).Interface().(Req)
+			bindTarget = req
+		}
+
 		// Check if Req is an empty struct (no request needed)
 		isEmpty := isEmptyStruct(reqType)
 
 		if !isEmpty {
 			// Bind request body to DTO only if request type is not empty
-			if err := c.Bind(&req); err != nil {
+			if err := c.Bind(bindTarget); err != nil {
 				log.Error().
 					Str("method", c.Request().Method).
-					Str("path", c.Request().URL.Path).
+					Str("path", c.Path()).
 					Str("remote_addr", c.RealIP()).
 					Str("user_agent", c.Request().UserAgent()).
 					Msg("Failed to bind request")
@@ -50,11 +61,26 @@ func WrapWithStatus[Req any, Res any](fn func(context.Context, Req) (Res, error)
 				})
 			}
 
+			// Bind fields tagged with header, such as preconditions
+			if err := (&echo.DefaultBinder{}).BindHeaders(c, bindTarget); err != nil {
+				log.Error().
+					Str("method", c.Request().Method).
+					Str("path", c.Path()).
+					Str("remote_addr", c.RealIP()).
+					Str("user_agent", c.Request().UserAgent()).
+					Msg("Failed to bind request headers")
+
+				return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+					Code:    servererr.ErrorCodeBadRequest.String(),
+					Message: "Invalid request headers",
+				})
+			}
+
 			// Validate request if validator is available
 			if err := c.Validate(req); err != nil {
 				log.Error().
 					Str("method", c.Request().Method).
-					Str("path", c.Request().URL.Path).
+					Str("path", c.Path()).
 					Str("remote_addr", c.RealIP()).
 					Str("user_agent", c.Request().UserAgent()).
 					Msg("Request validation failed")
@@ -66,12 +92,12 @@ func WrapWithStatus[Req any, Res any](fn func(context.Context, Req) (Res, error)
 			}
 		} else {
 			// Even for empty requests, we might need to bind path parameters
-			if err := c.Bind(&req); err != nil {
+			if err := c.Bind(bindTarget); err != nil {
 				// Ignore binding errors for truly empty structs
 				if !isEmptyStruct(reqType) {
 					log.Error().
 						Str("method", c.Request().Method).
-						Str("path", c.Request().URL.Path).
+						Str("path", c.Path()).
 						Str("remote_addr", c.RealIP()).
 						Str("user_agent", c.Request().UserAgent()).
 						Msg("Failed to bind request")
@@ -91,6 +117,9 @@ func WrapWithStatus[Req any, Res any](fn func(context.Context, Req) (Res, error)
 			ctx = SetUserIDInContext(ctx, userID)
 		}
 
+		// Let the business logic set response headers, such as entity tags
+		ctx = context.WithValue(ctx, responseHeaderContextKey{}, c.Response().Header())
+
 		// Call business logic function
 		res, err := fn(ctx, req)
 
@@ -100,7 +129,7 @@ func WrapWithStatus[Req any, Res any](fn func(context.Context, Req) (Res, error)
 			// Log error response
 			log.Error().
 				Str("method", c.Request().Method).
-				Str("path", c.Request().URL.Path).
+				Str("path", c.Path()).
 				Dur("duration", duration).
 				Err(err).
 				Msg("Request completed with error")
@@ -123,16 +152,39 @@ func WrapWithStatus[Req any, Res any](fn func(context.Context, Req) (Res, error)
 		// Log successful response
 		log.Info().
 			Str("method", c.Request().Method).
-			Str("path", c.Request().URL.Path).
+			Str("path", c.Path()).
 			Dur("duration", duration).
 			Int("status", status).
 			Msg("Request completed successfully")
 
+		// Stream files as is
+		if file, ok := any(res).(*dto.FileResponse); ok {
+			return streamFile(c, status, file)
+		}
+
 		// Return success response
 		return c.JSON(status, res)
 	}
 }
 
+// streamFile writes a file response as a download and closes its content
+func streamFile(c echo.Context, status int, file *dto.FileResponse) error {
+	defer file.Content.Close()
+
+	contentType := file.ContentType
+	if contentType == "" {
+		contentType = echo.MIMEOctetStream
+	}
+
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": file.Name}))
+	if file.Size >= 0 {
+		header.Set(echo.HeaderContentLength, strconv.FormatInt(file.Size, 10))
+	}
+
+	return c.Stream(status, contentType, file.Content)
+}
+
 // isEmptyStruct checks if a type is an empty struct
 func isEmptyStruct(t reflect.Type) bool {
 	if t == nil {
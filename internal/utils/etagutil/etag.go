@@ -0,0 +1,38 @@
+package etagutil
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidETag = errors.New("invalid entity tag")
+
+// Any is the If-Match value matching whatever the current version is
+const Any = "*"
+
+// Format returns the entity tag of a version
+func Format(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+// Parse reads the version out of an If-Match value holding a single entity tag.
+// Weak tags are accepted as versions change on every update. Returns nil for Any.
+func Parse(value string) (*int, error) {
+	value = strings.TrimSpace(value)
+	if value == Any {
+		return nil, nil
+	}
+
+	value = strings.TrimPrefix(value, "W/")
+	if len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+		return nil, ErrInvalidETag
+	}
+
+	version, err := strconv.Atoi(value[1 : len(value)-1])
+	if err != nil || version < 1 {
+		return nil, ErrInvalidETag
+	}
+
+	return &version, nil
+}
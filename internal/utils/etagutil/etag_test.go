@@ -0,0 +1,76 @@
+package etagutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ETagUtilTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ETagUtilTestSuite) TestFormat() {
+	// Act
+	etag := Format(3)
+
+	// Assert
+	assert.Equal(suite.T(), `"3"`, etag)
+}
+
+func (suite *ETagUtilTestSuite) TestParse_RoundTrip() {
+	// Act
+	version, err := Parse(Format(42))
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	if assert.NotNil(suite.T(), version) {
+		assert.Equal(suite.T(), 42, *version)
+	}
+}
+
+func (suite *ETagUtilTestSuite) TestParse_WeakTag() {
+	// Act
+	version, err := Parse(` W/"7" `)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	if assert.NotNil(suite.T(), version) {
+		assert.Equal(suite.T(), 7, *version)
+	}
+}
+
+func (suite *ETagUtilTestSuite) TestParse_Any() {
+	// Act
+	version, err := Parse("*")
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), version)
+}
+
+func (suite *ETagUtilTestSuite) TestParse_Invalid() {
+	testCases := []string{
+		"",
+		"3",
+		`"abc"`,
+		`"0"`,
+		`"-1"`,
+		`"1", "2"`,
+		`W/3`,
+	}
+
+	for _, value := range testCases {
+		// Act
+		version, err := Parse(value)
+
+		// Assert
+		assert.Nil(suite.T(), version, value)
+		assert.Equal(suite.T(), ErrInvalidETag, err, value)
+	}
+}
+
+func TestETagUtilTestSuite(t *testing.T) {
+	suite.Run(t, new(ETagUtilTestSuite))
+}
@@ -0,0 +1,23 @@
+package icalutil
+
+import "io"
+
+// WriteCalendar writes a VCALENDAR to w. header may add calendar properties past
+// the ones every calendar has, body writes the components of the calendar.
+func WriteCalendar(w io.Writer, header func(cal *Writer), body func(cal *Writer) error) error {
+	cal := NewWriter(w)
+	cal.Begin("VCALENDAR")
+	cal.Property("VERSION", "2.0")
+	cal.Property("PRODID", ProductID)
+	cal.Property("CALSCALE", "GREGORIAN")
+	if header != nil {
+		header(cal)
+	}
+
+	if err := body(cal); err != nil {
+		return err
+	}
+
+	cal.End("VCALENDAR")
+	return cal.Err()
+}
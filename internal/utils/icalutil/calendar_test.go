@@ -0,0 +1,49 @@
+package icalutil
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *ICalUtilTestSuite) TestWriteCalendar() {
+	// Arrange
+	var buf bytes.Buffer
+	header := func(cal *Writer) {
+		cal.Property("METHOD", "PUBLISH")
+	}
+
+	// Act
+	err := WriteCalendar(&buf, header, func(cal *Writer) error {
+		cal.Begin("VTODO")
+		cal.End("VTODO")
+		return cal.Err()
+	})
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "BEGIN:VCALENDAR\r\n"+
+		"VERSION:2.0\r\n"+
+		"PRODID:"+ProductID+"\r\n"+
+		"CALSCALE:GREGORIAN\r\n"+
+		"METHOD:PUBLISH\r\n"+
+		"BEGIN:VTODO\r\n"+
+		"END:VTODO\r\n"+
+		"END:VCALENDAR\r\n", buf.String())
+}
+
+func (suite *ICalUtilTestSuite) TestWriteCalendar_BodyError() {
+	// Arrange
+	var buf bytes.Buffer
+	bodyErr := errors.New("read failed")
+
+	// Act
+	err := WriteCalendar(&buf, nil, func(cal *Writer) error {
+		return bodyErr
+	})
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, bodyErr)
+	assert.NotContains(suite.T(), buf.String(), "END:VCALENDAR")
+}
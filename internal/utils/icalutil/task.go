@@ -0,0 +1,109 @@
+package icalutil
+
+import (
+	"strconv"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+)
+
+// ProductID identifies this service as the producer of iCalendar files
+const ProductID = "-//sdd-task-management//Tasks//EN"
+
+// dueEventUIDPrefix sets the UID of the due date event of a task apart
+// from the UID of its VTODO
+const dueEventUIDPrefix = "due-"
+
+// WriteTaskTodo writes a task as a VTODO component
+func WriteTaskTodo(w *Writer, task *entities.Task) {
+	w.Begin("VTODO")
+	w.Property("UID", task.ID)
+	w.DateTime("DTSTAMP", task.UpdatedAt)
+	w.DateTime("CREATED", task.CreatedAt)
+	w.DateTime("LAST-MODIFIED", task.UpdatedAt)
+	w.Property("SEQUENCE", taskSequence(task))
+	w.Text("SUMMARY", task.Title)
+	if task.Description != "" {
+		w.Text("DESCRIPTION", task.Description)
+	}
+	if task.StartAt != nil {
+		w.DateTime("DTSTART", *task.StartAt)
+	}
+	if task.DueAt != nil {
+		w.DateTime("DUE", *task.DueAt)
+	}
+	w.Property("STATUS", TaskStatus(task))
+	w.Property("PRIORITY", strconv.Itoa(TaskPriority(task.Priority)))
+	w.End("VTODO")
+}
+
+// WriteTaskEvent writes the due date of a task as a VEVENT component, for
+// calendar clients that do not show VTODOs. The event spans from the start
+// of the task when it has one, otherwise it is a moment at the due date.
+// Tasks without a due date are skipped.
+func WriteTaskEvent(w *Writer, task *entities.Task) {
+	if task.DueAt == nil {
+		return
+	}
+
+	w.Begin("VEVENT")
+	w.Property("UID", dueEventUIDPrefix+task.ID)
+	w.DateTime("DTSTAMP", task.UpdatedAt)
+	w.DateTime("CREATED", task.CreatedAt)
+	w.DateTime("LAST-MODIFIED", task.UpdatedAt)
+	w.Property("SEQUENCE", taskSequence(task))
+	w.Text("SUMMARY", task.Title)
+	if task.Description != "" {
+		w.Text("DESCRIPTION", task.Description)
+	}
+	if task.StartAt != nil && task.StartAt.Before(*task.DueAt) {
+		w.DateTime("DTSTART", *task.StartAt)
+		w.DateTime("DTEND", *task.DueAt)
+	} else {
+		w.DateTime("DTSTART", *task.DueAt)
+	}
+	w.Property("TRANSP", "TRANSPARENT")
+	w.End("VEVENT")
+}
+
+// TaskStatus maps the status of a task to a VTODO status, statuses of custom
+// workflows follow their category
+func TaskStatus(task *entities.Task) string {
+	switch task.Status {
+	case enums.TaskStatusTodo:
+		return "NEEDS-ACTION"
+	case enums.TaskStatusInProgress:
+		return "IN-PROCESS"
+	case enums.TaskStatusCompleted:
+		return "COMPLETED"
+	}
+
+	switch task.StatusCategory {
+	case enums.TaskStatusCategoryActive:
+		return "IN-PROCESS"
+	case enums.TaskStatusCategoryDone:
+		return "COMPLETED"
+	default:
+		return "NEEDS-ACTION"
+	}
+}
+
+// TaskPriority maps a task priority onto the iCalendar scale, where 1 is the
+// highest, 9 the lowest and 0 undefined
+func TaskPriority(priority enums.TaskPriority) int {
+	switch priority {
+	case enums.TaskPriorityHigh:
+		return 1
+	case enums.TaskPriorityMedium:
+		return 5
+	case enums.TaskPriorityLow:
+		return 9
+	default:
+		return 0
+	}
+}
+
+// taskSequence is the revision number of a task, starting at 0
+func taskSequence(task *entities.Task) string {
+	return strconv.Itoa(max(task.Version-1, 0))
+}
@@ -0,0 +1,116 @@
+package icalutil
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/domain/entities"
+	"github.com/graphzc/sdd-task-management-example/internal/domain/enums"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ICalTaskTestSuite struct {
+	suite.Suite
+	task *entities.Task
+}
+
+func (suite *ICalTaskTestSuite) SetupTest() {
+	createdAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	suite.task = &entities.Task{
+		ID:             "task-1",
+		Title:          "Ship release",
+		Status:         enums.TaskStatusInProgress,
+		StatusCategory: enums.TaskStatusCategoryActive,
+		Priority:       enums.TaskPriorityHigh,
+		Version:        3,
+		CreatedAt:      createdAt,
+		UpdatedAt:      createdAt.Add(time.Hour),
+	}
+}
+
+func (suite *ICalTaskTestSuite) TestWriteTaskTodo() {
+	// Arrange
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	dueAt := time.Date(2024, 3, 5, 17, 0, 0, 0, time.UTC)
+	suite.task.DueAt = &dueAt
+
+	// Act
+	WriteTaskTodo(w, suite.task)
+
+	// Assert
+	assert.NoError(suite.T(), w.Err())
+	assert.Equal(suite.T(), "BEGIN:VTODO\r\n"+
+		"UID:task-1\r\n"+
+		"DTSTAMP:20240301T100000Z\r\n"+
+		"CREATED:20240301T090000Z\r\n"+
+		"LAST-MODIFIED:20240301T100000Z\r\n"+
+		"SEQUENCE:2\r\n"+
+		"SUMMARY:Ship release\r\n"+
+		"DUE:20240305T170000Z\r\n"+
+		"STATUS:IN-PROCESS\r\n"+
+		"PRIORITY:1\r\n"+
+		"END:VTODO\r\n", buf.String())
+}
+
+func (suite *ICalTaskTestSuite) TestWriteTaskEvent_DueOnly() {
+	// Arrange
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	dueAt := time.Date(2024, 3, 5, 17, 0, 0, 0, time.UTC)
+	suite.task.DueAt = &dueAt
+
+	// Act
+	WriteTaskEvent(w, suite.task)
+
+	// Assert
+	assert.Contains(suite.T(), buf.String(), "BEGIN:VEVENT\r\nUID:due-task-1\r\n")
+	assert.Contains(suite.T(), buf.String(), "DTSTART:20240305T170000Z\r\n")
+	assert.NotContains(suite.T(), buf.String(), "DTEND")
+}
+
+func (suite *ICalTaskTestSuite) TestWriteTaskEvent_SpansFromStart() {
+	// Arrange
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	startAt := time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC)
+	dueAt := time.Date(2024, 3, 5, 17, 0, 0, 0, time.UTC)
+	suite.task.StartAt = &startAt
+	suite.task.DueAt = &dueAt
+
+	// Act
+	WriteTaskEvent(w, suite.task)
+
+	// Assert
+	assert.Contains(suite.T(), buf.String(), "DTSTART:20240304T090000Z\r\nDTEND:20240305T170000Z\r\n")
+}
+
+func (suite *ICalTaskTestSuite) TestWriteTaskEvent_NoDueDate() {
+	// Arrange
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+
+	// Act
+	WriteTaskEvent(w, suite.task)
+
+	// Assert
+	assert.Empty(suite.T(), buf.String())
+}
+
+func (suite *ICalTaskTestSuite) TestTaskStatus_CustomWorkflow() {
+	// Arrange
+	suite.task.Status = enums.TaskStatus("REVIEW")
+	suite.task.StatusCategory = enums.TaskStatusCategoryDone
+
+	// Act
+	status := TaskStatus(suite.task)
+
+	// Assert
+	assert.Equal(suite.T(), "COMPLETED", status)
+}
+
+func TestICalTaskTestSuite(t *testing.T) {
+	suite.Run(t, new(ICalTaskTestSuite))
+}
@@ -0,0 +1,97 @@
+package icalutil
+
+import (
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxLineOctets is the longest content line RFC 5545 allows before folding
+const maxLineOctets = 75
+
+// dateTimeFormat is the UTC form of an iCalendar DATE-TIME value
+const dateTimeFormat = "20060102T150405Z"
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+// Writer writes iCalendar (RFC 5545) content lines. The first write error is
+// kept and returned by Err, later writes are skipped.
+type Writer struct {
+	w   io.Writer
+	err error
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Begin opens a component such as VCALENDAR or VTODO
+func (w *Writer) Begin(component string) {
+	w.Property("BEGIN", component)
+}
+
+// End closes a component opened with Begin
+func (w *Writer) End(component string) {
+	w.Property("END", component)
+}
+
+// Property writes a property whose value is already in iCalendar form
+func (w *Writer) Property(name, value string) {
+	w.writeLine(name + ":" + value)
+}
+
+// Text writes a property holding free text, escaping the characters iCalendar reserves
+func (w *Writer) Text(name, value string) {
+	w.Property(name, textEscaper.Replace(value))
+}
+
+// DateTime writes a property holding a moment in time as UTC
+func (w *Writer) DateTime(name string, t time.Time) {
+	w.Property(name, FormatDateTime(t))
+}
+
+// Err returns the first error met while writing
+func (w *Writer) Err() error {
+	return w.err
+}
+
+// writeLine folds a content line into lines of at most 75 octets, continuation
+// lines start with a space and multi-byte characters are never split
+func (w *Writer) writeLine(line string) {
+	if w.err != nil {
+		return
+	}
+
+	var b strings.Builder
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+
+		// The leading space of a continuation line counts towards its length
+		limit = maxLineOctets - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+
+	_, w.err = io.WriteString(w.w, b.String())
+}
+
+// FormatDateTime formats t as an iCalendar DATE-TIME in UTC
+func FormatDateTime(t time.Time) string {
+	return t.UTC().Format(dateTimeFormat)
+}
@@ -0,0 +1,104 @@
+package icalutil
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ICalUtilTestSuite struct {
+	suite.Suite
+}
+
+type failingWriter struct {
+	writes int
+}
+
+func (f *failingWriter) Write(p []byte) (int, error) {
+	f.writes++
+	return 0, errors.New("closed")
+}
+
+func (suite *ICalUtilTestSuite) TestWriter_Component() {
+	// Arrange
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+
+	// Act
+	w.Begin("VTODO")
+	w.Property("PRIORITY", "1")
+	w.End("VTODO")
+
+	// Assert
+	assert.NoError(suite.T(), w.Err())
+	assert.Equal(suite.T(), "BEGIN:VTODO\r\nPRIORITY:1\r\nEND:VTODO\r\n", buf.String())
+}
+
+func (suite *ICalUtilTestSuite) TestWriter_TextEscaping() {
+	// Arrange
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+
+	// Act
+	w.Text("SUMMARY", "Plan; review, ship\\done\nnext")
+
+	// Assert
+	assert.Equal(suite.T(), `SUMMARY:Plan\; review\, ship\\done\nnext`+"\r\n", buf.String())
+}
+
+func (suite *ICalUtilTestSuite) TestWriter_FoldsLongLines() {
+	// Arrange
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+
+	// Act
+	w.Text("DESCRIPTION", strings.Repeat("ก", 60))
+
+	// Assert
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n")
+	assert.Greater(suite.T(), len(lines), 1)
+	unfolded := lines[0]
+	for i, line := range lines {
+		assert.LessOrEqual(suite.T(), len(line), 75)
+		if i > 0 {
+			assert.True(suite.T(), strings.HasPrefix(line, " "))
+			unfolded += line[1:]
+		}
+	}
+	assert.Equal(suite.T(), "DESCRIPTION:"+strings.Repeat("ก", 60), unfolded)
+}
+
+func (suite *ICalUtilTestSuite) TestWriter_StopsAfterError() {
+	// Arrange
+	failing := &failingWriter{}
+	w := NewWriter(failing)
+
+	// Act
+	w.Begin("VCALENDAR")
+	w.End("VCALENDAR")
+
+	// Assert
+	assert.Error(suite.T(), w.Err())
+	assert.Equal(suite.T(), 1, failing.writes)
+}
+
+func (suite *ICalUtilTestSuite) TestFormatDateTime() {
+	// Arrange
+	bangkok := time.FixedZone("ICT", 7*60*60)
+	moment := time.Date(2025, 3, 1, 9, 30, 0, 0, bangkok)
+
+	// Act
+	formatted := FormatDateTime(moment)
+
+	// Assert
+	assert.Equal(suite.T(), "20250301T023000Z", formatted)
+}
+
+func TestICalUtilTestSuite(t *testing.T) {
+	suite.Run(t, new(ICalUtilTestSuite))
+}
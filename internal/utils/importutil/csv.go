@@ -0,0 +1,259 @@
+package importutil
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/timeutil"
+	"github.com/rs/zerolog/log"
+)
+
+// customFieldColumnPrefix maps a CSV column to the custom field named after the dot
+const customFieldColumnPrefix = "customFields."
+
+// taskColumns are the task fields a CSV column can map to, keyed by lower case name
+var taskColumns = map[string]string{
+	"workspaceid": "workspaceId",
+	"projectid":   "projectId",
+	"parentid":    "parentId",
+	"title":       "title",
+	"description": "description",
+	"priority":    "priority",
+	"startat":     "startAt",
+	"dueat":       "dueAt",
+	"tagids":      "tagIds",
+}
+
+// readCSVRows reads the records of a CSV file whose first record is the header.
+// Columns are matched to task fields through the mapping or by their name,
+// columns matching no field are ignored.
+func readCSVRows(content io.Reader, mapping map[string]string, maxRows int) ([]Row, error) {
+	reader := csv.NewReader(content)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Msg("Failed to read CSV header")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid file. CSV must start with a header row",
+		)
+	}
+
+	columns, err := resolveCSVColumns(header, mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []Row
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, servererr.NewError(
+					servererr.ErrorCodeBadRequest,
+					"Invalid file",
+				)
+			}
+
+			// A malformed quote leaves the reader unable to find the next record
+			return nil, servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				fmt.Sprintf("Invalid file. CSV line %d: %s", parseErr.Line, parseErr.Err),
+			)
+		}
+
+		if len(rows) == maxRows {
+			return nil, errTooManyRows(maxRows)
+		}
+
+		line, _ := reader.FieldPos(0)
+		rows = append(rows, toCSVRow(line, columns, record))
+	}
+
+	return rows, nil
+}
+
+// resolveCSVColumns returns the task field of every header column, empty for ignored ones
+func resolveCSVColumns(header []string, mapping map[string]string) ([]string, error) {
+	if len(header) > 0 {
+		// Spreadsheet programs often start UTF-8 files with a byte order mark
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+
+	headerIndex := make(map[string]int, len(header))
+	for i, name := range header {
+		headerIndex[strings.TrimSpace(name)] = i
+	}
+
+	columns := make([]string, len(header))
+	for i, name := range header {
+		columns[i] = taskColumns[strings.ToLower(strings.TrimSpace(name))]
+	}
+
+	for name, field := range mapping {
+		i, ok := headerIndex[name]
+		if !ok {
+			return nil, servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				fmt.Sprintf("Invalid mapping. Column %q is not in the CSV header", name),
+			)
+		}
+
+		if field == "" {
+			columns[i] = ""
+			continue
+		}
+
+		if strings.HasPrefix(field, customFieldColumnPrefix) {
+			columns[i] = field
+			continue
+		}
+
+		column, ok := taskColumns[strings.ToLower(field)]
+		if !ok {
+			return nil, servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				fmt.Sprintf("Invalid mapping. Unknown task field %q", field),
+			)
+		}
+		columns[i] = column
+	}
+
+	return columns, nil
+}
+
+// toCSVRow turns a record into a create request, reporting the first cell that cannot be read
+func toCSVRow(line int, columns []string, record []string) Row {
+	row := Row{Row: line, Skipped: true}
+
+	for i, value := range record {
+		value = strings.TrimSpace(value)
+		if value == "" || i >= len(columns) || columns[i] == "" {
+			continue
+		}
+		row.Skipped = false
+
+		if err := setField(&row.Request, columns[i], value); err != nil {
+			row.Err = servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				err.Error(),
+			)
+			return row
+		}
+	}
+
+	return row
+}
+
+func setField(req *dto.TaskCreateRequest, column string, value string) error {
+	if fieldID, ok := strings.CutPrefix(column, customFieldColumnPrefix); ok {
+		// Cells holding JSON keep their type, anything else is a string
+		raw := json.RawMessage(value)
+		if !json.Valid(raw) {
+			raw, _ = json.Marshal(value)
+		}
+
+		if req.CustomFields == nil {
+			req.CustomFields = make(map[string]json.RawMessage)
+		}
+		req.CustomFields[fieldID] = raw
+		return nil
+	}
+
+	switch column {
+	case "workspaceId":
+		req.WorkspaceID = &value
+	case "projectId":
+		req.ProjectID = &value
+	case "parentId":
+		req.ParentID = &value
+	case "title":
+		req.Title = UnescapeCell(value)
+	case "description":
+		req.Description = UnescapeCell(value)
+	case "priority":
+		priority, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("priority must be a number, got %q", value)
+		}
+		req.Priority = priority
+	case "startAt", "dueAt":
+		parsed, err := parseTime(value)
+		if err != nil {
+			return fmt.Errorf("%s must be an RFC 3339 time or a YYYY-MM-DD date, got %q", column, value)
+		}
+		if column == "startAt" {
+			req.StartAt = &parsed
+		} else {
+			req.DueAt = &parsed
+		}
+	case "tagIds":
+		req.TagIDs = strings.FieldsFunc(value, func(r rune) bool {
+			return r == ';' || r == ',' || r == ' '
+		})
+	}
+
+	return nil
+}
+
+// parseTime reads an RFC 3339 time, or a date taken as midnight in Bangkok
+func parseTime(value string) (time.Time, error) {
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed, nil
+	}
+
+	location, err := timeutil.GetBangkokLocation()
+	if err != nil {
+		location = time.UTC
+	}
+
+	return time.ParseInLocation(time.DateOnly, value, location)
+}
+
+// formulaPrefixes are the leading characters that make a spreadsheet
+// evaluate a cell as a formula
+const formulaPrefixes = "=+-@\t\r"
+
+// EscapeCell quotes user written text starting like a formula with a leading ',
+// so opening an exported CSV file in a spreadsheet cannot run it. Text that
+// would read as already quoted gets one too, so UnescapeCell gives it back as is.
+func EscapeCell(value string) string {
+	if value == "" {
+		return value
+	}
+
+	if strings.ContainsRune(formulaPrefixes, rune(value[0])) {
+		return "'" + value
+	}
+
+	if rest, ok := strings.CutPrefix(value, "'"); ok && EscapeCell(rest) != rest {
+		return "'" + value
+	}
+
+	return value
+}
+
+// UnescapeCell undoes EscapeCell, so an exported file imports the text as it was written
+func UnescapeCell(value string) string {
+	if unquoted, ok := strings.CutPrefix(value, "'"); ok && EscapeCell(unquoted) == value {
+		return unquoted
+	}
+	return value
+}
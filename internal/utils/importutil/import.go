@@ -0,0 +1,143 @@
+package importutil
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/graphzc/sdd-task-management-example/internal/dto"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/validator"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+)
+
+// Row is a task read from an import file, Err is set when it cannot be created
+type Row struct {
+	Row     int
+	Request dto.TaskCreateRequest
+	Skipped bool
+	Err     *servererr.ServerError
+}
+
+// Options describe how to read an import file
+type Options struct {
+	// Format is FormatCSV or FormatJSON
+	Format string
+	// Mapping maps CSV header columns to task fields, columns named after a field need no entry
+	Mapping map[string]string
+	// MaxRows is the most rows a file may have, reading stops once it has more
+	MaxRows int
+	// WorkspaceID and ProjectID are given to the rows that name neither
+	WorkspaceID *string
+	ProjectID   *string
+}
+
+// ResolveFormat takes the format given in the request, or else the one the file name
+// or content type of the upload points to
+func ResolveFormat(format string, fileName string, contentType string) (string, error) {
+	if format != "" {
+		return format, nil
+	}
+
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".csv":
+		return FormatCSV, nil
+	case ".json":
+		return FormatJSON, nil
+	}
+
+	switch {
+	case strings.HasPrefix(contentType, "text/csv"):
+		return FormatCSV, nil
+	case strings.HasPrefix(contentType, "application/json"):
+		return FormatJSON, nil
+	}
+
+	log.Warn().
+		Str("fileName", fileName).
+		Str("contentType", contentType).
+		Msg("Unknown import format")
+
+	return "", servererr.NewError(
+		servererr.ErrorCodeBadRequest,
+		"Invalid format. Format must be csv or json",
+	)
+}
+
+// ParseMapping reads a mapping given as a JSON object from CSV header to task field
+func ParseMapping(raw string) (map[string]string, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	var mapping map[string]string
+	if err := json.Unmarshal([]byte(raw), &mapping); err != nil {
+		log.Warn().
+			Err(err).
+			Msg("Invalid import mapping")
+
+		return nil, servererr.NewError(
+			servererr.ErrorCodeBadRequest,
+			"Invalid mapping. Mapping must be a JSON object from CSV header to task field",
+		)
+	}
+
+	return mapping, nil
+}
+
+// ReadTasks reads the rows of an import file and checks each with the rules of
+// the create endpoint. A file that cannot be read fails as a whole, a row that
+// is not a valid task only has its Err set.
+func ReadTasks(content io.Reader, opts *Options, v *validator.Validator) ([]Row, error) {
+	var rows []Row
+	var err error
+	if opts.Format == FormatCSV {
+		rows, err = readCSVRows(content, opts.Mapping, opts.MaxRows)
+	} else {
+		rows, err = readJSONRows(content, opts.MaxRows)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range rows {
+		row := &rows[i]
+		if row.Skipped || row.Err != nil {
+			continue
+		}
+
+		if row.Request.WorkspaceID == nil && row.Request.ProjectID == nil {
+			row.Request.WorkspaceID = opts.WorkspaceID
+			row.Request.ProjectID = opts.ProjectID
+		}
+
+		if err := v.Validate(&row.Request); err != nil {
+			var serverErr *servererr.ServerError
+			if errors.As(err, &serverErr) {
+				row.Err = serverErr
+				continue
+			}
+			return nil, err
+		}
+	}
+
+	return rows, nil
+}
+
+func errTooManyRows(maxRows int) error {
+	log.Warn().
+		Msg("Too many rows to import")
+
+	return servererr.NewError(
+		servererr.ErrorCodeBadRequest,
+		fmt.Sprintf("Invalid file. An import can have at most %d rows", maxRows),
+	)
+}
@@ -0,0 +1,220 @@
+package importutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/validator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+const testWorkspaceID = "7f0c2a4e-2b1d-4c39-9a51-6d8e0f3b2c11"
+
+type ImportUtilTestSuite struct {
+	suite.Suite
+	validator *validator.Validator
+}
+
+func (suite *ImportUtilTestSuite) SetupTest() {
+	suite.validator = validator.NewValidator()
+}
+
+func (suite *ImportUtilTestSuite) TestResolveFormat() {
+	testCases := []struct {
+		name        string
+		format      string
+		fileName    string
+		contentType string
+		expected    string
+	}{
+		{name: "given format", format: "json", fileName: "tasks.csv", expected: FormatJSON},
+		{name: "csv extension", fileName: "Tasks.CSV", expected: FormatCSV},
+		{name: "json extension", fileName: "tasks.json", expected: FormatJSON},
+		{name: "csv content type", fileName: "tasks", contentType: "text/csv; charset=utf-8", expected: FormatCSV},
+		{name: "json content type", fileName: "tasks", contentType: "application/json", expected: FormatJSON},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Act
+			format, err := ResolveFormat(tc.format, tc.fileName, tc.contentType)
+
+			// Assert
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tc.expected, format)
+		})
+	}
+}
+
+func (suite *ImportUtilTestSuite) TestResolveFormat_Unknown() {
+	// Act
+	_, err := ResolveFormat("", "tasks.txt", "text/plain")
+
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), servererr.ErrorCodeBadRequest, err.(*servererr.ServerError).Code)
+}
+
+func (suite *ImportUtilTestSuite) TestParseMapping_Invalid() {
+	// Act
+	mapping, err := ParseMapping(`["title"]`)
+
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), mapping)
+}
+
+func (suite *ImportUtilTestSuite) TestReadTasks_CSV() {
+	// Arrange
+	content := "\ufeffName,Description,Priority,dueAt,Notes\n" +
+		"Write report,Quarterly numbers,2,2026-01-31,ignored\n" +
+		",,,,\n" +
+		"Fix bug,Crash on start,high,,\n" +
+		"'=Formula,Text,1,,\n"
+	opts := &Options{
+		Format:      FormatCSV,
+		Mapping:     map[string]string{"Name": "title"},
+		MaxRows:     10,
+		WorkspaceID: stringPtr(testWorkspaceID),
+	}
+
+	// Act
+	rows, err := ReadTasks(strings.NewReader(content), opts, suite.validator)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), rows, 4)
+
+	assert.Equal(suite.T(), 2, rows[0].Row)
+	assert.Nil(suite.T(), rows[0].Err)
+	assert.Equal(suite.T(), "Write report", rows[0].Request.Title)
+	assert.Equal(suite.T(), 2, rows[0].Request.Priority)
+	assert.NotNil(suite.T(), rows[0].Request.DueAt)
+	assert.Equal(suite.T(), testWorkspaceID, *rows[0].Request.WorkspaceID)
+
+	assert.True(suite.T(), rows[1].Skipped)
+
+	assert.NotNil(suite.T(), rows[2].Err)
+	assert.Contains(suite.T(), rows[2].Err.Message, "priority must be a number")
+
+	assert.Nil(suite.T(), rows[3].Err)
+	assert.Equal(suite.T(), "=Formula", rows[3].Request.Title)
+}
+
+func (suite *ImportUtilTestSuite) TestReadTasks_CSVUnknownMappedColumn() {
+	// Arrange
+	opts := &Options{
+		Format:  FormatCSV,
+		Mapping: map[string]string{"Missing": "title"},
+		MaxRows: 10,
+	}
+
+	// Act
+	rows, err := ReadTasks(strings.NewReader("title\nA\n"), opts, suite.validator)
+
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), rows)
+}
+
+func (suite *ImportUtilTestSuite) TestReadTasks_CSVTooManyRows() {
+	// Arrange
+	content := "title,description,priority\n" + strings.Repeat("A,B,1\n", 3)
+	opts := &Options{Format: FormatCSV, MaxRows: 2}
+
+	// Act
+	rows, err := ReadTasks(strings.NewReader(content), opts, suite.validator)
+
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "at most 2 rows")
+	assert.Nil(suite.T(), rows)
+}
+
+func (suite *ImportUtilTestSuite) TestReadTasks_JSON() {
+	// Arrange
+	content := `[
+		{"title": "Write report", "description": "Quarterly numbers", "priority": 2},
+		null,
+		{"title": "No priority", "description": "Missing"},
+		{"title": 1}
+	]`
+	opts := &Options{Format: FormatJSON, MaxRows: 10}
+
+	// Act
+	rows, err := ReadTasks(strings.NewReader(content), opts, suite.validator)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), rows, 4)
+	assert.Nil(suite.T(), rows[0].Err)
+	assert.Equal(suite.T(), "Write report", rows[0].Request.Title)
+	assert.True(suite.T(), rows[1].Skipped)
+	assert.NotNil(suite.T(), rows[2].Err)
+	assert.NotNil(suite.T(), rows[3].Err)
+	assert.Contains(suite.T(), rows[3].Err.Message, "Invalid task")
+}
+
+func (suite *ImportUtilTestSuite) TestReadTasks_JSONNotAnArray() {
+	// Arrange
+	opts := &Options{Format: FormatJSON, MaxRows: 10}
+
+	// Act
+	rows, err := ReadTasks(strings.NewReader(`{"title": "A"}`), opts, suite.validator)
+
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), rows)
+}
+
+func (suite *ImportUtilTestSuite) TestReadTasks_JSONTooManyRows() {
+	// Arrange
+	content := "[" + strings.Repeat(`{"title": "A"},`, 3) + `{"title": "A"}]`
+	opts := &Options{Format: FormatJSON, MaxRows: 2}
+
+	// Act
+	rows, err := ReadTasks(strings.NewReader(content), opts, suite.validator)
+
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "at most 2 rows")
+	assert.Nil(suite.T(), rows)
+}
+
+func (suite *ImportUtilTestSuite) TestEscapeCell() {
+	testCases := []struct {
+		value    string
+		expected string
+	}{
+		{value: "", expected: ""},
+		{value: "Plain text", expected: "Plain text"},
+		{value: "=SUM(A1:A2)", expected: "'=SUM(A1:A2)"},
+		{value: "+1", expected: "'+1"},
+		{value: "-1", expected: "'-1"},
+		{value: "@user", expected: "'@user"},
+		{value: "\tindented", expected: "'\tindented"},
+		{value: "'quoted", expected: "'quoted"},
+		{value: "'=already", expected: "''=already"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.value, func() {
+			// Act
+			escaped := EscapeCell(tc.value)
+
+			// Assert
+			assert.Equal(suite.T(), tc.expected, escaped)
+			assert.Equal(suite.T(), tc.value, UnescapeCell(escaped))
+		})
+	}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func TestImportUtilTestSuite(t *testing.T) {
+	suite.Run(t, new(ImportUtilTestSuite))
+}
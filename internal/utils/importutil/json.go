@@ -0,0 +1,59 @@
+package importutil
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
+	"github.com/rs/zerolog/log"
+)
+
+// readJSONRows reads a JSON array of tasks shaped like the create request, null entries are skipped.
+// The array is read entry by entry so reading stops as soon as it has too many.
+func readJSONRows(content io.Reader, maxRows int) ([]Row, error) {
+	decoder := json.NewDecoder(content)
+
+	if token, err := decoder.Token(); err != nil || token != json.Delim('[') {
+		return nil, errInvalidJSON(err)
+	}
+
+	var rows []Row
+	for decoder.More() {
+		if len(rows) == maxRows {
+			return nil, errTooManyRows(maxRows)
+		}
+
+		var entry json.RawMessage
+		if err := decoder.Decode(&entry); err != nil {
+			return nil, errInvalidJSON(err)
+		}
+
+		row := Row{Row: len(rows) + 1}
+		if string(entry) == "null" {
+			row.Skipped = true
+		} else if err := json.Unmarshal(entry, &row.Request); err != nil {
+			row.Err = servererr.NewError(
+				servererr.ErrorCodeBadRequest,
+				"Invalid task. "+err.Error(),
+			)
+		}
+		rows = append(rows, row)
+	}
+
+	if _, err := decoder.Token(); err != nil {
+		return nil, errInvalidJSON(err)
+	}
+
+	return rows, nil
+}
+
+func errInvalidJSON(err error) error {
+	log.Warn().
+		Err(err).
+		Msg("Failed to read JSON import")
+
+	return servererr.NewError(
+		servererr.ErrorCodeBadRequest,
+		"Invalid file. JSON must be an array of tasks",
+	)
+}
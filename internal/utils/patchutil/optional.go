@@ -0,0 +1,55 @@
+package patchutil
+
+import "encoding/json"
+
+// Optional is a member of a JSON Merge Patch (RFC 7396) document. Present tells
+// whether the member was sent at all, and Value is nil when it was sent as null.
+type Optional[T any] struct {
+	Present bool
+	Value   *T
+}
+
+// Set returns a present member holding value
+func Set[T any](value T) Optional[T] {
+	return Optional[T]{Present: true, Value: &value}
+}
+
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	o.Present = true
+
+	if string(data) == "null" {
+		o.Value = nil
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	o.Value = &value
+
+	return nil
+}
+
+// Interface returns the sent value, or nil when the member is absent or null
+func (o Optional[T]) Interface() any {
+	if o.Value == nil {
+		return nil
+	}
+
+	return *o.Value
+}
+
+// IsNull reports whether the member was sent as null, asking to remove the value
+func (o Optional[T]) IsNull() bool {
+	return o.Present && o.Value == nil
+}
+
+// Apply returns the patched value, or current when the member was not sent
+func (o Optional[T]) Apply(current *T) *T {
+	if !o.Present {
+		return current
+	}
+
+	return o.Value
+}
@@ -0,0 +1,94 @@
+package patchutil
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type PatchUtilTestSuite struct {
+	suite.Suite
+}
+
+type patchDocument struct {
+	Title Optional[string] `json:"title"`
+	DueAt Optional[int]    `json:"dueAt"`
+}
+
+func (suite *PatchUtilTestSuite) TestUnmarshal_AbsentMember() {
+	// Arrange
+	var doc patchDocument
+
+	// Act
+	err := json.Unmarshal([]byte(`{}`), &doc)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), doc.Title.Present)
+	assert.False(suite.T(), doc.Title.IsNull())
+}
+
+func (suite *PatchUtilTestSuite) TestUnmarshal_NullMember() {
+	// Arrange
+	var doc patchDocument
+
+	// Act
+	err := json.Unmarshal([]byte(`{"dueAt": null}`), &doc)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), doc.DueAt.Present)
+	assert.True(suite.T(), doc.DueAt.IsNull())
+	assert.False(suite.T(), doc.Title.Present)
+}
+
+func (suite *PatchUtilTestSuite) TestUnmarshal_ValueMember() {
+	// Arrange
+	var doc patchDocument
+
+	// Act
+	err := json.Unmarshal([]byte(`{"title": "Write docs", "dueAt": 3}`), &doc)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	if assert.NotNil(suite.T(), doc.Title.Value) {
+		assert.Equal(suite.T(), "Write docs", *doc.Title.Value)
+	}
+	if assert.NotNil(suite.T(), doc.DueAt.Value) {
+		assert.Equal(suite.T(), 3, *doc.DueAt.Value)
+	}
+}
+
+func (suite *PatchUtilTestSuite) TestUnmarshal_WrongType() {
+	// Arrange
+	var doc patchDocument
+
+	// Act
+	err := json.Unmarshal([]byte(`{"dueAt": "soon"}`), &doc)
+
+	// Assert
+	assert.Error(suite.T(), err)
+}
+
+func (suite *PatchUtilTestSuite) TestApply() {
+	// Arrange
+	current := "Current"
+
+	// Act
+	absent := Optional[string]{}.Apply(&current)
+	null := Optional[string]{Present: true}.Apply(&current)
+	set := Set("Patched").Apply(&current)
+
+	// Assert
+	assert.Equal(suite.T(), &current, absent)
+	assert.Nil(suite.T(), null)
+	if assert.NotNil(suite.T(), set) {
+		assert.Equal(suite.T(), "Patched", *set)
+	}
+}
+
+func TestPatchUtilTestSuite(t *testing.T) {
+	suite.Run(t, new(PatchUtilTestSuite))
+}
@@ -0,0 +1,78 @@
+package rankutil
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrInvalidRank  = errors.New("invalid rank")
+	ErrRankOrdering = errors.New("previous rank must sort before next rank")
+)
+
+// digits are the characters of a rank, in byte order so ranks compare as plain
+// strings (and under the C collation in the database)
+const digits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+const base = len(digits)
+
+// Between returns a rank that sorts strictly after prev and before next. An empty
+// prev means the start of the list and an empty next its end. Ranks never end with
+// the lowest digit, which keeps a rank available between any two distinct ones.
+func Between(prev, next string) (string, error) {
+	if !isValid(prev) || !isValid(next) {
+		return "", ErrInvalidRank
+	}
+
+	if next != "" && prev >= next {
+		return "", ErrRankOrdering
+	}
+
+	var rank strings.Builder
+	bounded := next != ""
+	for i := 0; ; i++ {
+		low := 0
+		if i < len(prev) {
+			low = strings.IndexByte(digits, prev[i])
+		}
+
+		high := base
+		if bounded && i < len(next) {
+			high = strings.IndexByte(digits, next[i])
+		}
+
+		// Shared prefix, the rank follows both bounds here
+		if low == high {
+			rank.WriteByte(digits[low])
+			continue
+		}
+
+		if mid := (low + high) / 2; mid > low {
+			rank.WriteByte(digits[mid])
+			return rank.String(), nil
+		}
+
+		// No digit fits in between, so the rest only has to sort after prev
+		rank.WriteByte(digits[low])
+		bounded = false
+	}
+}
+
+// isValid reports whether rank is empty or made of rank digits without a trailing lowest digit
+func isValid(rank string) bool {
+	if rank == "" {
+		return true
+	}
+
+	if rank[len(rank)-1] == digits[0] {
+		return false
+	}
+
+	for i := 0; i < len(rank); i++ {
+		if strings.IndexByte(digits, rank[i]) < 0 {
+			return false
+		}
+	}
+
+	return true
+}
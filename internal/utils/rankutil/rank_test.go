@@ -0,0 +1,104 @@
+package rankutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type RankUtilTestSuite struct {
+	suite.Suite
+}
+
+func (suite *RankUtilTestSuite) TestBetween_EmptyList() {
+	// Act
+	rank, err := Between("", "")
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "i", rank)
+}
+
+func (suite *RankUtilTestSuite) TestBetween_Start() {
+	// Act
+	rank, err := Between("", "00000001i")
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Less(suite.T(), rank, "00000001i")
+}
+
+func (suite *RankUtilTestSuite) TestBetween_End() {
+	// Act
+	rank, err := Between("zz", "")
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Greater(suite.T(), rank, "zz")
+}
+
+func (suite *RankUtilTestSuite) TestBetween_AdjacentDigits() {
+	// Act
+	rank, err := Between("a", "b")
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Greater(suite.T(), rank, "a")
+	assert.Less(suite.T(), rank, "b")
+}
+
+func (suite *RankUtilTestSuite) TestBetween_PrefixOfNext() {
+	// Act
+	rank, err := Between("a", "a1")
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Greater(suite.T(), rank, "a")
+	assert.Less(suite.T(), rank, "a1")
+}
+
+func (suite *RankUtilTestSuite) TestBetween_RepeatedInsertsStayOrdered() {
+	// Arrange
+	prev, next := "a", "b"
+
+	// Act & Assert
+	for i := 0; i < 200; i++ {
+		rank, err := Between(prev, next)
+		assert.NoError(suite.T(), err)
+		assert.Greater(suite.T(), rank, prev)
+		assert.Less(suite.T(), rank, next)
+		next = rank
+	}
+}
+
+func (suite *RankUtilTestSuite) TestBetween_OutOfOrder() {
+	// Act
+	rank, err := Between("b", "a")
+
+	// Assert
+	assert.Empty(suite.T(), rank)
+	assert.Equal(suite.T(), ErrRankOrdering, err)
+}
+
+func (suite *RankUtilTestSuite) TestBetween_EqualRanks() {
+	// Act
+	_, err := Between("a", "a")
+
+	// Assert
+	assert.Equal(suite.T(), ErrRankOrdering, err)
+}
+
+func (suite *RankUtilTestSuite) TestBetween_InvalidRank() {
+	// Act
+	_, errTrailingZero := Between("a0", "")
+	_, errUppercase := Between("", "A")
+
+	// Assert
+	assert.Equal(suite.T(), ErrInvalidRank, errTrailingZero)
+	assert.Equal(suite.T(), ErrInvalidRank, errUppercase)
+}
+
+func TestRankUtilTestSuite(t *testing.T) {
+	suite.Run(t, new(RankUtilTestSuite))
+}
@@ -24,6 +24,9 @@ func EchoHTTPErrorHandler(err error, c echo.Context) {
 		case 404:
 			code = ErrorCodeNotFound
 			message = "Route not found"
+		case 413:
+			code = ErrorCodeBadRequest
+			message = "Request body is too large"
 		}
 	} else if serverErr, ok := err.(*ServerError); ok {
 		code = serverErr.Code
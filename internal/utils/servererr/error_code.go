@@ -3,14 +3,18 @@ package servererr
 type ErrorCode string
 
 const (
-	ErrorCodeInternalServerError ErrorCode = "INTERNAL_SERVER_ERROR"
-	ErrorCodeBadRequest          ErrorCode = "BAD_REQUEST"
-	ErrorCodeNotFound            ErrorCode = "NOT_FOUND"
-	ErrorCodeUnauthorized        ErrorCode = "UNAUTHORIZED"
-	ErrorCodeForbidden           ErrorCode = "FORBIDDEN"
-	ErrorCodeConflict            ErrorCode = "CONFLICT"
-	ErrorCodeTooManyRequests     ErrorCode = "TOO_MANY_REQUESTS"
-	ErrorCodeServiceUnavailable  ErrorCode = "SERVICE_UNAVAILABLE"
+	ErrorCodeInternalServerError  ErrorCode = "INTERNAL_SERVER_ERROR"
+	ErrorCodeBadRequest           ErrorCode = "BAD_REQUEST"
+	ErrorCodeNotFound             ErrorCode = "NOT_FOUND"
+	ErrorCodeUnauthorized         ErrorCode = "UNAUTHORIZED"
+	ErrorCodeForbidden            ErrorCode = "FORBIDDEN"
+	ErrorCodeConflict             ErrorCode = "CONFLICT"
+	ErrorCodeTooManyRequests      ErrorCode = "TOO_MANY_REQUESTS"
+	ErrorCodeServiceUnavailable   ErrorCode = "SERVICE_UNAVAILABLE"
+	ErrorCodeTaskBlocked          ErrorCode = "TASK_BLOCKED"
+	ErrorCodeInvalidTransition    ErrorCode = "INVALID_STATUS_TRANSITION"
+	ErrorCodePreconditionFailed   ErrorCode = "PRECONDITION_FAILED"
+	ErrorCodePreconditionRequired ErrorCode = "PRECONDITION_REQUIRED"
 )
 
 func (e ErrorCode) String() string {
@@ -19,14 +23,18 @@ func (e ErrorCode) String() string {
 
 func (e ErrorCode) HTTPStatus() int {
 	mapErrorToHTTPStatus := map[ErrorCode]int{
-		ErrorCodeInternalServerError: 500,
-		ErrorCodeBadRequest:          400,
-		ErrorCodeNotFound:            404,
-		ErrorCodeUnauthorized:        401,
-		ErrorCodeForbidden:           403,
-		ErrorCodeConflict:            409,
-		ErrorCodeTooManyRequests:     429,
-		ErrorCodeServiceUnavailable:  503,
+		ErrorCodeInternalServerError:  500,
+		ErrorCodeBadRequest:           400,
+		ErrorCodeNotFound:             404,
+		ErrorCodeUnauthorized:         401,
+		ErrorCodeForbidden:            403,
+		ErrorCodeConflict:             409,
+		ErrorCodeTooManyRequests:      429,
+		ErrorCodeServiceUnavailable:   503,
+		ErrorCodeTaskBlocked:          409,
+		ErrorCodeInvalidTransition:    409,
+		ErrorCodePreconditionFailed:   412,
+		ErrorCodePreconditionRequired: 428,
 	}
 
 	if status, ok := mapErrorToHTTPStatus[e]; ok {
@@ -21,6 +21,10 @@ func (suite *ServerErrorTestSuite) TestErrorCode_Constants() {
 	assert.Equal(suite.T(), ErrorCode("CONFLICT"), ErrorCodeConflict)
 	assert.Equal(suite.T(), ErrorCode("TOO_MANY_REQUESTS"), ErrorCodeTooManyRequests)
 	assert.Equal(suite.T(), ErrorCode("SERVICE_UNAVAILABLE"), ErrorCodeServiceUnavailable)
+	assert.Equal(suite.T(), ErrorCode("TASK_BLOCKED"), ErrorCodeTaskBlocked)
+	assert.Equal(suite.T(), ErrorCode("INVALID_STATUS_TRANSITION"), ErrorCodeInvalidTransition)
+	assert.Equal(suite.T(), ErrorCode("PRECONDITION_FAILED"), ErrorCodePreconditionFailed)
+	assert.Equal(suite.T(), ErrorCode("PRECONDITION_REQUIRED"), ErrorCodePreconditionRequired)
 }
 
 // Test ErrorCode.String() method
@@ -33,6 +37,10 @@ func (suite *ServerErrorTestSuite) TestErrorCode_String() {
 	assert.Equal(suite.T(), "CONFLICT", ErrorCodeConflict.String())
 	assert.Equal(suite.T(), "TOO_MANY_REQUESTS", ErrorCodeTooManyRequests.String())
 	assert.Equal(suite.T(), "SERVICE_UNAVAILABLE", ErrorCodeServiceUnavailable.String())
+	assert.Equal(suite.T(), "TASK_BLOCKED", ErrorCodeTaskBlocked.String())
+	assert.Equal(suite.T(), "INVALID_STATUS_TRANSITION", ErrorCodeInvalidTransition.String())
+	assert.Equal(suite.T(), "PRECONDITION_FAILED", ErrorCodePreconditionFailed.String())
+	assert.Equal(suite.T(), "PRECONDITION_REQUIRED", ErrorCodePreconditionRequired.String())
 }
 
 // Test ErrorCode.HTTPStatus() method
@@ -46,6 +54,10 @@ func (suite *ServerErrorTestSuite) TestErrorCode_HTTPStatus() {
 	assert.Equal(suite.T(), 409, ErrorCodeConflict.HTTPStatus())
 	assert.Equal(suite.T(), 429, ErrorCodeTooManyRequests.HTTPStatus())
 	assert.Equal(suite.T(), 503, ErrorCodeServiceUnavailable.HTTPStatus())
+	assert.Equal(suite.T(), 409, ErrorCodeTaskBlocked.HTTPStatus())
+	assert.Equal(suite.T(), 409, ErrorCodeInvalidTransition.HTTPStatus())
+	assert.Equal(suite.T(), 412, ErrorCodePreconditionFailed.HTTPStatus())
+	assert.Equal(suite.T(), 428, ErrorCodePreconditionRequired.HTTPStatus())
 }
 
 func (suite *ServerErrorTestSuite) TestErrorCode_HTTPStatus_UnknownCode() {
@@ -168,6 +180,10 @@ func TestErrorCode_HTTPStatus_TableDriven(t *testing.T) {
 		{"Conflict", ErrorCodeConflict, 409},
 		{"Too Many Requests", ErrorCodeTooManyRequests, 429},
 		{"Service Unavailable", ErrorCodeServiceUnavailable, 503},
+		{"Task Blocked", ErrorCodeTaskBlocked, 409},
+		{"Invalid Transition", ErrorCodeInvalidTransition, 409},
+		{"Precondition Failed", ErrorCodePreconditionFailed, 412},
+		{"Precondition Required", ErrorCodePreconditionRequired, 428},
 		{"Unknown Code", ErrorCode("UNKNOWN"), 500},
 		{"Empty Code", ErrorCode(""), 500},
 	}
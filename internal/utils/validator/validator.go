@@ -1,11 +1,15 @@
 package validator
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
+	"time"
 
 	valid "github.com/go-playground/validator/v10"
+	"github.com/graphzc/sdd-task-management-example/internal/utils/patchutil"
 )
 
 type Validator struct {
@@ -13,9 +17,30 @@ type Validator struct {
 }
 
 func NewValidator() *Validator {
+	validate := valid.New()
+
+	// Validate merge patch members by the value they carry
+	validate.RegisterCustomTypeFunc(
+		optionalValue,
+		patchutil.Optional[string]{},
+		patchutil.Optional[int]{},
+		patchutil.Optional[time.Time]{},
+		patchutil.Optional[[]string]{},
+		patchutil.Optional[map[string]json.RawMessage]{},
+	)
+
 	return &Validator{
-		validate: valid.New(),
+		validate: validate,
+	}
+}
+
+// optionalValue unwraps a merge patch member, absent and null members validate as empty
+func optionalValue(field reflect.Value) any {
+	if optional, ok := field.Interface().(interface{ Interface() any }); ok {
+		return optional.Interface()
 	}
+
+	return nil
 }
 
 func (v *Validator) ValidateStruct(payload any) error {
@@ -3,6 +3,7 @@ package validator
 import (
 	"testing"
 
+	"github.com/graphzc/sdd-task-management-example/internal/utils/patchutil"
 	"github.com/graphzc/sdd-task-management-example/internal/utils/servererr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -42,6 +43,11 @@ type TestComplexStruct struct {
 	IsActive bool     // No validation
 }
 
+type TestPatchStruct struct {
+	Priority patchutil.Optional[int]      `validate:"omitempty,min=1,max=3"`
+	TagIDs   patchutil.Optional[[]string] `validate:"omitempty,dive,uuid"`
+}
+
 // Test NewValidator
 func (suite *ValidatorTestSuite) TestNewValidator() {
 	validator := NewValidator()
@@ -254,6 +260,39 @@ func (suite *ValidatorTestSuite) TestValidator_EchoValidate_InvalidData() {
 	assert.Contains(suite.T(), serverErr.Message, "name is required")
 }
 
+// Test ValidateStruct with merge patch members
+func (suite *ValidatorTestSuite) TestValidateStruct_PatchMembers() {
+	// Arrange
+	absent := TestPatchStruct{}
+	null := TestPatchStruct{
+		Priority: patchutil.Optional[int]{Present: true},
+		TagIDs:   patchutil.Optional[[]string]{Present: true},
+	}
+	valid := TestPatchStruct{
+		Priority: patchutil.Set(2),
+		TagIDs:   patchutil.Set([]string{"5f1b8a57-3a4c-4f52-9a43-4a3b3c1d2e10"}),
+	}
+	invalid := TestPatchStruct{
+		Priority: patchutil.Set(5),
+		TagIDs:   patchutil.Set([]string{"not-a-uuid"}),
+	}
+
+	// Act
+	absentErr := suite.validator.ValidateStruct(absent)
+	nullErr := suite.validator.ValidateStruct(null)
+	validErr := suite.validator.ValidateStruct(valid)
+	invalidErr := suite.validator.ValidateStruct(invalid)
+
+	// Assert
+	assert.NoError(suite.T(), absentErr)
+	assert.NoError(suite.T(), nullErr)
+	assert.NoError(suite.T(), validErr)
+	if assert.Error(suite.T(), invalidErr) {
+		assert.Contains(suite.T(), invalidErr.Error(), "priority is max")
+		assert.Contains(suite.T(), invalidErr.Error(), "uuid")
+	}
+}
+
 func TestValidatorTestSuite(t *testing.T) {
 	suite.Run(t, new(ValidatorTestSuite))
 }
@@ -0,0 +1,119 @@
+package webhookutil
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type AddressTestSuite struct {
+	suite.Suite
+}
+
+func (suite *AddressTestSuite) TestIsPublicAddress() {
+	testCases := []struct {
+		name     string
+		ip       string
+		expected bool
+	}{
+		{name: "public IPv4", ip: "93.184.216.34", expected: true},
+		{name: "public IPv6", ip: "2606:4700:4700::1111", expected: true},
+		{name: "loopback IPv4", ip: "127.0.0.1", expected: false},
+		{name: "loopback IPv6", ip: "::1", expected: false},
+		{name: "private 10/8", ip: "10.1.2.3", expected: false},
+		{name: "private 172.16/12", ip: "172.16.0.1", expected: false},
+		{name: "private 192.168/16", ip: "192.168.1.1", expected: false},
+		{name: "unique local IPv6", ip: "fd00::1", expected: false},
+		{name: "cloud metadata", ip: "169.254.169.254", expected: false},
+		{name: "link-local IPv6", ip: "fe80::1", expected: false},
+		{name: "unspecified IPv4", ip: "0.0.0.0", expected: false},
+		{name: "unspecified IPv6", ip: "::", expected: false},
+		{name: "carrier-grade NAT", ip: "100.64.0.1", expected: false},
+		{name: "multicast", ip: "224.0.0.1", expected: false},
+		{name: "broadcast", ip: "255.255.255.255", expected: false},
+		{name: "IPv4-mapped loopback", ip: "::ffff:127.0.0.1", expected: false},
+		{name: "NAT64 private", ip: "64:ff9b::a00:1", expected: false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Act
+			result := IsPublicAddress(netip.MustParseAddr(tc.ip))
+
+			// Assert
+			assert.Equal(suite.T(), tc.expected, result)
+		})
+	}
+}
+
+func (suite *AddressTestSuite) TestCheckHost_PublicAddress() {
+	// Act
+	err := CheckHost(context.Background(), net.DefaultResolver, "93.184.216.34")
+
+	// Assert
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *AddressTestSuite) TestCheckHost_PrivateAddress() {
+	// Act
+	err := CheckHost(context.Background(), net.DefaultResolver, "169.254.169.254")
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, ErrForbiddenAddress)
+}
+
+func (suite *AddressTestSuite) TestCheckHost_Localhost() {
+	// Act
+	err := CheckHost(context.Background(), net.DefaultResolver, "localhost")
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, ErrForbiddenAddress)
+}
+
+func (suite *AddressTestSuite) TestNewClient_RefusesPrivateReceiver() {
+	// Arrange
+	called := false
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer receiver.Close()
+
+	request := &Request{
+		URL:        receiver.URL,
+		Secret:     "test-secret",
+		Event:      "task.created",
+		DeliveryID: "delivery-1",
+		Body:       []byte(`{}`),
+		Timestamp:  time.Now(),
+	}
+
+	// Act
+	status, err := Send(context.Background(), NewClient(time.Second), request)
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, ErrForbiddenAddress)
+	assert.Equal(suite.T(), 0, status)
+	assert.False(suite.T(), called)
+}
+
+func (suite *AddressTestSuite) TestNewClient_DoesNotFollowRedirects() {
+	// Arrange
+	client := NewClient(time.Second)
+
+	// Act
+	err := client.CheckRedirect(nil, nil)
+
+	// Assert
+	assert.ErrorIs(suite.T(), err, http.ErrUseLastResponse)
+}
+
+func TestAddressTestSuite(t *testing.T) {
+	suite.Run(t, new(AddressTestSuite))
+}
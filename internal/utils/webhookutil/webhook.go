@@ -0,0 +1,112 @@
+package webhookutil
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// EventHeader names the event a delivery carries
+	EventHeader = "X-Webhook-Event"
+	// DeliveryHeader identifies a delivery, it changes on manual redelivery
+	DeliveryHeader = "X-Webhook-Delivery"
+	// TimestampHeader is the Unix time the delivery was signed at
+	TimestampHeader = "X-Webhook-Timestamp"
+	// SignatureHeader is the HMAC-SHA256 of "<timestamp>.<body>" keyed by the
+	// webhook secret, hex encoded and prefixed with "sha256="
+	SignatureHeader = "X-Webhook-Signature"
+
+	signaturePrefix = "sha256="
+	userAgent       = "sdd-task-management-webhooks/1.0"
+
+	// maxDrainBytes is how much of a response body is read so the connection can be reused
+	maxDrainBytes = 4096
+)
+
+// Request is a signed event about to be sent to a webhook
+type Request struct {
+	URL        string
+	Secret     string
+	Event      string
+	DeliveryID string
+	Body       []byte
+	Timestamp  time.Time
+}
+
+// StatusError reports a receiver answering with a non-2xx status
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected response status %d", e.StatusCode)
+}
+
+// Sign returns the signature of a body sent at timestamp. The timestamp is
+// signed too so receivers can reject replayed deliveries.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks a signature in constant time, as receivers should
+func Verify(secret string, timestamp time.Time, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// Send posts a signed request and returns the response status. A response
+// outside 2xx is reported as a *StatusError along with its status, a status
+// of 0 means no response was received.
+func Send(ctx context.Context, client *http.Client, req *Request) (int, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return 0, err
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", userAgent)
+	httpReq.Header.Set(EventHeader, req.Event)
+	httpReq.Header.Set(DeliveryHeader, req.DeliveryID)
+	httpReq.Header.Set(TimestampHeader, strconv.FormatInt(req.Timestamp.Unix(), 10))
+	httpReq.Header.Set(SignatureHeader, Sign(req.Secret, req.Timestamp, req.Body))
+
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainBytes))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, &StatusError{StatusCode: resp.StatusCode}
+	}
+
+	return resp.StatusCode, nil
+}
+
+// Backoff returns how long to wait before the retry following the given
+// number of attempts, doubling base on every attempt up to max
+func Backoff(attempts int, base, max time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= max {
+			return max
+		}
+	}
+
+	return min(delay, max)
+}
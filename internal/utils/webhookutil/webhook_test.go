@@ -0,0 +1,160 @@
+package webhookutil
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type WebhookUtilTestSuite struct {
+	suite.Suite
+	timestamp time.Time
+}
+
+func (suite *WebhookUtilTestSuite) SetupTest() {
+	suite.timestamp = time.Unix(1700000000, 0)
+}
+
+func (suite *WebhookUtilTestSuite) newRequest(url string) *Request {
+	return &Request{
+		URL:        url,
+		Secret:     "test-secret",
+		Event:      "task.created",
+		DeliveryID: "delivery-1",
+		Body:       []byte(`{"event":"task.created"}`),
+		Timestamp:  suite.timestamp,
+	}
+}
+
+func (suite *WebhookUtilTestSuite) TestSign_Deterministic() {
+	// Act
+	first := Sign("secret", suite.timestamp, []byte("body"))
+	second := Sign("secret", suite.timestamp, []byte("body"))
+
+	// Assert
+	assert.Equal(suite.T(), first, second)
+	assert.Regexp(suite.T(), "^sha256=[0-9a-f]{64}$", first)
+}
+
+func (suite *WebhookUtilTestSuite) TestSign_CoversSecretTimestampAndBody() {
+	// Arrange
+	signature := Sign("secret", suite.timestamp, []byte("body"))
+
+	// Act & Assert
+	assert.NotEqual(suite.T(), signature, Sign("other", suite.timestamp, []byte("body")))
+	assert.NotEqual(suite.T(), signature, Sign("secret", suite.timestamp.Add(time.Second), []byte("body")))
+	assert.NotEqual(suite.T(), signature, Sign("secret", suite.timestamp, []byte("body2")))
+}
+
+func (suite *WebhookUtilTestSuite) TestVerify() {
+	// Arrange
+	signature := Sign("secret", suite.timestamp, []byte("body"))
+
+	// Act & Assert
+	assert.True(suite.T(), Verify("secret", suite.timestamp, []byte("body"), signature))
+	assert.False(suite.T(), Verify("wrong", suite.timestamp, []byte("body"), signature))
+	assert.False(suite.T(), Verify("secret", suite.timestamp, []byte("tampered"), signature))
+}
+
+func (suite *WebhookUtilTestSuite) TestSend_SignedRequestReachesReceiver() {
+	// Arrange
+	var received *http.Request
+	var body []byte
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	req := suite.newRequest(receiver.URL)
+
+	// Act
+	status, err := Send(context.Background(), receiver.Client(), req)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusNoContent, status)
+	assert.Equal(suite.T(), http.MethodPost, received.Method)
+	assert.Equal(suite.T(), "application/json", received.Header.Get("Content-Type"))
+	assert.Equal(suite.T(), "task.created", received.Header.Get(EventHeader))
+	assert.Equal(suite.T(), "delivery-1", received.Header.Get(DeliveryHeader))
+	assert.Equal(suite.T(), req.Body, body)
+
+	timestamp, err := strconv.ParseInt(received.Header.Get(TimestampHeader), 10, 64)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), Verify("test-secret", time.Unix(timestamp, 0), body, received.Header.Get(SignatureHeader)))
+}
+
+func (suite *WebhookUtilTestSuite) TestSend_ErrorStatus() {
+	// Arrange
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer receiver.Close()
+
+	// Act
+	status, err := Send(context.Background(), receiver.Client(), suite.newRequest(receiver.URL))
+
+	// Assert
+	assert.Equal(suite.T(), http.StatusInternalServerError, status)
+	var statusErr *StatusError
+	assert.True(suite.T(), errors.As(err, &statusErr))
+	assert.Equal(suite.T(), http.StatusInternalServerError, statusErr.StatusCode)
+}
+
+func (suite *WebhookUtilTestSuite) TestSend_Unreachable() {
+	// Arrange
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := receiver.URL
+	receiver.Close()
+
+	// Act
+	status, err := Send(context.Background(), http.DefaultClient, suite.newRequest(url))
+
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), 0, status)
+}
+
+func (suite *WebhookUtilTestSuite) TestSend_Timeout() {
+	// Arrange
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer receiver.Close()
+
+	client := &http.Client{Timeout: 20 * time.Millisecond}
+
+	// Act
+	status, err := Send(context.Background(), client, suite.newRequest(receiver.URL))
+
+	// Assert
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), 0, status)
+}
+
+func (suite *WebhookUtilTestSuite) TestBackoff() {
+	// Arrange
+	base := 30 * time.Second
+	max := 10 * time.Minute
+
+	// Act & Assert
+	assert.Equal(suite.T(), 30*time.Second, Backoff(1, base, max))
+	assert.Equal(suite.T(), time.Minute, Backoff(2, base, max))
+	assert.Equal(suite.T(), 4*time.Minute, Backoff(4, base, max))
+	assert.Equal(suite.T(), max, Backoff(6, base, max))
+	assert.Equal(suite.T(), max, Backoff(100, base, max))
+}
+
+func TestWebhookUtilTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookUtilTestSuite))
+}
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_tasks_user_id_title;
+DROP INDEX IF EXISTS idx_tasks_user_id_priority;
+DROP INDEX IF EXISTS idx_tasks_user_id_updated_at;
+DROP INDEX IF EXISTS idx_tasks_user_id_created_at;
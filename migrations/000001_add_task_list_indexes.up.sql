@@ -0,0 +1,4 @@
+CREATE INDEX IF NOT EXISTS idx_tasks_user_id_created_at ON tasks (user_id, created_at, id);
+CREATE INDEX IF NOT EXISTS idx_tasks_user_id_updated_at ON tasks (user_id, updated_at, id);
+CREATE INDEX IF NOT EXISTS idx_tasks_user_id_priority ON tasks (user_id, priority, id);
+CREATE INDEX IF NOT EXISTS idx_tasks_user_id_title ON tasks (user_id, title, id);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_tasks_user_id_due_at;
+
+ALTER TABLE tasks
+    DROP CONSTRAINT IF EXISTS chk_tasks_start_before_due,
+    DROP COLUMN IF EXISTS due_at,
+    DROP COLUMN IF EXISTS start_at;
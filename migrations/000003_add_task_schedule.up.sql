@@ -0,0 +1,6 @@
+ALTER TABLE tasks
+    ADD COLUMN IF NOT EXISTS start_at TIMESTAMPTZ NULL,
+    ADD COLUMN IF NOT EXISTS due_at TIMESTAMPTZ NULL,
+    ADD CONSTRAINT chk_tasks_start_before_due CHECK (start_at IS NULL OR due_at IS NULL OR start_at < due_at);
+
+CREATE INDEX IF NOT EXISTS idx_tasks_user_id_due_at ON tasks (user_id, due_at) WHERE due_at IS NOT NULL;
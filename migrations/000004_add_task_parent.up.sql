@@ -0,0 +1,5 @@
+ALTER TABLE tasks
+    ADD COLUMN IF NOT EXISTS parent_id UUID NULL REFERENCES tasks (id),
+    ADD CONSTRAINT chk_tasks_parent_not_self CHECK (parent_id IS NULL OR parent_id <> id);
+
+CREATE INDEX IF NOT EXISTS idx_tasks_parent_id ON tasks (parent_id) WHERE parent_id IS NOT NULL;
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS task_dependencies (
+    task_id            UUID        NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    blocked_by_task_id UUID        NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    created_at         TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (task_id, blocked_by_task_id),
+    CONSTRAINT chk_task_dependencies_not_self CHECK (task_id <> blocked_by_task_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_dependencies_blocked_by_task_id ON task_dependencies (blocked_by_task_id);
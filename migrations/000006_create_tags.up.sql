@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS tags (
+    id         UUID         PRIMARY KEY,
+    user_id    UUID         NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name       VARCHAR(50)  NOT NULL,
+    color      VARCHAR(7)   NOT NULL,
+    created_at TIMESTAMPTZ  NOT NULL,
+    updated_at TIMESTAMPTZ  NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tags_user_id_name ON tags (user_id, lower(name));
+
+CREATE TABLE IF NOT EXISTS task_tags (
+    task_id    UUID        NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    tag_id     UUID        NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (task_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_tags_tag_id ON task_tags (tag_id);
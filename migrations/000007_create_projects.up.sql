@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS projects (
+    id          UUID         PRIMARY KEY,
+    user_id     UUID         NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name        VARCHAR(100) NOT NULL,
+    description TEXT         NOT NULL DEFAULT '',
+    archived_at TIMESTAMPTZ,
+    created_at  TIMESTAMPTZ  NOT NULL,
+    updated_at  TIMESTAMPTZ  NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_projects_user_id_created_at ON projects (user_id, created_at DESC, id DESC);
+
+ALTER TABLE tasks
+    ADD COLUMN IF NOT EXISTS project_id UUID NULL REFERENCES projects (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_project_id ON tasks (project_id) WHERE project_id IS NOT NULL;
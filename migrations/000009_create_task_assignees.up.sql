@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS task_assignees (
+    task_id    UUID        NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    user_id    UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (task_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_assignees_user_id ON task_assignees (user_id);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS task_comments (
+    id         UUID        PRIMARY KEY,
+    task_id    UUID        NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    user_id    UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    body       TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    edited_at  TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_comments_task_id_created_at ON task_comments (task_id, created_at);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS task_attachments (
+    id           UUID         PRIMARY KEY,
+    task_id      UUID         NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    user_id      UUID         NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    file_name    VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    size         BIGINT       NOT NULL,
+    content_hash CHAR(64)     NOT NULL,
+    created_at   TIMESTAMPTZ  NOT NULL,
+    UNIQUE (task_id, content_hash)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_attachments_content_hash ON task_attachments (content_hash);
@@ -0,0 +1,11 @@
+-- Activity outlives the task it describes, so task_id has no foreign key
+CREATE TABLE IF NOT EXISTS task_activities (
+    id         UUID        PRIMARY KEY,
+    task_id    UUID        NOT NULL,
+    actor_id   UUID        REFERENCES users (id) ON DELETE SET NULL,
+    action     VARCHAR(32) NOT NULL,
+    changes    JSONB       NOT NULL DEFAULT '[]',
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_activities_task_id_created_at ON task_activities (task_id, created_at DESC, id DESC);
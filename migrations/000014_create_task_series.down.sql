@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_tasks_series_id;
+
+ALTER TABLE tasks
+    DROP COLUMN IF EXISTS series_id;
+
+DROP TABLE IF EXISTS task_series;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS task_series (
+    id                 UUID        PRIMARY KEY,
+    user_id            UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    rule               TEXT        NOT NULL,
+    anchor_at          TIMESTAMPTZ NOT NULL,
+    last_occurrence_at TIMESTAMPTZ NOT NULL,
+    stopped_at         TIMESTAMPTZ,
+    created_at         TIMESTAMPTZ NOT NULL,
+    updated_at         TIMESTAMPTZ NOT NULL
+);
+
+ALTER TABLE tasks
+    ADD COLUMN IF NOT EXISTS series_id UUID NULL REFERENCES task_series (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_series_id ON tasks (series_id) WHERE series_id IS NOT NULL;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS task_time_entries (
+    id         UUID        PRIMARY KEY,
+    task_id    UUID        NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    user_id    UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    started_at TIMESTAMPTZ NOT NULL,
+    ended_at   TIMESTAMPTZ,
+    note       TEXT        NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    CHECK (ended_at IS NULL OR ended_at > started_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_time_entries_task_id_started_at ON task_time_entries (task_id, started_at);
+CREATE INDEX IF NOT EXISTS idx_task_time_entries_user_id_started_at ON task_time_entries (user_id, started_at);
+
+-- A user runs at most one timer at a time
+CREATE UNIQUE INDEX IF NOT EXISTS idx_task_time_entries_running_user_id ON task_time_entries (user_id) WHERE ended_at IS NULL;
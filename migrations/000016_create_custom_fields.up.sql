@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS custom_fields (
+    id           UUID             PRIMARY KEY,
+    workspace_id UUID             REFERENCES workspaces (id) ON DELETE CASCADE,
+    project_id   UUID             REFERENCES projects (id) ON DELETE CASCADE,
+    name         VARCHAR(100)     NOT NULL,
+    type         VARCHAR(20)      NOT NULL,
+    required     BOOLEAN          NOT NULL DEFAULT FALSE,
+    options      TEXT[]           NOT NULL DEFAULT '{}',
+    min_value    DOUBLE PRECISION,
+    max_value    DOUBLE PRECISION,
+    created_at   TIMESTAMPTZ      NOT NULL,
+    updated_at   TIMESTAMPTZ      NOT NULL,
+    CHECK ((workspace_id IS NULL) <> (project_id IS NULL))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_custom_fields_workspace_id_name ON custom_fields (workspace_id, lower(name)) WHERE workspace_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_custom_fields_project_id_name ON custom_fields (project_id, lower(name)) WHERE project_id IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS task_custom_field_values (
+    task_id    UUID        NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    field_id   UUID        NOT NULL REFERENCES custom_fields (id) ON DELETE CASCADE,
+    value      JSONB       NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (task_id, field_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_custom_field_values_field_id ON task_custom_field_values (field_id);
+CREATE INDEX IF NOT EXISTS idx_task_custom_field_values_value ON task_custom_field_values USING GIN (value jsonb_path_ops);
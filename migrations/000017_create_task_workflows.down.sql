@@ -0,0 +1,15 @@
+DROP TABLE IF EXISTS task_workflow_transitions;
+DROP TABLE IF EXISTS task_workflow_statuses;
+DROP TABLE IF EXISTS task_workflows;
+
+-- Map custom statuses back onto the default workflow by their category
+UPDATE tasks
+SET status = CASE status_category
+    WHEN 'done' THEN 'COMPLETED'
+    WHEN 'active' THEN 'IN_PROGRESS'
+    ELSE 'TODO'
+END
+WHERE status NOT IN ('TODO', 'IN_PROGRESS', 'COMPLETED');
+
+ALTER TABLE tasks
+    DROP COLUMN IF EXISTS status_category;
@@ -0,0 +1,48 @@
+-- Statuses become keys of a workflow, categories keep done and overdue checks independent of them
+ALTER TABLE tasks
+    DROP CONSTRAINT IF EXISTS tasks_status_check;
+
+ALTER TABLE tasks
+    ALTER COLUMN status TYPE VARCHAR(30) USING status::text;
+
+ALTER TABLE tasks
+    ADD COLUMN IF NOT EXISTS status_category VARCHAR(10) NOT NULL DEFAULT 'todo';
+
+-- Existing tasks follow the default workflow: TODO, IN_PROGRESS and COMPLETED
+UPDATE tasks
+SET status_category = CASE status
+    WHEN 'COMPLETED' THEN 'done'
+    WHEN 'IN_PROGRESS' THEN 'active'
+    ELSE 'todo'
+END;
+
+CREATE TABLE IF NOT EXISTS task_workflows (
+    id           UUID         PRIMARY KEY,
+    workspace_id UUID         REFERENCES workspaces (id) ON DELETE CASCADE,
+    project_id   UUID         REFERENCES projects (id) ON DELETE CASCADE,
+    name         VARCHAR(100) NOT NULL,
+    created_at   TIMESTAMPTZ  NOT NULL,
+    updated_at   TIMESTAMPTZ  NOT NULL,
+    CHECK ((workspace_id IS NULL) <> (project_id IS NULL))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_task_workflows_workspace_id ON task_workflows (workspace_id) WHERE workspace_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_task_workflows_project_id ON task_workflows (project_id) WHERE project_id IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS task_workflow_statuses (
+    workflow_id UUID        NOT NULL REFERENCES task_workflows (id) ON DELETE CASCADE,
+    key         VARCHAR(30) NOT NULL,
+    name        VARCHAR(50) NOT NULL,
+    category    VARCHAR(10) NOT NULL,
+    position    INTEGER     NOT NULL,
+    PRIMARY KEY (workflow_id, key)
+);
+
+CREATE TABLE IF NOT EXISTS task_workflow_transitions (
+    workflow_id UUID        NOT NULL,
+    from_status VARCHAR(30) NOT NULL,
+    to_status   VARCHAR(30) NOT NULL,
+    PRIMARY KEY (workflow_id, from_status, to_status),
+    FOREIGN KEY (workflow_id, from_status) REFERENCES task_workflow_statuses (workflow_id, key) ON DELETE CASCADE,
+    FOREIGN KEY (workflow_id, to_status) REFERENCES task_workflow_statuses (workflow_id, key) ON DELETE CASCADE
+);
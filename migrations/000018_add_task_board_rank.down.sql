@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_tasks_personal_board_rank;
+DROP INDEX IF EXISTS idx_tasks_workspace_board_rank;
+
+ALTER TABLE tasks
+    DROP COLUMN IF EXISTS board_rank;
@@ -0,0 +1,21 @@
+-- Board ranks compare as plain strings, the C collation keeps byte order
+ALTER TABLE tasks
+    ADD COLUMN IF NOT EXISTS board_rank VARCHAR(255) COLLATE "C";
+
+-- Existing tasks keep their listing order, newest first, within each board column
+UPDATE tasks
+SET board_rank = ranked.board_rank
+FROM (
+    SELECT id, lpad(to_hex(row_number() OVER (
+        PARTITION BY workspace_id, CASE WHEN workspace_id IS NULL THEN user_id END, status
+        ORDER BY created_at DESC, id DESC
+    )), 8, '0') || 'i' AS board_rank
+    FROM tasks
+) ranked
+WHERE tasks.id = ranked.id;
+
+ALTER TABLE tasks
+    ALTER COLUMN board_rank SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_workspace_board_rank ON tasks (workspace_id, status, board_rank) WHERE workspace_id IS NOT NULL AND deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_tasks_personal_board_rank ON tasks (user_id, status, board_rank) WHERE workspace_id IS NULL AND deleted_at IS NULL;
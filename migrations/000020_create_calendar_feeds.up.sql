@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS calendar_feeds (
+    user_id    UUID        PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    token_hash CHAR(64)    NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_calendar_feeds_token_hash ON calendar_feeds (token_hash);
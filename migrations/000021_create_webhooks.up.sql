@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS webhooks (
+    id                   UUID          PRIMARY KEY,
+    user_id              UUID          NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    workspace_id         UUID          NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+    url                  VARCHAR(2048) NOT NULL,
+    secret               VARCHAR(255)  NOT NULL,
+    events               TEXT[]        NOT NULL,
+    enabled              BOOLEAN       NOT NULL DEFAULT TRUE,
+    consecutive_failures INTEGER       NOT NULL DEFAULT 0,
+    disabled_at          TIMESTAMPTZ   NULL,
+    created_at           TIMESTAMPTZ   NOT NULL,
+    updated_at           TIMESTAMPTZ   NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhooks_user_id ON webhooks (user_id) WHERE workspace_id IS NULL;
+CREATE INDEX IF NOT EXISTS idx_webhooks_workspace_id ON webhooks (workspace_id) WHERE workspace_id IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id              UUID        PRIMARY KEY,
+    webhook_id      UUID        NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    event_id        UUID        NOT NULL,
+    event           VARCHAR(50) NOT NULL,
+    payload         TEXT        NOT NULL,
+    status          VARCHAR(20) NOT NULL,
+    attempts        INTEGER     NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NULL,
+    last_attempt_at TIMESTAMPTZ NULL,
+    response_status INTEGER     NULL,
+    last_error      TEXT        NULL,
+    redelivery_of   UUID        NULL REFERENCES webhook_deliveries (id) ON DELETE SET NULL,
+    created_at      TIMESTAMPTZ NOT NULL,
+    CONSTRAINT chk_webhook_deliveries_status CHECK (status IN ('PENDING', 'SUCCEEDED', 'FAILED'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_webhook_id_created_at ON webhook_deliveries (webhook_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_next_attempt_at ON webhook_deliveries (next_attempt_at) WHERE status = 'PENDING';